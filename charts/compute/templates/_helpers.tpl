@@ -17,6 +17,10 @@ v{{ .Chart.Version }}
 {{- .Values.networkConsumer.image | default (printf "%s/unikorn-compute-network-consumer:%s" (include "unikorn.defaultRepositoryPath" .) (.Values.tag | default (include "unikorn.defaultTag" .))) }}
 {{- end }}
 
+{{- define "unikorn.projectConsumerImage" -}}
+{{- .Values.projectConsumer.image | default (printf "%s/unikorn-compute-project-consumer:%s" (include "unikorn.defaultRepositoryPath" .) (.Values.tag | default (include "unikorn.defaultTag" .))) }}
+{{- end }}
+
 {{- define "unikorn.computeServerImage" -}}
 {{- .Values.server.image | default (printf "%s/unikorn-compute-server:%s" (include "unikorn.defaultRepositoryPath" .) (.Values.tag | default (include "unikorn.defaultTag" .))) }}
 {{- end }}
@@ -19,9 +19,14 @@ package main
 
 import (
 	"github.com/unikorn-cloud/compute/pkg/managers/instance"
+	"github.com/unikorn-cloud/compute/pkg/metrics"
 	"github.com/unikorn-cloud/core/pkg/manager"
+
+	ctrlmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
 )
 
 func main() {
+	metrics.MustRegister(ctrlmetrics.Registry)
+
 	manager.Run(&instance.Factory{})
 }
@@ -25,6 +25,7 @@ import (
 
 	computev1 "github.com/unikorn-cloud/compute/pkg/apis/unikorn/v1alpha1"
 	"github.com/unikorn-cloud/compute/pkg/constants"
+	"github.com/unikorn-cloud/compute/pkg/health"
 	"github.com/unikorn-cloud/core/pkg/client"
 	coreconstants "github.com/unikorn-cloud/core/pkg/constants"
 	"github.com/unikorn-cloud/core/pkg/messaging/consumer"
@@ -40,7 +41,10 @@ import (
 func main() {
 	var options options.CoreOptions
 
+	var healthOptions health.Options
+
 	options.AddFlags(pflag.CommandLine)
+	healthOptions.AddFlags(pflag.CommandLine)
 
 	pflag.Parse()
 
@@ -59,6 +63,16 @@ func main() {
 		os.Exit(1)
 	}
 
+	checker := health.New(&healthOptions)
+	checker.AddLivenessCheck("kubernetes", health.KubernetesCheck(cli, options.Namespace, &computev1.ComputeClusterList{}))
+
+	go func() {
+		if err := checker.Start(ctx); err != nil {
+			fmt.Println(err)
+			os.Exit(1)
+		}
+	}()
+
 	deleteInstanceConsumer := consumer.NewCascadingDelete(cli, &computev1.ComputeInstanceList{}, consumer.WithNamespace(options.Namespace), consumer.WithResourceLabel(regionconstants.NetworkLabel))
 	deleteClusterConsumer := consumer.NewCascadingDelete(cli, &computev1.ComputeClusterList{}, consumer.WithNamespace(options.Namespace), consumer.WithResourceLabel(coreconstants.NetworkLabel))
 
@@ -31,7 +31,6 @@ import (
 	unikornv1 "github.com/unikorn-cloud/compute/pkg/apis/unikorn/v1alpha1"
 	"github.com/unikorn-cloud/compute/pkg/constants"
 	"github.com/unikorn-cloud/compute/pkg/server"
-	"github.com/unikorn-cloud/core/pkg/client"
 
 	"sigs.k8s.io/controller-runtime/pkg/log"
 )
@@ -61,7 +60,7 @@ func start() {
 		return
 	}
 
-	client, err := client.New(ctx, unikornv1.AddToScheme)
+	client, err := server.NewClient(ctx, unikornv1.AddToScheme)
 	if err != nil {
 		logger.Error(err, "failed to create client")
 
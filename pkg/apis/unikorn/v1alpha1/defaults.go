@@ -0,0 +1,72 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"time"
+
+	unikornv1core "github.com/unikorn-cloud/core/pkg/apis/unikorn/v1alpha1"
+)
+
+const (
+	// DefaultTargetCPUUtilization is used when an autoscaled pool doesn't specify
+	// a target, and matches the API default.
+	DefaultTargetCPUUtilization = 70
+
+	// DefaultAutoHealingGracePeriod is used when an auto healing pool doesn't specify
+	// a grace period, and matches the API default.
+	DefaultAutoHealingGracePeriod = 600 * time.Second
+
+	// DefaultMaxUnavailable is used when an update strategy doesn't specify how many
+	// machines may be unavailable, and matches the API default.
+	DefaultMaxUnavailable = 1
+)
+
+// Default fills in any unset fields with the same values the API would use, so
+// resources created directly are stored identically to those created via the API.
+// The network is the operator configured default, and is copied if used.
+func (s *ComputeClusterSpec) Default(network *unikornv1core.NetworkGeneric) {
+	if s.Network == nil {
+		if network != nil {
+			s.Network = network.DeepCopy()
+		}
+	} else if len(s.Network.DNSNameservers) == 0 && network != nil {
+		s.Network.DNSNameservers = append(s.Network.DNSNameservers, network.DNSNameservers...)
+	}
+
+	if s.WorkloadPools == nil {
+		return
+	}
+
+	for i := range s.WorkloadPools.Pools {
+		pool := &s.WorkloadPools.Pools[i]
+
+		if pool.Autoscaling != nil && pool.Autoscaling.TargetCPUUtilization == 0 {
+			pool.Autoscaling.TargetCPUUtilization = DefaultTargetCPUUtilization
+		}
+
+		if pool.AutoHealing != nil && pool.AutoHealing.GracePeriod.Duration == 0 {
+			pool.AutoHealing.GracePeriod.Duration = DefaultAutoHealingGracePeriod
+		}
+
+		// Both being zero is invalid, so treat it as unset.
+		if pool.UpdateStrategy != nil && pool.UpdateStrategy.MaxUnavailable == 0 && pool.UpdateStrategy.MaxSurge == 0 {
+			pool.UpdateStrategy.MaxUnavailable = DefaultMaxUnavailable
+		}
+	}
+}
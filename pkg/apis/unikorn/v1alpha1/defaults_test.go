@@ -0,0 +1,80 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1_test
+
+import (
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	unikornv1 "github.com/unikorn-cloud/compute/pkg/apis/unikorn/v1alpha1"
+	unikornv1core "github.com/unikorn-cloud/core/pkg/apis/unikorn/v1alpha1"
+)
+
+// TestClusterDefault checks unset fields are defaulted, and set ones are left alone.
+func TestClusterDefault(t *testing.T) {
+	t.Parallel()
+
+	network := &unikornv1core.NetworkGeneric{
+		NodeNetwork:    mustPrefix(t, "10.0.0.0/24"),
+		DNSNameservers: unikornv1core.IPv4AddressSliceFromIPSlice([]net.IP{net.ParseIP("1.1.1.1")}),
+	}
+
+	spec := &unikornv1.ComputeClusterSpec{
+		WorkloadPools: &unikornv1.ComputeClusterWorkloadPoolsSpec{
+			Pools: []unikornv1.ComputeClusterWorkloadPoolSpec{
+				{
+					Name:           "defaulted",
+					Autoscaling:    &unikornv1.WorkloadPoolAutoscalingSpec{},
+					AutoHealing:    &unikornv1.WorkloadPoolAutoHealingSpec{},
+					UpdateStrategy: &unikornv1.WorkloadPoolUpdateStrategy{},
+				},
+				{
+					Name: "explicit",
+					UpdateStrategy: &unikornv1.WorkloadPoolUpdateStrategy{
+						MaxSurge: 1,
+					},
+				},
+			},
+		},
+	}
+
+	spec.Default(network)
+
+	require.Equal(t, network, spec.Network)
+	require.NotSame(t, network, spec.Network)
+
+	pool := spec.WorkloadPools.Pools[0]
+	require.Equal(t, unikornv1.DefaultTargetCPUUtilization, pool.Autoscaling.TargetCPUUtilization)
+	require.Equal(t, unikornv1.DefaultAutoHealingGracePeriod, pool.AutoHealing.GracePeriod.Duration)
+	require.Equal(t, unikornv1.DefaultMaxUnavailable, pool.UpdateStrategy.MaxUnavailable)
+
+	pool = spec.WorkloadPools.Pools[1]
+	require.Equal(t, 0, pool.UpdateStrategy.MaxUnavailable)
+
+	// Nameservers are filled in if missing, but an explicit network is kept.
+	spec.Network = &unikornv1core.NetworkGeneric{
+		NodeNetwork: mustPrefix(t, "172.16.0.0/16"),
+	}
+
+	spec.Default(network)
+
+	require.Equal(t, "172.16.0.0/16", spec.Network.NodeNetwork.String())
+	require.Equal(t, network.DNSNameservers, spec.Network.DNSNameservers)
+}
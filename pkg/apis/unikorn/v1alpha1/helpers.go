@@ -36,6 +36,41 @@ var (
 	ErrApplicationLookup = errors.New("failed to lookup an application")
 )
 
+// updateStandardConditions derives the Ready, Progressing and Degraded conditions
+// expected by generic Kubernetes tooling from the Available condition, written at
+// the end of every reconcile, and the Healthy condition, if set by the provisioner.
+func updateStandardConditions(conditions *[]unikornv1core.Condition, status corev1.ConditionStatus, reason unikornv1core.ConditionReason, message string) {
+	progressing := corev1.ConditionFalse
+
+	if reason == unikornv1core.ConditionReasonProvisioning || reason == unikornv1core.ConditionReasonDeprovisioning {
+		progressing = corev1.ConditionTrue
+	}
+
+	unikornv1core.UpdateCondition(conditions, ConditionProgressing, progressing, reason, message)
+
+	degraded := corev1.ConditionFalse
+	degradedReason := reason
+	degradedMessage := message
+
+	if reason == unikornv1core.ConditionReasonErrored || reason == unikornv1core.ConditionReasonCancelled {
+		degraded = corev1.ConditionTrue
+	} else if health, err := unikornv1core.GetCondition(*conditions, unikornv1core.ConditionHealthy); err == nil && health.Status == corev1.ConditionFalse {
+		degraded = corev1.ConditionTrue
+		degradedReason = health.Reason
+		degradedMessage = health.Message
+	}
+
+	unikornv1core.UpdateCondition(conditions, ConditionDegraded, degraded, degradedReason, degradedMessage)
+
+	if status == corev1.ConditionTrue && degraded == corev1.ConditionTrue {
+		unikornv1core.UpdateCondition(conditions, ConditionReady, corev1.ConditionFalse, degradedReason, degradedMessage)
+
+		return
+	}
+
+	unikornv1core.UpdateCondition(conditions, ConditionReady, status, reason, message)
+}
+
 // Paused implements the ReconcilePauser interface.
 func (c *ComputeCluster) Paused() bool {
 	return c.Spec.Pause
@@ -52,6 +87,12 @@ func (c *ComputeCluster) StatusConditionRead(t unikornv1core.ConditionType) (*un
 // ignored.
 func (c *ComputeCluster) StatusConditionWrite(t unikornv1core.ConditionType, status corev1.ConditionStatus, reason unikornv1core.ConditionReason, message string) {
 	unikornv1core.UpdateCondition(&c.Status.Conditions, t, status, reason, message)
+
+	if t == unikornv1core.ConditionAvailable {
+		updateStandardConditions(&c.Status.Conditions, status, reason, message)
+
+		c.Status.ObservedGeneration = c.Generation
+	}
 }
 
 // ResourceLabels generates a set of labels to uniquely identify the resource
@@ -90,6 +131,32 @@ func (c *ComputeCluster) GetWorkloadPool(name string) (*ComputeClusterWorkloadPo
 	return nil, false
 }
 
+// GetAutoscalingStatus looks up the autoscaling status of a workload pool by name.
+func (c *ComputeCluster) GetAutoscalingStatus(name string) (*WorkloadPoolAutoscalingStatus, bool) {
+	for i := range c.Status.Autoscaling {
+		status := &c.Status.Autoscaling[i]
+
+		if status.Name == name {
+			return status, true
+		}
+	}
+
+	return nil, false
+}
+
+// GetSecurityGroupStatus looks up the security group managed for a workload pool by name.
+func (c *ComputeCluster) GetSecurityGroupStatus(name string) (*WorkloadPoolSecurityGroupStatus, bool) {
+	for i := range c.Status.SecurityGroups {
+		status := &c.Status.SecurityGroups[i]
+
+		if status.Name == name {
+			return status, true
+		}
+	}
+
+	return nil, false
+}
+
 // HasFirewallRules tells us if the pool as an firewall rules defined.
 func (p *ComputeClusterWorkloadPoolSpec) HasFirewallRules() bool {
 	return len(p.Firewall) > 0
@@ -111,6 +178,12 @@ func (c *ComputeInstance) StatusConditionRead(t unikornv1core.ConditionType) (*u
 // ignored.
 func (c *ComputeInstance) StatusConditionWrite(t unikornv1core.ConditionType, status corev1.ConditionStatus, reason unikornv1core.ConditionReason, message string) {
 	unikornv1core.UpdateCondition(&c.Status.Conditions, t, status, reason, message)
+
+	if t == unikornv1core.ConditionAvailable {
+		updateStandardConditions(&c.Status.Conditions, status, reason, message)
+
+		c.Status.ObservedGeneration = c.Generation
+	}
 }
 
 // ResourceLabels generates a set of labels to uniquely identify the resource
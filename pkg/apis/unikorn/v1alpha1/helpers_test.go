@@ -0,0 +1,68 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	unikornv1 "github.com/unikorn-cloud/compute/pkg/apis/unikorn/v1alpha1"
+	unikornv1core "github.com/unikorn-cloud/core/pkg/apis/unikorn/v1alpha1"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+func requireCondition(t *testing.T, instance *unikornv1.ComputeInstance, conditionType unikornv1core.ConditionType, status corev1.ConditionStatus) {
+	t.Helper()
+
+	condition, err := instance.StatusConditionRead(conditionType)
+	require.NoError(t, err)
+	require.Equal(t, status, condition.Status, conditionType)
+}
+
+// TestStandardConditions ensures standard conditions track the reconcile outcome
+// and resource health.
+func TestStandardConditions(t *testing.T) {
+	t.Parallel()
+
+	instance := &unikornv1.ComputeInstance{}
+	instance.Generation = 3
+
+	instance.StatusConditionWrite(unikornv1core.ConditionAvailable, corev1.ConditionFalse, unikornv1core.ConditionReasonProvisioning, "Provisioning")
+	requireCondition(t, instance, unikornv1.ConditionReady, corev1.ConditionFalse)
+	requireCondition(t, instance, unikornv1.ConditionProgressing, corev1.ConditionTrue)
+	requireCondition(t, instance, unikornv1.ConditionDegraded, corev1.ConditionFalse)
+	require.Equal(t, int64(3), instance.Status.ObservedGeneration)
+
+	instance.StatusConditionWrite(unikornv1core.ConditionAvailable, corev1.ConditionTrue, unikornv1core.ConditionReasonProvisioned, "Provisioned")
+	requireCondition(t, instance, unikornv1.ConditionReady, corev1.ConditionTrue)
+	requireCondition(t, instance, unikornv1.ConditionProgressing, corev1.ConditionFalse)
+	requireCondition(t, instance, unikornv1.ConditionDegraded, corev1.ConditionFalse)
+
+	unikornv1core.UpdateCondition(&instance.Status.Conditions, unikornv1core.ConditionHealthy, corev1.ConditionFalse, unikornv1core.ConditionReasonDegraded, "server error")
+
+	instance.StatusConditionWrite(unikornv1core.ConditionAvailable, corev1.ConditionTrue, unikornv1core.ConditionReasonProvisioned, "Provisioned")
+	requireCondition(t, instance, unikornv1.ConditionReady, corev1.ConditionFalse)
+	requireCondition(t, instance, unikornv1.ConditionDegraded, corev1.ConditionTrue)
+
+	instance.StatusConditionWrite(unikornv1core.ConditionAvailable, corev1.ConditionFalse, unikornv1core.ConditionReasonErrored, "Unhandled error")
+	requireCondition(t, instance, unikornv1.ConditionReady, corev1.ConditionFalse)
+	requireCondition(t, instance, unikornv1.ConditionProgressing, corev1.ConditionFalse)
+	requireCondition(t, instance, unikornv1.ConditionDegraded, corev1.ConditionTrue)
+}
@@ -50,6 +50,10 @@ var (
 func init() {
 	SchemeBuilder.Register(&ComputeCluster{}, &ComputeClusterList{})
 	SchemeBuilder.Register(&ComputeInstance{}, &ComputeInstanceList{})
+	SchemeBuilder.Register(&ComputeClusterTemplate{}, &ComputeClusterTemplateList{})
+	SchemeBuilder.Register(&ComputeMaintenanceWindow{}, &ComputeMaintenanceWindowList{})
+	SchemeBuilder.Register(&ComputeBootstrapProfile{}, &ComputeBootstrapProfileList{})
+	SchemeBuilder.Register(&ComputeWebhook{}, &ComputeWebhookList{})
 }
 
 // Resource maps a resource type to a group resource.
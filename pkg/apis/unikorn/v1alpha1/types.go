@@ -30,16 +30,90 @@ type ComputeClusterWorkloadPoolSpec struct {
 	unikornv1core.MachineGeneric `json:",inline"`
 	// Name is the name of the pool.
 	Name string `json:"name"`
+	// HostnamePrefix, if set, is used in place of the pool name when generating
+	// server host names.  Existing servers retain their host names.
+	// +kubebuilder:validation:MaxLength=56
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	HostnamePrefix string `json:"hostnamePrefix,omitempty"`
+	// Domain, if set, is the DNS domain the pool's servers belong to, their
+	// fully qualified domain name being their host name within it.
+	Domain string `json:"domain,omitempty"`
 	// PublicIPAllocation is the workload pool public IP allocation configuration.
 	PublicIPAllocation *PublicIPAllocationSpec `json:"publicIpAllocation,omitempty"`
 	// Firewall is the workload pool firewall configuration.
 	Firewall []FirewallRule `json:"firewall,omitempty"`
 	// UserData contains configuration information or scripts to use upon launch.
 	UserData []byte `json:"userData,omitempty"`
+	// UserDataTemplate, when true, expands UserData as a Go template for each
+	// machine.  Available variables are .ClusterID, .ClusterName, .PoolName,
+	// .MachineName, .FQDN and .PrivateNetworkCIDR.
+	UserDataTemplate bool `json:"userDataTemplate,omitempty"`
 	// ImageSelector is the image selector to use for the pool.
 	ImageSelector *ComputeWorkloadPoolImageSelector `json:"imageSelector,omitempty"`
 	// AllowedAddressPairs is a list of allowed address pairs for the network interface. This will allow multiple MAC/IP address (range) pairs to pass through this port.
 	AllowedAddressPairs []ComputeWorkloadPoolAddressPair `json:"allowedAddressPairs,omitempty"`
+	// SecurityGroupIDs are pre-existing security groups to attach to servers
+	// in addition to the managed group generated from the firewall rules.
+	SecurityGroupIDs []string `json:"securityGroupIds,omitempty"`
+	// Autoscaling, if set, allows the monitor to adjust the pool's replicas
+	// between the given bounds based on observed CPU utilization.
+	Autoscaling *WorkloadPoolAutoscalingSpec `json:"autoscaling,omitempty"`
+	// AutoHealing, if set, replaces servers that remain unhealthy for
+	// longer than the grace period.
+	AutoHealing *WorkloadPoolAutoHealingSpec `json:"autoHealing,omitempty"`
+	// UpdateStrategy, if set, limits how many servers are rebuilt or resized
+	// at once when the pool's image or flavor changes.  When not set all
+	// servers are updated at once.
+	UpdateStrategy *WorkloadPoolUpdateStrategy `json:"updateStrategy,omitempty"`
+	// GoldenImage, if set, records that the pool's image is a snapshot of an
+	// instance.  Servers are not reconciled until the snapshot is ready.
+	GoldenImage *WorkloadPoolGoldenImage `json:"goldenImage,omitempty"`
+	// Labels are applied as tags to the pool's servers, taking precedence
+	// over cluster tags of the same name.
+	Labels unikornv1core.TagList `json:"labels,omitempty"`
+	// BootstrapProfile, if set, is the name of a bootstrap profile whose
+	// user data is run before the pool's own e.g. to install GPU drivers.
+	BootstrapProfile string `json:"bootstrapProfile,omitempty"`
+}
+
+type WorkloadPoolGoldenImage struct {
+	// InstanceID is the instance that was snapshotted.
+	InstanceID string `json:"instanceId"`
+	// ImageID is the resulting snapshot image.
+	ImageID string `json:"imageId"`
+}
+
+type WorkloadPoolUpdateStrategy struct {
+	// MaxUnavailable is the number of servers that may be unavailable
+	// during an update.
+	// +kubebuilder:validation:Minimum=0
+	MaxUnavailable int `json:"maxUnavailable,omitempty"`
+	// MaxSurge is the number of servers that may be created above the
+	// desired replica count during an update, so replacements can be
+	// brought up before the servers they replace are deleted.
+	// +kubebuilder:validation:Minimum=0
+	MaxSurge int `json:"maxSurge,omitempty"`
+}
+
+type WorkloadPoolAutoHealingSpec struct {
+	// GracePeriod is how long a server must be continuously unhealthy
+	// before it is deleted and recreated.
+	GracePeriod metav1.Duration `json:"gracePeriod"`
+}
+
+type WorkloadPoolAutoscalingSpec struct {
+	// MinReplicas is the lower bound the pool may be scaled to.
+	// +kubebuilder:validation:Minimum=0
+	MinReplicas int `json:"minReplicas"`
+	// MaxReplicas is the upper bound the pool may be scaled to.
+	// +kubebuilder:validation:Minimum=1
+	MaxReplicas int `json:"maxReplicas"`
+	// TargetCPUUtilization is the average CPU utilization percentage
+	// across the pool that the autoscaler will attempt to maintain.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	// +kubebuilder:default=70
+	TargetCPUUtilization int `json:"targetCpuUtilization,omitempty"`
 }
 
 type ComputeWorkloadPoolAddressPair struct {
@@ -117,6 +191,7 @@ type ComputeClusterList struct {
 // +kubebuilder:subresource:status
 // +kubebuilder:printcolumn:name="display name",type="string",JSONPath=".metadata.labels['unikorn-cloud\\.org/name']"
 // +kubebuilder:printcolumn:name="status",type="string",JSONPath=".status.conditions[?(@.type==\"Available\")].reason"
+// +kubebuilder:printcolumn:name="ready",type="string",JSONPath=".status.conditions[?(@.type==\"Ready\")].status"
 // +kubebuilder:printcolumn:name="age",type="date",JSONPath=".metadata.creationTimestamp"
 type ComputeCluster struct {
 	metav1.TypeMeta   `json:",inline"`
@@ -129,6 +204,11 @@ type ComputeCluster struct {
 type ComputeClusterSpec struct {
 	// Pause, if true, will inhibit reconciliation.
 	Pause bool `json:"pause,omitempty"`
+	// MaintenanceMode, if true, stops servers from being created, deleted,
+	// rebuilt or resized e.g. during region maintenance.  Unlike Pause, the
+	// status continues to reflect the observed state of the servers.
+	// TODO: V1 delete me.
+	MaintenanceMode bool `json:"maintenanceMode,omitempty"`
 	// Tags are aribrary user data.
 	Tags unikornv1core.TagList `json:"tags,omitempty"`
 	// Region to provision the cluster in.
@@ -142,6 +222,21 @@ type ComputeClusterSpec struct {
 	WorkloadPools *ComputeClusterWorkloadPoolsSpec `json:"workloadPools,omitempty"`
 	// Pools of instances.
 	Pools []InstancePoolSpec `json:"pools,omitempty"`
+	// Machines records changes made to individual machines that override
+	// the configuration of their workload pool.
+	// TODO: V1 delete me.
+	Machines []ComputeClusterMachineSpec `json:"machines,omitempty"`
+}
+
+type ComputeClusterMachineSpec struct {
+	// ID is the server ID.
+	ID string `json:"id"`
+	// Pool is the workload pool the machine belongs to.
+	Pool string `json:"pool"`
+	// FlavorID, if set, overrides the pool's flavor.
+	FlavorID *string `json:"flavorId,omitempty"`
+	// PublicIP, if set, overrides whether the pool allocates a public IP.
+	PublicIP *bool `json:"publicIp,omitempty"`
 }
 
 type InstancePoolSpec struct {
@@ -171,10 +266,131 @@ type ComputeClusterStatus struct {
 	// WorkloadPools is the status of all pools.
 	// TODO: V1 delete me.
 	WorkloadPools []WorkloadPoolStatus `json:"workloadpools,omitempty"`
+	// Evictions reports the progress of the most recent eviction request.
+	// TODO: V1 delete me.
+	Evictions []MachineEvictionStatus `json:"evictions,omitempty"`
+	// EvictionRequest records the deletion hint most recently acted upon by
+	// the controller.  The API owns the hint annotation, so the controller
+	// records its consumption here rather than modifying metadata.
+	// TODO: V1 delete me.
+	EvictionRequest string `json:"evictionRequest,omitempty"`
+	// DetachmentRequest records the detachment annotation most recently acted
+	// upon by the controller, in the same way as EvictionRequest.
+	// TODO: V1 delete me.
+	DetachmentRequest string `json:"detachmentRequest,omitempty"`
+	// AdoptionRequest records the adoption annotation most recently acted
+	// upon by the controller, in the same way as EvictionRequest.
+	// TODO: V1 delete me.
+	AdoptionRequest string `json:"adoptionRequest,omitempty"`
+	// ObservedGeneration is the generation of the spec last reconciled,
+	// and that the conditions describe.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// Current service state of a Compute cluster.
 	Conditions []unikornv1core.Condition `json:"conditions,omitempty"`
 	// Pools are the pool statuses.
 	Pools []InstancePoolStatus `json:"pools,omitempty"`
+	// Autoscaling records the last scaling decision for each autoscaled pool.
+	// This is maintained by the monitor, not the controller.
+	// TODO: V1 delete me.
+	Autoscaling []WorkloadPoolAutoscalingStatus `json:"autoscaling,omitempty"`
+	// Cancellation is set when the update to the current generation was
+	// cancelled before it completed.
+	// TODO: V1 delete me.
+	Cancellation *ComputeClusterCancellationStatus `json:"cancellation,omitempty"`
+	// Quota records the last verification of the cluster's quota allocation.
+	// This is maintained by the monitor, not the controller.
+	Quota *QuotaStatus `json:"quota,omitempty"`
+	// PhoneHomeToken is used to sign the URLs machines call on boot completion,
+	// this is only set when phone home is enabled.
+	// TODO: V1 delete me.
+	PhoneHomeToken *string `json:"phoneHomeToken,omitempty"`
+	// SecurityGroups records the security groups managed for each pool, so they
+	// can be referenced by users.
+	// TODO: V1 delete me.
+	SecurityGroups []WorkloadPoolSecurityGroupStatus `json:"securityGroups,omitempty"`
+	// MachineTracking records what the controller needs to remember about
+	// individual machines between reconciles.
+	// TODO: V1 delete me.
+	MachineTracking []MachineTrackingStatus `json:"machineTracking,omitempty"`
+}
+
+type MachineTrackingStatus struct {
+	// ID is the server ID.
+	ID string `json:"id"`
+	// Pool is the workload pool the machine belongs to, this is recorded
+	// along with any inherited flavor.
+	Pool string `json:"pool,omitempty"`
+	// FlavorID is a flavor override inherited from the machine this one
+	// replaced, when that was rebuilt or healed.
+	FlavorID *string `json:"flavorId,omitempty"`
+	// UnhealthySince is when the machine was first observed as unhealthy.
+	UnhealthySince *metav1.Time `json:"unhealthySince,omitempty"`
+	// TransitioningSince is when the machine was first observed in a
+	// transitional state e.g. stopping, or verifying a resize.
+	TransitioningSince *metav1.Time `json:"transitioningSince,omitempty"`
+	// Resize tracks an in place resize while it's in progress.
+	Resize *MachineResizeStatus `json:"resize,omitempty"`
+}
+
+// +kubebuilder:validation:Enum=Stopping;Resizing
+type MachineResizePhase string
+
+const (
+	// MachineResizePhaseStopping waits for the machine to stop.
+	MachineResizePhaseStopping MachineResizePhase = "Stopping"
+	// MachineResizePhaseResizing waits for the region to complete the resize.
+	MachineResizePhaseResizing MachineResizePhase = "Resizing"
+)
+
+type MachineResizeStatus struct {
+	// FlavorID is the flavor being resized to.
+	FlavorID string `json:"flavorId"`
+	// Phase is how far the resize has progressed.
+	Phase MachineResizePhase `json:"phase"`
+	// Restart records that the machine was powered on before the resize,
+	// so should be started again once it has completed.
+	Restart bool `json:"restart,omitempty"`
+}
+
+type ComputeClusterCancellationStatus struct {
+	// Generation is the cluster generation whose update was cancelled.
+	Generation int64 `json:"generation"`
+	// Message summarizes how far the update progressed before cancellation.
+	Message string `json:"message"`
+}
+
+type QuotaStatus struct {
+	// Drifted is true when the quota allocation held by the identity
+	// service does not match that required by the resource.
+	Drifted bool `json:"drifted,omitempty"`
+	// Message describes any drift that was detected, and whether it
+	// was repaired.
+	Message string `json:"message,omitempty"`
+	// LastCheckTime is when the allocation was last verified.
+	LastCheckTime metav1.Time `json:"lastCheckTime"`
+}
+
+type WorkloadPoolAutoscalingStatus struct {
+	// Name of the workload pool.
+	Name string `json:"name"`
+	// LastScaleTime is when the pool was last scaled.
+	LastScaleTime metav1.Time `json:"lastScaleTime"`
+	// PreviousReplicas is the replica count before the last scaling decision.
+	PreviousReplicas int `json:"previousReplicas"`
+	// DesiredReplicas is the replica count chosen by the last scaling decision.
+	DesiredReplicas int `json:"desiredReplicas"`
+	// CPUUtilization is the average CPU utilization percentage that
+	// triggered the last scaling decision, if one was observed.
+	CPUUtilization *int `json:"cpuUtilization,omitempty"`
+	// Message describes why the last scaling decision was made.
+	Message string `json:"message,omitempty"`
+}
+
+type WorkloadPoolSecurityGroupStatus struct {
+	// Name of the workload pool.
+	Name string `json:"name"`
+	// ID of the security group.
+	ID string `json:"id"`
 }
 
 type InstancePoolStatus struct {
@@ -193,6 +409,54 @@ type WorkloadPoolStatus struct {
 	Machines []MachineStatus `json:"machines,omitempty"`
 }
 
+const (
+	// ConditionReady follows Kubernetes conventions and is true when the resource
+	// is provisioned and not degraded, this allows "kubectl wait --for=condition=Ready".
+	ConditionReady unikornv1core.ConditionType = "Ready"
+
+	// ConditionProgressing is true while the resource is being provisioned or
+	// deprovisioned.
+	ConditionProgressing unikornv1core.ConditionType = "Progressing"
+
+	// ConditionDegraded is true when provisioning has failed or the resource
+	// is reported as unhealthy.
+	ConditionDegraded unikornv1core.ConditionType = "Degraded"
+)
+
+const (
+	// ConditionMaintenance is reported on machines, and their cluster, while under
+	// provider maintenance.
+	ConditionMaintenance unikornv1core.ConditionType = "Maintenance"
+
+	// ConditionReasonMaintenance indicates provider maintenance is in progress.
+	ConditionReasonMaintenance unikornv1core.ConditionReason = "ProviderMaintenance"
+
+	// ConditionMaintenanceMode is reported on clusters while they are in
+	// maintenance mode, and servers are not being reconciled.
+	ConditionMaintenanceMode unikornv1core.ConditionType = "MaintenanceMode"
+
+	// ConditionReasonMaintenanceMode indicates the cluster is in maintenance mode.
+	ConditionReasonMaintenanceMode unikornv1core.ConditionReason = "MaintenanceMode"
+)
+
+const (
+	// ConditionIdentityReady is reported on clusters and is true once the cloud
+	// identity has been provisioned by the region.
+	ConditionIdentityReady unikornv1core.ConditionType = "IdentityReady"
+
+	// ConditionNetworkReady is reported on clusters and is true once the
+	// cluster network has been provisioned by the region.
+	ConditionNetworkReady unikornv1core.ConditionType = "NetworkReady"
+
+	// ConditionSecurityGroupsReady is reported on clusters and is true once
+	// all pool security groups have been reconciled.
+	ConditionSecurityGroupsReady unikornv1core.ConditionType = "SecurityGroupsReady"
+
+	// ConditionServersReady is reported on clusters and is true once all
+	// servers have been reconciled and any rollouts have completed.
+	ConditionServersReady unikornv1core.ConditionType = "ServersReady"
+)
+
 type MachineStatus struct {
 	// ID is the unique identifier of the machine.
 	ID string `json:"id"`
@@ -210,8 +474,33 @@ type MachineStatus struct {
 	PublicIP *string `json:"publicIp,omitempty"`
 	// Status is the current status of the machine.
 	Status unikornv1region.InstanceLifecyclePhase `json:"status"`
+	// Cordoned machines are excluded from updates, rebuilds and scale down.
+	Cordoned bool `json:"cordoned,omitempty"`
+	// Labels are the server's tags, excluding system tags.
+	Labels unikornv1core.TagList `json:"labels,omitempty"`
 	// Conditions is a set of status conditions for the machine.
 	Conditions []unikornv1core.Condition `json:"conditions,omitempty"`
+	// CreationTime is when the machine was created.
+	CreationTime *metav1.Time `json:"creationTime,omitempty"`
+}
+
+// +kubebuilder:validation:Enum=Pending;Deleting;Deleted;Failed
+type MachineEvictionPhase string
+
+const (
+	MachineEvictionPhasePending  MachineEvictionPhase = "Pending"
+	MachineEvictionPhaseDeleting MachineEvictionPhase = "Deleting"
+	MachineEvictionPhaseDeleted  MachineEvictionPhase = "Deleted"
+	MachineEvictionPhaseFailed   MachineEvictionPhase = "Failed"
+)
+
+type MachineEvictionStatus struct {
+	// ID is the unique identifier of the machine.
+	ID string `json:"id"`
+	// Phase is the current state of the eviction.
+	Phase MachineEvictionPhase `json:"phase"`
+	// Message gives additional detail when the eviction has failed.
+	Message *string `json:"message,omitempty"`
 }
 
 // ComputeInstanceList is a typed list of instances.
@@ -229,6 +518,7 @@ type ComputeInstanceList struct {
 // +kubebuilder:subresource:status
 // +kubebuilder:printcolumn:name="display name",type="string",JSONPath=".metadata.labels['unikorn-cloud\\.org/name']"
 // +kubebuilder:printcolumn:name="status",type="string",JSONPath=".status.conditions[?(@.type==\"Available\")].reason"
+// +kubebuilder:printcolumn:name="ready",type="string",JSONPath=".status.conditions[?(@.type==\"Ready\")].status"
 // +kubebuilder:printcolumn:name="age",type="date",JSONPath=".metadata.creationTimestamp"
 type ComputeInstance struct {
 	metav1.TypeMeta   `json:",inline"`
@@ -248,6 +538,38 @@ type ComputeInstanceSpec struct {
 	// UserData is passed to cloud-init and may be a script, a multipart MIME archive etc.
 	// as permitted by the cloud-init specification.
 	UserData []byte `json:"userData,omitempty"`
+	// PowerSchedule, if set, powers the instance on and off at set times.
+	PowerSchedule *ComputeInstancePowerSchedule `json:"powerSchedule,omitempty"`
+	// SnapshotPolicy, if set, periodically snapshots the instance.
+	SnapshotPolicy *ComputeInstanceSnapshotPolicy `json:"snapshotPolicy,omitempty"`
+	// BootstrapProfile, if set, is the name of a bootstrap profile whose
+	// user data is run before the instance's own e.g. to install GPU drivers.
+	BootstrapProfile string `json:"bootstrapProfile,omitempty"`
+}
+
+type ComputeInstancePowerSchedule struct {
+	// Start is a standard 5 field cron expression defining when the
+	// instance is powered on.
+	Start string `json:"start,omitempty"`
+	// Stop is a standard 5 field cron expression defining when the
+	// instance is powered off.
+	Stop string `json:"stop,omitempty"`
+	// TimeZone is the IANA time zone the schedule is evaluated in,
+	// defaulting to UTC.
+	TimeZone string `json:"timeZone,omitempty"`
+}
+
+type ComputeInstanceSnapshotPolicy struct {
+	// Schedule is a standard 5 field cron expression defining when the
+	// instance is snapshotted.
+	Schedule string `json:"schedule"`
+	// TimeZone is the IANA time zone the schedule is evaluated in,
+	// defaulting to UTC.
+	TimeZone string `json:"timeZone,omitempty"`
+	// Retention is the number of scheduled snapshots to keep, the oldest
+	// are deleted once this is exceeded.
+	// +kubebuilder:validation:Minimum=1
+	Retention int `json:"retention"`
 }
 
 type ComputeInstanceNetworking struct {
@@ -258,7 +580,9 @@ type ComputeInstanceNetworking struct {
 	SecurityGroupIDs []string `json:"securityGroupIDs,omitempty"`
 	// AllowedSourceAddresses defines a set of network prefixes that are
 	// allowed to egress from the instance.  For use where the instance is
-	// being used as a router for NFV.
+	// being used as a router for NFV.  These are passed to the region server
+	// and applied as allowed address pairs on its port, so they relax the
+	// anti-spoofing filter only, security group rules still apply.
 	AllowedSourceAddresses []unikornv1core.IPv4Prefix `json:"allowedSourceAddresses,omitempty"`
 }
 
@@ -271,6 +595,261 @@ type ComputeInstanceStatus struct {
 	PublicIP *string `json:"publicIp,omitempty"`
 	// PowerState is the current status of the machine.
 	PowerState *unikornv1region.InstanceLifecyclePhase `json:"powerState,omitempty"`
+	// ObservedGeneration is the generation of the spec last reconciled,
+	// and that the conditions describe.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// Conditions is a set of status conditions for the machine.
 	Conditions []unikornv1core.Condition `json:"conditions,omitempty"`
+	// PowerSchedule records the last scheduled power action.  This is
+	// maintained by the monitor, not the controller.
+	PowerSchedule *ComputeInstancePowerScheduleStatus `json:"powerSchedule,omitempty"`
+	// SnapshotPolicy records the last scheduled snapshot.  This is
+	// maintained by the monitor, not the controller.
+	SnapshotPolicy *ComputeInstanceSnapshotPolicyStatus `json:"snapshotPolicy,omitempty"`
+	// Quota records the last verification of the instance's quota allocation.
+	// This is maintained by the monitor, not the controller.
+	Quota *QuotaStatus `json:"quota,omitempty"`
+}
+
+// +kubebuilder:validation:Enum=start;stop
+type PowerScheduleAction string
+
+const (
+	PowerScheduleActionStart PowerScheduleAction = "start"
+	PowerScheduleActionStop  PowerScheduleAction = "stop"
+)
+
+type ComputeInstancePowerScheduleStatus struct {
+	// LastAction is the last scheduled action that was applied.
+	LastAction PowerScheduleAction `json:"lastAction"`
+	// LastActionTime is the scheduled time of the last action.
+	LastActionTime metav1.Time `json:"lastActionTime"`
+}
+
+type ComputeInstanceSnapshotPolicyStatus struct {
+	// LastSnapshotID is the image ID of the last scheduled snapshot.
+	LastSnapshotID string `json:"lastSnapshotID"`
+	// LastSnapshotTime is when the last scheduled snapshot was taken.
+	LastSnapshotTime metav1.Time `json:"lastSnapshotTime"`
+}
+
+// ComputeClusterTemplateList is a typed list of cluster templates.
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type ComputeClusterTemplateList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ComputeClusterTemplate `json:"items"`
+}
+
+// ComputeClusterTemplate is a set of blessed pool configurations published by
+// operators, that users can create clusters from.
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:resource:scope=Namespaced,categories=unikorn
+// +kubebuilder:printcolumn:name="display name",type="string",JSONPath=".metadata.labels['unikorn-cloud\\.org/name']"
+// +kubebuilder:printcolumn:name="age",type="date",JSONPath=".metadata.creationTimestamp"
+type ComputeClusterTemplate struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              ComputeClusterTemplateSpec `json:"spec"`
+}
+
+// ComputeClusterTemplateSpec defines the pools a cluster is created with.
+type ComputeClusterTemplateSpec struct {
+	// Tags are aribrary user data.
+	Tags unikornv1core.TagList `json:"tags,omitempty"`
+	// Pools are the pools to create.
+	Pools []ComputeClusterTemplatePoolSpec `json:"pools"`
+}
+
+// ComputeClusterTemplatePoolSpec defines a blessed pool configuration.
+type ComputeClusterTemplatePoolSpec struct {
+	// Name of the workload pool.
+	Name string `json:"name"`
+	// Replicas are the default number of instances in the pool.
+	Replicas int `json:"replicas"`
+	// FlavorID is the region service flavor to deploy with.
+	FlavorID string `json:"flavorId"`
+	// ImageID is the region service image to deploy with.
+	ImageID string `json:"imageId,omitempty"`
+	// ImageSelector is resolved to the most recent matching image when
+	// a cluster is created, and is mutually exclusive with ImageID.
+	ImageSelector *ComputeWorkloadPoolImageSelector `json:"imageSelector,omitempty"`
+	// Networking is the networking configuration.
+	Networking *ComputeInstanceNetworking `json:"networking,omitempty"`
+	// UserData contains configuration information or scripts to use upon launch.
+	UserData []byte `json:"userData,omitempty"`
+}
+
+// ComputeMaintenanceWindowList is a typed list of maintenance windows.
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type ComputeMaintenanceWindowList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ComputeMaintenanceWindow `json:"items"`
+}
+
+// ComputeMaintenanceWindow records a period of provider maintenance.  While active,
+// affected machines are expected to be disrupted, so are not auto healed or rebuilt.
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:resource:scope=Namespaced,categories=unikorn
+// +kubebuilder:printcolumn:name="display name",type="string",JSONPath=".metadata.labels['unikorn-cloud\\.org/name']"
+// +kubebuilder:printcolumn:name="region",type="string",JSONPath=".spec.regionId"
+// +kubebuilder:printcolumn:name="start",type="string",JSONPath=".spec.start"
+// +kubebuilder:printcolumn:name="end",type="string",JSONPath=".spec.end"
+// +kubebuilder:printcolumn:name="age",type="date",JSONPath=".metadata.creationTimestamp"
+type ComputeMaintenanceWindow struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              ComputeMaintenanceWindowSpec `json:"spec"`
+}
+
+// ComputeMaintenanceWindowSpec defines what is affected by maintenance and when.
+type ComputeMaintenanceWindowSpec struct {
+	// Tags are aribrary user data.
+	Tags unikornv1core.TagList `json:"tags,omitempty"`
+	// RegionID, if set, limits the maintenance to a region.  When no machines
+	// are specified every machine in the region is affected.
+	RegionID string `json:"regionId,omitempty"`
+	// MachineIDs, if set, limits the maintenance to specific machines.
+	MachineIDs []string `json:"machineIds,omitempty"`
+	// Start is when maintenance begins.
+	Start metav1.Time `json:"start"`
+	// End is when maintenance is expected to be complete.
+	End metav1.Time `json:"end"`
+	// Reason is a human readable description of the maintenance.
+	Reason string `json:"reason,omitempty"`
+}
+
+// ComputeBootstrapProfileList is a typed list of bootstrap profiles.
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type ComputeBootstrapProfileList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ComputeBootstrapProfile `json:"items"`
+}
+
+// ComputeBootstrapProfile is an operator maintained set of user data templates,
+// e.g. to install GPU drivers and container runtimes, that users can select for
+// their machines by name.
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:resource:scope=Namespaced,categories=unikorn
+// +kubebuilder:printcolumn:name="description",type="string",JSONPath=".spec.description"
+// +kubebuilder:printcolumn:name="age",type="date",JSONPath=".metadata.creationTimestamp"
+type ComputeBootstrapProfile struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              ComputeBootstrapProfileSpec `json:"spec"`
+}
+
+// ComputeBootstrapProfileSpec defines the profile's templates.
+type ComputeBootstrapProfileSpec struct {
+	// Description is a human readable description of the profile.
+	Description string `json:"description,omitempty"`
+	// Templates are selected by the machine's image and flavor, the first
+	// matching template is used, so more specific templates should come first.
+	// +kubebuilder:validation:MinItems=1
+	Templates []ComputeBootstrapTemplate `json:"templates"`
+}
+
+// ComputeBootstrapTemplate is user data for a class of machine.
+type ComputeBootstrapTemplate struct {
+	// ImageFamily, if set, limits the template to images of an operating
+	// system family e.g. debian or redhat.
+	ImageFamily string `json:"imageFamily,omitempty"`
+	// GPUVendor, if set, limits the template to flavors with GPUs from a
+	// vendor e.g. NVIDIA or AMD.
+	GPUVendor string `json:"gpuVendor,omitempty"`
+	// UserData is cloud-init user data, expanded as a Go template.  Available
+	// variables are .OSFamily, .OSDistro, .OSVersion, .GPUVendor, .GPUModel,
+	// .GPUCount and .GPUDriver.
+	UserData string `json:"userData"`
+}
+
+// ComputeWebhookList is a typed list of webhooks.
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type ComputeWebhookList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ComputeWebhook `json:"items"`
+}
+
+// ComputeWebhook registers an organization's interest in resource lifecycle
+// events.  Events are posted to the URL, signed with the secret of the same name,
+// so external systems needn't poll the API.
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:resource:scope=Namespaced,categories=unikorn
+// +kubebuilder:printcolumn:name="display name",type="string",JSONPath=".metadata.labels['unikorn-cloud\\.org/name']"
+// +kubebuilder:printcolumn:name="organization",type="string",JSONPath=".metadata.labels['unikorn-cloud\\.org/organization']"
+// +kubebuilder:printcolumn:name="url",type="string",JSONPath=".spec.url"
+// +kubebuilder:printcolumn:name="age",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+type ComputeWebhook struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              ComputeWebhookSpec   `json:"spec"`
+	Status            ComputeWebhookStatus `json:"status,omitempty"`
+}
+
+// WebhookEventType is the type of lifecycle event.
+// +kubebuilder:validation:Enum=cluster.provisioned;cluster.eviction.completed;machine.health.degraded;instance.deleted
+type WebhookEventType string
+
+const (
+	// WebhookEventClusterProvisioned is raised when a cluster becomes available.
+	WebhookEventClusterProvisioned WebhookEventType = "cluster.provisioned"
+	// WebhookEventClusterEvictionCompleted is raised when all machines requested
+	// for eviction have been dealt with.
+	WebhookEventClusterEvictionCompleted WebhookEventType = "cluster.eviction.completed"
+	// WebhookEventMachineHealthDegraded is raised when a healthy machine becomes
+	// unhealthy.
+	WebhookEventMachineHealthDegraded WebhookEventType = "machine.health.degraded"
+	// WebhookEventInstanceDeleted is raised when an instance has been deleted.
+	WebhookEventInstanceDeleted WebhookEventType = "instance.deleted"
+)
+
+// ComputeWebhookSpec defines where events are delivered.
+type ComputeWebhookSpec struct {
+	// Tags are aribrary user data.
+	Tags unikornv1core.TagList `json:"tags,omitempty"`
+	// URL is where events are posted.
+	URL string `json:"url"`
+	// Events, if set, limits delivery to the specified event types,
+	// otherwise all events are delivered.
+	Events []WebhookEventType `json:"events,omitempty"`
+}
+
+// ComputeWebhookStatus records events awaiting delivery, and delivery problems.
+type ComputeWebhookStatus struct {
+	// PendingDeliveries are events queued for delivery, oldest first.  These
+	// are persisted so delivery survives controller restarts.  This is bounded
+	// in size, so when full the oldest are dead lettered.
+	PendingDeliveries []WebhookDelivery `json:"pendingDeliveries,omitempty"`
+	// FailedDeliveries are events that could not be delivered after
+	// retrying, most recent first.  This is bounded in size, so older
+	// failures are discarded.
+	FailedDeliveries []WebhookDelivery `json:"failedDeliveries,omitempty"`
+}
+
+// WebhookDelivery records an event delivery.
+type WebhookDelivery struct {
+	// EventID is the unique event identifier.
+	EventID string `json:"eventId"`
+	// EventType is the type of event.
+	EventType WebhookEventType `json:"eventType"`
+	// Time is when the event was queued, or when delivery was abandoned
+	// for failed deliveries.
+	Time metav1.Time `json:"time"`
+	// NextAttempt is when delivery of a pending event will next be tried.
+	NextAttempt *metav1.Time `json:"nextAttempt,omitempty"`
+	// Attempts is how many times delivery was tried.
+	Attempts int `json:"attempts"`
+	// Error is the reason the last attempt failed.
+	Error string `json:"error,omitempty"`
+	// Payload is the event that was to be delivered, so it may be
+	// inspected or replayed.
+	Payload string `json:"payload"`
 }
@@ -0,0 +1,155 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"errors"
+	"fmt"
+
+	unikornv1core "github.com/unikorn-cloud/core/pkg/apis/unikorn/v1alpha1"
+)
+
+// ErrValidation is raised when a resource specification is semantically invalid,
+// beyond what can be expressed by the CRD schema.
+var ErrValidation = errors.New("validation error")
+
+const (
+	// maxPort is the largest valid TCP or UDP port.
+	maxPort = 65535
+
+	// maxNodeNetworkPrefixLength leaves room for at least a gateway and a
+	// couple of servers after the network and broadcast addresses.
+	maxNodeNetworkPrefixLength = 29
+)
+
+// Validate checks a firewall rule's port range makes sense, and that it allows
+// traffic from somewhere.
+func (r *FirewallRule) Validate() error {
+	if r.Port < 1 || r.Port > maxPort {
+		return fmt.Errorf("%w: firewall rule port %d out of range", ErrValidation, r.Port)
+	}
+
+	if r.PortMax != nil {
+		if *r.PortMax > maxPort {
+			return fmt.Errorf("%w: firewall rule maximum port %d out of range", ErrValidation, *r.PortMax)
+		}
+
+		if *r.PortMax < r.Port {
+			return fmt.Errorf("%w: firewall rule maximum port is less than the port", ErrValidation)
+		}
+	}
+
+	if len(r.Prefixes) == 0 {
+		return fmt.Errorf("%w: firewall rule must specify at least one prefix", ErrValidation)
+	}
+
+	return nil
+}
+
+// validateReplicas checks replica counts are within bounds.
+func validateReplicas(name string, replicas int, autoscaling *WorkloadPoolAutoscalingSpec) error {
+	if replicas < 0 {
+		return fmt.Errorf("%w: pool %s replicas must not be negative", ErrValidation, name)
+	}
+
+	if autoscaling == nil {
+		return nil
+	}
+
+	if autoscaling.MinReplicas > autoscaling.MaxReplicas {
+		return fmt.Errorf("%w: pool %s autoscaling minimum replicas exceeds maximum replicas", ErrValidation, name)
+	}
+
+	if replicas < autoscaling.MinReplicas || replicas > autoscaling.MaxReplicas {
+		return fmt.Errorf("%w: pool %s replicas outside of autoscaling bounds", ErrValidation, name)
+	}
+
+	return nil
+}
+
+// validateNodeNetwork checks the node network is usable.  Prefixes are always
+// normalized to the network address when decoded, so only the size matters.
+func validateNodeNetwork(network *unikornv1core.NetworkGeneric) error {
+	if network == nil {
+		return nil
+	}
+
+	ones, bits := network.NodeNetwork.Mask.Size()
+
+	if bits == 0 || ones == 0 || ones > maxNodeNetworkPrefixLength {
+		return fmt.Errorf("%w: node network %s must have a prefix length between 1 and %d", ErrValidation, network.NodeNetwork.String(), maxNodeNetworkPrefixLength)
+	}
+
+	return nil
+}
+
+// Validate checks the cluster specification is something the provisioner can act on.
+func (s *ComputeClusterSpec) Validate() error {
+	if err := validateNodeNetwork(s.Network); err != nil {
+		return err
+	}
+
+	names := map[string]bool{}
+
+	if s.WorkloadPools != nil {
+		for i := range s.WorkloadPools.Pools {
+			pool := &s.WorkloadPools.Pools[i]
+
+			if names[pool.Name] {
+				return fmt.Errorf("%w: pool %s is defined more than once", ErrValidation, pool.Name)
+			}
+
+			names[pool.Name] = true
+
+			if err := validateReplicas(pool.Name, pool.Replicas, pool.Autoscaling); err != nil {
+				return err
+			}
+
+			for j := range pool.Firewall {
+				if err := pool.Firewall[j].Validate(); err != nil {
+					return fmt.Errorf("%w: pool %s", err, pool.Name)
+				}
+			}
+		}
+	}
+
+	for i := range s.Pools {
+		pool := &s.Pools[i]
+
+		if names[pool.Name] {
+			return fmt.Errorf("%w: pool %s is defined more than once", ErrValidation, pool.Name)
+		}
+
+		names[pool.Name] = true
+
+		if err := validateReplicas(pool.Name, pool.Replicas, nil); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ValidateUpdate checks the cluster specification may be changed as requested.
+func (s *ComputeClusterSpec) ValidateUpdate(old *ComputeClusterSpec) error {
+	if old.RegionID != "" && s.RegionID != old.RegionID {
+		return fmt.Errorf("%w: region ID is immutable", ErrValidation)
+	}
+
+	return nil
+}
@@ -0,0 +1,137 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1_test
+
+import (
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	unikornv1 "github.com/unikorn-cloud/compute/pkg/apis/unikorn/v1alpha1"
+	unikornv1core "github.com/unikorn-cloud/core/pkg/apis/unikorn/v1alpha1"
+
+	"k8s.io/utils/ptr"
+)
+
+func mustPrefix(t *testing.T, s string) unikornv1core.IPv4Prefix {
+	t.Helper()
+
+	_, prefix, err := net.ParseCIDR(s)
+	require.NoError(t, err)
+
+	return unikornv1core.IPv4Prefix{IPNet: *prefix}
+}
+
+func validClusterSpec(t *testing.T) *unikornv1.ComputeClusterSpec {
+	t.Helper()
+
+	return &unikornv1.ComputeClusterSpec{
+		RegionID: "region",
+		Network: &unikornv1core.NetworkGeneric{
+			NodeNetwork: mustPrefix(t, "192.168.0.0/24"),
+		},
+		WorkloadPools: &unikornv1.ComputeClusterWorkloadPoolsSpec{
+			Pools: []unikornv1.ComputeClusterWorkloadPoolSpec{
+				{
+					MachineGeneric: unikornv1core.MachineGeneric{
+						Replicas: 3,
+					},
+					Name: "default",
+					Autoscaling: &unikornv1.WorkloadPoolAutoscalingSpec{
+						MinReplicas: 1,
+						MaxReplicas: 5,
+					},
+					Firewall: []unikornv1.FirewallRule{
+						{
+							Direction: unikornv1.Ingress,
+							Protocol:  unikornv1.TCP,
+							Prefixes:  []unikornv1core.IPv4Prefix{mustPrefix(t, "0.0.0.0/0")},
+							Port:      8000,
+							PortMax:   ptr.To(8080),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// TestClusterValidate checks semantically invalid cluster specifications are rejected.
+func TestClusterValidate(t *testing.T) {
+	t.Parallel()
+
+	require.NoError(t, validClusterSpec(t).Validate())
+
+	mutations := map[string]func(*unikornv1.ComputeClusterSpec){
+		"network too small": func(s *unikornv1.ComputeClusterSpec) {
+			s.Network.NodeNetwork = mustPrefix(t, "192.168.0.0/30")
+		},
+		"network empty": func(s *unikornv1.ComputeClusterSpec) {
+			s.Network.NodeNetwork = mustPrefix(t, "0.0.0.0/0")
+		},
+		"duplicate pool": func(s *unikornv1.ComputeClusterSpec) {
+			s.Pools = []unikornv1.InstancePoolSpec{{Name: "default"}}
+		},
+		"negative replicas": func(s *unikornv1.ComputeClusterSpec) {
+			s.WorkloadPools.Pools[0].Replicas = -1
+		},
+		"replicas above autoscaling maximum": func(s *unikornv1.ComputeClusterSpec) {
+			s.WorkloadPools.Pools[0].Replicas = 6
+		},
+		"autoscaling bounds inverted": func(s *unikornv1.ComputeClusterSpec) {
+			s.WorkloadPools.Pools[0].Autoscaling.MinReplicas = 6
+		},
+		"port zero": func(s *unikornv1.ComputeClusterSpec) {
+			s.WorkloadPools.Pools[0].Firewall[0].Port = 0
+		},
+		"port range inverted": func(s *unikornv1.ComputeClusterSpec) {
+			s.WorkloadPools.Pools[0].Firewall[0].PortMax = ptr.To(80)
+		},
+		"port range too large": func(s *unikornv1.ComputeClusterSpec) {
+			s.WorkloadPools.Pools[0].Firewall[0].PortMax = ptr.To(65536)
+		},
+		"no prefixes": func(s *unikornv1.ComputeClusterSpec) {
+			s.WorkloadPools.Pools[0].Firewall[0].Prefixes = nil
+		},
+	}
+
+	for name, mutate := range mutations {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			spec := validClusterSpec(t)
+			mutate(spec)
+
+			require.ErrorIs(t, spec.Validate(), unikornv1.ErrValidation)
+		})
+	}
+}
+
+// TestClusterValidateUpdate checks the region cannot be changed.
+func TestClusterValidateUpdate(t *testing.T) {
+	t.Parallel()
+
+	old := validClusterSpec(t)
+
+	spec := validClusterSpec(t)
+	require.NoError(t, spec.ValidateUpdate(old))
+
+	spec.RegionID = "other"
+	require.ErrorIs(t, spec.ValidateUpdate(old), unikornv1.ErrValidation)
+}
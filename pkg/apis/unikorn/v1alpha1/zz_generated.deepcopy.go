@@ -28,6 +28,103 @@ import (
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComputeBootstrapProfile) DeepCopyInto(out *ComputeBootstrapProfile) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComputeBootstrapProfile.
+func (in *ComputeBootstrapProfile) DeepCopy() *ComputeBootstrapProfile {
+	if in == nil {
+		return nil
+	}
+	out := new(ComputeBootstrapProfile)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ComputeBootstrapProfile) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComputeBootstrapProfileList) DeepCopyInto(out *ComputeBootstrapProfileList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ComputeBootstrapProfile, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComputeBootstrapProfileList.
+func (in *ComputeBootstrapProfileList) DeepCopy() *ComputeBootstrapProfileList {
+	if in == nil {
+		return nil
+	}
+	out := new(ComputeBootstrapProfileList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ComputeBootstrapProfileList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComputeBootstrapProfileSpec) DeepCopyInto(out *ComputeBootstrapProfileSpec) {
+	*out = *in
+	if in.Templates != nil {
+		in, out := &in.Templates, &out.Templates
+		*out = make([]ComputeBootstrapTemplate, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComputeBootstrapProfileSpec.
+func (in *ComputeBootstrapProfileSpec) DeepCopy() *ComputeBootstrapProfileSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ComputeBootstrapProfileSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComputeBootstrapTemplate) DeepCopyInto(out *ComputeBootstrapTemplate) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComputeBootstrapTemplate.
+func (in *ComputeBootstrapTemplate) DeepCopy() *ComputeBootstrapTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(ComputeBootstrapTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ComputeCluster) DeepCopyInto(out *ComputeCluster) {
 	*out = *in
@@ -56,6 +153,22 @@ func (in *ComputeCluster) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComputeClusterCancellationStatus) DeepCopyInto(out *ComputeClusterCancellationStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComputeClusterCancellationStatus.
+func (in *ComputeClusterCancellationStatus) DeepCopy() *ComputeClusterCancellationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ComputeClusterCancellationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ComputeClusterList) DeepCopyInto(out *ComputeClusterList) {
 	*out = *in
@@ -89,6 +202,32 @@ func (in *ComputeClusterList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComputeClusterMachineSpec) DeepCopyInto(out *ComputeClusterMachineSpec) {
+	*out = *in
+	if in.FlavorID != nil {
+		in, out := &in.FlavorID, &out.FlavorID
+		*out = new(string)
+		**out = **in
+	}
+	if in.PublicIP != nil {
+		in, out := &in.PublicIP, &out.PublicIP
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComputeClusterMachineSpec.
+func (in *ComputeClusterMachineSpec) DeepCopy() *ComputeClusterMachineSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ComputeClusterMachineSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ComputeClusterSpec) DeepCopyInto(out *ComputeClusterSpec) {
 	*out = *in
@@ -114,6 +253,13 @@ func (in *ComputeClusterSpec) DeepCopyInto(out *ComputeClusterSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Machines != nil {
+		in, out := &in.Machines, &out.Machines
+		*out = make([]ComputeClusterMachineSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -142,6 +288,13 @@ func (in *ComputeClusterStatus) DeepCopyInto(out *ComputeClusterStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Evictions != nil {
+		in, out := &in.Evictions, &out.Evictions
+		*out = make([]MachineEvictionStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
 		*out = make([]unikornv1alpha1.Condition, len(*in))
@@ -154,6 +307,40 @@ func (in *ComputeClusterStatus) DeepCopyInto(out *ComputeClusterStatus) {
 		*out = make([]InstancePoolStatus, len(*in))
 		copy(*out, *in)
 	}
+	if in.Autoscaling != nil {
+		in, out := &in.Autoscaling, &out.Autoscaling
+		*out = make([]WorkloadPoolAutoscalingStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Cancellation != nil {
+		in, out := &in.Cancellation, &out.Cancellation
+		*out = new(ComputeClusterCancellationStatus)
+		**out = **in
+	}
+	if in.Quota != nil {
+		in, out := &in.Quota, &out.Quota
+		*out = new(QuotaStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PhoneHomeToken != nil {
+		in, out := &in.PhoneHomeToken, &out.PhoneHomeToken
+		*out = new(string)
+		**out = **in
+	}
+	if in.SecurityGroups != nil {
+		in, out := &in.SecurityGroups, &out.SecurityGroups
+		*out = make([]WorkloadPoolSecurityGroupStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.MachineTracking != nil {
+		in, out := &in.MachineTracking, &out.MachineTracking
+		*out = make([]MachineTrackingStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -167,6 +354,125 @@ func (in *ComputeClusterStatus) DeepCopy() *ComputeClusterStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComputeClusterTemplate) DeepCopyInto(out *ComputeClusterTemplate) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComputeClusterTemplate.
+func (in *ComputeClusterTemplate) DeepCopy() *ComputeClusterTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(ComputeClusterTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ComputeClusterTemplate) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComputeClusterTemplateList) DeepCopyInto(out *ComputeClusterTemplateList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ComputeClusterTemplate, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComputeClusterTemplateList.
+func (in *ComputeClusterTemplateList) DeepCopy() *ComputeClusterTemplateList {
+	if in == nil {
+		return nil
+	}
+	out := new(ComputeClusterTemplateList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ComputeClusterTemplateList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComputeClusterTemplatePoolSpec) DeepCopyInto(out *ComputeClusterTemplatePoolSpec) {
+	*out = *in
+	if in.ImageSelector != nil {
+		in, out := &in.ImageSelector, &out.ImageSelector
+		*out = new(ComputeWorkloadPoolImageSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Networking != nil {
+		in, out := &in.Networking, &out.Networking
+		*out = new(ComputeInstanceNetworking)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.UserData != nil {
+		in, out := &in.UserData, &out.UserData
+		*out = make([]byte, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComputeClusterTemplatePoolSpec.
+func (in *ComputeClusterTemplatePoolSpec) DeepCopy() *ComputeClusterTemplatePoolSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ComputeClusterTemplatePoolSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComputeClusterTemplateSpec) DeepCopyInto(out *ComputeClusterTemplateSpec) {
+	*out = *in
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make(unikornv1alpha1.TagList, len(*in))
+		copy(*out, *in)
+	}
+	if in.Pools != nil {
+		in, out := &in.Pools, &out.Pools
+		*out = make([]ComputeClusterTemplatePoolSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComputeClusterTemplateSpec.
+func (in *ComputeClusterTemplateSpec) DeepCopy() *ComputeClusterTemplateSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ComputeClusterTemplateSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ComputeClusterWorkloadPoolSpec) DeepCopyInto(out *ComputeClusterWorkloadPoolSpec) {
 	*out = *in
@@ -200,6 +506,36 @@ func (in *ComputeClusterWorkloadPoolSpec) DeepCopyInto(out *ComputeClusterWorklo
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.SecurityGroupIDs != nil {
+		in, out := &in.SecurityGroupIDs, &out.SecurityGroupIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Autoscaling != nil {
+		in, out := &in.Autoscaling, &out.Autoscaling
+		*out = new(WorkloadPoolAutoscalingSpec)
+		**out = **in
+	}
+	if in.AutoHealing != nil {
+		in, out := &in.AutoHealing, &out.AutoHealing
+		*out = new(WorkloadPoolAutoHealingSpec)
+		**out = **in
+	}
+	if in.UpdateStrategy != nil {
+		in, out := &in.UpdateStrategy, &out.UpdateStrategy
+		*out = new(WorkloadPoolUpdateStrategy)
+		**out = **in
+	}
+	if in.GoldenImage != nil {
+		in, out := &in.GoldenImage, &out.GoldenImage
+		*out = new(WorkloadPoolGoldenImage)
+		**out = **in
+	}
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(unikornv1alpha1.TagList, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -326,15 +662,81 @@ func (in *ComputeInstanceNetworking) DeepCopy() *ComputeInstanceNetworking {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ComputeInstanceSpec) DeepCopyInto(out *ComputeInstanceSpec) {
+func (in *ComputeInstancePowerSchedule) DeepCopyInto(out *ComputeInstancePowerSchedule) {
 	*out = *in
-	in.MachineGeneric.DeepCopyInto(&out.MachineGeneric)
-	if in.Tags != nil {
-		in, out := &in.Tags, &out.Tags
-		*out = make(unikornv1alpha1.TagList, len(*in))
-		copy(*out, *in)
-	}
-	if in.Networking != nil {
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComputeInstancePowerSchedule.
+func (in *ComputeInstancePowerSchedule) DeepCopy() *ComputeInstancePowerSchedule {
+	if in == nil {
+		return nil
+	}
+	out := new(ComputeInstancePowerSchedule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComputeInstancePowerScheduleStatus) DeepCopyInto(out *ComputeInstancePowerScheduleStatus) {
+	*out = *in
+	in.LastActionTime.DeepCopyInto(&out.LastActionTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComputeInstancePowerScheduleStatus.
+func (in *ComputeInstancePowerScheduleStatus) DeepCopy() *ComputeInstancePowerScheduleStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ComputeInstancePowerScheduleStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComputeInstanceSnapshotPolicy) DeepCopyInto(out *ComputeInstanceSnapshotPolicy) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComputeInstanceSnapshotPolicy.
+func (in *ComputeInstanceSnapshotPolicy) DeepCopy() *ComputeInstanceSnapshotPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(ComputeInstanceSnapshotPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComputeInstanceSnapshotPolicyStatus) DeepCopyInto(out *ComputeInstanceSnapshotPolicyStatus) {
+	*out = *in
+	in.LastSnapshotTime.DeepCopyInto(&out.LastSnapshotTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComputeInstanceSnapshotPolicyStatus.
+func (in *ComputeInstanceSnapshotPolicyStatus) DeepCopy() *ComputeInstanceSnapshotPolicyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ComputeInstanceSnapshotPolicyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComputeInstanceSpec) DeepCopyInto(out *ComputeInstanceSpec) {
+	*out = *in
+	in.MachineGeneric.DeepCopyInto(&out.MachineGeneric)
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make(unikornv1alpha1.TagList, len(*in))
+		copy(*out, *in)
+	}
+	if in.Networking != nil {
 		in, out := &in.Networking, &out.Networking
 		*out = new(ComputeInstanceNetworking)
 		(*in).DeepCopyInto(*out)
@@ -344,6 +746,16 @@ func (in *ComputeInstanceSpec) DeepCopyInto(out *ComputeInstanceSpec) {
 		*out = make([]byte, len(*in))
 		copy(*out, *in)
 	}
+	if in.PowerSchedule != nil {
+		in, out := &in.PowerSchedule, &out.PowerSchedule
+		*out = new(ComputeInstancePowerSchedule)
+		**out = **in
+	}
+	if in.SnapshotPolicy != nil {
+		in, out := &in.SnapshotPolicy, &out.SnapshotPolicy
+		*out = new(ComputeInstanceSnapshotPolicy)
+		**out = **in
+	}
 	return
 }
 
@@ -382,6 +794,21 @@ func (in *ComputeInstanceStatus) DeepCopyInto(out *ComputeInstanceStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.PowerSchedule != nil {
+		in, out := &in.PowerSchedule, &out.PowerSchedule
+		*out = new(ComputeInstancePowerScheduleStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SnapshotPolicy != nil {
+		in, out := &in.SnapshotPolicy, &out.SnapshotPolicy
+		*out = new(ComputeInstanceSnapshotPolicyStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Quota != nil {
+		in, out := &in.Quota, &out.Quota
+		*out = new(QuotaStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -395,6 +822,211 @@ func (in *ComputeInstanceStatus) DeepCopy() *ComputeInstanceStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComputeMaintenanceWindow) DeepCopyInto(out *ComputeMaintenanceWindow) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComputeMaintenanceWindow.
+func (in *ComputeMaintenanceWindow) DeepCopy() *ComputeMaintenanceWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(ComputeMaintenanceWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ComputeMaintenanceWindow) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComputeMaintenanceWindowList) DeepCopyInto(out *ComputeMaintenanceWindowList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ComputeMaintenanceWindow, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComputeMaintenanceWindowList.
+func (in *ComputeMaintenanceWindowList) DeepCopy() *ComputeMaintenanceWindowList {
+	if in == nil {
+		return nil
+	}
+	out := new(ComputeMaintenanceWindowList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ComputeMaintenanceWindowList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComputeMaintenanceWindowSpec) DeepCopyInto(out *ComputeMaintenanceWindowSpec) {
+	*out = *in
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make(unikornv1alpha1.TagList, len(*in))
+		copy(*out, *in)
+	}
+	if in.MachineIDs != nil {
+		in, out := &in.MachineIDs, &out.MachineIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	in.Start.DeepCopyInto(&out.Start)
+	in.End.DeepCopyInto(&out.End)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComputeMaintenanceWindowSpec.
+func (in *ComputeMaintenanceWindowSpec) DeepCopy() *ComputeMaintenanceWindowSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ComputeMaintenanceWindowSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComputeWebhook) DeepCopyInto(out *ComputeWebhook) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComputeWebhook.
+func (in *ComputeWebhook) DeepCopy() *ComputeWebhook {
+	if in == nil {
+		return nil
+	}
+	out := new(ComputeWebhook)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ComputeWebhook) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComputeWebhookList) DeepCopyInto(out *ComputeWebhookList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ComputeWebhook, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComputeWebhookList.
+func (in *ComputeWebhookList) DeepCopy() *ComputeWebhookList {
+	if in == nil {
+		return nil
+	}
+	out := new(ComputeWebhookList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ComputeWebhookList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComputeWebhookSpec) DeepCopyInto(out *ComputeWebhookSpec) {
+	*out = *in
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make(unikornv1alpha1.TagList, len(*in))
+		copy(*out, *in)
+	}
+	if in.Events != nil {
+		in, out := &in.Events, &out.Events
+		*out = make([]WebhookEventType, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComputeWebhookSpec.
+func (in *ComputeWebhookSpec) DeepCopy() *ComputeWebhookSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ComputeWebhookSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComputeWebhookStatus) DeepCopyInto(out *ComputeWebhookStatus) {
+	*out = *in
+	if in.PendingDeliveries != nil {
+		in, out := &in.PendingDeliveries, &out.PendingDeliveries
+		*out = make([]WebhookDelivery, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.FailedDeliveries != nil {
+		in, out := &in.FailedDeliveries, &out.FailedDeliveries
+		*out = make([]WebhookDelivery, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComputeWebhookStatus.
+func (in *ComputeWebhookStatus) DeepCopy() *ComputeWebhookStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ComputeWebhookStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ComputeWorkloadPoolAddressPair) DeepCopyInto(out *ComputeWorkloadPoolAddressPair) {
 	*out = *in
@@ -494,6 +1126,43 @@ func (in *InstancePoolStatus) DeepCopy() *InstancePoolStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineEvictionStatus) DeepCopyInto(out *MachineEvictionStatus) {
+	*out = *in
+	if in.Message != nil {
+		in, out := &in.Message, &out.Message
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineEvictionStatus.
+func (in *MachineEvictionStatus) DeepCopy() *MachineEvictionStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineEvictionStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineResizeStatus) DeepCopyInto(out *MachineResizeStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineResizeStatus.
+func (in *MachineResizeStatus) DeepCopy() *MachineResizeStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineResizeStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MachineStatus) DeepCopyInto(out *MachineStatus) {
 	*out = *in
@@ -507,6 +1176,11 @@ func (in *MachineStatus) DeepCopyInto(out *MachineStatus) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(unikornv1alpha1.TagList, len(*in))
+		copy(*out, *in)
+	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
 		*out = make([]unikornv1alpha1.Condition, len(*in))
@@ -514,6 +1188,10 @@ func (in *MachineStatus) DeepCopyInto(out *MachineStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.CreationTime != nil {
+		in, out := &in.CreationTime, &out.CreationTime
+		*out = (*in).DeepCopy()
+	}
 	return
 }
 
@@ -527,6 +1205,40 @@ func (in *MachineStatus) DeepCopy() *MachineStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineTrackingStatus) DeepCopyInto(out *MachineTrackingStatus) {
+	*out = *in
+	if in.FlavorID != nil {
+		in, out := &in.FlavorID, &out.FlavorID
+		*out = new(string)
+		**out = **in
+	}
+	if in.UnhealthySince != nil {
+		in, out := &in.UnhealthySince, &out.UnhealthySince
+		*out = (*in).DeepCopy()
+	}
+	if in.TransitioningSince != nil {
+		in, out := &in.TransitioningSince, &out.TransitioningSince
+		*out = (*in).DeepCopy()
+	}
+	if in.Resize != nil {
+		in, out := &in.Resize, &out.Resize
+		*out = new(MachineResizeStatus)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineTrackingStatus.
+func (in *MachineTrackingStatus) DeepCopy() *MachineTrackingStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineTrackingStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PublicIPAllocationSpec) DeepCopyInto(out *PublicIPAllocationSpec) {
 	*out = *in
@@ -543,6 +1255,131 @@ func (in *PublicIPAllocationSpec) DeepCopy() *PublicIPAllocationSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QuotaStatus) DeepCopyInto(out *QuotaStatus) {
+	*out = *in
+	in.LastCheckTime.DeepCopyInto(&out.LastCheckTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuotaStatus.
+func (in *QuotaStatus) DeepCopy() *QuotaStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(QuotaStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WebhookDelivery) DeepCopyInto(out *WebhookDelivery) {
+	*out = *in
+	in.Time.DeepCopyInto(&out.Time)
+	if in.NextAttempt != nil {
+		in, out := &in.NextAttempt, &out.NextAttempt
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WebhookDelivery.
+func (in *WebhookDelivery) DeepCopy() *WebhookDelivery {
+	if in == nil {
+		return nil
+	}
+	out := new(WebhookDelivery)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkloadPoolAutoHealingSpec) DeepCopyInto(out *WorkloadPoolAutoHealingSpec) {
+	*out = *in
+	out.GracePeriod = in.GracePeriod
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkloadPoolAutoHealingSpec.
+func (in *WorkloadPoolAutoHealingSpec) DeepCopy() *WorkloadPoolAutoHealingSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkloadPoolAutoHealingSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkloadPoolAutoscalingSpec) DeepCopyInto(out *WorkloadPoolAutoscalingSpec) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkloadPoolAutoscalingSpec.
+func (in *WorkloadPoolAutoscalingSpec) DeepCopy() *WorkloadPoolAutoscalingSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkloadPoolAutoscalingSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkloadPoolAutoscalingStatus) DeepCopyInto(out *WorkloadPoolAutoscalingStatus) {
+	*out = *in
+	in.LastScaleTime.DeepCopyInto(&out.LastScaleTime)
+	if in.CPUUtilization != nil {
+		in, out := &in.CPUUtilization, &out.CPUUtilization
+		*out = new(int)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkloadPoolAutoscalingStatus.
+func (in *WorkloadPoolAutoscalingStatus) DeepCopy() *WorkloadPoolAutoscalingStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkloadPoolAutoscalingStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkloadPoolGoldenImage) DeepCopyInto(out *WorkloadPoolGoldenImage) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkloadPoolGoldenImage.
+func (in *WorkloadPoolGoldenImage) DeepCopy() *WorkloadPoolGoldenImage {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkloadPoolGoldenImage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkloadPoolSecurityGroupStatus) DeepCopyInto(out *WorkloadPoolSecurityGroupStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkloadPoolSecurityGroupStatus.
+func (in *WorkloadPoolSecurityGroupStatus) DeepCopy() *WorkloadPoolSecurityGroupStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkloadPoolSecurityGroupStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WorkloadPoolStatus) DeepCopyInto(out *WorkloadPoolStatus) {
 	*out = *in
@@ -565,3 +1402,19 @@ func (in *WorkloadPoolStatus) DeepCopy() *WorkloadPoolStatus {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkloadPoolUpdateStrategy) DeepCopyInto(out *WorkloadPoolUpdateStrategy) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkloadPoolUpdateStrategy.
+func (in *WorkloadPoolUpdateStrategy) DeepCopy() *WorkloadPoolUpdateStrategy {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkloadPoolUpdateStrategy)
+	in.DeepCopyInto(out)
+	return out
+}
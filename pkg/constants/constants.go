@@ -22,6 +22,7 @@ import (
 	"os"
 	"path"
 	"strconv"
+	"strings"
 
 	"github.com/unikorn-cloud/core/pkg/util"
 )
@@ -60,18 +61,64 @@ const (
 	// for filtering purposes.
 	ResourceAPIVersionLabel = "resource.unikorn-cloud.org/api-version"
 
+	// ServerDeletionHintAnnotation records machines requested for eviction, encoded
+	// as <request ID>:<machine ID>,... so repeated requests can be told apart.
 	ServerDeletionHintAnnotation = "cluster.compute.unikorn-cloud.org/deletion-hint"
 
+	// ServerAdoptionAnnotation records existing servers that have been requested to
+	// join a workload pool, but have yet to be tagged as members by the provisioner.
+	ServerAdoptionAnnotation = "cluster.compute.unikorn-cloud.org/adoptions"
+
+	// ServerDetachmentAnnotation records machines that have been removed from their
+	// workload pool, but have yet to have their membership tags removed by the provisioner.
+	ServerDetachmentAnnotation = "cluster.compute.unikorn-cloud.org/detachments"
+
+	// ServerCordonAnnotation records machines that are held back from updates,
+	// rebuilds and scale down selection.
+	ServerCordonAnnotation = "cluster.compute.unikorn-cloud.org/cordoned"
+
+	// UpdateCancelAnnotation records the cluster generation whose update was cancelled.
+	// The provisioner stops creating and rebuilding servers until the next update.
+	UpdateCancelAnnotation = "cluster.compute.unikorn-cloud.org/cancelled-generation"
+
+	// SpecHistoryAnnotation records the specifications of a cluster's most recent
+	// generations, so an update can be rolled back.
+	SpecHistoryAnnotation = "cluster.compute.unikorn-cloud.org/spec-history"
+
+	// ServerBootFinishedAnnotation records machines, by host name, that have
+	// reported cloud-init completion via the phone home endpoint.
+	ServerBootFinishedAnnotation = "cluster.compute.unikorn-cloud.org/boot-finished"
+
 	InstanceLabel = "compute.unikorn-cloud.org/instance-id"
 
 	ClusterLabel = "compute.unikorn-cloud.org/cluster-id"
+
+	// PoolLabel identifies which cluster pool an instance belongs to.
+	PoolLabel = "compute.unikorn-cloud.org/pool"
+
+	// CloudEventTypeLabel is attached to Kubernetes events that carry a CloudEvent,
+	// and records its type, so consumers can select the events they're interested in.
+	CloudEventTypeLabel = "compute.unikorn-cloud.org/cloudevent-type"
 )
 
 const (
+	// PlatformTagPrefix is used by tags applied by the platform to attribute
+	// resources to clusters and workload pools.
+	PlatformTagPrefix = "unikorn-cloud.org/"
+
 	SystemTagPrefix = "compute.unikorn-cloud.org:"
 	InstanceIDTag   = SystemTagPrefix + "instance-id"
+	// SnapshotPolicyTag marks snapshots taken by an instance's snapshot policy,
+	// only these are subject to garbage collection.
+	SnapshotPolicyTag = SystemTagPrefix + "snapshot-policy"
 )
 
+// IsSystemTag returns true if the tag name is in a namespace reserved for use by
+// the platform, these must not be set or modified by users.
+func IsSystemTag(name string) bool {
+	return strings.HasPrefix(name, PlatformTagPrefix) || strings.HasPrefix(name, SystemTagPrefix)
+}
+
 func MarshalAPIVersion(i int) string {
 	return strconv.Itoa(i)
 }
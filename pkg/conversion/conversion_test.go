@@ -0,0 +1,296 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package conversion_test
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	computev1 "github.com/unikorn-cloud/compute/pkg/apis/unikorn/v1alpha1"
+	"github.com/unikorn-cloud/compute/pkg/conversion"
+	computeapi "github.com/unikorn-cloud/compute/pkg/openapi"
+	corev1 "github.com/unikorn-cloud/core/pkg/apis/unikorn/v1alpha1"
+	coreerrors "github.com/unikorn-cloud/core/pkg/server/errors"
+
+	"k8s.io/utils/ptr"
+)
+
+const (
+	// iterations is the number of random values to round trip per test.
+	iterations = 1000
+)
+
+// newRand returns a deterministically seeded random source so failures are
+// reproducible.
+func newRand() *rand.Rand {
+	//nolint:gosec
+	return rand.New(rand.NewPCG(1, 2))
+}
+
+// randomPrefixes returns up to max unique, canonical IPv4 prefixes.
+func randomPrefixes(t *testing.T, r *rand.Rand, maxPrefixes int) []corev1.IPv4Prefix {
+	t.Helper()
+
+	n := r.IntN(maxPrefixes + 1)
+	if n == 0 {
+		return nil
+	}
+
+	out := make([]corev1.IPv4Prefix, 0, n)
+
+	seen := map[string]bool{}
+
+	for len(out) < n {
+		address := fmt.Sprintf("%d.%d.%d.%d/%d", r.IntN(256), r.IntN(256), r.IntN(256), r.IntN(256), r.IntN(33))
+
+		_, prefix, err := net.ParseCIDR(address)
+		require.NoError(t, err)
+
+		if seen[prefix.String()] {
+			continue
+		}
+
+		seen[prefix.String()] = true
+
+		out = append(out, corev1.IPv4Prefix{
+			IPNet: *prefix,
+		})
+	}
+
+	return out
+}
+
+// randomTimeZone returns a time zone that is always loadable.
+func randomTimeZone(r *rand.Rand) string {
+	return []string{"", "UTC"}[r.IntN(2)]
+}
+
+// randomCron returns a valid 5 field cron expression.
+func randomCron(r *rand.Rand) string {
+	return fmt.Sprintf("%d %d * * %s", r.IntN(60), r.IntN(24), []string{"*", "1-5", "0,6"}[r.IntN(3)])
+}
+
+// TestNetworkingRoundTrip verifies networking survives conversion to and
+// from the API unaltered.
+func TestNetworkingRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	r := newRand()
+
+	for range iterations {
+		in := &computev1.ComputeInstanceNetworking{
+			PublicIP:               r.IntN(2) == 0,
+			AllowedSourceAddresses: randomPrefixes(t, r, 3),
+		}
+
+		for i := range r.IntN(4) {
+			in.SecurityGroupIDs = append(in.SecurityGroupIDs, fmt.Sprintf("sg-%d", i))
+		}
+
+		if !in.PublicIP && in.SecurityGroupIDs == nil && in.AllowedSourceAddresses == nil {
+			in = nil
+		}
+
+		out, err := conversion.GenerateNetworking(conversion.ConvertNetworking(in))
+		require.NoError(t, err)
+		require.Equal(t, in, out)
+	}
+}
+
+// TestNetworkingEmpty verifies empty networking collapses to nothing.
+func TestNetworkingEmpty(t *testing.T) {
+	t.Parallel()
+
+	out, err := conversion.GenerateNetworking(&computeapi.InstanceNetworking{
+		PublicIP:               ptr.To(false),
+		SecurityGroups:         &[]string{},
+		AllowedSourceAddresses: &[]string{},
+	})
+	require.NoError(t, err)
+	require.Nil(t, out)
+}
+
+// TestAllowedSourceAddresses verifies allowed source addresses are rejected
+// rather than silently altered when passed to the region.
+func TestAllowedSourceAddresses(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		addresses   []string
+		expectError bool
+	}{
+		{
+			name:      "valid",
+			addresses: []string{"10.0.0.0/8", "192.168.1.0/24", "0.0.0.0/0"},
+		},
+		{
+			name:        "malformed",
+			addresses:   []string{"10.0.0.0"},
+			expectError: true,
+		},
+		{
+			name:        "ipv6",
+			addresses:   []string{"2001:db8::/32"},
+			expectError: true,
+		},
+		{
+			name:        "host bits set",
+			addresses:   []string{"10.0.0.1/24"},
+			expectError: true,
+		},
+		{
+			name:        "duplicate",
+			addresses:   []string{"10.0.0.0/8", "10.0.0.0/8"},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			networking, err := conversion.GenerateNetworking(&computeapi.InstanceNetworking{
+				AllowedSourceAddresses: ptr.To(tc.addresses),
+			})
+
+			if tc.expectError {
+				require.True(t, coreerrors.IsBadRequest(err))
+				return
+			}
+
+			require.NoError(t, err)
+			require.Len(t, networking.AllowedSourceAddresses, len(tc.addresses))
+
+			for i := range tc.addresses {
+				require.Equal(t, tc.addresses[i], networking.AllowedSourceAddresses[i].String())
+			}
+		})
+	}
+}
+
+// TestUserDataRoundTrip verifies user data survives conversion to and from
+// the API unaltered, and that empty user data is omitted.
+func TestUserDataRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	r := newRand()
+
+	for range iterations {
+		var in []byte
+
+		if n := r.IntN(64); n > 0 {
+			in = make([]byte, n)
+
+			for i := range in {
+				in[i] = byte(r.UintN(256))
+			}
+		}
+
+		require.Equal(t, in, conversion.GenerateUserData(conversion.ConvertUserData(in)))
+	}
+
+	require.Nil(t, conversion.GenerateUserData(&[]byte{}))
+}
+
+// TestPowerScheduleRoundTrip verifies power schedules survive conversion to
+// and from the API unaltered.
+func TestPowerScheduleRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	r := newRand()
+
+	for range iterations {
+		in := &computev1.ComputeInstancePowerSchedule{
+			TimeZone: randomTimeZone(r),
+		}
+
+		// At least one of start or stop is required.
+		switch r.IntN(3) {
+		case 0:
+			in.Start = randomCron(r)
+		case 1:
+			in.Stop = randomCron(r)
+		default:
+			in.Start = randomCron(r)
+			in.Stop = randomCron(r)
+		}
+
+		out, err := conversion.GeneratePowerSchedule(conversion.ConvertPowerSchedule(in))
+		require.NoError(t, err)
+		require.Equal(t, in, out)
+	}
+}
+
+// TestSnapshotPolicyRoundTrip verifies snapshot policies survive conversion to
+// and from the API unaltered.
+func TestSnapshotPolicyRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	r := newRand()
+
+	for range iterations {
+		in := &computev1.ComputeInstanceSnapshotPolicy{
+			Schedule:  randomCron(r),
+			TimeZone:  randomTimeZone(r),
+			Retention: r.IntN(30) + 1,
+		}
+
+		out, err := conversion.GenerateSnapshotPolicy(conversion.ConvertSnapshotPolicy(in))
+		require.NoError(t, err)
+		require.Equal(t, in, out)
+	}
+}
+
+// TestFirewallRulesRoundTrip verifies firewall rules survive conversion to and
+// from the API unaltered.
+func TestFirewallRulesRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	r := newRand()
+
+	for range iterations {
+		var in []computev1.FirewallRule
+
+		for range r.IntN(4) {
+			rule := computev1.FirewallRule{
+				Direction: []computev1.FirewallRuleDirection{computev1.Ingress, computev1.Egress}[r.IntN(2)],
+				Protocol:  []computev1.FirewallRuleProtocol{computev1.TCP, computev1.UDP}[r.IntN(2)],
+				Port:      r.IntN(32768) + 1,
+			}
+
+			if r.IntN(2) == 0 {
+				rule.PortMax = ptr.To(rule.Port + r.IntN(32768))
+			}
+
+			for rule.Prefixes == nil {
+				rule.Prefixes = randomPrefixes(t, r, 3)
+			}
+
+			in = append(in, rule)
+		}
+
+		out, err := conversion.GenerateFirewallRules(conversion.ConvertFirewallRules(in))
+		require.NoError(t, err)
+		require.Equal(t, in, out)
+	}
+}
@@ -0,0 +1,27 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package conversion translates between custom resources and their API definitions
+// where that translation is shared between handlers and API versions.
+//
+// Conversions guarantee that, for any valid custom resource value x, generating from
+// the converted value yields x again i.e. Generate(Convert(x)) == x.  To make this hold,
+// values are kept in a canonical form: empty lists, empty user data and entirely defaulted
+// structures are always omitted, in both directions, rather than being represented as
+// an empty value on one side and an absent one on the other.  Any new field must be
+// added to both directions, and to the round trip tests, or it will be silently dropped.
+package conversion
@@ -0,0 +1,167 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package conversion
+
+import (
+	"net"
+
+	computev1 "github.com/unikorn-cloud/compute/pkg/apis/unikorn/v1alpha1"
+	computeapi "github.com/unikorn-cloud/compute/pkg/openapi"
+	corev1 "github.com/unikorn-cloud/core/pkg/apis/unikorn/v1alpha1"
+)
+
+// convertDirection converts from a custom resource into the API definition.
+func convertDirection(in computev1.FirewallRuleDirection) computeapi.FirewallRuleDirection {
+	switch in {
+	case computev1.Ingress:
+		return computeapi.Ingress
+	case computev1.Egress:
+		return computeapi.Egress
+	}
+
+	return ""
+}
+
+// convertProtocol converts from a custom resource into the API definition.
+func convertProtocol(in computev1.FirewallRuleProtocol) computeapi.FirewallRuleProtocol {
+	switch in {
+	case computev1.TCP:
+		return computeapi.Tcp
+	case computev1.UDP:
+		return computeapi.Udp
+	}
+
+	return ""
+}
+
+// ConvertPrefixes converts firewall rule prefixes into the API definition.
+func ConvertPrefixes(in []corev1.IPv4Prefix) []string {
+	out := make([]string, len(in))
+
+	for i, prefix := range in {
+		out[i] = prefix.String()
+	}
+
+	return out
+}
+
+// ConvertFirewallRule converts a firewall rule into the API definition.
+func ConvertFirewallRule(in *computev1.FirewallRule) *computeapi.FirewallRule {
+	return &computeapi.FirewallRule{
+		Direction: convertDirection(in.Direction),
+		Protocol:  convertProtocol(in.Protocol),
+		Port:      in.Port,
+		PortMax:   in.PortMax,
+		Prefixes:  ConvertPrefixes(in.Prefixes),
+	}
+}
+
+// ConvertFirewallRules converts firewall rules into the API definition.
+func ConvertFirewallRules(in []computev1.FirewallRule) *computeapi.FirewallRules {
+	if len(in) == 0 {
+		return nil
+	}
+
+	out := make(computeapi.FirewallRules, len(in))
+
+	for i := range in {
+		out[i] = *ConvertFirewallRule(&in[i])
+	}
+
+	return &out
+}
+
+// generateFirewallRuleDirection converts from the API definition into a custom resource.
+func generateFirewallRuleDirection(in computeapi.FirewallRuleDirection) computev1.FirewallRuleDirection {
+	switch in {
+	case computeapi.Ingress:
+		return computev1.Ingress
+	case computeapi.Egress:
+		return computev1.Egress
+	}
+
+	return ""
+}
+
+// generateFirewallRuleProtocol converts from the API definition into a custom resource.
+func generateFirewallRuleProtocol(in computeapi.FirewallRuleProtocol) computev1.FirewallRuleProtocol {
+	switch in {
+	case computeapi.Tcp:
+		return computev1.TCP
+	case computeapi.Udp:
+		return computev1.UDP
+	}
+
+	return ""
+}
+
+// generatePrefixes converts firewall rule prefixes from the API definition.
+func generatePrefixes(in []string) ([]corev1.IPv4Prefix, error) {
+	out := make([]corev1.IPv4Prefix, len(in))
+
+	for i := range in {
+		_, cidr, err := net.ParseCIDR(in[i])
+		if err != nil {
+			return nil, err
+		}
+
+		out[i] = corev1.IPv4Prefix{
+			IPNet: *cidr,
+		}
+	}
+
+	return out, nil
+}
+
+// GenerateFirewallRule converts a firewall rule from the API definition.
+func GenerateFirewallRule(in *computeapi.FirewallRule) (*computev1.FirewallRule, error) {
+	prefixes, err := generatePrefixes(in.Prefixes)
+	if err != nil {
+		return nil, err
+	}
+
+	out := &computev1.FirewallRule{
+		Direction: generateFirewallRuleDirection(in.Direction),
+		Protocol:  generateFirewallRuleProtocol(in.Protocol),
+		Port:      in.Port,
+		PortMax:   in.PortMax,
+		Prefixes:  prefixes,
+	}
+
+	return out, nil
+}
+
+// GenerateFirewallRules converts firewall rules from the API definition.
+func GenerateFirewallRules(in *computeapi.FirewallRules) ([]computev1.FirewallRule, error) {
+	if in == nil || len(*in) == 0 {
+		return nil, nil
+	}
+
+	out := make([]computev1.FirewallRule, len(*in))
+
+	for i := range *in {
+		rule, err := GenerateFirewallRule(&(*in)[i])
+		if err != nil {
+			return nil, err
+		}
+
+		out[i] = *rule
+	}
+
+	return out, nil
+}
@@ -0,0 +1,259 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package conversion
+
+import (
+	"fmt"
+	"net"
+	"reflect"
+
+	computev1 "github.com/unikorn-cloud/compute/pkg/apis/unikorn/v1alpha1"
+	"github.com/unikorn-cloud/compute/pkg/monitor/powerschedule"
+	"github.com/unikorn-cloud/compute/pkg/monitor/snapshot"
+	computeapi "github.com/unikorn-cloud/compute/pkg/openapi"
+	corev1 "github.com/unikorn-cloud/core/pkg/apis/unikorn/v1alpha1"
+	"github.com/unikorn-cloud/core/pkg/server/errors"
+
+	"k8s.io/utils/ptr"
+)
+
+// ConvertNetworking converts instance networking into the API definition.
+func ConvertNetworking(in *computev1.ComputeInstanceNetworking) *computeapi.InstanceNetworking {
+	if in == nil {
+		return nil
+	}
+
+	var out computeapi.InstanceNetworking
+
+	if in.PublicIP {
+		out.PublicIP = ptr.To(true)
+	}
+
+	if len(in.SecurityGroupIDs) > 0 {
+		out.SecurityGroups = ptr.To(in.SecurityGroupIDs)
+	}
+
+	if len(in.AllowedSourceAddresses) > 0 {
+		allowedSourceAddresses := make([]string, len(in.AllowedSourceAddresses))
+
+		for i := range in.AllowedSourceAddresses {
+			allowedSourceAddresses[i] = in.AllowedSourceAddresses[i].String()
+		}
+
+		out.AllowedSourceAddresses = ptr.To(allowedSourceAddresses)
+	}
+
+	if reflect.ValueOf(out).IsZero() {
+		return nil
+	}
+
+	return &out
+}
+
+// ConvertUserData converts user data into the API definition.  Empty user data
+// is omitted, as it is when generated.
+func ConvertUserData(in []byte) *[]byte {
+	if len(in) == 0 {
+		return nil
+	}
+
+	return &in
+}
+
+// ConvertBootstrapProfile converts a bootstrap profile name into the API definition.
+func ConvertBootstrapProfile(in string) *string {
+	if in == "" {
+		return nil
+	}
+
+	return &in
+}
+
+// ConvertPowerSchedule converts a power schedule into the API definition.
+func ConvertPowerSchedule(in *computev1.ComputeInstancePowerSchedule) *computeapi.InstancePowerSchedule {
+	if in == nil {
+		return nil
+	}
+
+	out := &computeapi.InstancePowerSchedule{}
+
+	if in.Start != "" {
+		out.Start = ptr.To(in.Start)
+	}
+
+	if in.Stop != "" {
+		out.Stop = ptr.To(in.Stop)
+	}
+
+	if in.TimeZone != "" {
+		out.TimeZone = ptr.To(in.TimeZone)
+	}
+
+	return out
+}
+
+// ConvertSnapshotPolicy converts a snapshot policy into the API definition.
+func ConvertSnapshotPolicy(in *computev1.ComputeInstanceSnapshotPolicy) *computeapi.InstanceSnapshotPolicy {
+	if in == nil {
+		return nil
+	}
+
+	out := &computeapi.InstanceSnapshotPolicy{
+		Schedule:  in.Schedule,
+		Retention: in.Retention,
+	}
+
+	if in.TimeZone != "" {
+		out.TimeZone = ptr.To(in.TimeZone)
+	}
+
+	return out
+}
+
+// parseAllowedSourceAddresses validates allowed source addresses.  These are applied
+// verbatim by the region as allowed address pairs on the server's port, so we reject
+// anything that would be silently altered along the way: non-IPv4 prefixes, prefixes
+// with host bits set, and duplicates.
+func parseAllowedSourceAddresses(in []string) ([]corev1.IPv4Prefix, error) {
+	out := make([]corev1.IPv4Prefix, len(in))
+
+	seen := map[string]bool{}
+
+	for i, v := range in {
+		ip, prefix, err := net.ParseCIDR(v)
+		if err != nil {
+			return nil, errors.OAuth2InvalidRequest("failed to parse IPv4 prefix").WithError(err)
+		}
+
+		if ip.To4() == nil {
+			return nil, errors.OAuth2InvalidRequest(fmt.Sprintf("allowed source address %s is not an IPv4 prefix", v))
+		}
+
+		if !ip.Equal(prefix.IP) {
+			return nil, errors.OAuth2InvalidRequest(fmt.Sprintf("allowed source address %s has host bits set, did you mean %s", v, prefix))
+		}
+
+		if seen[prefix.String()] {
+			return nil, errors.OAuth2InvalidRequest(fmt.Sprintf("allowed source address %s is duplicated", v))
+		}
+
+		seen[prefix.String()] = true
+
+		out[i] = corev1.IPv4Prefix{
+			IPNet: *prefix,
+		}
+	}
+
+	return out, nil
+}
+
+// GenerateNetworking converts instance networking from the API definition.  Empty
+// lists are omitted, and networking that is entirely defaulted is nil, as it is when
+// converted.
+func GenerateNetworking(in *computeapi.InstanceNetworking) (*computev1.ComputeInstanceNetworking, error) {
+	if in == nil {
+		//nolint:nilnil
+		return nil, nil
+	}
+
+	var temp computev1.ComputeInstanceNetworking
+
+	networking := *in
+
+	if networking.PublicIP != nil {
+		temp.PublicIP = *networking.PublicIP
+	}
+
+	if networking.SecurityGroups != nil && len(*networking.SecurityGroups) > 0 {
+		temp.SecurityGroupIDs = *networking.SecurityGroups
+	}
+
+	if networking.AllowedSourceAddresses != nil && len(*networking.AllowedSourceAddresses) > 0 {
+		allowedSourceAddresses, err := parseAllowedSourceAddresses(*networking.AllowedSourceAddresses)
+		if err != nil {
+			return nil, err
+		}
+
+		temp.AllowedSourceAddresses = allowedSourceAddresses
+	}
+
+	if reflect.ValueOf(temp).IsZero() {
+		//nolint:nilnil
+		return nil, nil
+	}
+
+	return &temp, nil
+}
+
+// GeneratePowerSchedule converts and validates a power schedule from the API definition.
+func GeneratePowerSchedule(in *computeapi.InstancePowerSchedule) (*computev1.ComputeInstancePowerSchedule, error) {
+	if in == nil {
+		//nolint:nilnil
+		return nil, nil
+	}
+
+	out := &computev1.ComputeInstancePowerSchedule{
+		Start:    ptr.Deref(in.Start, ""),
+		Stop:     ptr.Deref(in.Stop, ""),
+		TimeZone: ptr.Deref(in.TimeZone, ""),
+	}
+
+	if _, err := powerschedule.Parse(out); err != nil {
+		return nil, errors.OAuth2InvalidRequest(err.Error()).WithError(err)
+	}
+
+	return out, nil
+}
+
+// GenerateSnapshotPolicy converts and validates a snapshot policy from the API definition.
+func GenerateSnapshotPolicy(in *computeapi.InstanceSnapshotPolicy) (*computev1.ComputeInstanceSnapshotPolicy, error) {
+	if in == nil {
+		//nolint:nilnil
+		return nil, nil
+	}
+
+	out := &computev1.ComputeInstanceSnapshotPolicy{
+		Schedule:  in.Schedule,
+		TimeZone:  ptr.Deref(in.TimeZone, ""),
+		Retention: in.Retention,
+	}
+
+	if _, err := snapshot.Parse(out); err != nil {
+		return nil, errors.OAuth2InvalidRequest(err.Error()).WithError(err)
+	}
+
+	return out, nil
+}
+
+// GenerateUserData converts user data from the API definition.
+func GenerateUserData(in *[]byte) []byte {
+	if in == nil || len(*in) == 0 {
+		return nil
+	}
+
+	return *in
+}
+
+// GenerateBootstrapProfile converts a bootstrap profile name from the API definition.
+func GenerateBootstrapProfile(in *string) string {
+	if in == nil {
+		return ""
+	}
+
+	return *in
+}
@@ -0,0 +1,216 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package health provides liveness and readiness endpoints that check the
+// dependencies a component needs to function, so Kubernetes can detect, and
+// restart or stop routing traffic to, wedged components.
+package health
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/spf13/pflag"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+)
+
+var (
+	// ErrUnhealthy is raised when a dependency is not functioning.
+	ErrUnhealthy = errors.New("dependency unhealthy")
+)
+
+type Options struct {
+	// BindAddress is where the health endpoints are served.
+	BindAddress string
+
+	// Timeout bounds how long all checks may take.
+	Timeout time.Duration
+}
+
+func (o *Options) AddFlags(f *pflag.FlagSet) {
+	f.StringVar(&o.BindAddress, "health-probe-bind-address", ":8081", "Address to serve the /healthz and /readyz endpoints on.")
+	f.DurationVar(&o.Timeout, "health-check-timeout", 5*time.Second, "How long dependency checks may take before they are considered failed.")
+}
+
+// CheckFunc checks a dependency, returning an error if it's not functioning.
+type CheckFunc func(ctx context.Context) error
+
+// check is a named dependency check.
+type check struct {
+	name  string
+	check CheckFunc
+}
+
+// Checker serves liveness checks on /healthz and readiness checks on /readyz.
+// Liveness checks should be limited to things that a restart would fix, while
+// readiness checks may include anything required to serve requests.
+type Checker struct {
+	options   *Options
+	liveness  []check
+	readiness []check
+}
+
+// Checker may be added to a controller manager.
+var _ manager.LeaderElectionRunnable = &Checker{}
+
+// New creates a new health checker.
+func New(options *Options) *Checker {
+	return &Checker{
+		options: options,
+	}
+}
+
+// AddLivenessCheck adds a check to /healthz, it's implicitly a readiness check too.
+func (c *Checker) AddLivenessCheck(name string, fn CheckFunc) {
+	c.liveness = append(c.liveness, check{name: name, check: fn})
+}
+
+// AddReadinessCheck adds a check to /readyz.
+func (c *Checker) AddReadinessCheck(name string, fn CheckFunc) {
+	c.readiness = append(c.readiness, check{name: name, check: fn})
+}
+
+// run executes the checks and reports the outcome.
+func (c *Checker) run(checks []check) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		if c.options.Timeout > 0 {
+			var cancel context.CancelFunc
+
+			ctx, cancel = context.WithTimeout(ctx, c.options.Timeout)
+			defer cancel()
+		}
+
+		var report strings.Builder
+
+		status := http.StatusOK
+
+		for _, check := range checks {
+			if err := check.check(ctx); err != nil {
+				log.FromContext(ctx).Info("health check failed", "check", check.name, "error", err)
+
+				status = http.StatusServiceUnavailable
+
+				fmt.Fprintf(&report, "[-]%s failed: %v\n", check.name, err)
+
+				continue
+			}
+
+			fmt.Fprintf(&report, "[+]%s ok\n", check.name)
+		}
+
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		w.Header().Set("Cache-Control", "no-cache")
+		w.WriteHeader(status)
+
+		_, _ = w.Write([]byte(report.String()))
+	}
+}
+
+// Handler returns the health endpoints.
+func (c *Checker) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.Handle("GET /healthz", c.run(c.liveness))
+	mux.Handle("GET /readyz", c.run(append(c.liveness, c.readiness...)))
+
+	return mux
+}
+
+// Start serves the health endpoints until the context is cancelled.
+func (c *Checker) Start(ctx context.Context) error {
+	server := &http.Server{
+		Addr:              c.options.BindAddress,
+		ReadHeaderTimeout: 10 * time.Second,
+		Handler:           c.Handler(),
+	}
+
+	go func() {
+		<-ctx.Done()
+
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		_ = server.Shutdown(shutdownCtx)
+	}()
+
+	if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+
+	return nil
+}
+
+// NeedLeaderElection allows standby replicas to report their health too.
+func (*Checker) NeedLeaderElection() bool {
+	return false
+}
+
+// KubernetesCheck lists resources to ensure the Kubernetes API is reachable and the
+// component has access to what it needs.  The reader must not be backed by a cache.
+// The list is used as a template, and is never modified.
+func KubernetesCheck(reader client.Reader, namespace string, list client.ObjectList) CheckFunc {
+	return func(ctx context.Context) error {
+		//nolint:forcetypeassert
+		if err := reader.List(ctx, list.DeepCopyObject().(client.ObjectList), client.InNamespace(namespace), client.Limit(1)); err != nil {
+			return fmt.Errorf("%w: kubernetes: %w", ErrUnhealthy, err)
+		}
+
+		return nil
+	}
+}
+
+// HTTPClientFunc creates a HTTP client, this is expected to load any TLS
+// configuration e.g. CA and client certificates.
+type HTTPClientFunc func(ctx context.Context) (*http.Client, error)
+
+// HTTPCheck ensures a remote service is reachable, any response other than a
+// server error is considered healthy as it proves the service is serving
+// and that a TLS connection can be established.
+func HTTPCheck(newClient HTTPClientFunc, url string) CheckFunc {
+	return func(ctx context.Context) error {
+		client, err := newClient(ctx)
+		if err != nil {
+			return fmt.Errorf("%w: client: %w", ErrUnhealthy, err)
+		}
+
+		request, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+		if err != nil {
+			return err
+		}
+
+		response, err := client.Do(request)
+		if err != nil {
+			return fmt.Errorf("%w: %w", ErrUnhealthy, err)
+		}
+
+		defer response.Body.Close()
+
+		if response.StatusCode >= http.StatusInternalServerError {
+			return fmt.Errorf("%w: %s returned status %d", ErrUnhealthy, url, response.StatusCode)
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,94 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package health_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/unikorn-cloud/compute/pkg/health"
+)
+
+var errBroken = errors.New("broken")
+
+func probe(t *testing.T, handler http.Handler, path string) int {
+	t.Helper()
+
+	w := httptest.NewRecorder()
+
+	handler.ServeHTTP(w, httptest.NewRequestWithContext(t.Context(), http.MethodGet, path, nil))
+
+	return w.Code
+}
+
+// TestChecker ensures readiness failures don't affect liveness, while liveness
+// failures affect both.
+func TestChecker(t *testing.T) {
+	t.Parallel()
+
+	var liveness, readiness error
+
+	checker := health.New(&health.Options{})
+	checker.AddLivenessCheck("live", func(context.Context) error { return liveness })
+	checker.AddReadinessCheck("ready", func(context.Context) error { return readiness })
+
+	handler := checker.Handler()
+
+	require.Equal(t, http.StatusOK, probe(t, handler, "/healthz"))
+	require.Equal(t, http.StatusOK, probe(t, handler, "/readyz"))
+
+	readiness = errBroken
+
+	require.Equal(t, http.StatusOK, probe(t, handler, "/healthz"))
+	require.Equal(t, http.StatusServiceUnavailable, probe(t, handler, "/readyz"))
+
+	liveness = errBroken
+	readiness = nil
+
+	require.Equal(t, http.StatusServiceUnavailable, probe(t, handler, "/healthz"))
+	require.Equal(t, http.StatusServiceUnavailable, probe(t, handler, "/readyz"))
+}
+
+// TestHTTPCheck ensures only server errors are considered unhealthy.
+func TestHTTPCheck(t *testing.T) {
+	t.Parallel()
+
+	status := http.StatusUnauthorized
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(status)
+	}))
+	defer server.Close()
+
+	newClient := func(context.Context) (*http.Client, error) {
+		return server.Client(), nil
+	}
+
+	check := health.HTTPCheck(newClient, server.URL)
+
+	require.NoError(t, check(t.Context()))
+
+	status = http.StatusBadGateway
+
+	require.ErrorIs(t, check(t.Context()), health.ErrUnhealthy)
+}
@@ -0,0 +1,37 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package health
+
+import (
+	coreclient "github.com/unikorn-cloud/core/pkg/client"
+	identityclient "github.com/unikorn-cloud/identity/pkg/client"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// IdentityCheck ensures the identity service is able to issue tokens, by loading
+// client certificates and fetching its OIDC discovery document.
+func IdentityCheck(cli client.Client, options *coreclient.HTTPOptions, clientOptions *coreclient.HTTPClientOptions) CheckFunc {
+	return HTTPCheck(identityclient.NewBaseClient(cli, options, clientOptions).HTTPClient, options.Host()+"/.well-known/openid-configuration")
+}
+
+// RegionCheck ensures the region service is reachable with the configured client
+// certificates.
+func RegionCheck(cli client.Client, options *coreclient.HTTPOptions, clientOptions *coreclient.HTTPClientOptions) CheckFunc {
+	return HTTPCheck(identityclient.NewBaseClient(cli, options, clientOptions).HTTPClient, options.Host())
+}
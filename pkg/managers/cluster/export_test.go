@@ -0,0 +1,37 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// Validator is the admission validator, exported for testing.
+type Validator = validator
+
+// NewMaintenanceRequeuer creates a maintenance requeuer, exported for testing.
+func NewMaintenanceRequeuer(client client.Client, delegate reconcile.Reconciler) reconcile.Reconciler {
+	return &maintenanceRequeuer{
+		client:   client,
+		delegate: delegate,
+	}
+}
+
+//nolint:gochecknoglobals
+var MaintenanceWindowToClusterMapFunc = maintenanceWindowToClusterMapFunc
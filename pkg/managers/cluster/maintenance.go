@@ -0,0 +1,107 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"context"
+	"time"
+
+	unikornv1 "github.com/unikorn-cloud/compute/pkg/apis/unikorn/v1alpha1"
+	"github.com/unikorn-cloud/compute/pkg/provisioners/managers/cluster/util"
+
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// maintenanceRequeuer wraps a reconciler and ensures the cluster is reconciled
+// again when the next maintenance window affecting its region starts or ends,
+// otherwise machine conditions would be stale until something else happened
+// to trigger a reconcile.
+type maintenanceRequeuer struct {
+	client   client.Client
+	delegate reconcile.Reconciler
+}
+
+var _ reconcile.Reconciler = &maintenanceRequeuer{}
+
+// Reconcile implements the reconcile.Reconciler interface.
+func (r *maintenanceRequeuer) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	result, err := r.delegate.Reconcile(ctx, request)
+	if err != nil {
+		return result, err
+	}
+
+	cluster := &unikornv1.ComputeCluster{}
+
+	if err := r.client.Get(ctx, request.NamespacedName, cluster); err != nil {
+		if kerrors.IsNotFound(err) {
+			return result, nil
+		}
+
+		return reconcile.Result{}, err
+	}
+
+	windows := &unikornv1.ComputeMaintenanceWindowList{}
+
+	if err := r.client.List(ctx, windows, &client.ListOptions{Namespace: cluster.Namespace}); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	next, ok := util.NextMaintenanceChange(windows.Items, cluster.Spec.RegionID, time.Now())
+	if !ok {
+		return result, nil
+	}
+
+	after := max(time.Until(next), time.Second)
+
+	if result.RequeueAfter == 0 || after < result.RequeueAfter {
+		result.RequeueAfter = after
+	}
+
+	return result, nil
+}
+
+// maintenanceWindowToClusterMapFunc triggers a reconcile of all clusters a maintenance
+// window may affect when it's created, modified or deleted.
+func maintenanceWindowToClusterMapFunc(c client.Client) func(context.Context, *unikornv1.ComputeMaintenanceWindow) []reconcile.Request {
+	return func(ctx context.Context, window *unikornv1.ComputeMaintenanceWindow) []reconcile.Request {
+		var clusters unikornv1.ComputeClusterList
+
+		if err := c.List(ctx, &clusters, &client.ListOptions{Namespace: window.Namespace}); err != nil {
+			return nil
+		}
+
+		var requests []reconcile.Request
+
+		for i := range clusters.Items {
+			cluster := &clusters.Items[i]
+
+			if window.Spec.RegionID != "" && window.Spec.RegionID != cluster.Spec.RegionID {
+				continue
+			}
+
+			requests = append(requests, reconcile.Request{
+				NamespacedName: client.ObjectKeyFromObject(cluster),
+			})
+		}
+
+		return requests
+	}
+}
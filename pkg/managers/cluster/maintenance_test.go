@@ -0,0 +1,132 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	unikornv1 "github.com/unikorn-cloud/compute/pkg/apis/unikorn/v1alpha1"
+	"github.com/unikorn-cloud/compute/pkg/managers/cluster"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+func newMaintenanceClient(t *testing.T, objects ...client.Object) client.Client {
+	t.Helper()
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, unikornv1.AddToScheme(scheme))
+
+	return fake.NewClientBuilder().WithScheme(scheme).WithObjects(objects...).Build()
+}
+
+func newMaintenanceCluster(name, regionID string) *unikornv1.ComputeCluster {
+	return &unikornv1.ComputeCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      name,
+		},
+		Spec: unikornv1.ComputeClusterSpec{
+			RegionID: regionID,
+		},
+	}
+}
+
+func newMaintenanceWindow(name, regionID string, start, end time.Time) *unikornv1.ComputeMaintenanceWindow {
+	return &unikornv1.ComputeMaintenanceWindow{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      name,
+		},
+		Spec: unikornv1.ComputeMaintenanceWindowSpec{
+			RegionID: regionID,
+			Start:    metav1.NewTime(start),
+			End:      metav1.NewTime(end),
+		},
+	}
+}
+
+type staticReconciler struct {
+	result reconcile.Result
+}
+
+func (r *staticReconciler) Reconcile(context.Context, reconcile.Request) (reconcile.Result, error) {
+	return r.result, nil
+}
+
+// TestMaintenanceRequeue checks clusters are requeued for the next window boundary in
+// their region, unless the reconciler already wants to requeue sooner.
+func TestMaintenanceRequeue(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+
+	cli := newMaintenanceClient(t,
+		newMaintenanceCluster("cluster", "region"),
+		newMaintenanceWindow("window", "region", now.Add(time.Hour), now.Add(2*time.Hour)),
+		newMaintenanceWindow("other", "other", now.Add(time.Minute), now.Add(2*time.Hour)),
+	)
+
+	request := reconcile.Request{
+		NamespacedName: client.ObjectKey{Namespace: "default", Name: "cluster"},
+	}
+
+	result, err := cluster.NewMaintenanceRequeuer(cli, &staticReconciler{}).Reconcile(t.Context(), request)
+	require.NoError(t, err)
+	require.Greater(t, result.RequeueAfter, 59*time.Minute)
+	require.LessOrEqual(t, result.RequeueAfter, time.Hour)
+
+	delegate := &staticReconciler{
+		result: reconcile.Result{RequeueAfter: time.Minute},
+	}
+
+	result, err = cluster.NewMaintenanceRequeuer(cli, delegate).Reconcile(t.Context(), request)
+	require.NoError(t, err)
+	require.Equal(t, time.Minute, result.RequeueAfter)
+}
+
+// TestMaintenanceWindowToCluster checks windows map to the clusters in their region,
+// or all clusters when not scoped to a region.
+func TestMaintenanceWindowToCluster(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+
+	cli := newMaintenanceClient(t,
+		newMaintenanceCluster("a", "region"),
+		newMaintenanceCluster("b", "other"),
+	)
+
+	mapFunc := cluster.MaintenanceWindowToClusterMapFunc(cli)
+
+	requests := mapFunc(t.Context(), newMaintenanceWindow("window", "region", now, now.Add(time.Hour)))
+	require.Len(t, requests, 1)
+	require.Equal(t, "a", requests[0].Name)
+
+	requests = mapFunc(t.Context(), newMaintenanceWindow("window", "", now, now.Add(time.Hour)))
+	require.Len(t, requests, 2)
+}
@@ -23,7 +23,12 @@ import (
 
 	unikornv1 "github.com/unikorn-cloud/compute/pkg/apis/unikorn/v1alpha1"
 	"github.com/unikorn-cloud/compute/pkg/constants"
+	"github.com/unikorn-cloud/compute/pkg/health"
+	managerutil "github.com/unikorn-cloud/compute/pkg/managers/util"
+	"github.com/unikorn-cloud/compute/pkg/messaging"
+	messagingkubernetes "github.com/unikorn-cloud/compute/pkg/messaging/kubernetes"
 	"github.com/unikorn-cloud/compute/pkg/provisioners/managers/cluster"
+	"github.com/unikorn-cloud/compute/pkg/provisioners/notifications"
 	coreclient "github.com/unikorn-cloud/core/pkg/client"
 	coreconstants "github.com/unikorn-cloud/core/pkg/constants"
 	coremanager "github.com/unikorn-cloud/core/pkg/manager"
@@ -33,6 +38,7 @@ import (
 
 	"k8s.io/apimachinery/pkg/types"
 
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
@@ -43,23 +49,89 @@ import (
 )
 
 // Factory provides methods that can build a type specific controller.
-type Factory struct{}
+type Factory struct {
+	// options are retained so the webhook can be configured.
+	options *cluster.Options
+	// controllerOptions wrap the provisioner options with common ones.
+	controllerOptions *managerutil.ControllerOptions
+}
 
 var _ coremanager.ControllerFactory = &Factory{}
 
+var _ coremanager.ControllerInitializer = &Factory{}
+
 // Metadata returns the application, version and revision.
 func (*Factory) Metadata() util.ServiceDescriptor {
 	return constants.ServiceDescriptor()
 }
 
 // Options returns any options to be added to the CLI flags and passed to the reconciler.
-func (*Factory) Options() coremanager.ControllerOptions {
-	return &cluster.Options{}
+func (f *Factory) Options() coremanager.ControllerOptions {
+	f.options = &cluster.Options{}
+
+	f.controllerOptions = &managerutil.ControllerOptions{
+		ControllerOptions: f.options,
+	}
+
+	return f.controllerOptions
+}
+
+// Initialize registers the admission webhooks with the manager, which will then
+// serve them alongside the controller.  It also registers a controller that
+// publishes lifecycle CloudEvents, and one that delivers queued webhook events,
+// these share the manager's leader election, and the health endpoints.
+func (f *Factory) Initialize(ctx context.Context, manager manager.Manager, options *options.Options) error {
+	checker := health.New(&f.controllerOptions.Health)
+	checker.AddLivenessCheck("kubernetes", health.KubernetesCheck(manager.GetAPIReader(), options.Namespace, &unikornv1.ComputeClusterList{}))
+	f.options.AddReadinessChecks(checker, manager.GetClient())
+
+	if err := manager.Add(checker); err != nil {
+		return err
+	}
+
+	defaulter := &defaulter{
+		network: f.options.DefaultNetwork(),
+	}
+
+	if err := builder.WebhookManagedBy(manager).For(&unikornv1.ComputeCluster{}).WithDefaulter(defaulter).WithValidator(&validator{}).Complete(); err != nil {
+		return err
+	}
+
+	// Webhook events are queued by both the cluster and instance controllers, but
+	// only delivered from here, so they aren't delivered twice.  Webhooks are
+	// delivered to concurrently so a slow receiver doesn't hold up the others.
+	deliverer := notifications.NewDeliverer(manager.GetClient(), f.options.Notifier())
+
+	deliveryOptions := controller.Options{
+		MaxConcurrentReconciles: f.options.WebhookDeliveryConcurrency(),
+	}
+
+	if err := builder.ControllerManagedBy(manager).Named("computewebhook-delivery").For(&unikornv1.ComputeWebhook{}).WithOptions(deliveryOptions).Complete(deliverer); err != nil {
+		return err
+	}
+
+	producer := messagingkubernetes.New(manager.GetClient(), options.Namespace)
+
+	lifecycle := messaging.NewLifecycle(manager.GetClient(), producer, "cluster", func() *unikornv1.ComputeCluster {
+		return &unikornv1.ComputeCluster{}
+	})
+
+	return builder.ControllerManagedBy(manager).Named("computecluster-lifecycle").For(&unikornv1.ComputeCluster{}, builder.WithPredicates(messaging.Predicate())).Complete(lifecycle)
 }
 
-// Reconciler returns a new reconciler instance.
-func (*Factory) Reconciler(options *options.Options, controlerOptions coremanager.ControllerOptions, manager manager.Manager) reconcile.Reconciler {
-	return coremanager.NewReconciler(options, controlerOptions, manager, cluster.New)
+// Reconciler returns a new reconciler instance, limited so no one organization
+// can monopolize the controller, and requeued for upcoming maintenance windows.
+func (f *Factory) Reconciler(options *options.Options, _ coremanager.ControllerOptions, manager manager.Manager) reconcile.Reconciler {
+	newObject := func() client.Object {
+		return &unikornv1.ComputeCluster{}
+	}
+
+	reconciler := coremanager.NewReconciler(options, f.options, manager, cluster.New)
+
+	return &maintenanceRequeuer{
+		client:   manager.GetClient(),
+		delegate: managerutil.NewOrganizationLimiter(manager.GetClient(), &f.controllerOptions.Fairness, "cluster", newObject, reconciler),
+	}
 }
 
 // serverEnqueueRequest watches for cluster server member updates and triggers
@@ -106,10 +178,23 @@ func serverToClusterMapFunc(manager manager.Manager) func(context.Context, *regi
 	}
 }
 
+// clusterSpecWithoutTags returns the cluster specification without any tags.
+func clusterSpecWithoutTags(cluster *unikornv1.ComputeCluster) any {
+	spec := cluster.Spec.DeepCopy()
+	spec.Tags = nil
+
+	return spec
+}
+
 // RegisterWatches adds any watches that would trigger a reconcile.
 func (*Factory) RegisterWatches(manager manager.Manager, controller controller.Controller) error {
-	// Any changes to the cluster spec, trigger a reconcile.
-	if err := controller.Watch(source.Kind(manager.GetCache(), &unikornv1.ComputeCluster{}, &handler.TypedEnqueueRequestForObject[*unikornv1.ComputeCluster]{}, &predicate.TypedGenerationChangedPredicate[*unikornv1.ComputeCluster]{})); err != nil {
+	// Any changes to the cluster spec, trigger a reconcile.  Tags are only applied
+	// when servers are created, so tag only changes are ignored.
+	specChanged := &managerutil.SpecChangedPredicate[*unikornv1.ComputeCluster]{
+		Spec: clusterSpecWithoutTags,
+	}
+
+	if err := controller.Watch(source.Kind(manager.GetCache(), &unikornv1.ComputeCluster{}, &handler.TypedEnqueueRequestForObject[*unikornv1.ComputeCluster]{}, specChanged)); err != nil {
 		return err
 	}
 
@@ -118,6 +203,12 @@ func (*Factory) RegisterWatches(manager manager.Manager, controller controller.C
 		return err
 	}
 
+	// Maintenance windows opening, closing or changing affect the conditions reported
+	// on the clusters in their region.
+	if err := controller.Watch(source.Kind(manager.GetCache(), &unikornv1.ComputeMaintenanceWindow{}, handler.TypedEnqueueRequestsFromMapFunc(maintenanceWindowToClusterMapFunc(manager.GetClient())), &predicate.TypedGenerationChangedPredicate[*unikornv1.ComputeMaintenanceWindow]{})); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -0,0 +1,117 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"context"
+	"fmt"
+
+	unikornv1 "github.com/unikorn-cloud/compute/pkg/apis/unikorn/v1alpha1"
+	managerutil "github.com/unikorn-cloud/compute/pkg/managers/util"
+	unikornv1core "github.com/unikorn-cloud/core/pkg/apis/unikorn/v1alpha1"
+
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// defaulter applies the same defaults as the API to compute clusters, so those
+// created directly e.g. by GitOps are stored identically.
+type defaulter struct {
+	// network is applied when a cluster has none.
+	network *unikornv1core.NetworkGeneric
+}
+
+var _ admission.CustomDefaulter = &defaulter{}
+
+// Default implements the admission.CustomDefaulter interface.
+func (d *defaulter) Default(ctx context.Context, obj runtime.Object) error {
+	cluster, ok := obj.(*unikornv1.ComputeCluster)
+	if !ok {
+		return fmt.Errorf("%w: expected a compute cluster", unikornv1.ErrValidation)
+	}
+
+	// Once deleting, the controller must always be able to remove its finalizer.
+	if cluster.DeletionTimestamp != nil {
+		return nil
+	}
+
+	cluster.Spec.Default(d.network)
+
+	return nil
+}
+
+// validator checks compute clusters are provisionable before they are persisted,
+// this catches things the CRD schema cannot express e.g. cross field checks,
+// and protects against direct edits that bypass the API.
+type validator struct{}
+
+var _ admission.CustomValidator = &validator{}
+
+// ValidateCreate implements the admission.CustomValidator interface.
+func (*validator) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	cluster, ok := obj.(*unikornv1.ComputeCluster)
+	if !ok {
+		return nil, fmt.Errorf("%w: expected a compute cluster", unikornv1.ErrValidation)
+	}
+
+	return nil, cluster.Spec.Validate()
+}
+
+// ValidateUpdate implements the admission.CustomValidator interface.
+func (*validator) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	oldCluster, ok := oldObj.(*unikornv1.ComputeCluster)
+	if !ok {
+		return nil, fmt.Errorf("%w: expected a compute cluster", unikornv1.ErrValidation)
+	}
+
+	cluster, ok := newObj.(*unikornv1.ComputeCluster)
+	if !ok {
+		return nil, fmt.Errorf("%w: expected a compute cluster", unikornv1.ErrValidation)
+	}
+
+	// Once deleting, the controller must always be able to remove its finalizer.
+	if cluster.DeletionTimestamp != nil {
+		return nil, nil
+	}
+
+	if err := managerutil.ValidateRegionUpdate(oldCluster, cluster); err != nil {
+		return nil, err
+	}
+
+	if err := cluster.Spec.ValidateUpdate(&oldCluster.Spec); err != nil {
+		return nil, err
+	}
+
+	if err := cluster.Spec.Validate(); err != nil {
+		// Resources that predate validation must still be updatable by the
+		// controller e.g. to add annotations, so only warn about them.
+		if oldCluster.Spec.Validate() != nil {
+			return admission.Warnings{err.Error()}, nil
+		}
+
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+// ValidateDelete implements the admission.CustomValidator interface.
+func (*validator) ValidateDelete(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
@@ -0,0 +1,68 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	unikornv1 "github.com/unikorn-cloud/compute/pkg/apis/unikorn/v1alpha1"
+	"github.com/unikorn-cloud/compute/pkg/managers/cluster"
+	regionconstants "github.com/unikorn-cloud/region/pkg/constants"
+)
+
+func newCluster(replicas int) *unikornv1.ComputeCluster {
+	c := &unikornv1.ComputeCluster{}
+	c.Labels = map[string]string{
+		regionconstants.RegionLabel: "region",
+	}
+	c.Spec.RegionID = "region"
+	c.Spec.Pools = []unikornv1.InstancePoolSpec{
+		{
+			Name:     "default",
+			Replicas: replicas,
+		},
+	}
+
+	return c
+}
+
+// TestValidateUpdate checks invalid updates are rejected, unless the resource
+// was already invalid.
+func TestValidateUpdate(t *testing.T) {
+	t.Parallel()
+
+	v := &cluster.Validator{}
+
+	_, err := v.ValidateUpdate(t.Context(), newCluster(1), newCluster(2))
+	require.NoError(t, err)
+
+	_, err = v.ValidateUpdate(t.Context(), newCluster(1), newCluster(-1))
+	require.ErrorIs(t, err, unikornv1.ErrValidation)
+
+	warnings, err := v.ValidateUpdate(t.Context(), newCluster(-1), newCluster(-1))
+	require.NoError(t, err)
+	require.Len(t, warnings, 1)
+
+	moved := newCluster(1)
+	moved.Labels[regionconstants.RegionLabel] = "other"
+
+	_, err = v.ValidateUpdate(t.Context(), newCluster(1), moved)
+	require.ErrorIs(t, err, unikornv1.ErrValidation)
+}
@@ -23,6 +23,10 @@ import (
 
 	unikornv1 "github.com/unikorn-cloud/compute/pkg/apis/unikorn/v1alpha1"
 	"github.com/unikorn-cloud/compute/pkg/constants"
+	"github.com/unikorn-cloud/compute/pkg/health"
+	managerutil "github.com/unikorn-cloud/compute/pkg/managers/util"
+	"github.com/unikorn-cloud/compute/pkg/messaging"
+	messagingkubernetes "github.com/unikorn-cloud/compute/pkg/messaging/kubernetes"
 	"github.com/unikorn-cloud/compute/pkg/provisioners/managers/instance"
 	coreclient "github.com/unikorn-cloud/core/pkg/client"
 	coremanager "github.com/unikorn-cloud/core/pkg/manager"
@@ -32,6 +36,7 @@ import (
 
 	"k8s.io/apimachinery/pkg/types"
 
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
@@ -42,23 +47,69 @@ import (
 )
 
 // Factory provides methods that can build a type specific controller.
-type Factory struct{}
+type Factory struct {
+	// options are retained so the reconciler can be configured.
+	options *instance.Options
+	// controllerOptions wrap the provisioner options with common ones.
+	controllerOptions *managerutil.ControllerOptions
+}
 
 var _ coremanager.ControllerFactory = &Factory{}
 
+var _ coremanager.ControllerInitializer = &Factory{}
+
 // Metadata returns the application, version and revision.
 func (*Factory) Metadata() util.ServiceDescriptor {
 	return constants.ServiceDescriptor()
 }
 
 // Options returns any options to be added to the CLI flags and passed to the reconciler.
-func (*Factory) Options() coremanager.ControllerOptions {
-	return &instance.Options{}
+func (f *Factory) Options() coremanager.ControllerOptions {
+	f.options = &instance.Options{}
+
+	f.controllerOptions = &managerutil.ControllerOptions{
+		ControllerOptions: f.options,
+	}
+
+	return f.controllerOptions
 }
 
-// Reconciler returns a new reconciler instance.
-func (*Factory) Reconciler(options *options.Options, controlerOptions coremanager.ControllerOptions, manager manager.Manager) reconcile.Reconciler {
-	return coremanager.NewReconciler(options, controlerOptions, manager, instance.New)
+// Initialize registers the admission webhook with the manager, which will then
+// serve it alongside the controller.  It also registers a controller that
+// publishes lifecycle CloudEvents, this shares the manager's leader election,
+// and the health endpoints.
+func (f *Factory) Initialize(ctx context.Context, manager manager.Manager, options *options.Options) error {
+	checker := health.New(&f.controllerOptions.Health)
+	checker.AddLivenessCheck("kubernetes", health.KubernetesCheck(manager.GetAPIReader(), options.Namespace, &unikornv1.ComputeInstanceList{}))
+	f.options.AddReadinessChecks(checker, manager.GetClient())
+
+	if err := manager.Add(checker); err != nil {
+		return err
+	}
+
+	if err := builder.WebhookManagedBy(manager).For(&unikornv1.ComputeInstance{}).WithValidator(&validator{}).Complete(); err != nil {
+		return err
+	}
+
+	producer := messagingkubernetes.New(manager.GetClient(), options.Namespace)
+
+	lifecycle := messaging.NewLifecycle(manager.GetClient(), producer, "instance", func() *unikornv1.ComputeInstance {
+		return &unikornv1.ComputeInstance{}
+	})
+
+	return builder.ControllerManagedBy(manager).Named("computeinstance-lifecycle").For(&unikornv1.ComputeInstance{}, builder.WithPredicates(messaging.Predicate())).Complete(lifecycle)
+}
+
+// Reconciler returns a new reconciler instance, limited so no one organization
+// can monopolize the controller.
+func (f *Factory) Reconciler(options *options.Options, _ coremanager.ControllerOptions, manager manager.Manager) reconcile.Reconciler {
+	newObject := func() client.Object {
+		return &unikornv1.ComputeInstance{}
+	}
+
+	reconciler := coremanager.NewReconciler(options, f.options, manager, instance.New)
+
+	return managerutil.NewOrganizationLimiter(manager.GetClient(), &f.controllerOptions.Fairness, "instance", newObject, reconciler)
 }
 
 // serverToInstanceMapFunc watches for server updates and triggers
@@ -105,10 +156,26 @@ func serverToInstanceMapFunc(manager manager.Manager) func(context.Context, *reg
 	}
 }
 
+// instanceSpecWithoutTags returns the instance specification without any tags.
+func instanceSpecWithoutTags(instance *unikornv1.ComputeInstance) any {
+	spec := instance.Spec.DeepCopy()
+	spec.Tags = nil
+
+	return spec
+}
+
 // RegisterWatches adds any watches that would trigger a reconcile.
 func (*Factory) RegisterWatches(manager manager.Manager, controller controller.Controller) error {
-	// Any changes to the instance spec, trigger a reconcile.
-	if err := controller.Watch(source.Kind(manager.GetCache(), &unikornv1.ComputeInstance{}, &handler.TypedEnqueueRequestForObject[*unikornv1.ComputeInstance]{}, &predicate.TypedGenerationChangedPredicate[*unikornv1.ComputeInstance]{})); err != nil {
+	// Any changes to the instance spec, trigger a reconcile.  Tags are not propagated
+	// to servers, so tag only changes are ignored.  Label changes also trigger a
+	// reconcile as the network is defined by one, and instances may be migrated.
+	specChanged := &managerutil.SpecChangedPredicate[*unikornv1.ComputeInstance]{
+		Spec: instanceSpecWithoutTags,
+	}
+
+	changed := predicate.Or[*unikornv1.ComputeInstance](specChanged, predicate.TypedLabelChangedPredicate[*unikornv1.ComputeInstance]{})
+
+	if err := controller.Watch(source.Kind(manager.GetCache(), &unikornv1.ComputeInstance{}, &handler.TypedEnqueueRequestForObject[*unikornv1.ComputeInstance]{}, changed)); err != nil {
 		return err
 	}
 
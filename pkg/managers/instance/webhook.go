@@ -0,0 +1,66 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instance
+
+import (
+	"context"
+	"fmt"
+
+	unikornv1 "github.com/unikorn-cloud/compute/pkg/apis/unikorn/v1alpha1"
+	managerutil "github.com/unikorn-cloud/compute/pkg/managers/util"
+
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// validator checks compute instances cannot be modified in ways the provisioner
+// is unable to handle via direct edits that bypass the API.
+type validator struct{}
+
+var _ admission.CustomValidator = &validator{}
+
+// ValidateCreate implements the admission.CustomValidator interface.
+func (*validator) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+// ValidateUpdate implements the admission.CustomValidator interface.
+func (*validator) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	oldInstance, ok := oldObj.(*unikornv1.ComputeInstance)
+	if !ok {
+		return nil, fmt.Errorf("%w: expected a compute instance", unikornv1.ErrValidation)
+	}
+
+	instance, ok := newObj.(*unikornv1.ComputeInstance)
+	if !ok {
+		return nil, fmt.Errorf("%w: expected a compute instance", unikornv1.ErrValidation)
+	}
+
+	// Once deleting, the controller must always be able to remove its finalizer.
+	if instance.DeletionTimestamp != nil {
+		return nil, nil
+	}
+
+	return nil, managerutil.ValidateRegionUpdate(oldInstance, instance)
+}
+
+// ValidateDelete implements the admission.CustomValidator interface.
+func (*validator) ValidateDelete(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
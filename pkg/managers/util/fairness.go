@@ -0,0 +1,163 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/spf13/pflag"
+
+	"github.com/unikorn-cloud/compute/pkg/health"
+	"github.com/unikorn-cloud/compute/pkg/metrics"
+	coreconstants "github.com/unikorn-cloud/core/pkg/constants"
+	coremanager "github.com/unikorn-cloud/core/pkg/manager"
+
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// FairnessOptions limit how much of the controller's worker pool a single
+// organization may consume.
+type FairnessOptions struct {
+	// MaxOrganizationConcurrency is the maximum number of resources belonging to
+	// an organization that may be reconciled at the same time, zero is unlimited.
+	MaxOrganizationConcurrency int
+	// RequeueDelay is how long a reconcile that exceeds its organization's limit
+	// is deferred for, allowing other organizations to make progress.
+	RequeueDelay time.Duration
+}
+
+func (o *FairnessOptions) AddFlags(f *pflag.FlagSet) {
+	f.IntVar(&o.MaxOrganizationConcurrency, "max-organization-concurrency", 0, "Maximum number of resources belonging to a single organization reconciled at the same time, zero is unlimited.")
+	f.DurationVar(&o.RequeueDelay, "organization-requeue-delay", 5*time.Second, "How long reconciles that exceed an organization's concurrency limit are deferred for.")
+}
+
+// ControllerOptions combines a provisioner's options with those common to all
+// compute controllers.
+type ControllerOptions struct {
+	// ControllerOptions are the provisioner specific options.
+	coremanager.ControllerOptions
+
+	// Fairness limits per-organization concurrency.
+	Fairness FairnessOptions
+
+	// Health controls the liveness and readiness endpoints.
+	Health health.Options
+}
+
+func (o *ControllerOptions) AddFlags(f *pflag.FlagSet) {
+	o.ControllerOptions.AddFlags(f)
+	o.Fairness.AddFlags(f)
+	o.Health.AddFlags(f)
+}
+
+// OrganizationLimiter wraps a reconciler and limits the number of resources
+// belonging to each organization that are reconciled concurrently.  The worker
+// pool is shared, so without this a single organization creating many resources
+// will starve all others.  Requests over the limit are requeued after a delay,
+// freeing the worker for another organization's requests.
+type OrganizationLimiter struct {
+	client     client.Client
+	options    *FairnessOptions
+	controller string
+	newObject  func() client.Object
+	delegate   reconcile.Reconciler
+
+	lock     sync.Mutex
+	inflight map[string]int
+}
+
+var _ reconcile.Reconciler = &OrganizationLimiter{}
+
+// NewOrganizationLimiter creates a new limiter, the client should be backed by
+// the manager's cache so organization lookups are cheap.
+func NewOrganizationLimiter(client client.Client, options *FairnessOptions, controller string, newObject func() client.Object, delegate reconcile.Reconciler) *OrganizationLimiter {
+	return &OrganizationLimiter{
+		client:     client,
+		options:    options,
+		controller: controller,
+		newObject:  newObject,
+		delegate:   delegate,
+		inflight:   map[string]int{},
+	}
+}
+
+// acquire takes a slot for the organization, returning false if the
+// organization is at its limit.
+func (l *OrganizationLimiter) acquire(organizationID string) bool {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	if l.inflight[organizationID] >= l.options.MaxOrganizationConcurrency {
+		return false
+	}
+
+	l.inflight[organizationID]++
+
+	return true
+}
+
+// release returns a slot for the organization.
+func (l *OrganizationLimiter) release(organizationID string) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	l.inflight[organizationID]--
+
+	if l.inflight[organizationID] == 0 {
+		delete(l.inflight, organizationID)
+	}
+}
+
+// Reconcile implements the reconcile.Reconciler interface.
+func (l *OrganizationLimiter) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	if l.options.MaxOrganizationConcurrency <= 0 {
+		return l.delegate.Reconcile(ctx, request)
+	}
+
+	object := l.newObject()
+
+	// Let the reconciler deal with missing resources and any errors, there's
+	// nothing to be gained by being clever here.
+	if err := l.client.Get(ctx, request.NamespacedName, object); err != nil {
+		if !kerrors.IsNotFound(err) {
+			return reconcile.Result{}, err
+		}
+
+		return l.delegate.Reconcile(ctx, request)
+	}
+
+	organizationID, ok := object.GetLabels()[coreconstants.OrganizationLabel]
+	if !ok {
+		return l.delegate.Reconcile(ctx, request)
+	}
+
+	if !l.acquire(organizationID) {
+		metrics.ReconcilesDeferred.WithLabelValues(l.controller).Inc()
+
+		return reconcile.Result{RequeueAfter: l.options.RequeueDelay}, nil
+	}
+
+	defer l.release(organizationID)
+
+	return l.delegate.Reconcile(ctx, request)
+}
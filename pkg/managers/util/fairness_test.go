@@ -0,0 +1,128 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/unikorn-cloud/compute/pkg/managers/util"
+	coreconstants "github.com/unikorn-cloud/core/pkg/constants"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// blockingReconciler signals when a reconcile starts and blocks until released.
+type blockingReconciler struct {
+	started chan string
+	release chan struct{}
+}
+
+func (r *blockingReconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	r.started <- request.Name
+	<-r.release
+
+	return reconcile.Result{}, nil
+}
+
+func organizationObject(name, organizationID string) *corev1.ConfigMap {
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      name,
+			Labels: map[string]string{
+				coreconstants.OrganizationLabel: organizationID,
+			},
+		},
+	}
+}
+
+func request(name string) reconcile.Request {
+	return reconcile.Request{
+		NamespacedName: types.NamespacedName{
+			Namespace: "default",
+			Name:      name,
+		},
+	}
+}
+
+// TestOrganizationLimiter ensures an organization at its limit is deferred, while
+// other organizations continue to be reconciled.
+func TestOrganizationLimiter(t *testing.T) {
+	t.Parallel()
+
+	cli := fake.NewClientBuilder().WithObjects(
+		organizationObject("a1", "a"),
+		organizationObject("a2", "a"),
+		organizationObject("b1", "b"),
+	).Build()
+
+	options := &util.FairnessOptions{
+		MaxOrganizationConcurrency: 1,
+		RequeueDelay:               time.Second,
+	}
+
+	newObject := func() client.Object {
+		return &corev1.ConfigMap{}
+	}
+
+	delegate := &blockingReconciler{
+		started: make(chan string, 3),
+		release: make(chan struct{}),
+	}
+
+	limiter := util.NewOrganizationLimiter(cli, options, "test", newObject, delegate)
+
+	done := make(chan struct{})
+
+	go func() {
+		_, _ = limiter.Reconcile(t.Context(), request("a1"))
+
+		close(done)
+	}()
+
+	require.Equal(t, "a1", <-delegate.started)
+
+	result, err := limiter.Reconcile(t.Context(), request("a2"))
+	require.NoError(t, err)
+	require.Equal(t, time.Second, result.RequeueAfter)
+
+	go func() {
+		_, _ = limiter.Reconcile(t.Context(), request("b1"))
+	}()
+
+	require.Equal(t, "b1", <-delegate.started)
+
+	close(delegate.release)
+	<-done
+
+	// Once released, the organization may be reconciled again.
+	result, err = limiter.Reconcile(t.Context(), request("a2"))
+	require.NoError(t, err)
+	require.Zero(t, result.RequeueAfter)
+	require.Equal(t, "a2", <-delegate.started)
+}
@@ -0,0 +1,53 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"k8s.io/apimachinery/pkg/api/equality"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+)
+
+// SpecChangedPredicate behaves like a generation changed predicate, but ignores
+// generation changes that only affect fields that have no bearing on provisioning,
+// for example tags, saving reconciles that would only result in pointless region
+// API calls.
+type SpecChangedPredicate[T client.Object] struct {
+	predicate.TypedFuncs[T]
+
+	// Spec returns the object's specification with any metadata only fields
+	// cleared.  The object must not be modified.
+	Spec func(T) any
+}
+
+// Update implements the predicate interface.
+func (p SpecChangedPredicate[T]) Update(e event.TypedUpdateEvent[T]) bool {
+	if e.ObjectOld.GetGeneration() == e.ObjectNew.GetGeneration() {
+		return false
+	}
+
+	// Deletion bumps the generation when there are finalizers, and that must
+	// always be acted upon.
+	if !e.ObjectOld.GetDeletionTimestamp().Equal(e.ObjectNew.GetDeletionTimestamp()) {
+		return true
+	}
+
+	return !equality.Semantic.DeepEqual(p.Spec(e.ObjectOld), p.Spec(e.ObjectNew))
+}
@@ -0,0 +1,69 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	unikornv1 "github.com/unikorn-cloud/compute/pkg/apis/unikorn/v1alpha1"
+	"github.com/unikorn-cloud/compute/pkg/managers/util"
+	unikornv1core "github.com/unikorn-cloud/core/pkg/apis/unikorn/v1alpha1"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"sigs.k8s.io/controller-runtime/pkg/event"
+)
+
+func specWithoutTags(instance *unikornv1.ComputeInstance) any {
+	spec := instance.Spec.DeepCopy()
+	spec.Tags = nil
+
+	return spec
+}
+
+// TestSpecChangedPredicate ensures tag only changes are ignored, but everything
+// else is acted upon.
+func TestSpecChangedPredicate(t *testing.T) {
+	t.Parallel()
+
+	p := &util.SpecChangedPredicate[*unikornv1.ComputeInstance]{
+		Spec: specWithoutTags,
+	}
+
+	old := &unikornv1.ComputeInstance{}
+	old.Generation = 1
+	old.Spec.FlavorID = "foo"
+
+	tagged := old.DeepCopy()
+	tagged.Generation++
+	tagged.Spec.Tags = unikornv1core.TagList{{Name: "foo", Value: "bar"}}
+
+	require.False(t, p.Update(event.TypedUpdateEvent[*unikornv1.ComputeInstance]{ObjectOld: old, ObjectNew: tagged}))
+
+	resized := tagged.DeepCopy()
+	resized.Spec.FlavorID = "bar"
+
+	require.True(t, p.Update(event.TypedUpdateEvent[*unikornv1.ComputeInstance]{ObjectOld: old, ObjectNew: resized}))
+
+	deleted := tagged.DeepCopy()
+	deleted.DeletionTimestamp = &metav1.Time{}
+
+	require.True(t, p.Update(event.TypedUpdateEvent[*unikornv1.ComputeInstance]{ObjectOld: old, ObjectNew: deleted}))
+}
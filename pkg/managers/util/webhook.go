@@ -0,0 +1,43 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"fmt"
+
+	unikornv1 "github.com/unikorn-cloud/compute/pkg/apis/unikorn/v1alpha1"
+	regionconstants "github.com/unikorn-cloud/region/pkg/constants"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ValidateRegionUpdate checks the region a resource is provisioned in is not
+// changed, or removed, once set.  The provisioners are unable to migrate
+// resources between regions.
+func ValidateRegionUpdate(oldObj, newObj client.Object) error {
+	oldRegionID, ok := oldObj.GetLabels()[regionconstants.RegionLabel]
+	if !ok {
+		return nil
+	}
+
+	if newObj.GetLabels()[regionconstants.RegionLabel] != oldRegionID {
+		return fmt.Errorf("%w: region label is immutable", unikornv1.ErrValidation)
+	}
+
+	return nil
+}
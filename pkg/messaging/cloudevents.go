@@ -0,0 +1,80 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package messaging publishes CloudEvents describing compute resource lifecycle
+// changes onto the Kubernetes messaging layer, so sibling services can subscribe
+// to them rather than watching our custom resources.
+package messaging
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"time"
+)
+
+const (
+	// SpecVersion is the CloudEvents specification version we conform to.
+	SpecVersion = "1.0"
+
+	// TypePrefix is prepended to all event types.
+	TypePrefix = "org.unikorn-cloud.compute."
+
+	// Source is the source of all events.
+	Source = "//compute.unikorn-cloud.org"
+)
+
+// CloudEvent is a CloudEvent in structured JSON format.
+type CloudEvent struct {
+	// SpecVersion is the CloudEvents version.
+	SpecVersion string `json:"specversion"`
+	// ID uniquely identifies the event, for a given source.  This is
+	// deterministic, so consumers can deduplicate redelivered events.
+	ID string `json:"id"`
+	// Source identifies the context in which the event happened.
+	Source string `json:"source"`
+	// Type is the type of event e.g. org.unikorn-cloud.compute.cluster.provisioned.
+	Type string `json:"type"`
+	// Subject is the resource ID the event relates to.
+	Subject string `json:"subject"`
+	// Time is when the event was produced.
+	Time time.Time `json:"time"`
+	// DataContentType describes the data.
+	DataContentType string `json:"datacontenttype"`
+	// Data is event specific information.
+	Data json.RawMessage `json:"data,omitempty"`
+}
+
+// Producer publishes events.
+type Producer interface {
+	// Publish publishes an event, publishing the same event more than once
+	// must not result in duplicate delivery.
+	Publish(ctx context.Context, event *CloudEvent) error
+}
+
+// EventID generates a deterministic event ID from a set of distinguishing values.
+func EventID(values ...string) string {
+	hash := sha256.New()
+
+	for _, value := range values {
+		hash.Write([]byte(value))
+		hash.Write([]byte{0})
+	}
+
+	return hex.EncodeToString(hash.Sum(nil))[:32]
+}
@@ -0,0 +1,95 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/unikorn-cloud/compute/pkg/constants"
+	"github.com/unikorn-cloud/compute/pkg/messaging"
+
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Producer publishes CloudEvents as Kubernetes events, which are consumable
+// with the core Kubernetes message queue, without any knowledge of our custom
+// resources.  The event's message is the structured CloudEvent, and it's
+// labeled with the event type for selection.
+type Producer struct {
+	client    client.Client
+	namespace string
+}
+
+// New returns a new producer that publishes to the namespace.
+func New(client client.Client, namespace string) *Producer {
+	return &Producer{
+		client:    client,
+		namespace: namespace,
+	}
+}
+
+var _ messaging.Producer = &Producer{}
+
+func (p *Producer) Publish(ctx context.Context, event *messaging.CloudEvent) error {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	now := metav1.NewTime(event.Time)
+
+	// The event ID is used as the name, so an event that has already
+	// been published will be rejected, making publication idempotent.
+	resource := &corev1.Event{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: p.namespace,
+			Name:      event.ID,
+			Labels: map[string]string{
+				constants.CloudEventTypeLabel: event.Type,
+			},
+		},
+		InvolvedObject: corev1.ObjectReference{
+			Namespace: p.namespace,
+			Name:      event.Subject,
+		},
+		Type:    corev1.EventTypeNormal,
+		Reason:  "CloudEvent",
+		Message: string(data),
+		Source: corev1.EventSource{
+			Component: event.Source,
+		},
+		FirstTimestamp: now,
+		LastTimestamp:  now,
+		Count:          1,
+	}
+
+	if err := p.client.Create(ctx, resource); err != nil {
+		if kerrors.IsAlreadyExists(err) {
+			return nil
+		}
+
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,58 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/unikorn-cloud/compute/pkg/messaging"
+	"github.com/unikorn-cloud/compute/pkg/messaging/kubernetes"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+// TestPublishIdempotent ensures republished events are not duplicated.
+func TestPublishIdempotent(t *testing.T) {
+	t.Parallel()
+
+	cli := fake.NewClientBuilder().Build()
+
+	producer := kubernetes.New(cli, "default")
+
+	event := &messaging.CloudEvent{
+		SpecVersion: messaging.SpecVersion,
+		ID:          messaging.EventID("foo"),
+		Source:      messaging.Source,
+		Type:        messaging.TypePrefix + "cluster.created",
+		Subject:     "foo",
+		Time:        time.Now(),
+	}
+
+	require.NoError(t, producer.Publish(t.Context(), event))
+	require.NoError(t, producer.Publish(t.Context(), event))
+
+	events := &corev1.EventList{}
+	require.NoError(t, cli.List(t.Context(), events))
+	require.Len(t, events.Items, 1)
+	require.Equal(t, "org.unikorn-cloud.compute.cluster.created", events.Items[0].Labels["compute.unikorn-cloud.org/cloudevent-type"])
+}
@@ -0,0 +1,224 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package messaging
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"strings"
+	"time"
+
+	unikornv1core "github.com/unikorn-cloud/core/pkg/apis/unikorn/v1alpha1"
+	coreconstants "github.com/unikorn-cloud/core/pkg/constants"
+
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// Object is a resource that has lifecycle events.
+type Object interface {
+	client.Object
+	StatusConditionRead(t unikornv1core.ConditionType) (*unikornv1core.Condition, error)
+}
+
+// LifecycleData is the data attached to lifecycle events.
+type LifecycleData struct {
+	// OrganizationID is the organization the resource belongs to.
+	OrganizationID string `json:"organizationId,omitempty"`
+	// ProjectID is the project the resource belongs to.
+	ProjectID string `json:"projectId,omitempty"`
+	// Generation is the resource's specification version.
+	Generation int64 `json:"generation,omitempty"`
+	// Message is a human readable description of the status.
+	Message string `json:"message,omitempty"`
+}
+
+// Lifecycle is a reconciler that publishes lifecycle events for a resource type:
+// created, updated, provisioning, provisioned, errored, deleting and deleted.
+// Reconciliation replays events, so event IDs are derived from the state that
+// caused them, and the producer deduplicates.
+type Lifecycle[T Object] struct {
+	client       client.Client
+	producer     Producer
+	resourceType string
+	newObject    func() T
+	// now allows time to be mocked in tests.
+	now func() time.Time
+}
+
+// NewLifecycle returns a reconciler for the resource type e.g. "cluster".
+func NewLifecycle[T Object](client client.Client, producer Producer, resourceType string, newObject func() T) *Lifecycle[T] {
+	return &Lifecycle[T]{
+		client:       client,
+		producer:     producer,
+		resourceType: resourceType,
+		newObject:    newObject,
+		now:          time.Now,
+	}
+}
+
+func (l *Lifecycle[T]) newEvent(action, subject string, data *LifecycleData, idValues ...string) (*CloudEvent, error) {
+	eventType := TypePrefix + l.resourceType + "." + action
+
+	event := &CloudEvent{
+		SpecVersion:     SpecVersion,
+		ID:              EventID(append([]string{eventType, subject}, idValues...)...),
+		Source:          Source,
+		Type:            eventType,
+		Subject:         subject,
+		Time:            l.now().UTC(),
+		DataContentType: "application/json",
+	}
+
+	if data != nil {
+		raw, err := json.Marshal(data)
+		if err != nil {
+			return nil, err
+		}
+
+		event.Data = raw
+	}
+
+	return event, nil
+}
+
+// Events returns the lifecycle events implied by the resource's current state.
+func (l *Lifecycle[T]) Events(object T) ([]*CloudEvent, error) {
+	labels := object.GetLabels()
+
+	data := &LifecycleData{
+		OrganizationID: labels[coreconstants.OrganizationLabel],
+		ProjectID:      labels[coreconstants.ProjectLabel],
+		Generation:     object.GetGeneration(),
+	}
+
+	uid := string(object.GetUID())
+
+	var events []*CloudEvent
+
+	add := func(action string, idValues ...string) error {
+		event, err := l.newEvent(action, object.GetName(), data, append([]string{uid}, idValues...)...)
+		if err != nil {
+			return err
+		}
+
+		events = append(events, event)
+
+		return nil
+	}
+
+	if err := add("created"); err != nil {
+		return nil, err
+	}
+
+	if object.GetGeneration() > 1 && object.GetDeletionTimestamp() == nil {
+		if err := add("updated", strconv.FormatInt(object.GetGeneration(), 10)); err != nil {
+			return nil, err
+		}
+	}
+
+	if condition, err := object.StatusConditionRead(unikornv1core.ConditionAvailable); err == nil {
+		switch condition.Reason {
+		case unikornv1core.ConditionReasonProvisioning, unikornv1core.ConditionReasonProvisioned, unikornv1core.ConditionReasonErrored:
+			data.Message = condition.Message
+
+			if err := add(strings.ToLower(string(condition.Reason)), condition.LastTransitionTime.UTC().Format(time.RFC3339)); err != nil {
+				return nil, err
+			}
+
+			data.Message = ""
+		}
+	}
+
+	if object.GetDeletionTimestamp() != nil {
+		if err := add("deleting"); err != nil {
+			return nil, err
+		}
+	}
+
+	return events, nil
+}
+
+func (l *Lifecycle[T]) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	object := l.newObject()
+
+	if err := l.client.Get(ctx, request.NamespacedName, object); err != nil {
+		if !kerrors.IsNotFound(err) {
+			return reconcile.Result{}, err
+		}
+
+		// The resource has gone, so there's nothing to say other than that.
+		event, err := l.newEvent("deleted", request.Name, nil, request.Namespace)
+		if err != nil {
+			return reconcile.Result{}, err
+		}
+
+		return reconcile.Result{}, l.producer.Publish(ctx, event)
+	}
+
+	events, err := l.Events(object)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	for _, event := range events {
+		if err := l.producer.Publish(ctx, event); err != nil {
+			return reconcile.Result{}, err
+		}
+	}
+
+	return reconcile.Result{}, nil
+}
+
+// availableChanged checks whether the availability of a resource has changed.
+func availableChanged(e event.UpdateEvent) bool {
+	oldObject, ok := e.ObjectOld.(Object)
+	if !ok {
+		return true
+	}
+
+	newObject, ok := e.ObjectNew.(Object)
+	if !ok {
+		return true
+	}
+
+	oldCondition, oldErr := oldObject.StatusConditionRead(unikornv1core.ConditionAvailable)
+	newCondition, newErr := newObject.StatusConditionRead(unikornv1core.ConditionAvailable)
+
+	if oldErr != nil || newErr != nil {
+		return (oldErr == nil) != (newErr == nil)
+	}
+
+	return oldCondition.Reason != newCondition.Reason || !oldCondition.LastTransitionTime.Equal(&newCondition.LastTransitionTime)
+}
+
+// Predicate filters out updates that cannot result in new events, e.g. status
+// updates that don't affect availability.
+func Predicate() predicate.Predicate {
+	return predicate.Or(
+		predicate.GenerationChangedPredicate{},
+		predicate.Funcs{
+			UpdateFunc: availableChanged,
+		},
+	)
+}
@@ -0,0 +1,101 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package messaging_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	unikornv1 "github.com/unikorn-cloud/compute/pkg/apis/unikorn/v1alpha1"
+	"github.com/unikorn-cloud/compute/pkg/messaging"
+	unikornv1core "github.com/unikorn-cloud/core/pkg/apis/unikorn/v1alpha1"
+	coreconstants "github.com/unikorn-cloud/core/pkg/constants"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func newCluster() *unikornv1.ComputeCluster {
+	cluster := &unikornv1.ComputeCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "foo",
+			UID:        "2a3f2b5e-2a37-4a4b-8f0e-c63c6b3c8f4e",
+			Generation: 2,
+			Labels: map[string]string{
+				coreconstants.OrganizationLabel: "acme",
+				coreconstants.ProjectLabel:      "rockets",
+			},
+		},
+	}
+
+	cluster.StatusConditionWrite(unikornv1core.ConditionAvailable, corev1.ConditionTrue, unikornv1core.ConditionReasonProvisioned, "provisioned")
+
+	return cluster
+}
+
+func types(events []*messaging.CloudEvent) []string {
+	out := make([]string, len(events))
+
+	for i := range events {
+		out[i] = events[i].Type
+	}
+
+	return out
+}
+
+// TestLifecycleEvents ensures the correct events are derived from a resource.
+func TestLifecycleEvents(t *testing.T) {
+	t.Parallel()
+
+	lifecycle := messaging.NewLifecycle(nil, nil, "cluster", func() *unikornv1.ComputeCluster {
+		return &unikornv1.ComputeCluster{}
+	})
+
+	cluster := newCluster()
+
+	events, err := lifecycle.Events(cluster)
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		"org.unikorn-cloud.compute.cluster.created",
+		"org.unikorn-cloud.compute.cluster.updated",
+		"org.unikorn-cloud.compute.cluster.provisioned",
+	}, types(events))
+	require.Equal(t, "foo", events[0].Subject)
+	require.JSONEq(t, `{"organizationId":"acme","projectId":"rockets","generation":2,"message":"provisioned"}`, string(events[2].Data))
+
+	// Replays generate the same IDs so can be deduplicated.
+	replayed, err := lifecycle.Events(cluster)
+	require.NoError(t, err)
+
+	for i := range events {
+		require.Equal(t, events[i].ID, replayed[i].ID)
+	}
+
+	// Deletion supersedes updates.
+	cluster.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+
+	events, err = lifecycle.Events(cluster)
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		"org.unikorn-cloud.compute.cluster.created",
+		"org.unikorn-cloud.compute.cluster.provisioned",
+		"org.unikorn-cloud.compute.cluster.deleting",
+	}, types(events))
+}
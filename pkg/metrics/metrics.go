@@ -0,0 +1,166 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/unikorn-cloud/core/pkg/provisioners"
+	identityclient "github.com/unikorn-cloud/identity/pkg/client"
+)
+
+const (
+	namespace = "unikorn_compute"
+)
+
+//nolint:gochecknoglobals
+var (
+	// HTTPRequestDuration records API request latency by route.
+	HTTPRequestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "http_request_duration_seconds",
+		Help:      "API request duration by method, route and status code.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method", "route", "code"})
+
+	// ClientRequestDuration records latency of requests to other services.
+	ClientRequestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "client_request_duration_seconds",
+		Help:      "Outbound request duration by service, method and status code, transport errors have the code \"error\".",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"service", "method", "code"})
+
+	// ReconcileDuration records how long provisioning takes, and its outcome.
+	ReconcileDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "reconcile_duration_seconds",
+		Help:      "Provisioner reconcile duration by controller and result (success, yield or error).",
+		Buckets:   []float64{0.1, 0.5, 1, 2.5, 5, 10, 30, 60, 120},
+	}, []string{"controller", "result"})
+
+	// ServerOperations counts server lifecycle operations performed by the controllers.
+	ServerOperations = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "server_operations_total",
+		Help:      "Server operations performed by controller and operation (create, delete, rebuild or resize).",
+	}, []string{"controller", "operation"})
+
+	// ReconcilesDeferred counts reconciles deferred because their organization
+	// was at its concurrency limit.
+	ReconcilesDeferred = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "reconciles_deferred_total",
+		Help:      "Reconciles deferred by controller because the organization was at its concurrency limit.",
+	}, []string{"controller"})
+
+	// ServerListPages records how many pages were required to list servers.
+	ServerListPages = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "server_list_pages",
+		Help:      "Number of pages returned by the region when listing servers, by controller.",
+		Buckets:   []float64{1, 2, 5, 10, 20, 50, 100},
+	}, []string{"controller"})
+)
+
+// MustRegister adds all metrics to the registry.
+func MustRegister(registerer prometheus.Registerer) {
+	registerer.MustRegister(
+		HTTPRequestDuration,
+		ClientRequestDuration,
+		ReconcileDuration,
+		ServerOperations,
+		ReconcilesDeferred,
+		ServerListPages,
+	)
+}
+
+// ObserveReconcile records the duration and result of a reconcile that began at
+// the specified time.
+func ObserveReconcile(controller string, start time.Time, err error) {
+	result := "success"
+
+	switch {
+	case errors.Is(err, provisioners.ErrYield):
+		result = "yield"
+	case err != nil:
+		result = "error"
+	}
+
+	ReconcileDuration.WithLabelValues(controller, result).Observe(time.Since(start).Seconds())
+}
+
+// transport instruments outbound HTTP requests.
+type transport struct {
+	next    http.RoundTripper
+	service string
+}
+
+func (t *transport) RoundTrip(r *http.Request) (*http.Response, error) {
+	start := time.Now()
+
+	response, err := t.next.RoundTrip(r)
+
+	code := "error"
+
+	if err == nil {
+		code = strconv.Itoa(response.StatusCode)
+	}
+
+	ClientRequestDuration.WithLabelValues(t.service, r.Method, code).Observe(time.Since(start).Seconds())
+
+	return response, err
+}
+
+// Builder wraps an OpenAPI client builder so that all requests made by the
+// client are instrumented.
+type Builder[T any] struct {
+	identityclient.Builder[T]
+
+	service string
+}
+
+// NewBuilder returns an instrumented OpenAPI client builder.
+func NewBuilder[T any](builder identityclient.Builder[T], service string) *Builder[T] {
+	return &Builder[T]{
+		Builder: builder,
+		service: service,
+	}
+}
+
+// WithHTTPClient instruments the client's transport.  The client may be shared,
+// so it's copied rather than modified.
+func (b *Builder[T]) WithHTTPClient(client *http.Client) {
+	next := client.Transport
+	if next == nil {
+		next = http.DefaultTransport
+	}
+
+	instrumented := *client
+	instrumented.Transport = &transport{
+		next:    next,
+		service: b.service,
+	}
+
+	b.Builder.WithHTTPClient(&instrumented)
+}
@@ -0,0 +1,355 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package autoscaler
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net/http"
+	"time"
+
+	"github.com/spf13/pflag"
+
+	unikornv1 "github.com/unikorn-cloud/compute/pkg/apis/unikorn/v1alpha1"
+	managerutil "github.com/unikorn-cloud/compute/pkg/provisioners/managers/cluster/util"
+	"github.com/unikorn-cloud/compute/pkg/server/handler/cluster"
+	"github.com/unikorn-cloud/compute/pkg/server/handler/region"
+	"github.com/unikorn-cloud/core/pkg/constants"
+	coreerrors "github.com/unikorn-cloud/core/pkg/errors"
+	identityapi "github.com/unikorn-cloud/identity/pkg/openapi"
+	regionapi "github.com/unikorn-cloud/region/pkg/openapi"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// Options allow modification of parameters via the CLI.
+type Options struct {
+	// metricsURL is the base URL of a Prometheus compatible API to
+	// query for CPU utilization.  When not set, only the pool bounds
+	// are enforced.
+	metricsURL string
+	// metricsQuery is the query that returns CPU utilization per server.
+	metricsQuery string
+	// metricsServerLabel is the label in the query results that
+	// identifies the server.
+	metricsServerLabel string
+	// metricsTimeout bounds how long we wait for the metrics API.
+	metricsTimeout time.Duration
+	// cooldown is the minimum time between utilization driven scaling
+	// decisions for a pool, giving new servers time to take on load.
+	cooldown time.Duration
+	// tolerance is the fractional deviation from the target utilization
+	// that is ignored to prevent flapping.
+	tolerance float64
+}
+
+// AddFlags registers option flags with pflag.
+func (o *Options) AddFlags(f *pflag.FlagSet) {
+	f.StringVar(&o.metricsURL, "autoscaler-metrics-url", "", "Prometheus compatible API to query for server CPU utilization")
+	f.StringVar(&o.metricsQuery, "autoscaler-metrics-query", `100 * (1 - avg by (server_id) (rate(node_cpu_seconds_total{mode="idle"}[5m])))`, "Query returning CPU utilization percentage per server")
+	f.StringVar(&o.metricsServerLabel, "autoscaler-metrics-server-label", "server_id", "Label in the metrics query result that contains the server ID")
+	f.DurationVar(&o.metricsTimeout, "autoscaler-metrics-timeout", 10*time.Second, "Timeout for metrics queries")
+	f.DurationVar(&o.cooldown, "autoscaler-cooldown", 5*time.Minute, "Minimum period between utilization driven scaling of a pool")
+	f.Float64Var(&o.tolerance, "autoscaler-tolerance", 0.1, "Fractional deviation from the target utilization to ignore")
+}
+
+// Autoscaler adjusts the replica counts of autoscaled workload pools.
+type Autoscaler struct {
+	// client allows Compute API access.
+	client client.Client
+	// clusters allows clusters to be scaled along with their quota allocations.
+	clusters *cluster.Client
+	// region is a client to access regions.
+	region regionapi.ClientWithResponsesInterface
+	// metrics provides server utilization, and may be nil.
+	metrics MetricsSource
+	// options control scaling behaviour.
+	options *Options
+}
+
+// New returns a new autoscaler.
+func New(client client.Client, identity identityapi.ClientWithResponsesInterface, region regionapi.ClientWithResponsesInterface, options *Options) *Autoscaler {
+	a := &Autoscaler{
+		client:   client,
+		clusters: cluster.NewClient(client, "", &cluster.Options{}, identity, region),
+		region:   region,
+		options:  options,
+	}
+
+	if options.metricsURL != "" {
+		a.metrics = NewPrometheus(&http.Client{Timeout: options.metricsTimeout}, options.metricsURL, options.metricsQuery, options.metricsServerLabel)
+	}
+
+	return a
+}
+
+// decision is the outcome of evaluating an autoscaled pool.
+type decision struct {
+	// replicas is the desired number of replicas.
+	replicas int
+	// utilization is the average utilization that drove the decision.
+	utilization *int
+	// message describes the decision.
+	message string
+	// bounded is set when the decision was made to satisfy the pool
+	// bounds, rather than utilization, so is not subject to cooldown.
+	bounded bool
+}
+
+// decide calculates the desired replica count of a pool in the same way as
+// a Kubernetes horizontal pod autoscaler, scaling in proportion to how far
+// the observed utilization is from the target.  Pools scaled to zero have no
+// utilization, so will only scale up again when their minimum is raised.
+func decide(spec *unikornv1.WorkloadPoolAutoscalingSpec, replicas int, utilization *float64, tolerance float64) *decision {
+	if replicas < spec.MinReplicas {
+		return &decision{
+			replicas: spec.MinReplicas,
+			message:  "replicas below minimum",
+			bounded:  true,
+		}
+	}
+
+	if replicas > spec.MaxReplicas {
+		return &decision{
+			replicas: spec.MaxReplicas,
+			message:  "replicas above maximum",
+			bounded:  true,
+		}
+	}
+
+	if utilization == nil || replicas == 0 || spec.TargetCPUUtilization <= 0 {
+		return &decision{
+			replicas: replicas,
+		}
+	}
+
+	ratio := *utilization / float64(spec.TargetCPUUtilization)
+
+	if math.Abs(ratio-1) <= tolerance {
+		return &decision{
+			replicas: replicas,
+		}
+	}
+
+	desired := int(math.Ceil(float64(replicas) * ratio))
+
+	return &decision{
+		replicas:    min(max(desired, spec.MinReplicas), spec.MaxReplicas),
+		utilization: ptr.To(int(math.Round(*utilization))),
+		message:     fmt.Sprintf("average CPU utilization %.0f%% against a target of %d%%", *utilization, spec.TargetCPUUtilization),
+	}
+}
+
+// poolUtilization returns the average utilization of each pool's servers, ignoring
+// any that are being deleted or have no metrics.
+func (a *Autoscaler) poolUtilization(ctx context.Context, cluster *unikornv1.ComputeCluster, utilization map[string]float64) (map[string]float64, error) {
+	if utilization == nil {
+		//nolint:nilnil
+		return nil, nil
+	}
+
+	organizationID, ok := cluster.Labels[constants.OrganizationLabel]
+	if !ok {
+		return nil, fmt.Errorf("%w: cluster missing organization label", coreerrors.ErrConsistency)
+	}
+
+	servers, err := region.New(a.region).Servers(ctx, organizationID, cluster)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to list servers", err)
+	}
+
+	totals := map[string]float64{}
+	counts := map[string]int{}
+
+	for i := range servers {
+		server := &servers[i]
+
+		if server.Metadata.DeletionTime != nil {
+			continue
+		}
+
+		value, ok := utilization[server.Metadata.Id]
+		if !ok {
+			continue
+		}
+
+		pool, err := managerutil.GetWorkloadPoolTag(server.Metadata.Tags)
+		if err != nil {
+			continue
+		}
+
+		totals[pool] += value
+		counts[pool]++
+	}
+
+	out := map[string]float64{}
+
+	for pool, total := range totals {
+		out[pool] = total / float64(counts[pool])
+	}
+
+	return out, nil
+}
+
+// autoscaled tells us whether the cluster is eligible for autoscaling.
+func autoscaled(cluster *unikornv1.ComputeCluster) bool {
+	if cluster.DeletionTimestamp != nil || cluster.Paused() || cluster.Spec.MaintenanceMode {
+		return false
+	}
+
+	// Evictions adjust replica counts themselves, so let them settle first.
+	if managerutil.EvictionPending(cluster) {
+		return false
+	}
+
+	for i := range cluster.Spec.WorkloadPools.Pools {
+		if cluster.Spec.WorkloadPools.Pools[i].Autoscaling != nil {
+			return true
+		}
+	}
+
+	return false
+}
+
+// setStatus records a scaling decision for a pool.
+func setStatus(cluster *unikornv1.ComputeCluster, name string, previous int, d *decision, now time.Time) {
+	status := unikornv1.WorkloadPoolAutoscalingStatus{
+		Name:             name,
+		LastScaleTime:    metav1.NewTime(now),
+		PreviousReplicas: previous,
+		DesiredReplicas:  d.replicas,
+		CPUUtilization:   d.utilization,
+		Message:          d.message,
+	}
+
+	if current, ok := cluster.GetAutoscalingStatus(name); ok {
+		*current = status
+
+		return
+	}
+
+	cluster.Status.Autoscaling = append(cluster.Status.Autoscaling, status)
+}
+
+// scale evaluates all autoscaled pools in a cluster and applies any changes.
+func (a *Autoscaler) scale(ctx context.Context, cluster *unikornv1.ComputeCluster, utilization map[string]float64) error {
+	log := log.FromContext(ctx)
+
+	pools, err := a.poolUtilization(ctx, cluster, utilization)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+
+	replicas := map[string]int{}
+	decisions := map[string]*decision{}
+
+	for i := range cluster.Spec.WorkloadPools.Pools {
+		pool := &cluster.Spec.WorkloadPools.Pools[i]
+
+		if pool.Autoscaling == nil {
+			continue
+		}
+
+		var average *float64
+
+		if value, ok := pools[pool.Name]; ok {
+			average = &value
+		}
+
+		d := decide(pool.Autoscaling, pool.Replicas, average, a.options.tolerance)
+		if d.replicas == pool.Replicas {
+			continue
+		}
+
+		if status, ok := cluster.GetAutoscalingStatus(pool.Name); ok && !d.bounded && now.Sub(status.LastScaleTime.Time) < a.options.cooldown {
+			continue
+		}
+
+		log.Info("scaling pool", "cluster", cluster.Name, "pool", pool.Name, "from", pool.Replicas, "to", d.replicas, "reason", d.message)
+
+		replicas[pool.Name] = d.replicas
+		decisions[pool.Name] = d
+	}
+
+	if len(replicas) == 0 {
+		return nil
+	}
+
+	updated, err := a.clusters.Scale(ctx, cluster, replicas)
+	if err != nil {
+		return err
+	}
+
+	base := updated.DeepCopy()
+
+	for name, d := range decisions {
+		pool, _ := cluster.GetWorkloadPool(name)
+
+		setStatus(updated, name, pool.Replicas, d, now)
+	}
+
+	if err := a.client.Status().Patch(ctx, updated, client.MergeFrom(base)); err != nil {
+		return fmt.Errorf("%w: failed to update cluster status", err)
+	}
+
+	return nil
+}
+
+// Check implements the monitor Checker interface.
+func (a *Autoscaler) Check(ctx context.Context) error {
+	log := log.FromContext(ctx)
+
+	clusters := &unikornv1.ComputeClusterList{}
+
+	if err := a.client.List(ctx, clusters); err != nil {
+		return err
+	}
+
+	var utilization map[string]float64
+
+	if a.metrics != nil {
+		u, err := a.metrics.CPUUtilization(ctx)
+		if err != nil {
+			// Carry on, bounds are still worth enforcing.
+			log.Error(err, "failed to read server utilization")
+		}
+
+		utilization = u
+	}
+
+	for i := range clusters.Items {
+		cluster := &clusters.Items[i]
+
+		if !autoscaled(cluster) {
+			continue
+		}
+
+		if err := a.scale(ctx, cluster, utilization); err != nil {
+			log.Error(err, "failed to autoscale cluster", "cluster", cluster.Name)
+		}
+	}
+
+	return nil
+}
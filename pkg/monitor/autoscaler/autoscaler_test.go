@@ -0,0 +1,92 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package autoscaler_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	unikornv1 "github.com/unikorn-cloud/compute/pkg/apis/unikorn/v1alpha1"
+	"github.com/unikorn-cloud/compute/pkg/monitor/autoscaler"
+
+	"k8s.io/utils/ptr"
+)
+
+// TestDecide checks scaling follows utilization proportionally, within the
+// configured tolerance and bounds.
+func TestDecide(t *testing.T) {
+	t.Parallel()
+
+	spec := &unikornv1.WorkloadPoolAutoscalingSpec{
+		MinReplicas:          1,
+		MaxReplicas:          10,
+		TargetCPUUtilization: 50,
+	}
+
+	tests := []struct {
+		name        string
+		replicas    int
+		utilization *float64
+		expected    int
+		bounded     bool
+	}{
+		{name: "BelowMinimum", replicas: 0, expected: 1, bounded: true},
+		{name: "AboveMaximum", replicas: 12, utilization: ptr.To(90.0), expected: 10, bounded: true},
+		{name: "NoMetrics", replicas: 4, expected: 4},
+		{name: "WithinTolerance", replicas: 4, utilization: ptr.To(54.0), expected: 4},
+		{name: "ScaleUp", replicas: 4, utilization: ptr.To(80.0), expected: 7},
+		{name: "ScaleDown", replicas: 4, utilization: ptr.To(20.0), expected: 2},
+		{name: "ScaleUpClamped", replicas: 8, utilization: ptr.To(100.0), expected: 10},
+		{name: "ScaleDownClamped", replicas: 2, utilization: ptr.To(1.0), expected: 1},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			replicas, bounded := autoscaler.Decide(spec, test.replicas, test.utilization, 0.1)
+			require.Equal(t, test.expected, replicas)
+			require.Equal(t, test.bounded, bounded)
+		})
+	}
+}
+
+// TestPrometheus checks query results are keyed by the server label, and
+// samples without one are ignored.
+func TestPrometheus(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/api/v1/query", r.URL.Path)
+		require.Equal(t, "cpu", r.URL.Query().Get("query"))
+
+		w.Header().Set("Content-Type", "application/json")
+
+		_, _ = w.Write([]byte(`{"status":"success","data":{"resultType":"vector","result":[` +
+			`{"metric":{"server_id":"server-a"},"value":[1700000000,"42.5"]},` +
+			`{"metric":{"instance":"other"},"value":[1700000000,"99"]}]}}`))
+	}))
+	defer server.Close()
+
+	utilization, err := autoscaler.NewPrometheus(server.Client(), server.URL, "cpu", "server_id").CPUUtilization(t.Context())
+	require.NoError(t, err)
+	require.Equal(t, map[string]float64{"server-a": 42.5}, utilization)
+}
@@ -0,0 +1,30 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package autoscaler
+
+import (
+	unikornv1 "github.com/unikorn-cloud/compute/pkg/apis/unikorn/v1alpha1"
+)
+
+// Decide returns the desired replicas for a pool and whether that was forced
+// by the pool bounds.
+func Decide(spec *unikornv1.WorkloadPoolAutoscalingSpec, replicas int, utilization *float64, tolerance float64) (int, bool) {
+	d := decide(spec, replicas, utilization, tolerance)
+
+	return d.replicas, d.bounded
+}
@@ -0,0 +1,141 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package autoscaler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+var (
+	// ErrMetrics is raised when the metrics service returns an error or
+	// something we don't understand.
+	ErrMetrics = errors.New("metrics error")
+)
+
+// MetricsSource provides CPU utilization metrics for servers.
+type MetricsSource interface {
+	// CPUUtilization returns the CPU utilization percentage of all servers
+	// known to the source, keyed by server ID.
+	CPUUtilization(ctx context.Context) (map[string]float64, error)
+}
+
+// Prometheus is a metrics source that evaluates an instant query against a
+// Prometheus compatible API.  The query is expected to return a vector with
+// a sample per server, labelled with the server ID.
+type Prometheus struct {
+	// client is used to query the API.
+	client *http.Client
+	// endpoint is the base URL of the API.
+	endpoint string
+	// query is the PromQL query to evaluate.
+	query string
+	// label is the sample label that holds the server ID.
+	label string
+}
+
+// NewPrometheus returns a new Prometheus metrics source.
+func NewPrometheus(client *http.Client, endpoint, query, label string) *Prometheus {
+	return &Prometheus{
+		client:   client,
+		endpoint: endpoint,
+		query:    query,
+		label:    label,
+	}
+}
+
+// prometheusResponse is the subset of a Prometheus API instant query response
+// that we care about.
+type prometheusResponse struct {
+	Status string `json:"status"`
+	Error  string `json:"error"`
+	Data   struct {
+		ResultType string `json:"resultType"`
+		Result     []struct {
+			Metric map[string]string `json:"metric"`
+			// Value is a tuple of timestamp and stringified value.
+			Value []any `json:"value"`
+		} `json:"result"`
+	} `json:"data"`
+}
+
+// CPUUtilization implements the MetricsSource interface.
+func (p *Prometheus) CPUUtilization(ctx context.Context) (map[string]float64, error) {
+	endpoint, err := url.JoinPath(p.endpoint, "api", "v1", "query")
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint+"?"+url.Values{"query": []string{p.query}}.Encode(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := p.client.Do(request)
+	if err != nil {
+		return nil, err
+	}
+
+	defer response.Body.Close()
+
+	var result prometheusResponse
+
+	if err := json.NewDecoder(response.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("%w: failed to decode response with status %d", ErrMetrics, response.StatusCode)
+	}
+
+	if result.Status != "success" {
+		return nil, fmt.Errorf("%w: query failed: %s", ErrMetrics, result.Error)
+	}
+
+	if result.Data.ResultType != "vector" {
+		return nil, fmt.Errorf("%w: unexpected result type %s", ErrMetrics, result.Data.ResultType)
+	}
+
+	out := map[string]float64{}
+
+	for _, sample := range result.Data.Result {
+		id, ok := sample.Metric[p.label]
+		if !ok {
+			continue
+		}
+
+		if len(sample.Value) != 2 {
+			return nil, fmt.Errorf("%w: malformed sample for server %s", ErrMetrics, id)
+		}
+
+		raw, ok := sample.Value[1].(string)
+		if !ok {
+			return nil, fmt.Errorf("%w: malformed sample for server %s", ErrMetrics, id)
+		}
+
+		value, err := strconv.ParseFloat(raw, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%w: malformed sample for server %s", ErrMetrics, id)
+		}
+
+		out[id] = value
+	}
+
+	return out, nil
+}
@@ -23,6 +23,10 @@ import (
 
 	"github.com/spf13/pflag"
 
+	"github.com/unikorn-cloud/compute/pkg/monitor/autoscaler"
+	"github.com/unikorn-cloud/compute/pkg/monitor/powerschedule"
+	"github.com/unikorn-cloud/compute/pkg/monitor/quota"
+	"github.com/unikorn-cloud/compute/pkg/monitor/snapshot"
 	coreclient "github.com/unikorn-cloud/core/pkg/client"
 	identityclient "github.com/unikorn-cloud/identity/pkg/client"
 	"github.com/unikorn-cloud/identity/pkg/principal"
@@ -47,6 +51,10 @@ type Options struct {
 	// to ensure cloud identities and networks are provisioned, as well
 	// as deptovisioning them.
 	clientOptions coreclient.HTTPClientOptions
+	// autoscalerOptions control workload pool autoscaling.
+	autoscalerOptions autoscaler.Options
+	// quotaOptions control quota allocation drift detection.
+	quotaOptions quota.Options
 }
 
 // AddFlags registers option flags with pflag.
@@ -57,6 +65,8 @@ func (o *Options) AddFlags(f *pflag.FlagSet) {
 	o.identityOptions.AddFlags(f)
 	o.regionOptions.AddFlags(f)
 	o.clientOptions.AddFlags(f)
+	o.autoscalerOptions.AddFlags(f)
+	o.quotaOptions.AddFlags(f)
 
 	f.DurationVar(&o.pollPeriod, "poll-period", time.Minute, "Period to poll for updates")
 }
@@ -76,7 +86,26 @@ func Run(ctx context.Context, c client.Client, o *Options) {
 	ticker := time.NewTicker(o.pollPeriod)
 	defer ticker.Stop()
 
-	checkers := []Checker{}
+	identity, err := identityclient.New(c, o.identityOptions, &o.clientOptions).APIClient(ctx)
+	if err != nil {
+		log.Error(err, "failed to create identity client")
+
+		return
+	}
+
+	region, err := regionclient.New(c, o.regionOptions, &o.clientOptions).APIClient(ctx)
+	if err != nil {
+		log.Error(err, "failed to create region client")
+
+		return
+	}
+
+	checkers := []Checker{
+		autoscaler.New(c, identity, region, &o.autoscalerOptions),
+		powerschedule.New(c, region),
+		quota.New(c, identity, region, &o.quotaOptions),
+		snapshot.New(c, region),
+	}
 
 	for {
 		select {
@@ -0,0 +1,210 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package powerschedule
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	unikornv1 "github.com/unikorn-cloud/compute/pkg/apis/unikorn/v1alpha1"
+	"github.com/unikorn-cloud/compute/pkg/constants"
+	coreconstants "github.com/unikorn-cloud/core/pkg/constants"
+	coreapi "github.com/unikorn-cloud/core/pkg/openapi"
+	"github.com/unikorn-cloud/core/pkg/server/errors"
+	regionconstants "github.com/unikorn-cloud/region/pkg/constants"
+	regionapi "github.com/unikorn-cloud/region/pkg/openapi"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// Checker powers instances on and off according to their schedules.
+type Checker struct {
+	// client allows Compute API access.
+	client client.Client
+	// region is a client to access regions.
+	region regionapi.ClientWithResponsesInterface
+}
+
+// New returns a new power schedule checker.
+func New(client client.Client, region regionapi.ClientWithResponsesInterface) *Checker {
+	return &Checker{
+		client: client,
+		region: region,
+	}
+}
+
+// getServer returns the server for an instance, or nil if it's not been created yet.
+func (c *Checker) getServer(ctx context.Context, instance *unikornv1.ComputeInstance) (*regionapi.ServerV2Read, error) {
+	params := &regionapi.GetApiV2ServersParams{
+		OrganizationID: &regionapi.OrganizationIDQueryParameter{
+			instance.Labels[coreconstants.OrganizationLabel],
+		},
+		ProjectID: &regionapi.ProjectIDQueryParameter{
+			instance.Labels[coreconstants.ProjectLabel],
+		},
+		RegionID: &regionapi.RegionIDQueryParameter{
+			instance.Labels[regionconstants.RegionLabel],
+		},
+		NetworkID: &regionapi.NetworkIDQueryParameter{
+			instance.Labels[regionconstants.NetworkLabel],
+		},
+		Tag: &coreapi.TagSelectorParameter{
+			constants.InstanceLabel + "=" + instance.Name,
+		},
+	}
+
+	response, err := c.region.GetApiV2ServersWithResponse(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("%w: unable to query servers for instance", err)
+	}
+
+	if response.StatusCode() != http.StatusOK {
+		return nil, errors.PropagateError(response.HTTPResponse, response)
+	}
+
+	result := *response.JSON200
+
+	if len(result) == 0 {
+		//nolint:nilnil
+		return nil, nil
+	}
+
+	return &result[0], nil
+}
+
+// apply performs the scheduled action on the server, unless it's already in the
+// requested state.
+func (c *Checker) apply(ctx context.Context, server *regionapi.ServerV2Read, action unikornv1.PowerScheduleAction) error {
+	var powerState regionapi.InstanceLifecyclePhase
+
+	if server.Status.PowerState != nil {
+		powerState = *server.Status.PowerState
+	}
+
+	switch action {
+	case unikornv1.PowerScheduleActionStart:
+		if powerState == regionapi.InstanceLifecyclePhaseRunning {
+			return nil
+		}
+
+		response, err := c.region.PostApiV2ServersServerIDStartWithResponse(ctx, server.Metadata.Id)
+		if err != nil {
+			return fmt.Errorf("%w: unable to start server for instance", err)
+		}
+
+		if response.StatusCode() != http.StatusAccepted {
+			return errors.PropagateError(response.HTTPResponse, response)
+		}
+	case unikornv1.PowerScheduleActionStop:
+		if powerState == regionapi.InstanceLifecyclePhaseStopped {
+			return nil
+		}
+
+		response, err := c.region.PostApiV2ServersServerIDStopWithResponse(ctx, server.Metadata.Id)
+		if err != nil {
+			return fmt.Errorf("%w: unable to stop server for instance", err)
+		}
+
+		if response.StatusCode() != http.StatusAccepted {
+			return errors.PropagateError(response.HTTPResponse, response)
+		}
+	}
+
+	return nil
+}
+
+// check applies the most recently scheduled action to an instance, if it hasn't
+// already been.  Acting only once per scheduled action allows users to manually
+// start or stop an instance in between.
+func (c *Checker) check(ctx context.Context, instance *unikornv1.ComputeInstance, now time.Time) error {
+	log := log.FromContext(ctx)
+
+	schedule, err := Parse(instance.Spec.PowerSchedule)
+	if err != nil {
+		return err
+	}
+
+	action, at, ok := schedule.Last(now)
+	if !ok {
+		return nil
+	}
+
+	if status := instance.Status.PowerSchedule; status != nil && !at.After(status.LastActionTime.Time) {
+		return nil
+	}
+
+	server, err := c.getServer(ctx, instance)
+	if err != nil {
+		return err
+	}
+
+	// Not provisioned yet, try again later.
+	if server == nil || server.Metadata.ProvisioningStatus != coreapi.ResourceProvisioningStatusProvisioned {
+		return nil
+	}
+
+	log.Info("applying scheduled power action", "instance", instance.Name, "action", action, "scheduled", at)
+
+	if err := c.apply(ctx, server, action); err != nil {
+		return err
+	}
+
+	updated := instance.DeepCopy()
+	updated.Status.PowerSchedule = &unikornv1.ComputeInstancePowerScheduleStatus{
+		LastAction:     action,
+		LastActionTime: metav1.NewTime(at),
+	}
+
+	if err := c.client.Status().Patch(ctx, updated, client.MergeFrom(instance)); err != nil {
+		return fmt.Errorf("%w: failed to update instance status", err)
+	}
+
+	return nil
+}
+
+// Check implements the monitor Checker interface.
+func (c *Checker) Check(ctx context.Context) error {
+	log := log.FromContext(ctx)
+
+	instances := &unikornv1.ComputeInstanceList{}
+
+	if err := c.client.List(ctx, instances); err != nil {
+		return err
+	}
+
+	now := time.Now()
+
+	for i := range instances.Items {
+		instance := &instances.Items[i]
+
+		if instance.Spec.PowerSchedule == nil || instance.DeletionTimestamp != nil || instance.Spec.Pause {
+			continue
+		}
+
+		if err := c.check(ctx, instance, now); err != nil {
+			log.Error(err, "failed to apply power schedule", "instance", instance.Name)
+		}
+	}
+
+	return nil
+}
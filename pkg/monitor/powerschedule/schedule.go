@@ -0,0 +1,121 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package powerschedule
+
+import (
+	"errors"
+	"fmt"
+	"time"
+	// Images may not ship with time zone data.
+	_ "time/tzdata"
+
+	"github.com/robfig/cron/v3"
+
+	unikornv1 "github.com/unikorn-cloud/compute/pkg/apis/unikorn/v1alpha1"
+)
+
+var (
+	// ErrSchedule is raised when a schedule is invalid.
+	ErrSchedule = errors.New("invalid power schedule")
+)
+
+// lookback is how far back we search for the most recently scheduled action.
+// This accommodates weekly schedules, which is the common case, e.g. working days.
+const lookback = 7 * 24 * time.Hour
+
+// Schedule is a parsed power schedule.
+type Schedule struct {
+	// start is when to power on, if set.
+	start cron.Schedule
+	// stop is when to power off, if set.
+	stop cron.Schedule
+	// location is the time zone the schedule is evaluated in.
+	location *time.Location
+}
+
+// Parse checks a power schedule is valid and returns an evaluable form.
+func Parse(in *unikornv1.ComputeInstancePowerSchedule) (*Schedule, error) {
+	if in.Start == "" && in.Stop == "" {
+		return nil, fmt.Errorf("%w: one of start or stop must be specified", ErrSchedule)
+	}
+
+	location, err := time.LoadLocation(in.TimeZone)
+	if err != nil {
+		return nil, fmt.Errorf("%w: time zone %s unknown", ErrSchedule, in.TimeZone)
+	}
+
+	out := &Schedule{
+		location: location,
+	}
+
+	if in.Start != "" {
+		start, err := cron.ParseStandard(in.Start)
+		if err != nil {
+			return nil, fmt.Errorf("%w: start %s: %s", ErrSchedule, in.Start, err.Error())
+		}
+
+		out.start = start
+	}
+
+	if in.Stop != "" {
+		stop, err := cron.ParseStandard(in.Stop)
+		if err != nil {
+			return nil, fmt.Errorf("%w: stop %s: %s", ErrSchedule, in.Stop, err.Error())
+		}
+
+		out.stop = stop
+	}
+
+	return out, nil
+}
+
+// previous returns the last time the schedule fired at or before now.
+func (s *Schedule) previous(schedule cron.Schedule, now time.Time) (time.Time, bool) {
+	var last time.Time
+
+	for t := schedule.Next(now.Add(-lookback).In(s.location)); !t.IsZero() && !t.After(now); t = schedule.Next(t) {
+		last = t
+	}
+
+	return last, !last.IsZero()
+}
+
+// Last returns the most recently scheduled action at or before now, and when
+// it was scheduled for.  If start and stop coincide, stop wins as the least
+// costly option.
+func (s *Schedule) Last(now time.Time) (unikornv1.PowerScheduleAction, time.Time, bool) {
+	var action unikornv1.PowerScheduleAction
+
+	var at time.Time
+
+	if s.start != nil {
+		if t, ok := s.previous(s.start, now); ok {
+			action = unikornv1.PowerScheduleActionStart
+			at = t
+		}
+	}
+
+	if s.stop != nil {
+		if t, ok := s.previous(s.stop, now); ok && !t.Before(at) {
+			action = unikornv1.PowerScheduleActionStop
+			at = t
+		}
+	}
+
+	return action, at, action != ""
+}
@@ -0,0 +1,95 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package powerschedule_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	unikornv1 "github.com/unikorn-cloud/compute/pkg/apis/unikorn/v1alpha1"
+	"github.com/unikorn-cloud/compute/pkg/monitor/powerschedule"
+)
+
+// TestScheduleLast checks the most recent action is selected across working
+// hours, in the requested time zone.
+func TestScheduleLast(t *testing.T) {
+	t.Parallel()
+
+	schedule, err := powerschedule.Parse(&unikornv1.ComputeInstancePowerSchedule{
+		Start:    "0 8 * * 1-5",
+		Stop:     "0 18 * * 1-5",
+		TimeZone: "America/New_York",
+	})
+	require.NoError(t, err)
+
+	location, err := time.LoadLocation("America/New_York")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		now      time.Time
+		action   unikornv1.PowerScheduleAction
+		expected time.Time
+	}{
+		{
+			name:     "WorkingHours",
+			now:      time.Date(2026, 10, 14, 12, 0, 0, 0, location),
+			action:   unikornv1.PowerScheduleActionStart,
+			expected: time.Date(2026, 10, 14, 8, 0, 0, 0, location),
+		},
+		{
+			name:     "Evening",
+			now:      time.Date(2026, 10, 14, 20, 0, 0, 0, location),
+			action:   unikornv1.PowerScheduleActionStop,
+			expected: time.Date(2026, 10, 14, 18, 0, 0, 0, location),
+		},
+		{
+			name:     "Weekend",
+			now:      time.Date(2026, 10, 18, 12, 0, 0, 0, location),
+			action:   unikornv1.PowerScheduleActionStop,
+			expected: time.Date(2026, 10, 16, 18, 0, 0, 0, location),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			action, at, ok := schedule.Last(test.now)
+			require.True(t, ok)
+			require.Equal(t, test.action, action)
+			require.True(t, test.expected.Equal(at), "expected %v, got %v", test.expected, at)
+		})
+	}
+}
+
+// TestParseInvalid checks bad schedules are rejected.
+func TestParseInvalid(t *testing.T) {
+	t.Parallel()
+
+	_, err := powerschedule.Parse(&unikornv1.ComputeInstancePowerSchedule{})
+	require.ErrorIs(t, err, powerschedule.ErrSchedule)
+
+	_, err = powerschedule.Parse(&unikornv1.ComputeInstancePowerSchedule{Start: "not a cron"})
+	require.ErrorIs(t, err, powerschedule.ErrSchedule)
+
+	_, err = powerschedule.Parse(&unikornv1.ComputeInstancePowerSchedule{Stop: "0 18 * * *", TimeZone: "Mars/Olympus_Mons"})
+	require.ErrorIs(t, err, powerschedule.ErrSchedule)
+}
@@ -0,0 +1,251 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package quota
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/spf13/pflag"
+
+	unikornv1 "github.com/unikorn-cloud/compute/pkg/apis/unikorn/v1alpha1"
+	computeconstants "github.com/unikorn-cloud/compute/pkg/constants"
+	"github.com/unikorn-cloud/compute/pkg/server/handler/cluster"
+	"github.com/unikorn-cloud/compute/pkg/server/handler/instance"
+	"github.com/unikorn-cloud/core/pkg/constants"
+	coreerrors "github.com/unikorn-cloud/core/pkg/errors"
+	"github.com/unikorn-cloud/core/pkg/server/errors"
+	identityclient "github.com/unikorn-cloud/identity/pkg/client"
+	identityapi "github.com/unikorn-cloud/identity/pkg/openapi"
+	"github.com/unikorn-cloud/identity/pkg/principal"
+	regionapi "github.com/unikorn-cloud/region/pkg/openapi"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// Options allow modification of parameters via the CLI.
+type Options struct {
+	// period defines how often allocations are verified, this is
+	// relatively expensive as every resource requires an identity
+	// and region lookup.
+	period time.Duration
+	// repair, if set, updates allocations that have drifted to match
+	// what the resource requires.
+	repair bool
+}
+
+// AddFlags registers option flags with pflag.
+func (o *Options) AddFlags(f *pflag.FlagSet) {
+	f.DurationVar(&o.period, "quota-drift-period", time.Hour, "Period to verify quota allocations match resources")
+	f.BoolVar(&o.repair, "quota-drift-repair", false, "Update quota allocations that have drifted from their resources")
+}
+
+// Checker verifies quota allocations held by identity match those required by
+// clusters and instances, these can drift after crashes or manual edits.
+type Checker struct {
+	// client allows Compute API access.
+	client client.Client
+	// identity is a client to access the identity service.
+	identity identityapi.ClientWithResponsesInterface
+	// clusters calculates cluster allocations.
+	clusters *cluster.Client
+	// instances calculates instance allocations.
+	instances *instance.Client
+	// options control verification behaviour.
+	options *Options
+	// lastCheck is when allocations were last verified.
+	lastCheck time.Time
+}
+
+// New returns a new quota drift checker.
+func New(client client.Client, identity identityapi.ClientWithResponsesInterface, region regionapi.ClientWithResponsesInterface, options *Options) *Checker {
+	return &Checker{
+		client:    client,
+		identity:  identity,
+		clusters:  cluster.NewClient(client, "", &cluster.Options{}, identity, region),
+		instances: instance.NewClient(client, "", identity, region),
+		options:   options,
+	}
+}
+
+// getAllocation reads the allocation currently held for a resource.
+func (c *Checker) getAllocation(ctx context.Context, resource client.Object) (identityapi.ResourceAllocationList, error) {
+	// Allocations are charged to whoever created the resource.
+	userPrincipal, err := principal.FromResource(resource)
+	if err != nil {
+		return nil, err
+	}
+
+	allocationID, ok := resource.GetAnnotations()[constants.AllocationAnnotation]
+	if !ok {
+		return nil, fmt.Errorf("%w: resource has no allocation annotation", coreerrors.ErrConsistency)
+	}
+
+	response, err := c.identity.GetApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsAllocationIDWithResponse(ctx, userPrincipal.OrganizationID, userPrincipal.ProjectID, allocationID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: unable to read allocation", err)
+	}
+
+	if response.StatusCode() != http.StatusOK {
+		return nil, errors.PropagateError(response.HTTPResponse, response)
+	}
+
+	return response.JSON200.Spec.Allocations, nil
+}
+
+// verify compares the allocation held for a resource with what it expects, repairing
+// it if enabled, and returns the status to record.
+func (c *Checker) verify(ctx context.Context, resource client.Object, expected identityapi.ResourceAllocationList, now time.Time) (*unikornv1.QuotaStatus, error) {
+	log := log.FromContext(ctx)
+
+	actual, err := c.getAllocation(ctx, resource)
+	if err != nil {
+		return nil, err
+	}
+
+	status := &unikornv1.QuotaStatus{
+		LastCheckTime: metav1.NewTime(now),
+	}
+
+	drift := diff(expected, actual)
+	if len(drift) == 0 {
+		return status, nil
+	}
+
+	message := strings.Join(drift, "; ")
+
+	log.Info("quota allocation drift detected", "name", resource.GetName(), "drift", message, "repair", c.options.repair)
+
+	if !c.options.repair {
+		status.Drifted = true
+		status.Message = message
+
+		return status, nil
+	}
+
+	if err := identityclient.NewAllocations(c.client, c.identity).Update(ctx, resource, expected); err != nil {
+		return nil, fmt.Errorf("%w: unable to repair allocation", err)
+	}
+
+	status.Message = "repaired: " + message
+
+	return status, nil
+}
+
+// hasAllocation tells us whether the resource should be verified.
+func hasAllocation(resource client.Object) bool {
+	if resource.GetDeletionTimestamp() != nil {
+		return false
+	}
+
+	_, ok := resource.GetAnnotations()[constants.AllocationAnnotation]
+
+	return ok
+}
+
+func (c *Checker) checkCluster(ctx context.Context, resource *unikornv1.ComputeCluster, now time.Time) error {
+	expected, err := c.clusters.ExpectedAllocations(ctx, resource)
+	if err != nil {
+		return err
+	}
+
+	status, err := c.verify(ctx, resource, expected, now)
+	if err != nil {
+		return err
+	}
+
+	updated := resource.DeepCopy()
+	updated.Status.Quota = status
+
+	return c.client.Status().Patch(ctx, updated, client.MergeFrom(resource))
+}
+
+func (c *Checker) checkInstance(ctx context.Context, resource *unikornv1.ComputeInstance, now time.Time) error {
+	expected, err := c.instances.ExpectedAllocations(ctx, resource)
+	if err != nil {
+		return err
+	}
+
+	status, err := c.verify(ctx, resource, expected, now)
+	if err != nil {
+		return err
+	}
+
+	updated := resource.DeepCopy()
+	updated.Status.Quota = status
+
+	return c.client.Status().Patch(ctx, updated, client.MergeFrom(resource))
+}
+
+// Check verifies all allocations once per period.
+func (c *Checker) Check(ctx context.Context) error {
+	log := log.FromContext(ctx)
+
+	now := time.Now()
+
+	if now.Sub(c.lastCheck) < c.options.period {
+		return nil
+	}
+
+	c.lastCheck = now
+
+	clusters := &unikornv1.ComputeClusterList{}
+
+	if err := c.client.List(ctx, clusters); err != nil {
+		return err
+	}
+
+	for i := range clusters.Items {
+		resource := &clusters.Items[i]
+
+		// Only v1 clusters hold allocations.
+		if _, ok := resource.Labels[computeconstants.ResourceAPIVersionLabel]; ok || !hasAllocation(resource) {
+			continue
+		}
+
+		if err := c.checkCluster(ctx, resource, now); err != nil {
+			log.Error(err, "failed to verify cluster quota allocation", "cluster", resource.Name)
+		}
+	}
+
+	instances := &unikornv1.ComputeInstanceList{}
+
+	if err := c.client.List(ctx, instances); err != nil {
+		return err
+	}
+
+	for i := range instances.Items {
+		resource := &instances.Items[i]
+
+		if !hasAllocation(resource) {
+			continue
+		}
+
+		if err := c.checkInstance(ctx, resource, now); err != nil {
+			log.Error(err, "failed to verify instance quota allocation", "instance", resource.Name)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,81 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package quota
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+
+	identityapi "github.com/unikorn-cloud/identity/pkg/openapi"
+)
+
+// amount is the quantity of a single resource kind.
+type amount struct {
+	committed int
+	reserved  int
+}
+
+// index maps allocations by resource kind, zero allocations are treated as absent
+// so they don't register as drift.
+func index(in identityapi.ResourceAllocationList) map[string]amount {
+	out := map[string]amount{}
+
+	for _, allocation := range in {
+		if allocation.Committed == 0 && allocation.Reserved == 0 {
+			continue
+		}
+
+		a := out[allocation.Kind]
+		a.committed += allocation.Committed
+		a.reserved += allocation.Reserved
+
+		out[allocation.Kind] = a
+	}
+
+	return out
+}
+
+// diff compares the expected and actual allocations and returns a description of
+// each resource kind that differs, ordered by kind.
+func diff(expected, actual identityapi.ResourceAllocationList) []string {
+	e := index(expected)
+	a := index(actual)
+
+	kinds := slices.Collect(maps.Keys(e))
+
+	for kind := range a {
+		if _, ok := e[kind]; !ok {
+			kinds = append(kinds, kind)
+		}
+	}
+
+	slices.Sort(kinds)
+
+	var out []string
+
+	for _, kind := range kinds {
+		if e[kind] == a[kind] {
+			continue
+		}
+
+		out = append(out, fmt.Sprintf("%s allocated %d/%d committed/reserved, expected %d/%d", kind, a[kind].committed, a[kind].reserved, e[kind].committed, e[kind].reserved))
+	}
+
+	return out
+}
@@ -0,0 +1,66 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package quota_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/unikorn-cloud/compute/pkg/monitor/quota"
+	identityapi "github.com/unikorn-cloud/identity/pkg/openapi"
+)
+
+// TestDiffMatching checks allocations that match, ignoring order and zero
+// entries, don't register as drift.
+func TestDiffMatching(t *testing.T) {
+	t.Parallel()
+
+	expected := identityapi.ResourceAllocationList{
+		{Kind: "servers", Committed: 3, Reserved: 2},
+		{Kind: "gpus", Committed: 0},
+	}
+
+	actual := identityapi.ResourceAllocationList{
+		{Kind: "floatingips", Committed: 0},
+		{Kind: "servers", Committed: 3, Reserved: 2},
+	}
+
+	require.Empty(t, quota.Diff(expected, actual))
+}
+
+// TestDiffDrift checks changed, missing and unexpected allocations are reported.
+func TestDiffDrift(t *testing.T) {
+	t.Parallel()
+
+	expected := identityapi.ResourceAllocationList{
+		{Kind: "servers", Committed: 3},
+		{Kind: "gpus", Committed: 8},
+	}
+
+	actual := identityapi.ResourceAllocationList{
+		{Kind: "servers", Committed: 2},
+		{Kind: "floatingips", Committed: 1},
+	}
+
+	require.Equal(t, []string{
+		"floatingips allocated 1/0 committed/reserved, expected 0/0",
+		"gpus allocated 0/0 committed/reserved, expected 8/0",
+		"servers allocated 2/0 committed/reserved, expected 3/0",
+	}, quota.Diff(expected, actual))
+}
@@ -0,0 +1,275 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package snapshot
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"time"
+
+	unikornv1 "github.com/unikorn-cloud/compute/pkg/apis/unikorn/v1alpha1"
+	"github.com/unikorn-cloud/compute/pkg/constants"
+	coreconstants "github.com/unikorn-cloud/core/pkg/constants"
+	coreapi "github.com/unikorn-cloud/core/pkg/openapi"
+	"github.com/unikorn-cloud/core/pkg/server/errors"
+	regionconstants "github.com/unikorn-cloud/region/pkg/constants"
+	regionapi "github.com/unikorn-cloud/region/pkg/openapi"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// Checker snapshots instances according to their policies and garbage collects
+// snapshots that are no longer retained.
+type Checker struct {
+	// client allows Compute API access.
+	client client.Client
+	// region is a client to access regions.
+	region regionapi.ClientWithResponsesInterface
+}
+
+// New returns a new snapshot checker.
+func New(client client.Client, region regionapi.ClientWithResponsesInterface) *Checker {
+	return &Checker{
+		client: client,
+		region: region,
+	}
+}
+
+// getServer returns the server for an instance, or nil if it's not been created yet.
+func (c *Checker) getServer(ctx context.Context, instance *unikornv1.ComputeInstance) (*regionapi.ServerV2Read, error) {
+	params := &regionapi.GetApiV2ServersParams{
+		OrganizationID: &regionapi.OrganizationIDQueryParameter{
+			instance.Labels[coreconstants.OrganizationLabel],
+		},
+		ProjectID: &regionapi.ProjectIDQueryParameter{
+			instance.Labels[coreconstants.ProjectLabel],
+		},
+		RegionID: &regionapi.RegionIDQueryParameter{
+			instance.Labels[regionconstants.RegionLabel],
+		},
+		NetworkID: &regionapi.NetworkIDQueryParameter{
+			instance.Labels[regionconstants.NetworkLabel],
+		},
+		Tag: &coreapi.TagSelectorParameter{
+			constants.InstanceLabel + "=" + instance.Name,
+		},
+	}
+
+	response, err := c.region.GetApiV2ServersWithResponse(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("%w: unable to query servers for instance", err)
+	}
+
+	if response.StatusCode() != http.StatusOK {
+		return nil, errors.PropagateError(response.HTTPResponse, response)
+	}
+
+	result := *response.JSON200
+
+	if len(result) == 0 {
+		//nolint:nilnil
+		return nil, nil
+	}
+
+	return &result[0], nil
+}
+
+// snapshot takes a snapshot of the server, tagging it so it can be found again
+// for garbage collection.
+func (c *Checker) snapshot(ctx context.Context, instance *unikornv1.ComputeInstance, server *regionapi.ServerV2Read, now time.Time) (*regionapi.ImageResponse, error) {
+	request := regionapi.SnapshotCreate{
+		Metadata: coreapi.ResourceWriteMetadata{
+			Name:        fmt.Sprintf("%s-%d", instance.Name, now.Unix()),
+			Description: ptr.To("Scheduled snapshot"),
+			Tags: &coreapi.TagList{
+				{
+					Name:  constants.InstanceIDTag,
+					Value: instance.Name,
+				},
+				{
+					Name:  constants.SnapshotPolicyTag,
+					Value: "true",
+				},
+			},
+		},
+		Spec: regionapi.SnapshotCreateSpec{},
+	}
+
+	response, err := c.region.PostApiV2ServersServerIDSnapshotWithResponse(ctx, server.Metadata.Id, request)
+	if err != nil {
+		return nil, fmt.Errorf("%w: unable to snapshot server for instance", err)
+	}
+
+	if response.StatusCode() != http.StatusCreated {
+		return nil, errors.PropagateError(response.HTTPResponse, response)
+	}
+
+	return response.JSON201, nil
+}
+
+// hasTag checks whether the image has the named tag with the given value.
+func hasTag(image *regionapi.Image, name, value string) bool {
+	if image.Metadata.Tags == nil {
+		return false
+	}
+
+	return slices.ContainsFunc(*image.Metadata.Tags, func(tag coreapi.Tag) bool {
+		return tag.Name == name && tag.Value == value
+	})
+}
+
+// expired returns the scheduled snapshots of an instance beyond those to be retained.
+func expired(images regionapi.Images, instanceID string, retention int) regionapi.Images {
+	images = slices.DeleteFunc(slices.Clone(images), func(image regionapi.Image) bool {
+		return !hasTag(&image, constants.InstanceIDTag, instanceID) || !hasTag(&image, constants.SnapshotPolicyTag, "true")
+	})
+
+	if len(images) <= retention {
+		return nil
+	}
+
+	// Newest first.
+	slices.SortFunc(images, func(a, b regionapi.Image) int {
+		return b.Metadata.CreationTime.Compare(a.Metadata.CreationTime)
+	})
+
+	return images[retention:]
+}
+
+// garbageCollect deletes any scheduled snapshots that are no longer retained.
+func (c *Checker) garbageCollect(ctx context.Context, instance *unikornv1.ComputeInstance) error {
+	log := log.FromContext(ctx)
+
+	organizationID := instance.Labels[coreconstants.OrganizationLabel]
+	regionID := instance.Labels[regionconstants.RegionLabel]
+
+	params := &regionapi.GetApiV2RegionsRegionIDImagesParams{
+		OrganizationID: &regionapi.OrganizationIDQueryParameter{
+			organizationID,
+		},
+		Scope: ptr.To(regionapi.GetApiV2RegionsRegionIDImagesParamsScopeOwned),
+	}
+
+	response, err := c.region.GetApiV2RegionsRegionIDImagesWithResponse(ctx, regionID, params)
+	if err != nil {
+		return fmt.Errorf("%w: unable to list images", err)
+	}
+
+	if response.StatusCode() != http.StatusOK {
+		return errors.PropagateError(response.HTTPResponse, response)
+	}
+
+	for _, image := range expired(*response.JSON200, instance.Name, instance.Spec.SnapshotPolicy.Retention) {
+		log.Info("deleting expired snapshot", "instance", instance.Name, "image", image.Metadata.Id)
+
+		response, err := c.region.DeleteApiV1OrganizationsOrganizationIDRegionsRegionIDImagesImageIDWithResponse(ctx, organizationID, regionID, image.Metadata.Id)
+		if err != nil {
+			return fmt.Errorf("%w: unable to delete image", err)
+		}
+
+		if response.StatusCode() != http.StatusAccepted && response.StatusCode() != http.StatusNotFound {
+			return errors.PropagateError(response.HTTPResponse, response)
+		}
+	}
+
+	return nil
+}
+
+// check snapshots an instance if one is due, then prunes old snapshots.
+func (c *Checker) check(ctx context.Context, instance *unikornv1.ComputeInstance, now time.Time) error {
+	log := log.FromContext(ctx)
+
+	schedule, err := Parse(instance.Spec.SnapshotPolicy)
+	if err != nil {
+		return err
+	}
+
+	last := instance.CreationTimestamp.Time
+
+	if status := instance.Status.SnapshotPolicy; status != nil {
+		last = status.LastSnapshotTime.Time
+	}
+
+	if !schedule.Due(last, now) {
+		return nil
+	}
+
+	server, err := c.getServer(ctx, instance)
+	if err != nil {
+		return err
+	}
+
+	// Not provisioned yet, try again later.
+	if server == nil || server.Metadata.ProvisioningStatus != coreapi.ResourceProvisioningStatusProvisioned {
+		return nil
+	}
+
+	log.Info("taking scheduled snapshot", "instance", instance.Name)
+
+	image, err := c.snapshot(ctx, instance, server, now)
+	if err != nil {
+		return err
+	}
+
+	// Record the snapshot before garbage collection so a failure there doesn't
+	// result in another snapshot on the next poll.
+	updated := instance.DeepCopy()
+	updated.Status.SnapshotPolicy = &unikornv1.ComputeInstanceSnapshotPolicyStatus{
+		LastSnapshotID:   image.Metadata.Id,
+		LastSnapshotTime: metav1.NewTime(now),
+	}
+
+	if err := c.client.Status().Patch(ctx, updated, client.MergeFrom(instance)); err != nil {
+		return fmt.Errorf("%w: failed to update instance status", err)
+	}
+
+	return c.garbageCollect(ctx, instance)
+}
+
+// Check implements the monitor Checker interface.
+func (c *Checker) Check(ctx context.Context) error {
+	log := log.FromContext(ctx)
+
+	instances := &unikornv1.ComputeInstanceList{}
+
+	if err := c.client.List(ctx, instances); err != nil {
+		return err
+	}
+
+	now := time.Now()
+
+	for i := range instances.Items {
+		instance := &instances.Items[i]
+
+		if instance.Spec.SnapshotPolicy == nil || instance.DeletionTimestamp != nil || instance.Spec.Pause {
+			continue
+		}
+
+		if err := c.check(ctx, instance, now); err != nil {
+			log.Error(err, "failed to apply snapshot policy", "instance", instance.Name)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,76 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package snapshot_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/unikorn-cloud/compute/pkg/constants"
+	"github.com/unikorn-cloud/compute/pkg/monitor/snapshot"
+	coreapi "github.com/unikorn-cloud/core/pkg/openapi"
+	regionapi "github.com/unikorn-cloud/region/pkg/openapi"
+)
+
+func image(id, instanceID string, scheduled bool, created time.Time) regionapi.Image {
+	tags := coreapi.TagList{
+		{
+			Name:  constants.InstanceIDTag,
+			Value: instanceID,
+		},
+	}
+
+	if scheduled {
+		tags = append(tags, coreapi.Tag{
+			Name:  constants.SnapshotPolicyTag,
+			Value: "true",
+		})
+	}
+
+	return regionapi.Image{
+		Metadata: coreapi.StaticResourceMetadata{
+			Id:           id,
+			CreationTime: created,
+			Tags:         &tags,
+		},
+	}
+}
+
+// TestExpired ensures only the oldest scheduled snapshots of the instance are
+// selected for deletion.
+func TestExpired(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+
+	images := regionapi.Images{
+		image("oldest", "foo", true, now.Add(-3*time.Hour)),
+		image("newest", "foo", true, now),
+		image("manual", "foo", false, now.Add(-4*time.Hour)),
+		image("other", "bar", true, now.Add(-5*time.Hour)),
+		image("middle", "foo", true, now.Add(-2*time.Hour)),
+	}
+
+	result := snapshot.Expired(images, "foo", 2)
+	require.Len(t, result, 1)
+	require.Equal(t, "oldest", result[0].Metadata.Id)
+
+	require.Empty(t, snapshot.Expired(images, "foo", 3))
+}
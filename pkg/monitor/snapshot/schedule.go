@@ -0,0 +1,75 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package snapshot
+
+import (
+	"errors"
+	"fmt"
+	"time"
+	// Images may not ship with time zone data.
+	_ "time/tzdata"
+
+	"github.com/robfig/cron/v3"
+
+	unikornv1 "github.com/unikorn-cloud/compute/pkg/apis/unikorn/v1alpha1"
+)
+
+var (
+	// ErrPolicy is raised when a snapshot policy is invalid.
+	ErrPolicy = errors.New("invalid snapshot policy")
+)
+
+// Schedule is a parsed snapshot policy schedule.
+type Schedule struct {
+	// schedule is when to take snapshots.
+	schedule cron.Schedule
+	// location is the time zone the schedule is evaluated in.
+	location *time.Location
+}
+
+// Parse checks a snapshot policy is valid and returns an evaluable schedule.
+func Parse(in *unikornv1.ComputeInstanceSnapshotPolicy) (*Schedule, error) {
+	if in.Retention < 1 {
+		return nil, fmt.Errorf("%w: retention must be at least 1", ErrPolicy)
+	}
+
+	location, err := time.LoadLocation(in.TimeZone)
+	if err != nil {
+		return nil, fmt.Errorf("%w: time zone %s unknown", ErrPolicy, in.TimeZone)
+	}
+
+	schedule, err := cron.ParseStandard(in.Schedule)
+	if err != nil {
+		return nil, fmt.Errorf("%w: schedule %s: %s", ErrPolicy, in.Schedule, err.Error())
+	}
+
+	out := &Schedule{
+		schedule: schedule,
+		location: location,
+	}
+
+	return out, nil
+}
+
+// Due returns whether a snapshot is due given when the last one was taken.
+// Missed snapshots, e.g. due to downtime, are coalesced into one.
+func (s *Schedule) Due(last, now time.Time) bool {
+	next := s.schedule.Next(last.In(s.location))
+
+	return !next.IsZero() && !next.After(now)
+}
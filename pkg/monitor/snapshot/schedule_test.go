@@ -0,0 +1,59 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package snapshot_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	unikornv1 "github.com/unikorn-cloud/compute/pkg/apis/unikorn/v1alpha1"
+	"github.com/unikorn-cloud/compute/pkg/monitor/snapshot"
+)
+
+func TestParseInvalid(t *testing.T) {
+	t.Parallel()
+
+	policies := []unikornv1.ComputeInstanceSnapshotPolicy{
+		{Schedule: "0 2 * * *"},
+		{Schedule: "bad", Retention: 1},
+		{Schedule: "0 2 * * *", TimeZone: "Mars/Olympus_Mons", Retention: 1},
+	}
+
+	for i := range policies {
+		_, err := snapshot.Parse(&policies[i])
+		require.ErrorIs(t, err, snapshot.ErrPolicy)
+	}
+}
+
+func TestDue(t *testing.T) {
+	t.Parallel()
+
+	schedule, err := snapshot.Parse(&unikornv1.ComputeInstanceSnapshotPolicy{
+		Schedule:  "0 2 * * *",
+		Retention: 7,
+	})
+	require.NoError(t, err)
+
+	last := time.Date(2026, 1, 1, 2, 0, 0, 0, time.UTC)
+
+	require.False(t, schedule.Due(last, last.Add(time.Hour)))
+	require.True(t, schedule.Due(last, last.Add(24*time.Hour)))
+	require.True(t, schedule.Due(last, last.Add(72*time.Hour)))
+}
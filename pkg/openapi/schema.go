@@ -20,186 +20,465 @@ import (
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+y9C3PbtvI3/FUw/P/PtJ0jyqJuljzTOa9jp4ne1onrS3qzHw9IQhJqCmAJ0I6S8fPZ",
-	"n8GNIimSEiU5dXrUc6ZNRBKXxe5isdj97WfLo7OQEkQ4s44+WyGM4AxxFMm/eUHMOIpGp+fmZ/Grj5gX",
-	"4ZBjSqwj62qKgH4PjE6bVsPC4ucQ8qnVsAicIeto0ZDVsCL0V4wj5FtHPIpRw2LeFM2gaPh/IzS2jqz/",
-	"OViM6UA9ZQf3sYsigjhi7+AMLcbz9NSwpjDyL5BLKa8Y5y9TxKcoAnyKQCRfBpgB8Wky5r9iFM0XgxbP",
-	"rPT4+DwUv7uUBggS2TUmjEPioZUkMi+W02jR1LMQKUBkwqcrRim6RYwjH9CYhzEH6qsyCqmnRTTChKOJ",
-	"7nkGvSkmq0mk3yunUNLQsxCIIP5Io/vR6c9ikhVjPQ4C+shAhBiNIw8xwClwERjjgKMI+cCdA91WGd2S",
-	"rjKkwxzNWIqGjEeYTKynhvkBRhGcy7HSaAIJ/gTFiFbSNf1yOXGzTT4LhbNd7IDM6QbLaL00r40IHkb0",
-	"T+TxlbTW75WTOWnoWSictL4D4uq2yuianshGJI3QZB3uVa+VE9Q08yz0NI3vgJyqqTJqpmaxETFjgu9p",
-	"RGwvoLF/59EI3c0gJnfh/eSOhojAEN95dDaj5I7DySUKkMdpVDUjwBAHdAw4nMjpzCD3pgBOoNinUjPF",
-	"RG6pYxrNwI2czvcPMIjRjdW4IXwaM/A4RQQg4lEf+WBOYzBBHNxY/+Fw8v2Y0n91Tj3Ib+JWq90XP7kw",
-	"+lfn1KeTG6uMWhxONiPUk2ISxPgr6mOUNnI+tE8iBDm6UM/lE0o4IvKPMAwD7EklcvAnExT6bKGPcBYG",
-	"SPxxhjj0IZeDMZvV3NYti3GwEHnyodb8vrAjWr2h20F9ewhRz+623UN72HW79rjbHruHsO9ChKyc1hTf",
-	"+d1+q+X3kY2G/Z7ddbtdGw5aA3vQHbvtMez0D1ttq2GFlAbMOvrjszUO4AON5LfeYa8/QG3fHg+ha3d7",
-	"Hd8ewg60e07nsDc+HHTbfVcQfQYnSH4AnRbqtNDAbrX60O4OUN+GHe/Q7njDrtMfDJ1xx0kpBUoD25Gi",
-	"KOnFrCPn6Xahl+QQIGo7Q//Qdlpi2v2WYw+8tmcjdIha/b477HhI8vR64ptbPrXIeV42Bqon3hHqRHNB",
-	"c0lrPDUWDHEd+s/OEC9nlTYguSJQNclj+U41weXKndBZGHN0or7bFdULSK51bQ0RFCIbUOifJ4sFhcJH",
-	"/rHvR4ixc4gj9buH/cg6spxWc9BsNVsHTt8S/D/GEXqEQSDf8XGEPE0nTCaiASmuEbeOBi0hLGiMPwrl",
-	"9IflDNtNpz9oOs3WQbtrKVHi1KOBUINeaD01qht0Wv2++vMZ/GgdOcPhMNdDqyn/dzCwGpZzKLpTI28X",
-	"9XabmPOCkhuyrPiU6S1I7jyY8YhaR1bsxoTHVsN6QBFT82l3m62u3osNs3aeElb20RjGARfTjd0Ae6Nz",
-	"sRUrDpHMQaAbJKxWi8kz7PhLhIsZXXNtwu6az8HiIF3I8ugByxXbjM3NOUguoA+H7daw17bd9tizu64/",
-	"tGHL7du9bvfwELa9VrvXtRrWodPxxr3ewO76nbbd7Q0H9gCO20JZ9AaHbv8Q9lrW7drkMRMoJUxiQOjR",
-	"SiNCfgXGEZ0BaEhWSB9zGN75njyljKeVwZfQuvX3fP2JMGKkrHhxhPn8TUTjUK253xv2unBsO/6hY3eh",
-	"O7Zd1+nbvcP20Dt0+p3BoC8Xc2Pj4fk27OzSlmweWqoSr8laG7d5+5LAkE0p3yHbmKZtptveYMJmWFUT",
-	"N+qDU2B6ApAkdKic9s7Nlb9PVrZl/PqLU2nK5LlxpU0jtysWUsJyZ4yfMOMX+kmdJfoju0ZGHK6wXKt2",
-	"q92xW4d2x7lyWkfd3lG397vVsKYIBnx6ySGPmXWk/yqOUbjGEi5br19Qq8hPHrCwBTCZJDNJfkT+i7Gl",
-	"V3IubPnOYd+xe+6gY3d9B9qw6zt29xD1e8hzkTvoSZWdNcobFtOz3ujwuCDJihNa2ih2e87A63ft/qDX",
-	"t7t+/9CGh8Oh3XG6Luz3B/3ucGw9iY9qHhcuEPSFAFQfGIzgNK30WWwTodnLzF5mXpbMbCQydcQlc2g5",
-	"RRzi4GuUnBcvNrvwIeydAi/FKZBWGMvrZA6waS15uv7sSuVCmNfZm02pZKS49Lvu2G21W/bgsOPYXWfQ",
-	"tmHXG9jjAeq53thzvA5KNLAYTLs/cGF/MLaH/WHL7g7HLXvQbXXt3rjruO6h1/G9juRx/AA5Gp0rJ5X4",
-	"n7MO6y9IKT40DCEEzVDOuogJkV7324KF2NTTmPMJlilDX2o65IPUA3l5kVwgFajHvWLcK8a9Ytwrxn+y",
-	"Ysy5pwu0IPsq3RF7PbjXg3s9+M/Vg7ebKUJWrAUDzORVVE4bMqkOjVf3K/UwSSf9i1WDX/zKYMGFOgZs",
-	"4yuErb1IjygS5EEp1s/Jl1bTrWYnJz+DTrPbawoN3m9bz+loWjB/qZ8pd/mRkRn2td5l7KVmLzVbXGmk",
-	"+L9Mbsyek5cftenUiNeEnodCjvy0qJVG7oMpZMBFiADzGYDEB484CGRAahyMcRCIX9mceNOIEhqzYN68",
-	"Ib/RGMzgHIQ0CLTfRIV4ygZmlGBOI4A5A2lOlg+VMAK18DeEUwAfIeZy6gFK+2JoiCIdrV2PCC709cX2",
-	"Zts0iiJhNVqYPMAA+3eaXFZDPbnLEtQQ06X+HOhPrIbFI+ihO6lweoeu53T9oet3+8645fbgYdt3B52W",
-	"0x26/UNora2BaxBBTaKA2y7S4x0rT5hqH8ixS7I0ADXZL+ptnyIGCBXrRDjE5IbAZOnVbTYYYxT4tTnW",
-	"o2QcYG/LpTKtlKwRXDDoI+ZTOW4GZwgIJQ9gECHozwH6iBlnL3vt9CzMfJmaDySUT1HUADGLYRDMAZ9i",
-	"BmYIEibmOgdT+ICys667TmMaudj3EdluoZJmSlYqZiry1keEYxgw4FPJdskEEnYTah4HaILY1yBtj5AB",
-	"HxGs4vthzKc00rZEQ68WnAut68GYqZfEbDMvCm15j4ihh9CoGYowj4YyuB5AAo7PR4kQS6IKCSbfLCh5",
-	"QwjyEGMwmqdoCagK0Zd620cRCAPIxzSa1eUXTDiKCAwuUfSAoteCPttxDpMNaUoXM4/WZpwCRSgvgHj2",
-	"krnjmICYoI8h8mQaXQRiMoXEF5OQ3wDqeXEUIb8JrlI8AgGPIGEYEa7fg8S/IeIpiz0PibYIEEqPR/Mm",
-	"AKOxYjEsGUAsrwcZaoAwQJAJBgppxAHmADIZQMZYXFs/EMp/oDHxt1tkQvndWDRTssI8k3eYKPVkd5Iq",
-	"/CWv+LX0FgkWHWPig8XGVJfe4q/YP48ol8xjdobNyJ9RM3dK0qQlP+U8PDo4EM+b0Juhpkdn1m3DchGM",
-	"UHQ3Q3xKfXbH4lCwEPLlNwj6KLJkzIMalDiDcR6yo4MDRPyQYsIXrQnq0xDlGlHTU0ehMQ6EuY9mEAc1",
-	"oo63J2bRAr4PERmdyg0YT2JloAKpsjkFPmYefUCR1NtiB1MkB5qiKrtpijnHZHJDoNCyqkeQ0AUoScdM",
-	"SG8cEa3PZOK0FHjZBiT5rUHpAcxk8lRMVCoZo2r79yBZjG1KH0WTqSHWZr6YmN7RlgIvTh6M3amtscx6",
-	"yxJTafkXrdaLBmw2YzVjvUOJExj6GIrtu2AN1KF0uX+9FXqUMBqg9zL7erNl0G8y68j6CZP4I9BucdBr",
-	"Or1my3Zag759/zAD37oxDnz//wu8eattw5nf79qtXuc78O3E88C319KtDhyn2RVfKS+783/b7War+53+",
-	"uQHevLsGgQ++Ff99hUnMccCkvaI+/w60m53Bd+B/ho6tG7w8OwdnlIDjeAK6wBkcdZ2j7iG4vjoB7Va7",
-	"l3ScGm5z6MgRy5+cQe+7G3JCZzNx9gwwQUfg1fv3V3ejs+M3r78/cCnlBw+zAJP4k52fc0Qp//78+OLq",
-	"+np0+r3Th8MeHHfs3rh3aHc7bceGfTi2/Var73mee+i3uiCiQK/K95zPnfRfLlsghAR739vOptxYhx/K",
-	"HHTyFZOxn4kH26SvS8SYzEzZhPniKEjtDNr30ZwE1Gn66KFJmAcDuUcc9VuD1sED8e4CzFFzymfBf0LI",
-	"p9//q/ODlKN/dU69fheNBy6y20heWThde9CBA7vvHLYH/X7XPTxsPS/dNS2qCc/US1tQXvn7nsGZ6gwP",
-	"W3bLsVvOVat1JP//u/GZDuHA63cOW3a31e/ZXb8L7aEPW/Zh/3Dgj7stzx/6C5/ppNltTvFkOkOzJnRa",
-	"raYzaTqtiZt2W8LIm2Kx+cWR+OTjoH/X71oNywvjH+AMB3PryBoRjgLwK6IEnAeQYxLPwMDpt67At5f3",
-	"8wDeo+/UF8w66jYsH7N766jdaliTMBZ9BHSCPRiciP3QOmo3rBma0WhuHfW7DWtGfRTIThjHxOPgbNTu",
-	"tYTFMZ2z1GdOw3pAxJe71fHZqZiDaabTruEG3GSRq72F+qX6LCQdwM90hdW22+0rp33U6h45nYR/YL87",
-	"Hrb7Q7vTRy2723HatjvwHbvX9ocdv9cfuocpn3vsxu12q2s/OM12r9m3J2Fs99q95qDXbPXsQw/5XafX",
-	"XYebNCP4EX5AYgGTVizNANLKPXZaYuHf6v+0Wy1hvSar/u7D6HR0LLqjKkGD+siAc1BX2qbL98tjw8Q+",
-	"cjEkVsO6RxGRHCd2m49Ww3qAEYaC6vpsW3Qr3bAY/oTe4FfWkdNqWIyO+SOM0Af1nhzOApPAOrI0ycSH",
-	"DzjiMQy0hSiemR/0BULie2fahy7dYDUuhOozXckhWD4DfAq5NFVdpCxq6YvArMoHsU6nz3bxtOf1r5/X",
-	"b5+P2Veob/WO4noYIXkDAjl2g8RJvRXrq8df7tI1P01OQ8CQFyEOREMeEmdSwOgMPU5RhAwWyPWPO76w",
-	"je/tR8S47dS9R0VQSJRCNtMmwDt1KcmS9EkNESJIzTj07p+NgfTqVXOQfqk+bzA2/RHNN7MA9PXqj0gI",
-	"vC3+efX6zegdeH/++t3l5VtwfjH6cHz1Gvz4+jf59Ia4nVeBS959gidO9Puv99z/8/Wx+OfVm96DO7sW",
-	"f3ztzobx7z8fm39eiX+dPYp/8083xGtP+O+//Dx/d3X98b146+SEP1z0Xv2Aj3/t//v6DT1/PIjfHFw7",
-	"p/Df+J0TvHv72y+f7ge/Tc/fo+vH4+Mbcvzj8fTTyYf/f+Q9Bpc/q3brtHpDito9fn0S/Pbnb5OPP/z5",
-	"+qz717TDgsPRZdsPX326/Hh/cdV6dzUfjn6aTzA8viH8r/bw7f3rX0avxlHvZzg5OP131x1eXb+L+qPO",
-	"L9ctf+q+v/qIXw96vSsxwre/fojhL/zBm3Unv//6it6Q339xAm/2Axu9+XB/9ue1c3Z1P4HtD70bIkn9",
-	"+t1p6TI809lHcVLJti7GcY/mzZRJIcVrGRCjBCUJzOKA4zBA4Oz45GB0DqD6BHwbQTJB34EQ4kiCBYSQ",
-	"CVUa0XiiNacOKAAhjXjzhlzNQyHRwXxxXyI9aTwFJoeZuXQmE+kLBxGNNepAGIlH3OAASeiOorv1k9Hp",
-	"hXSvifGLD5dghmbQ0zMvbuHs+CSZZ0VDT+lE4j/UiG4XGsr9E3kyIXuZ2DKtsgDEyagV/UUyCElkCa9k",
-	"oJOq2KdgcZewlZJRXUo/q34XsapRJeupI0sXG6cZL6cAydBUBRohrzsllzZvyKs50PGDDUBJMAch9O4R",
-	"X3r1mwXjyBusMfTQNwwsWO+G5LuU+5powQD5AXDNkIpikBwlnYBQoX4telKxDx5PM5rc+GnMweW74ysQ",
-	"xQHK0n1Jwsw4TPSFWTFJo0Luyy9EHhKpYAWqAJGyYpG2GnbkZDV3CGem6dSWXQPq6VJ8kpeZZLi6ySLx",
-	"KWpHqbD3Y2kprTUI1X3jc45eqaCnIk1gOHF0KhUB59BTsQtLIAOcFi52PmRtJeKj0KTGPMoG9mBS2EMq",
-	"uK0K4rBmu7l1yk0j3WsaMWV5+W7XQPcSK4/H2uBJjaWABWQAV5GA5BMnvoBcaBJcejRM3/5Bf2NB0Tya",
-	"Oiut95l6fZVsJe3erqLwqu3JWwrMXnNnyiVKV+hCI+VlzLPEM9nl1knh1aMRLymMjyXaqe8rCXWZLFLp",
-	"GOUby4OroXNSaM2YgUhFWAJaognWn7QavJp6OvSyEtKzbDjrKJCki7S6aKxDZw31UkHnZXyXl78nbr4b",
-	"ZjIozpTdXMaLebgzY2aXceYiGybfku4ImDcKGTDrRNg5sd+mm39KJ9yUjda8UTha7Jd/WDLBJD+n7Dvl",
-	"Ri35OhUWXfa9fiVl7Zbt+UtelZ2T+3y5k6d0BHfpHOQbq6bANhj2KpeftsV+wmPkzb0AnU8hQ0tSJoNa",
-	"Et5ZLGpjwf7J8ApJnWP0taWUlW8ZJSlPCzS+hcSut9FWaImibXc5P3WlLokQ9L8yOyszy5rGVvbb9Syu",
-	"1ZxRbObkSZ1YylmYyizl19rEl04ByXZebFLksidrYXBmPq2wB7J9rEGzNTe7sk2Osel5yoOab+by8m2i",
-	"hu/RXDs5lO8giRxK0+5ZCZfitBVkSX9WpGLy5DEjBMIGKxDkRQZw1dD1a7LTJGN3Z6K/uGz6Cboo+ACD",
-	"eFmfazVuBnxbi1LrMlOGWqWstUgt3kA9s4V+/iJkTGfx5qf/Lp65KErjwaa4PF0tpGAhklbrrUStfTGz",
-	"HJtuixmJWbkrFonlxiPebj8vYODVw5fIv+ttNUgGwstT1cs+TRVs6FtvyXVWddMFLHV/qLdGBkChoDCR",
-	"itOgIGY60cR42K2GRQnSLtGcvXv71Mj+liAz3Gq8/9QCY7+q65KzTRrqoTIVUzRiCloUb2tZrOryUhfr",
-	"IFUXqmeJxL3WtjY6LfQOpdop4icDBHIRB4XjN8/l9QKQl73qIgOu2pRTICBFK5Q8Tt/W8AiOx9iT7Ydh",
-	"oLKaZM/KZY1IPJNskoCKqCuc1NTSLqaIl/iZacSTyzKZ98Q4jNRtlnooLwyLtpEUdElRy4j4+VYaABOx",
-	"yvhhccujgjVoxBsAj41/EPklHSbgKBWyPjq9SN11JVPDHMzwZMplHhCZg9H5Q1fMd3T+0AeYqO8I5Ys6",
-	"R2vWO0kjsZS48uXTzJ2kWT7uhVbDiv2wYN1y7LvgolSPem1TpFnF2pXEy/A4W8Hka2nQjFQV0C6rWQrV",
-	"htCTWo0ZfVUkYyq4aoeOCcpOVaNPqTCswpug5O6bzRlHM6DfLlS5SfTWei3ptAK1dax22GoyLLopYocc",
-	"OHzFxUwlNPyLNTCy89vYwChoZu0LzASOfn9/+VLuL5cgViqW/F0GuGNVU6nYh2x+27KUlIdvrBEakv+q",
-	"0q9rSnHSSCZ4ZhYCLry9KTIkJTeXoUmqh5d5O2V8lZJ31X1weo2+HkdlTubXdFEmX+3gOrikCMY6Cj4p",
-	"hPH3Kviy2VfOtuzSeSU3LSCAinSYegpOzq8PLo7PlDFboUXy902V57H1G8tiCK3DSSnl9dSQwBCnetny",
-	"TgSJSMKACxnqd21TPTCboovJmEYz9WdxPJANMHOWjUNKQABj4k2bN+RK1yiE3GDRiMUDmIEJfUAyJ1fj",
-	"M0jmsDHBXBw4iA8jv6EwcEymvuqocUMgOBudvdYRjeJsIuP4H1ADIO4JkqkehQKbc7RyT0nWfLFOlcxV",
-	"4jISCkIFxCl5zNAJujTmJQu8kSEAgdGxYCKULHBRQImsGVkSU7BAePoCV3WVl6TKHMhfkBoGWbhBlidR",
-	"urfJJvP3lWu0uNZlSz1arxMwUcVfFXESqwvivHgLfGvbm5WZChXIX2ueTLOofMsn07KStQWD+TF5VUEE",
-	"gbOYKUeDgoYCp+8uDQCUigQK5kCYdJEEFAHeFEbQ4yhiDUCkD58JXTudh1NEWEP7ZIR6RBJnjE+FcjEf",
-	"SRNPfqVUqOiXgxllHPQ7qbYBJqlS4zP48SdVWfyo32lYM0zMX53i2OuUZ61iPRb1xVlDiCNDMtQ4wWTA",
-	"2QvMAod6vuVsxfIZJiP1prNGkG76nmuNyzXTVbEbr7AcZd2YbhO8pXJuq79+oEE8Q2kHTh1vizwflFs4",
-	"PyjrJqFq1eon0MBr+MqVF/ypDPW3Msht+YsdXHdVmUDX+okRzp3ZQrXNkmSWjSULpVBBqoDAIqZe4Yz+",
-	"KmzewvAwaRDBGTIbvZwfACOj+RUAIyZTFGGuc1nE62EQM7GrT2nEAYvHZRH+21ra5YwqR54wa7JVqYrf",
-	"WHJeXc797zTe83fVjXXN+VTQcMXmteH9tJbFopuBVNjuBrJaQw6Kzd3aDDmFGgtsR4EDS3HL61JfWwao",
-	"7iqUX6wX70f5waSOFcl7gCGJPFUQOpKA2Odbeq3wyoqaK3K55Uhrmi0iaZG3rYKqucPM6LTa2lp6fa1k",
-	"qDpIu2n4LQVyWDyFn0xWXfqDBBEswTyYRJDwXIjXAhuuMgesoOFvmMJ0VYC8lYHIW9BAocCdSRC45aG9",
-	"kk810JUELJRXqAozLnV3qPHiGpZL/bnVsP6KUTQvvP/dcGhlrKVv8t2qcTKQwNKZbaMAtG1d2d6Uttst",
-	"k8ZayxPgDSIowp5GkJwhxuAENZZgCKjgr3aByihu9RiIMxrSraq1A+hjCImvjoyShm+vrs71K2K/bwIJ",
-	"EMrkGUtYAr558f1xzKeg3Wy1s0k3DeDGKvtTtY30eVKMMcKIwyjxaIkOFMzY8fmIAYmUK+ROdEAZWlzW",
-	"iwVe9JeNTsgjQefQSPMwdmkcyxQ2seKpO/FUH6kssZIJi93NkI/hnVzrhkGWvkOEYz6/45TeBTCaIPlN",
-	"GFGJ3uoG6M4k7zdS8LpF8lMArpdfvg8ocgVRNDvoGmmuwZqVLRSrkQSMb+lwQvBfMQLyBYAlsO8Yo0iu",
-	"SArPc7VTqhz6tWh/2TZCsYCzlRMk5SUJxOvi5xg1AE8yzCV4jZhecksotC9LA9zcEEx89HHhSRBGquB8",
-	"KWiQcxSJPv/PHy17eGz/Du1Pt9/+52jxN/uuefu51eg7T6k3vvvP/1rbqc0yIMwlYmgYTFgAc5kgTc5X",
-	"3iIWw47uTIeW7dFPVQCmz6LBF7luZQS9yuws5r0a+/gyiurOZiKbLoxFS+bTKFnMgnFVEH9LOU7fs1fc",
-	"g64d/bCBhzl34ZoPmKgd0JDSl5mwgzrO/Fyna4QZmBkssDLceXZcclVTCMwRgvWx/1dfWT/HUq3JJcuL",
-	"t2asyC6WbNHVpqtlRrOThSrMgSwkQqq8h/aUpQ8xxp6KyT2hjyTJZZtL/KhJBP0FCPC2J4ClO5/llMEl",
-	"usmQ5iAQhmKOYqoySoQ5Kjg9V1pUV2keSD1qpOFBpNkA48lMMLUCE4EzbYDPqAQHIxx95JVuxmfO1+Bw",
-	"ssvNmcNJ4ZYiZ3O72VqfF2amForqoirN2rwaInmGyaVlpv8quddHucc7ZednV4+CHNi7WL4x/bzE9ape",
-	"j4KFKyIzxzOU1YGqDEaAuAqNTrymPuTIFq+/gMTuvy29eXkPqJ37u97eICOjt9oQFhZhuV/l/ej0RG0/",
-	"KaymrKpNm4z1YtXrjBXNHlBJTPYMitNLEp6sz2KCLcGD02w3O80bch4hO0KyVIbaBnRYtPJWyJpIcRQh",
-	"woP5wlmUO8Y93Nz4/765aab+s+1RrUROn9O4rVAGKnPLfzUv1gSyAs3jlOoML3/JvblEiSzo5PraRXew",
-	"vnYpSziKldsiabzEhTyjvnQerZy5CntZY+amxRUzh9l56+bXnXcRKkGG5GvoFlWSxigYzDIuDy3zf8ZM",
-	"38SoqzWfkm+40QI3BJL5ihJxytHnIoLGOMmxMtd1+BPyb0gyBDXx5g2xtjtHclgYL83hBMxgGMpxRi7m",
-	"EYzmxrVDlRuIyQIeiCFVZ4lQ5V6EgazGJct+qAJNc5DIpAKxk5CoHElXpnglZkjoakR8yUMqB8iXqHxY",
-	"mYw3RFuFKrHFUL4hP9eIneKRBzmayGIdAPN1b+eOjQCIWZc6HR6KXWWCSeUjc7fH4aS57q2oavN26yVc",
-	"daMk7Nnn8NwL7lm5Y62IUMxiPC9dmp9fg/QbaXM1gYOG4o1+dw27s1ZZiaIb/FRJiWWkzKTCSPWHayBc",
-	"mpZWs0a9gg2FQYtl5Rry85NFLIr4//riJymX+kZPZr1kGl09Y9H21pNVkQWFmaDyyRcJwCw9VKwVhrnB",
-	"fDeO2Ny0rxr0zQv3zqaeafipYbkwQmLOQXlaDV8ES+kNHAIf+bIqlG/i0YqTa1KVOgrmHiFtRwtlpQDi",
-	"094PgJqTJpCw9Iugz5xKW7YJw3hlEMjJ+XVJ+JwJVVz+Gs5oTOSegMIpmqEIBkC8Lc4Db14Vt6bR9ne2",
-	"dpMwNgk3prxI9VDVW3KI+NUaYS6SeEnjmhyNLDPuSCCqc3NN1ZKNdt71lN222+8kjM9UeZjlebw5v87w",
-	"bdPadoM1va0yWPI9PxMNk8nvgIrFqlFMZAWEZrZ0T5Ek6DdSov/m/JoB+ABxIGOWIAMMoeRQ//6yWJDL",
-	"pE1Se5WMJWWEKvikOCkkW2So0EmpX8nP8FsPRj77bjHT4oGZkh675YwPqtW8ctGdGXKk1Ex2oo3swm6t",
-	"bxYjKiShWAM1tLSJrGucNGQ5p63NY1wMl2Kq2vzTzCsFdFArNXSD9neQRFq/1ze6gE4hG6myOgDrMNMg",
-	"QAX4g6b2zopGtLuxYaryah5NdGKZWwgFz6PpTXTC36MyNNF2s4bvLwtFcQmQIvVGQXWHpOhRlWEr3lLX",
-	"dNKWfYQRnx+4mJKSBXxmaI9xYovvsHlt4D8tKjrttPkfVaNVwCRpiuuXFL19xO45DQ8q8iRLMUp0SSnj",
-	"nVriDtnBjapLdWOtPqhr4iSL0FgPwGRDxVtjr/liR81dH4cShZxUIttt0+8vM/XGlkIDMMGzeKZOgeKt",
-	"xcWVTjrhST5QlXVYVMNsd7V38o0XFELbNd0+ZNvPC4Ih6NJA5Cru+rSZ2ApVcHXsGwYCk+itLvvTxuDi",
-	"Ul9df8g/quJwQpxxNn1hGxuxKiBBvvANK0eN3X0O/IJ2S4sof93N6nxY4se8HwpyIPpJJ92mZEv6pNLr",
-	"lS7sl/ZwNSxI5jtaqUr/Rc2yfc9xQscmN3Wr43kJCkLxYTsRoFC8JKNlSCb50azPeSJPF6pAg9WwLjmV",
-	"t2bmj7sQqcT0KVgqufliN5aORnN3ZQYYUe9eyLauXrmDgVR4QZXfk46XTAxmCv0vosZ9NFaAkuLsD717",
-	"wf/6RjM9fORPIZdhRrLW5g7G/2Ni2uXHr+waKZ/pMaiinlv3rB7/kKq7WBJJYkoz6rtzCW8xg/rm2Fd3",
-	"nAEW8lRQi2WplGO+m9FY9GMOY0T5vrWApzrUoR0s5ZfRTQJI/BtCCQJsSuNAIhekQsKkV91AthoEPHlL",
-	"DPAsKbUIVFFMdkOK+nQhQ7ZUdEmOuLpPV9AEM43TkOpVDAjAxWA//HT8Tiarpm/Hy/L2loi29WagHpeh",
-	"YKinXxQKZBNkrA1m/GXuoVJ9LbP3EqDPgsEK0upT0rhjUiSCnmxcO+/iSjSbp7bOpkpmtiNqX+kplAHw",
-	"fMOMfoqWFOiifmwan2VXGrXSfElqxj6HYZKS8m2tk6KT0yL05TzDtLvyoqpAwaVKphIKBIQRSjx/ScCg",
-	"+a+R6Ka1LXPpgqpFZ/yknOrSHldRUEIwZK6oxDrpB0mDRdKiAV2KMuTkE2PbYJYKjZRgQSqDTmyIH850",
-	"omfqPjB35sGfCvo4TU7ka998yoaW55HKQb+UOVYqQ0emux7H6cS2wvS3aAEki4VtkmSOZhOKYaYlGZ4X",
-	"0Mfl9LcT6qOlH6+jwDqyppyH7OjgQCWW8HmT3LMmigWxZKnrbpMwDwao6dHZgRr/wUP7INNSkohlHX0W",
-	"oinGtlXrsoUMPKZ8pCoBYzKmJeVxNW7KJYoesIdkpoVWk0zmD+IkdFBXPVkKDxRnESAPIzdkBgmcoBki",
-	"pfj4HPMApUoNpDrO1KB3mk6n2ZLuJiWQ1pHVabaaHRXIO5UrdtB8REFgy4SAA5UraSdJe3Z5ct9oFgZI",
-	"5XbIqOjllH0xpCRvUox7gngxWp+ygmUzi0TLUB6WVeLRXBKqCG1AovQbzh35Mgec/4KC4EcxofcluZ8y",
-	"a1FGP0katFutMp2bvHewfcppUpxc8NNHe6qymmUddvF3Qm0jvLYWwZkKM5OV2p8a1gEM8cGDc5BO92IH",
-	"nzPJcKdPpiZeUXyawfbSXFm6KhLhAQbBwsh/xHyqr53T/RXS/zjEH5z36UG+zwwxKQyxyTrkikssiNqw",
-	"ujteRxf6FyqTO9uLs9NeYmI4W7JKqp/OTvtJEumznXR32gmh/Acak8xEejteFlnFmsBApT9LmIWMaBkp",
-	"kvkCxZvfH7JMR1YGn4RWNOXASnMNFq8cZOVuAUP41Fj5ab3YWwPCn+ridn11oNMm2cFnk4JZW0d8Mbok",
-	"I0xPtWGFtCiMSGEoMwABQY/pIiVZhXRO2UqNdK5pdG76z6goqQJeUX9ezsbmFSw0lBzXSa5AjYLZeFpS",
-	"ee26Km+v8bbUeMOddmKwUL5GjbcjJXLwWf9pdPqUpFEWHXTk79mCQllZVW9sLK0nZhjWJmJWB4bD81DI",
-	"89y7l8W99bGF9bGhrf4GcRnhzuXNLnjA6NFEgZTK2RpG+iZCVtt8P5Wj3vP33rp+bity9VfJHpazPYsy",
-	"xBQ2/GInSx+PVSUs5KewqwmcFFqm8a6k8O+2UPdb5161/KPM2ANZAPMrOB1vrtcKz9SJiZ6vCZorBaqv",
-	"QxSut7zyxxIswoMBAj59lKrwhuSK9Cp0yqTNRxQhEKqiljs+tyfq8fWDwqmsrSNN1dS9Xtzrxb1eTPSi",
-	"Ed6Dz0nhkacDnQZOy/Lp6xxl0mnlqkGdw5vK3H2Gw4ypGX9mZnWSmdP2N0Z1IAn2OmCvA/6bj12rv0qU",
-	"T62vVI2lZ7hGWVtFaqCMbe5m1bWHufXIoXr8naoymduXUpYa7WSvLffacq8t62rLL6f6pjDyI+RS+s89",
-	"T2+4BGWn8Lcw8oEi2UKbGx8jfKar7nL9/naxgPtD8F6lf1UqXUfWyUI8X/hUzOiY7/VeHb13Scf8Bem9",
-	"y8UC7vXeXu/t9d6aeo/DaK/y1lV5qoA0YCqL+AUoPbl6e32313d7fbeuvqPhXt2tq+5oCCCIFJDCS9B2",
-	"NNwru72y++9RduUJ1RLWW+ZTjHHAUYT8fIp1guIvYWF8PB6jCBGeRLrxebgiqYwlZeZNimAKbCaVyF37",
-	"2uJCT+vZ7x70IPfCvJUwv1hBY/FsBqO5yYGMErZStcL+sAyj3e7usuC2tvQefFZ/ED+VAlSb/GANhr5W",
-	"0idTWZ8m63ghm7qXBYaMrAIzhWxR4Hsbub3Q0/lBT+bZxVjPZy/G+z15R6pinLCuURWGmW+/5L2iUQw7",
-	"0y9l+HFGvShYu+20SxqB7vmUy0jN5Nl1i5rNXrXsVcuOVAs2jGs0i+bkl6NY2lUZ5VkMkzXRJ7wC5JNC",
-	"BdBO5WrXI8bWWfiNmvT+OUbRfDNHTv1PzXrV/1KjAC5/ertRxp9ang9tsax7pbhXiruL1aqAhVjHl9je",
-	"CuXBsLXqrzxNxKkhInvx+Gd6FcoSM9rPiqHQ3uMi7NX8Pw4Xoa41qfARVkEhtHcEb7DX5HsJ+JuD0rcB",
-	"MigFKWjvBnjAiIfqdztUrL2o7UXt+QwzUwmiyvOpX6np0UhaLt+MRknne5/GS/RpJEu41z173bMrJ29K",
-	"5hM/b/Lb7Up/R7Z4TYnHI61Yau/epv0deDxMU3v52YNZbi8/WgQMU5UIUNHmfvDZ/HFNv0uVlKU8L0m/",
-	"o6T5ve9lvyV9PSKl+X2FSDW2toyld6ZKqJZM4iqJau13nr2YfEkxEey7UkbqneAWG1IN/02l8RdXS9CG",
-	"VuAOXDh7WdzL4u5kUcvCtlbgSjSzjfa4MlizDbe+PTrZXlr/OTtnTjKecyPdCiRslcrQCFi70BmrUb62",
-	"0xxmqHusrr3u+Gfojg/vTp7VAl+tBUrBXqqk/4votCmM/As5unWSdC80AktKwwDwSpbzhnHAVVlpCf0c",
-	"omhMoxmAwBQ3Bccn5yON4dK8Ib/RGHiQABYiD4/xHEAgxgJC+ogiVfIQQAYg+CtWRf/06NZxYS902sUe",
-	"pGWvw74yHaaFrPq0UpHoW6qFGIEhm9LqmyKZs2Mw3XPqaUdaqVS9XMF7iTCvxwnoOK1qZEFUr2ikmNfT",
-	"CpeGEFs4OUwbW1121U8e2quYvYrZXsUY5t3eJcLY9L6oFHf9c80F4hFGD0g6RHT5763OM5dqaM9+jlFV",
-	"zPeCuRfMHZ9ftBD8zWeXMtC2Zz66rI2LVie2JaUc9mBme93wlW3akvGf4VhQjFL298l3BghMfExgffHe",
-	"o3ftpfvrkm4a1hfup6f/FwAA///oCqrrqUwBAA==",
+	"H4sIAAAAAAAC/+z9jXPbOJI3jv8rKP2ep2bvTpIlv9tVV1eeJDPj32wSb5xkbneVJwWRkIQ1BWgI0I42",
+	"5f/9W90ASJAiJVKWHWeGt3UT2yTx2t1o9Munv3YCOV9IwYRWnfOvnRmjIYvxR6bp9Bf8FX4LmQpivtBc",
+	"is5550IQuaC/J4wwobleEk2nhIfwy2TJxZToGSO3LFZcCiIn+GvMlEzigHWJnnFF5nRJxmwkFrG85SEL",
+	"CRf42uWk95rqYEbMUOBrSlQyVuz3hAlNkkVINesSGbvX30jBzDcjUfFRzGjY73Q7KpixOYX56OWCdc47",
+	"SsdcTDv39/fdzoLGdM60nT4N51y8s2P+lYvwbwmLl1funZI1iSJ5p9JpKqIlGTMy4ZFmMQvJeEluuMBh",
+	"cHj/d2iv0+0IOoeRwLPcCLlmcxzJ/4nZpHPe+f/tZVu1Z15Teyuj7Nx33dxoHNNlB2YWRInSLL58uWb4",
+	"72eM2PfI5ct0lAuqZ9kg04Y63U7Mfk94zMLOuY4T5o983YBvkjGLBdNMvaFzlo3HG+Z7Nl9EVLPaw9X2",
+	"g43jzlp+nPHHjGoWXkw0ix9KLVoSPZOKEdsooROc6oyRKb9lgmg+Z1W05A8kR1MTGc+p7px3gId60ESn",
+	"u8IK3c6EsyhU61Y/5nNFIq40QTIlobwTZsyMKBaxAIZsmoE/xyxMAubEwEIKxYji/2b9kfjJvERjRkKp",
+	"iWLAhvA17KAirD/tk1FnzjQNqaZ9mOGoA9w/6ihNdaJGnS6h+DbRM6pHYkGVggWdxTKZzggVBDmB0MUi",
+	"4malGQ1mhEVszoTuE/LeE0/k8iXhitDoji7VSMRMJ7FgYZdQERK1oLFids53PIqIkJoEUmjKBaFR5OY8",
+	"p/ENbJoijsyq9sp8UM75C6o1i+Gj//dP2vv3Re8fn+y/g97Zp//8y2jUL/v7f/zn/1nd1hKxMOExu6NR",
+	"9C6JNjObe5nESbSG0/JtrmWzErqTwBVrBnKtY0bnRLA7IhO9SDSsMNewYXcx15qJymXGpsuOgLGUEaMC",
+	"BzBlgsUUOqsperIPyB2yq1qwgE94YP7GHV/HTGm5hgqydtYuWcq/XOjjw2yTudBsaqXQjMbhOzaWUhfm",
+	"sIhZQHXWbH5Wv82YnlkJE+PnMHporE/Iy/TjLkmUYWTomqRnJuFCaUbDLuF6JOaJ0o41JhEPNLnjelb6",
+	"2YSMpZ4h/9u1q14lGM2mLZwxGunZNYqGHRzZpjliRE3luLw+m5/hieA3Mha9IJJJ+DmQMfs8p1x8XtxM",
+	"P8sFE3TBPwdyPpfisxvpL36HZaw9k0qL3AFVSsZzGsy4YAReJ/B+BVe75h7l2OQTVN7WDPWtiIz4XiIJ",
+	"GRUQSMfXK39QnjLaNaLXyG6nWr56T6eping3YwJExx2+CIQ7h1EwhecBV2Sa0BgOpikF0gZKDpI4Bm1y",
+	"LsOUxdMFM81mS+b02M56kccnoL7WWgAznfwhOpbhEhaC61qzt0r1SKCCvIjZLZeJm7+QJJJiyuLiSlDD",
+	"JkHEYU+BSYIZowsc0i2NOOzGSAQ0mLEwHZpatzKZyr5peYTSVATshUyE3kDMIpmPje5vbiIBjYj73owa",
+	"1aJKpQm6yA1nTr/weTLvnA8Hg25nzoX9rVTsup42HqTuxeozNGvqUfgtYmKqZxtGCd0yBXqYPWjNV1WL",
+	"Z56Wbaa/RlbcbFwi+171CqUNPcoC2dYbnSH2m7IjZP3ZoXZyasRsyqVYPTcUi29Z/NlR1F/5hAXLIGJX",
+	"M6pY6ckBTWgm4O3fuAjlXY3dSr8gd/jJuo1baf1RthC628HpT0lAFbIsE4prfsvQqoDyydo1eJw/OAvb",
+	"i/+sl3GC6TsZ31y+3MGAbVuVo3FdNae3ytUuoSEZT6ng/8YDciP5+C9XE06+yUehmXwXO9gMv8GqHVmZ",
+	"1yNuy0LK6M1mpRAoJJI0JPD+Oq3QtfcouwGNP1z2rplLYSPghfLl33yRXsg7Fr+W4bqV/UXewfgyLRY/",
+	"InJh731dGG7IJjSJdDYjuBaZV+AkFqDLwd0pilhUNZG5DFmn7g7ArK/c6LO5wNG3C/lp5ghH3Jp1d/09",
+	"nzNwEct/sUBvFF32vWqplTb0OCziWt/FTpm2KnfJm8hjSihwBoDTgIvpzq7wfqMblLHV/p/kOn+12m3Z",
+	"6vyeSE1/iuit3GzKn+Brxva6kLEm9JbyiI55BHfEiYwrDWW2/Q1KC47lHXLcxrEYxiTUDWrM4J4JW9VN",
+	"zaPmPp6+wtVma1Bse98wUmPMer9cbDr34FNQ6swHfUKu5UTb35S7EKHotkIbyGmpNJsTNUu0MYKPxDSm",
+	"AZskUbTskrsZjxga0dJ2jEhEAYRtObFUNUucUF1pkc3VTr3J/lRKMW+hdy/EXOM7YHTTVCNy2bkEUyxI",
+	"Yq6XP8cyWWxcefc2mcLr1TtQaPVRNkLxqaA6idexyQVJ30JnC6GJnhlbi0aXS2o4qLzuuu8bugaUjPUm",
+	"Enkbg+/ajkIlkVZ4JaJz1jW2H6Byzedw5yg9FQj5MdXBRsK1gTpYHDoqu3xZOTcZ69qsCs6za/gAZtfg",
+	"zNB0eo0ONhmv3yim8YZKUdAak15qzMy4yBoHwbFARjiR/76lUcJGne5I6FmijGBmIpDgpF/KhEyZJqPO",
+	"/2g6/e+JlP/34GVA9SgZDPaP4U9jGv/fg5ehnI46lSKNTrfVtO/YeCblzUbGsu9Vc1Ta0CPw0r1pkin9",
+	"oww5s+EEEsf3zjyAP4HbkAn8EV2TxqC89y8Fs/jaYV/ofBEx+BHvJucdS5mwdqjSXr5UnfN/dg4mw2Cf",
+	"ndHeyfj4sHcYDljvjB4Ne/vB6eQ4HLLD8cmg8+m+7rzcSH+LuWZmNhW0xb5wZU5BHA7SGX5NuIAfnZes",
+	"v7LGJc5+PAY1p5pttUTOQQw/u4vQsmc7AVICdQIfWhNI2DnvjAdHZ+MDdtw7o+yod7g/PumdHY4Pe5PD",
+	"/cn4hB6PKWOdgmEAvgsPjweD8Jj12NnxUe9wfHjYo6eD097p4WS8P6EHxyeD/Y65wsIOpSOCjlmscDlw",
+	"Nqpzfnr/KdPkofGAsv3hWXjSGw5gUMeDYe802A96jJ2wwfHx+OwgMKd7ve2sXufyvU31HGcvz/aRTGI5",
+	"JzSNt6izr7vazOki6emYcmElg9vObI2t4opLeHJ0fMr2w97kjI57h0cHYe+MHtDe0fDg5Ghycnq4fzzu",
+	"dDt8TqfMCVOUI1zpWHbOO8k4ETrpdDs2hqlz3tk/7A8OO/fddXt5eP9p641Zw24rcS52Y2TsXGHeoVu1",
+	"IR/3X8RshxvyjLhry53HD+hwwA4G7LQ3GBzT3uEpO+7Rg+CkdxCcHQ6PT8+Gk4Nh3kjUG+b2fPg0/Ou2",
+	"bz2FpFpOLYL4sAgfnSCezy5tseRmgdYveR0OxJ17IeeLRLMX5rtdrXrJktuLTgMWdFbSq3Sz0OXLwosw",
+	"jJlSV5TH5u8BD+POeWc46J/2B/3B3vC4A/Tvon7wnZDHLLDrxMUUGkB2jXXn/HQAzMIm/AuDBjvDs/3+",
+	"8Pi0P+wP9vYPO4aVtAxQ39HBonPfXd/gcHB8bH5+Tb90zodnZ2eFHgZ9/N/eaafbGZ5Ad2bk+2W9fUq9",
+	"f53zrUkWPlXNjpV7n1gPslMmU/kWyTjiweUV3IMNhSBxCDqOUlJrROQ5cqw8fSzVpuTu1IMsWLaU5Nkt",
+	"D7ZWd1PvLm5gSM/2B2dH+73x/iToHY7Dsx4djI97R4eHJyd0PxjsHx12up2T4UEwOTo67R2GB/u9w6Oz",
+	"094pneyDsDg6PRkfn9CjJlqwm8BmLdj3PuBXTk1ap/36YXIPOJfXccbh4UGeExwjDErZrOa6+AMvX5Z8",
+	"oCBeCTCcleadMaXLkoZ77FpVgQAmX0Y+xWHUXBWyn4CKe/41b/QxrBAenR0d0klvGJ4Me4d0POmNx8Pj",
+	"3tHJ/llwMjw+OD09RhrfWqd6PD0mv7UVZ6oVNu7devqMe/uNWb3XfBpvSzz+ng3Gx+x0vM96p5MB6x3S",
+	"Q7xWH/VO6D49mAyCYXjEOo2nnx/kxivYXN4yQkW2IsBIQmKkpufrr1yTa0EXaib1DlnJNd1Ttu0tiMAN",
+	"ax0xeKvgevJXYu20d67Zfjv58VBh0Hxz1mq9RQ6tof7aA/Idg4j/rfak6WrXnnJuaGuOet8oMqNialw3",
+	"Zlgm2ci2VLEAhaCnXRHmbLlg8S1XMu5NeDy/ozHziZQJWLH9wf5Rb3DaGwzfD/bPB4PzweAfnSwWL0Ri",
+	"OpwMgxN6wHpn4/2wd8hOJz16HBz1BuGQ7U8O6OH4KAC1IWZUmXCGtGviuibJYhrT0Fj2syvI+Gh4Ghwf",
+	"9o5Pj457h+HxSY+enJ31DoaHY3p8fHp8eDbpdDtK01inoz3pHQzf76ejvW+woYWlXrOpJXFrjQwroMX8",
+	"LKOQiUvg7a02NQ313D1tF4ZXj7rHCY/Coqr2gyIovaxiu0EGpyElWy0IdeqscWUCocoQ3Xcyipztr1lo",
+	"y5qZF2JwvAAdidk8qW7PRS391flV3tOpugKPy1ZrEDM49oEt5Z1gcedT1vDH9OI43D84PDpGX4D2bcya",
+	"0TncMMGJ0znvgMEQnDud+/p3n5VZlC8e5Hwu4PFaLskdXE31+nrjbRQGlBvPeqtawTtbSxnNNd9UDXn8",
+	"6a472wvTrSEBrRNtV8eZ5sEN047JWRADZXcOJsfBkA3GZ3Q/PAxO2cn4iA4ng7DjHXS3Jn/5n84c1k+9",
+	"uyzMzrq+ydPphwzPqRAYK4kjOEi1XqjzvT2YjerTYM76gZw7G0mD88euyBqRY99octQYwWKyKlZSk//K",
+	"lX5nnzbZgX/mt8AR93s+Z/4xPHg/HJwfHp0fHoHSkEt0Ou+kC9nt8AaqsNtu585peF8923BfPZ0MwU4E",
+	"97XJkPZO6Ph0fECHwQBVk5IQLy/ui2ECtY3JxyXkqcjd75ok7czo2lzRuQcLY10/rLfL7xgNYafLaQoT",
+	"ceUk1c4z9z4NYqlULhJZ9TuZE+AVcs6W9GMyZiAfZs6UQsNn54VNVzbihxhTfO/Lyc3+7+QvdWx0/4Ex",
+	"rhCt65nxrdJ5jY3aLjrdji4Q6xCJ9eR8uP+PLDdSyHhOIzQklw34J8ojk29tOdKOPD+Kc4IBb4R9CRgz",
+	"FF86KtNa5dCOzwf+0O5obPyZnxq6Jsy2bSAG8yoxwtHfdKudbbXnyOc1TbKrYQuO32gQsIVGZrNN1iGN",
+	"jr9vbpuMbgY6KaaimWDjrPPpIvE7npj9qb/gnjaL0UBla45ZDIkO5JyZy6Bb+oJ66e+Bc/s+mvg+8Miu",
+	"QnybX5dOeh9MzsanwZD1jgO4A9Kjk94ZBJMMg/3xAT0Mj9jxpNMtdcjXFKvP1mf/aUunfU2xXPDfqzJC",
+	"2IYIWhr49nEbQAI1wzacEudv/8f9ZyQBmulvnsP/CT0OT0xmDwk/2GjBpYNweHI87B2NTw96h+GQ9uhh",
+	"OOwdnrDjIxaM2fj0CN05+TgGXz/dwsm0EpVWFdTyiLptSvyeAO3myP1LT4TNrsW5NtdzZDkjXkE6PLvb",
+	"ThBnq2rUSNQyQxYx+PGfn8piU9DWVt/4et/N2h54bXdO6cn4ODiCLw8mvUM6HPfOgtOwd8KOJ0f0cHwQ",
+	"7Iedwgj2cyP41MA4VFyuWtExC/Nufr2fx4nXyrxW5j1E5nUfUzx1fUA+APCo6sW+tueB9t3f+8z2m7FG",
+	"l3KcZl/0Hl4TewoBlvJCtwQqrzhy81nVrdNYJX6koTUUbsf4gfED2Nb6ztJn733WDtrpdlgcg1bYyR5g",
+	"p+7J5/zY09wnc5PET8AoF1AhpLbQTTK6NZf6mAbsM4qNo5NxMDwMz8bh4fFwMhgf0ZP9cHx6MBgensFt",
+	"tdM0EOsVDrtkde2iGagXc38l5ltiwVcwe1jGfvoNCSVTPjjZSIA/w72BGXcGeczfIhsM9pJpyqPvUTw/",
+	"e9m8i9jMNtjyuQRb+qfS6j7ZueWO4pf1Z1fJFykYV4qD0xs6djk+HE/Gg/1B7/TkYNg7HJ7u9+hhcNqb",
+	"nLKjcTAJhsEBS495GMz+8emYHp9OemfHZ4Pe4dlk0Ds9HBz2jiaHw/H4JDgIgwOkcX4L2SNXJvgX/jes",
+	"Q/rZUnbOM4LY901y7xKRGkFXNmLbCO5CrHXViRuipGMh8R5keLUum/ShZ3BuMK8tVTQQr9vM2XZT32fg",
+	"Dm5HtSXnwoMuSDRlJXtNkvM51whHODxOPSvTRWJMM2jeDTvng/tu/t30VZt0Vnj7k6/smVgdg4mBqeSd",
+	"bnqF2s+uUINSwmt4PcNh8H/jbe++6/VtvPl+197tbeghaE1psMxfy3JtftqS+re+pxV4qNUGWm2g1QZa",
+	"beCPqw0Ucl1KpKD6Lg31rRxs5WArB/+4cvDTdoJQ7cLpUlO0uttGQcTmbxkWK3wn5kEXAtW3dP7ZIZF7",
+	"FsLinwqmQR9cmsyoImPGBPGuEt/EHOiB86bo6iqDV3cY1UBIrFh6xV/tq5gFUoQcmjVRS89l3avXnCgu",
+	"Auajdn/bXdgwTlh6vz5OeYEbNxV/c/6WSE3VdhtiZCq+aCDfInPb9U6SmsFWEZ9zzcIfl+5i7nDfVm/w",
+	"3c4kZqxzfliMkVQd+IbiKnTOj9Zd7U9dI8NBySU/a2R/4LeyX2jlYD9tZsWskLVxfOi3MTwuNJK2cZo2",
+	"MYkkQr7xRb6l4aBggGhKY2avS8WmyAVu/qBSY43ZBUsxQsmIvUWU7p0blRrFmueGgofLQz1LL0yLFoMc",
+	"nRzUdzblHlufkwGsMvU+uJjiIvlZy9txlY2OGgTH41O2T4fhYXB04oWg7y4Te6tU7OqTN5eOvbIY6iFh",
+	"oE+zHJ+2WQ+1WRXJLYzhJSMi1YUHlbnl+niid5iXvWf0NDg+OBn0Dgdw4woPae8spIPeyfHJaTg5HATh",
+	"WViQvU4I3nfzDe9Gptdf39XVqToac2CjvpFZzhdU83HkkifNuhfT/rcQYlKwtxNc+joproY6uvVetrT0",
+	"qVZCrJVRRet62pbDOcVQ9ALMqbcS32lsDKZJP1szw5MnbWe3PAssuHUS94PjX1KQ7exqWbi/WjPIoH9Q",
+	"uJ+eHvQPj/pgITne7zxmiEyeO+tw2w68cx6Xf58xuC3PtTz3gFDcwin3YIvQZiauPBvxBLTGu5ecToVU",
+	"mge795SvdlEFEIDvkTB9kYwTEUZFsfPCDKr3kquFVNwZRgulC5PplCmtCAXMaoawxAC7izYBQA4HMykL",
+	"vR7W3JOydXplcarUE+RzqSzvM2LbZG/lG2iW+Vacr8PJL1dAF7HES4ezgM0loh4HTGjioL0suRUAI75l",
+	"Pm3hKBiMh8F+eMB6h5Mj2jscHwe90/AE0loHdDjeDw7CQ+bV6CsBA2kmq/9AeCGftgYMqZfStYodosrJ",
+	"6TEU+ZaSvgfkmeoDcJV48hkpQurX1pLsk0+pBRqfogXahCtvtEIX6q2PRK4co2+U5kolLOxiA6b2IxZt",
+	"T7AAsiK23mMgF8sdqOF+nu2TnWNPkIb8xJnHNmV9Ne3Yx3TZTiZVQNCcdLodTafqETFoqlCL0rqv0H+/",
+	"U0Rf+bbG8ALwSrU0yMOurE5DPaN5qHUTcWdjfkJmYxoUuHBMuUn0GRdo6ntzn2GddizNDtVhkmjCIwjw",
+	"pWopglkshUxUtOyPxN9lguJsIdO8COvOgwbmUnAtYxR0uUoh8DBXE3qEpQ7uKNd4oYmYH0Oc58EGizCR",
+	"8ZiHIRPb8arztKbNVLhaE2VAw7FeLo0UCSWeIzN6y/LJInBt5RGbMvVYLtcGq8M25crA8RUywW0Vz0TP",
+	"ZGxtI3CecYVbP2YkoIkyL8Fscy/Cxt4w4dYDNj+3IiqQC3ODo4JcXF2mKTi4qKFkSvyQreRICBbAqREv",
+	"vbUk0viE7aEcEycqm9ILKBSxoJHBIkG39cMox3K/+bWceCYpcopZqCCifP6cqeNCkESwLwsWYDXFmCRi",
+	"RuG2HRL8hsgAwyfCPnnv0QglOqZCoQJk3qMiHAl4qpIgYKYyIyUx0/GyT8jlxJAYRwJA7Ykq1iWLiFHF",
+	"XD02rglVqDGAmtV0v4XUP8lEhA/bZCH15wk0szYCxhWCTgVkmluGBWCe845/wNg0INEJFyGh6Ryarjf8",
+	"ysOrWGokngyWaZvlz4mZz867d/5PhCE739uD5ykIGdx9xozGLP48Z3omQ/VZJQsgIYbZCUah9jECPTwz",
+	"JsKF5EJnrcHqywUrNGKmZy55YJ3qdDtsTnnUADD94YtZtoFvF0xcvsRAJz5NLEojimwtSchVIEH79qqQ",
+	"wXO7osbVNuMaLE0jQcnC9UjSdbEV7rlXLl9Lw7PmxoNtUFE8Gowc4AoLYyXClKBT+OWSBFRkY5vZuq/Z",
+	"EBsTXyJc7+yBDA9KklKfzdFYwfSFxZykiFbPVqyXDdgdxmbG9oQCZZF9WcDxXbIH9aJbrplSWNhgm33I",
+	"ow26mLlpJIf9kN32hQpohHx6fjw4HezdiuBzxDXrz/Q8+p8F1bP//r8HP+FcoOza8SGbnI5Zb59hkOrw",
+	"sHd6QE97x8OT/dPj48Pxyclg251otBZVvjp8hyjzUt7E0ag3Gyqwe6vs8Oxk0BsM0UA1yAxUvEGchkNC",
+	"6h/2Z3w6m7N5nw4Hg/5w2h8OpmPfKEbjYMZBACUxfPLl9Pjz8WGn2wkWyU90zqNl57xzKTSLyP8yKchV",
+	"RDUXyZycDo8H78lfrm+WEb1h/2G+UBhrF3J1YwLiAOfs/GsnklMe0OiFAbrb73bmbC5jG/A2lyGLsBOl",
+	"uQg0eX25j+aMxWypvM+GEB0uQpQYF69fdu6zZg72G9hWt9nkDTE7XtBIo9a5QWh+lKCK/d7+/vvh/vng",
+	"8Hx4kNIPPT6cnO0fn/UOjtmgd3gw3O+NT8Nh72g/PDsIj47PxieeHzcZJ/v7g8Pe7bC/f9Q/7gGy1tH+",
+	"Uf/0qD846p0ELDwcHh3WoSZLCGHMbxlsYNqKhVLGMPzOxXAAG/+L/Wd/gLFX6a6/+Xj58vICupPKBfna",
+	"kQo5Rv1gNaNg4og4ZGNORafbuWGxQIqLuEi+oEUo5lTo9H5RjtQFKY8/8x9NbKWSEw02Xmt2wuFkpRc7",
+	"5x27ZPDhLY91QiN7SnfOsz8UcT2V9cvGjIbLBlbe5kRXcRHBZ6aGKqgLY2a0GrwPcrXuHlin00cLZmhp",
+	"/fun9U+PR+wbxLd5x1A9jXPhgDaB4UGkbx4/XSBPcZpaLoiBrSbQUMDgXkCUnLO7GYuZSwD48OuOg4CS",
+	"m94dU7o3bBqbw7ASMxKJUwFsfRqVYnzb2ANYaqVpcPNoBGR3bz0F2Zea04ZSs1/ZcktsNxOy8ysDhu/B",
+	"//346ufLN+Tt1as319e/kKt3lx8v3r8iv776Oz4difHBj9FYvPk3fTGM//G/Nzr816sL+L8ffz66Hc8/",
+	"wI+vxvOz5B9/u3D/9yP85/Ud/Ff/eySC/an+x29/W755/+HLW3jrxQt9++7ox5/4xf8e/9eHn+XV3V7y",
+	"896H4Uv6X/zNMHrzy99/+/fN6d9nV2/Zh7uLi5G4+PVi9u8XH///l8FddP03026TVkeirN2LVy+iv//r",
+	"79MvP/3r1evD32cHKjq5vN4PFz/++/rLzbv3gzfvl2eXf11OOb0YCf37/tkvN69+u/xxEh/9jU73Xv7X",
+	"4fjs/Yc38fHlwW8fBuFs/Pb9F/7q9OjoPYzwl//9mNDf9G0wP5z+439/lCPxj9+GUTD/SV3+/PHm9b8+",
+	"DF+/v5nS/Y9HI4FL/erNy8pteKS7j6GkimMdxnHDlkifVtpvaSNKgcfxDLsF3r7FfFHvQ+B9N3Rzl+yl",
+	"Z03G3P/sKE0j1gP5r4yhyEiDznnncHw0GYT7wSkdspPJwfgsPA4GdJ8dTk7Hw/AgOGIn9GwyGOcOr9th",
+	"f3jQb3C3TFei3HUERmseMGJfI1yA/M/8JhYy/xlF5hyF++wUwOUPxodBD2Bxe2eTY6jAfRoAXu5wsk87",
+	"3dW6Bg9Cuq8t1+vVNPBUhAYiPS35UCdKxr6s/F18HgExz3sDH72ehbf31o3zkkWgRHObmE21ZvMFjOEo",
+	"y9vEYZHQvOmw6M4xrA7+Yi08IQuNNme6IEeDg07XfIvrdUrPxlA9r7dv4EqPxr3j4CTsnbIsGsl98N4o",
+	"H+WrsKBLiJnsnHe+jjo8HHXOR7UaH3W6I1Rr8IuStked+0oEf0NeTdAnPI5ZXxHEM5Clja/W+/gV0xHL",
+	"XOKQqFhWBaIPqymSuUdPnSzuHYgmH1/a7Xzpwfu9WxoDA5hLVHEML9KWVh5dpk3fdzsrZSxWB3+xMmQT",
+	"TLPMpT/2DRMtWKw5U0WhsCMjs42GvwYfSeiP+rXrK8c7tet3pNGqfnGXf2YzSBvNdkOOYSSdsiVEyXv+",
+	"tVLuFpcTa/RyzeaqcdGRTnYFoHFMlyvjuU4Xozgalczn4O42JREKQ/pBWfmwuq1+zZUyOr+4ukxVhVzg",
+	"Bnj9A1t/BCRQP6u0wYVmU1Mm+8YyUO1lQI6790MLywNSpisD4l7wCEbc9TtFZitSBI7O66ucHuQiq018",
+	"/rWqMjG6ZyFuwTnEsBivXGjCMWjFoTD8oFZLm+W3ZIHFNMqmjWHsNtol10jWGTxyI4COSxaha20kWPL5",
+	"64bbn22MXL7M0/XqKtjX+nhkfvkrE1M965wfH3Q7cy7cr0M4SbRmMXz1//5Je/8e9M4+/eWfPfvTf7o/",
+	"/cf//J+ykc+5uDRDGBZZpbC3uIr+VEs3d6Xyesnc4B0yTyLNFxEjry9e7F1eEWo+IX+JqZiy/yALys2e",
+	"Lyh4wGaxTKbWxmLTWQh4gPsj8X65gLt/tMyiW9DvCTvnkiC4ctFMEAWlwJcuE1veOk8spkZ8GbG8uHz5",
+	"zpbWk3elZDCngZ15eQuvL16k81zTUGHhcUT1FnuTaLVfpIPARa4vXlc3t0y+mreuUYjYd9laxkj302ZV",
+	"ZyY2N14tCTMZEVjEMePJ/kj8uCQWW6ZLpIiWZEFB31159YeMcDDeaEJRjmekNxLFLgUWwJgx92GfkA/K",
+	"CgykKJiK+UJ5PZmgukD7hIYiXSaaXL+5eG+Ttgm5cjPGnuESAZuj3CBGIrdRLt4qnQ8wQLdY+Q7bJkpD",
+	"ECE0CeGCr2gws8tL5onSJjAoEfz3hJHLq9tDQ9yo+ApJICmPjLlWIIvXSSmRLpeLPnTjxb5KmaRIL35R",
+	"qDIqEVJjGIyprUk0vWEGMHoRg4F77gcxdMndDNKT8kGPfi37ArPLpKxTPBqS+ZhheVxQpc32misE+OHT",
+	"WKvSQzoNsF6dzSyZU4GIKTipEuRW7KR05VwSwWqraoaU4KSda75LzCdpplZ12+a+UGz5NydHzcwjqnRu",
+	"6sbSgbHrmvWwjcodL1tk0yw87xJbTgxO2RADTQh1W+zfAWxBtG5afuzTJvmJT9PVy3bHTrpMsuYLla3T",
+	"VXOA8d1cptaEx0rXFq5+l2vYxBXuMbcUzWm5DuWXfDaF4VIuMHKRpoV9nuRm4pTK3EXE2gsalCvyZn0N",
+	"X6+7kcDzNXtb1WQZD8Rsu4X08m5LRYx5DPD9cGBoDVLaRG+ZDrR8XOWvaA0qG6T/DqpjTrQWLgvicYdq",
+	"YZEbUQvA9r29ZXHMQwslnUsY/1qeeQmPv9lEC/Rc2CB/+F2PumqQ+VXpHeiCjCNQz8LC7ScXsdgn5NUX",
+	"GuhoSaQwmTouAuDyJRzE+PNIOMTGVMPwwEGKnJEl1pftgnlKXlx92Ht38Tp/BffBE1aoJM2+L2vVDLlh",
+	"Y36htrWJ47mXU/zJTZdOvLAScpkBrIDaxsWMxVzb2w68vogS0CXxnCcqmVQpV3k0gTqZ7m+yL3IYmmUj",
+	"t3q2pxtlyDBamsxAykW5UgSJBS/tqbKCkwWfKTKmih0f9kChC1mYp0LfrwJEZxrAfiGnL1lIQSKaiGAG",
+	"V8IZ5jbMqXYLDacC3AKnENYqsqQJPLt6XHAEHRQhjcOuyaFx4fOmoy6E4L6+fP3KXlxpDDeUYMZvWZcw",
+	"HeS0ofFSs428jQTirbiHh1STnzfd9tLSfTnmVk1VEr/LGpqJL3VXR+eeKO/gdGX681Jn9TStxVG5RoE6",
+	"pO2xQqVeR+9b0PmGTa65s7lTq84O42TdTB+0w+nWbd7pSnt4oXTkk2qYK+bu5lrmrlTLWsbu1eqq2+1d",
+	"lbm7bG419swd3kEFM26rj6XlEoumxVq8UWkzXhn+ugL138tt56F0+HH/ha3ZUb1gzh39Pa2Pm9eu1sfx",
+	"BI2iGih86cem++7XXV36Cjppe/vLhvonubV96m7m0xW5XE3dIG9d/ZzSdTMmVZX5TbR04pJW+NKCColS",
+	"qS6BKd8ltJuPK4ymrqhQWcuXL9WaZs2XYe7k3Gh2bnA/K1ccbZ2j5sM1n+oM9Vywu7Jrd4PplGuddq/S",
+	"pc1G/akm2WzSXnDU+epLjRWYXIdrNJisZm/pmrPJBESAud7n6kI9THlZXY/G2ouFnVgTOVLpoPhmQSJN",
+	"TmN7ENaMLMk+2xxVAg2vDS5ZLcVdI7AkK9/QlFI3KNl2KdaoW7vQquElU3h/G1KsjnhJx1gR2dJAsfFs",
+	"2JCcbOAjiRT9rU27ZtJm8M6WWyukpXo4dQJa0i78g7tbZ50/oOxZt87f38XEsfo2Kneu1EpWg+clizQt",
+	"30BTgxCC6bBgAMlqMBrckjRk0A8VLPp306II63sAEqFzRLUuVB0hNLqjSwTIShRbH5RVHdXoD7FEl3AF",
+	"GLYeJCoY1mSJqXisP+0TmmiJSdlhDVOVjd3KlswbWOMd3SSKV3bUzFKBYz9EOILxEhevvoxeS2BlMjv3",
+	"wQtQ1KIIP6kSke+YwZzIwouyEDTf8WsZGwP6TLMwHzaRMVZ/sUBLZW6RysCBX+QdmVCL0OP0LQMSmms7",
+	"1+dm8eb627y/L1y1nSphS0MuLGRppoCFbMFEyESwXJ1rRJV+j9A0WTh8ZfyBjcaGbyypNIg/qB+Owb4s",
+	"IiqoH46RnYYN4jFAu2DF4Is1LakKivttxvTMxiBla4mHGKRD+nER7+MEJv8TjRT8+0HcCHknSqIj1sVj",
+	"eH0Yk4TddBKziQnB9Lu8DA0yI2hFy063Y11J7tfrAg6c+yvGUppf68Zu2PUpDeIooaMG5Kxq0HO6KtzG",
+	"AXnKhB8ha+C5mLJ0BGXr70Au56KRuCIAt2b0IYxjupstgXVNQsKW8i5jz42S7nVWnq+mwavsc6e8r5i/",
+	"qoNtCwG2XnTmmEVSTB15racIbL+e6aS8NnS5zaSy/HT9+4RXfHqrPXQbU3cHq46pF+XDqtTrx1Lqn7jg",
+	"asbC9SLItTTDol/2MDQoAJkbFR5ObHMeQhGEZo5EvHKEuoRp/A6GYlsGBrHFGb0dG0sZMSrMmsShFHWH",
+	"zBVxH/QJeWF/TLcMAzDZlyBKwPEMgT0jYc5Z1bUGm1ChXxj1KYQ8rxhWLr+r8kBz4/Ki/usfaFnhyWL7",
+	"ljSIe6P0tMknl+38cvGL3/y9X9uyarTujdLR8rD6w4oJpqUwq75z0SulX0d0zKJdLoymU3dl9cCTa9Nt",
+	"IhBb0YEsek30CXntCDgRhYcmnFlIjdcAhP00wFLO/pgIzZ0cXoF0ZiJU5QTulYCoWl77ihdb3a+w6q8k",
+	"Fe6cGq9WO7n3q1VUzgHf2DQFtcWwN0FRWKvxX/mEBcsgYlczqtjKOYiAdylrZTTvSQdPbypZ6oIc+FT3",
+	"VFTVFqSKOqmZlM2OoO3Px2wT15+SVhutGq1vsrJHWGGwwD7GP4eekdWDMxTqDZ2zFAKx2MXLN9dEZC9k",
+	"4OVaZr1YT5XLMGjmw6hrkeuCdV7B3VQ6UHX3MIUgXnXO+JwK4fwVzg5IHzAvuDuTkGFuRhs0OtN4t7ie",
+	"mykSLPMv8DZYfmO2iOJw9uNbBk9XeH4Bd5WhYWgtHnN5iz95lXITYT+vmW2aDevCNpv95V3aQfa311lX",
+	"2R8/ZJ2WzruuM8TN1qC8Vjj10jWsz43e2t93a7oFU6LfjVdwU8OeX3C14Sfw/5WPKuf+o+j8Q9ldppba",
+	"M5zdpubisEuURNEkjZmumcSIGUA2bTVq+BBFlxmzOWV24KQ0NLTOW5kOu55EeBLfZVWvm8+lnXkxM+vp",
+	"w4yjaWh8La/o2uUu90d1c0PdvInl3tKiUmGqTn9XDtPcLBt6TfPf1nOdbl7qcn9lcanT4JMFjemcOd9p",
+	"fuXrJZgXI3RcF48dQeSMT1fNify33KdrPIT5Pmosfk0DTpXhJvB8Fg3NhaveDhydbxTdwvioClfc1zLc",
+	"cM31LKlc5O6hcxkyF4nvKeSmThBCYlu9oevO+W56tOIlF8+r8iusVU2bzTJ/nwDGVbMrD1ttpWTe9S/p",
+	"RfiGLW1Ss8kVTnGd/UXoPybdehJjA1X6n5Wdn0Xq3AC+AMYHsAjVSEmpHseF18h9t+P8mg9u0zUCiqYE",
+	"6isXXnCRM89TpfAHldGlMV/DFZEQzLS2T35QUMIlWpLfAdkSNPyRsM2gjglEr5XJtsY/gErILYaqedFw",
+	"AFZzCNPC1KYmAOTTjATmtaTB4/h2zMDSyUJCpxSMCb49qSBkh6dnZfZBa1W4qrj1fVCmANciokFOU7az",
+	"ABvnlAkHtzD3rHz4huqmqUwxhRUZCZPSBBOlC3S22EvyDSMMVtSkqoOKbPzEJtMf09vhRVBIfeu7N8Wj",
+	"4weeHI9rDkx9MTWqR7oEHHd12dF4MozWv8JUP2LdK0jYQr3zWsdUs+lye0b7kG+n4h7gluJTIwF1kZcu",
+	"K/56oFBVuNLEDFkwERZVDR20wMF4JlHD49MYaHvBYi7DLpgNXNHPkbA8hjqRKaszr3QpmKtbbAZSoq5i",
+	"N1fYyzWD89eqA5hn1zk/Hgy6JXYNFDc0ZSyXbxlIoblIsEaUN70sOoCr3FDmXPA52D+OB6XRIQ33wZPI",
+	"JYAvyhedLh8KeHfMCA3/lWCRFhDsc6otnMuYWlBpOTZxKJARShLNHW5wfyTQoaKY7uas5mn7eFEGUYFB",
+	"MdQMAtxUnEZGZIGaYKQsvBtEdL5A4TMSSAb8lgkyhiozgNdhSFkZa1psq0whHILQXS/cputGYCrll1xT",
+	"6Jd3a1PP5vQL7E2JjSO3c8NS+AkuNjTORZ3GB2WNaxpPmX6xSD5k+5Cj2ZNBWZ0/esti8LkUdhA4LGBC",
+	"wyMvs47QIJZK5UwwdkUAa3qwfgWKtyJvObq5lf+0LY2vMypjjIp9j4QsMHcdPL9tua2MTqrscSWru82C",
+	"grTTMZ9OTYEXM6YqO5uC9XpXMx8yE3ITvKGsaxoW5Bqmu8EliuwI/tB0BXcS5PPbzASzrGwJdAXbUmXx",
+	"vuUyUY0XxErbNStSIM/88pT0vLo5zei27jUzH59RiW+3Y907u0+5JdzKK6Sydp5IPapOHX5TKlZXGSNX",
+	"nLLKcFJAd5pTQcGR4YLKYK+6hE9IGnkRszsaRSnElKsFNxJoxZ2wmInAeELYF1N2L/vInb8mxd5zfhGJ",
+	"V/YcJGOz9PZmNPthRfdchQyIZaSwQFVO41q1AXixJkb7sEAQscOZsLGnTpsAE4NieFfPN20t8IQaN1qf",
+	"kOsknrLsJTzsiZZ3NA6ViXEtPfrxs9yhOejWky5+nK8Di6RjaTURKyfyysdIhElsin/aGaALwSqCc2AL",
+	"nN0YEfYgetXJMBkVtFnPobJeSZjTLx9EelvNzXS4xUyTrC1SnMymwTTTYxu5tbeFVajsfbPzoMyms/WI",
+	"H+aOLzliNg+/PH+71N7sJW8/79yIEqv+g+3yTXZ12w2sTGYyb70yuNl1o0Sb18JbCR0NrLl4XTf47Qt4",
+	"8f6+ThAo2qF4YEqfwhVtChLPgXzTFcg8AoMgpuKJNPFXIZtwYWvu2bW5nJeqmhnKkEWlMXkqFqqy0+1I",
+	"wexSFkJ5Pt13839zYEqdTzCr/DrxtQBHFVFtajsgozXi829wvpWCQvoJ0D/Yg9CuhZdWgy5Bo4nYFhsn",
+	"8PjZMFT7jW/M2ZnEjDVrFH/jgCvKHicP6PeEYjz9eotAxfDKR7Q+tWjtTHeSTZROqSKxyO7Dpw1UVjcS",
+	"GymtsTzELtZIQnyu6lD66jjKYM82Dcu8dWFUHh7B8iF1uFHUnVKpS942U7bimWwtWWqlDaJoucC09DOx",
+	"qhtbkbtgAgS9zRSmNXXnxzEVwYx4oAaYBJHETKVwuQsaK1e83BtSn5A37A77zm43GMSFKEiI2Ypqru3P",
+	"2Ei1jFhMNSiUmB1DbuEyp3IlBqwkcj45Z/btdFcecXFLI+7iOde8YHe9+gWUuWue2zzoysf2ivgZr4hr",
+	"XpzKKGRiY3cLecfizwYcouQ1C6QtxecFE2H52oB++TkTGFVv+CW8iy/YPfrsPEllY/mcQkxV71P2zobl",
+	"Xn2xfN2z99wQLTS6e8/52uh8zKdgjv8Mrq/PLuCp8Faax7D6qGx5/GcpwGLueepD/2xiucvWpuStiklb",
+	"5SVMTIUbFq4+g6EgWD41BQdLHhdnkQIvlowtfVbJPytvFHd05QXn8V/zSszGUurPNuOs8rVKZlt9M7+Q",
+	"xhxgg7+rdi89zd0JCd7Csue2hMTnQIpJxAPtvWMrsvRphEmCn7GQgip5oWTt3SNvtjHTa15I4qg0x5Dd",
+	"8qBOlYfsvi8JfuNQdZ0Y8FIcrEbCMe1wwo3qCMHNwF4jAfyFCXWUzPkXncTomx5LPesSqknEEL1asBW/",
+	"2RqcUucKX3sfK3NwmzxAGrOcXwofZsXaeS4GaiTsfdxFEcDw7GTBWJdBsNrmusYqd8cVqmlpL5tKS6yM",
+	"d2Po1ca4Utvk5cuNCkr6ZqqdrOghzhz5LolKSSdn40xh8zEydUMwSshjFlT7VNLHflUCHdMJqCZauniD",
+	"GTM957NhucB0bPiL+eFTKdZFXIE3D0/SohBIxkrT2IaCLmysxLRCzYfnr2lFgD4TYbGVLuECqI7fZtUM",
+	"8D8LxJHnkzxPlHRoCxesNVNASQH3ojc1rsmcg6URVDaxNAkEMoZ/j4El8DshdWMUJNxtLYOqBFT3NFd7",
+	"w22fDhadbicJF5uTkjMq8nq0e+stzacNpF0FClSXvLvGhsG1ykRiCYRQlbUg3w2IUW1TNUMW81ubBplR",
+	"O9eKRROiJLxibgMjQZUNRlDZi+a8KTdC1LCn5bi/NOup0obmf7qWNHNzVxtESK2rZH7Uq5SZG1rVzj/N",
+	"8KrsfiXXztowbyhfTAO5suCplafEkZh3D9TsRc/Y2n6yGDo2EmV2qD4hqZfHJkV1iZDmIYn4HPgpi1ev",
+	"MB7VgS+vSi+FLlj4Y8XqmnGgvQgn6EbkbQsKaCqWm51waxGlzUO1fsM9ojSz8gbCG6S6l9s0iiS4Arle",
+	"URUL3yPOpFl2zpuS5ztMy5TqpWn03iuOXraBWZ0ptVSazYl9u5QYbteVilttybxtrcubt98uQ9ZNGRk4",
+	"9lqDFVtEJv2uQGPz89vaP1PSTG3fiPu2RYxtEWMfHzG2urbFKjXbpIbXfBpvriQEmatY+yOjN0KFiQzx",
+	"sn23Im7XvInPTNu3Sqm7qdsLs6Jzl1H0uFSUi9Ap0Sivc8E5Krsjplndd26GXRtx4YzZbpI/qLTS5UhI",
+	"gTE7xWYpBpTJRZZ/aZtU0hoa7ALZPATX90hkhesuJyawxd3rTMCsV2Qz3yU2aC1IWcMjUZhVn5ALQdh8",
+	"oZdGUTAJ1KhBFdvcUO7Sf7dKd1qfULq2Hk5JvZMaR51XNHBTeYrquoc1aioWv1oLUeESqGSMW5qTYTQD",
+	"rijPfVol6XXDy4eorbHZuAW7At/BNUSNlRpv8LHKczmMGk0UE0JN5BVW3Ouix9hWUScy0YqHaK2w20dm",
+	"MomVK26obJeYQpKWciFHZMJZFJIglgJQuWB5jfvorbCmHB+5z7UClReNIYin1y+TeO1JP2uOnFORYLg6",
+	"2msMxIrScrEwBr4x03eMldALvl4VDisJOmGKCwWtpHXlOwNySv6T/CcZ9o5KhZeWi2btTybFDoZre4B9",
+	"+ocUVfnkF28ucCvJv6VgNgY32yUGzje8snHRdaWFuJFfH96/yI/kVQJrt/dXKUIpVodSmyJrBG5bCrAL",
+	"ZMnAv4KL3NG6Cmp4scbCaJszqfU0pS3fEGXowm7fp9KEJNfHhoBq2xn0k06rbkB1SZDyhbN5FQawTtpu",
+	"Ar6uXsnnnMhd0OdrpnCnX+0A9zptS9CFmknd4PKm7Cff+PJWNfs6s72SEQ/KUm7t88IB458qGBHN6hwX",
+	"I9HgvEhX1QUha8oFnBngcY+JTLE8bAhtPtMJnVz2FHFRvfkGE0ERGaLMkBYzzUS1yMkMaWWj1ZLcMLbI",
+	"SduTTQlGqvJ8d6dLSmT+RhQPl308W/7zuZ8subA8N/Out+z1SbbB8ZOtIBQ8dlUX1x48rq/LDfGBKbSt",
+	"fb8CBS9rcBM4rxsqnDQ43AecMt4kSgaxdqmrsPc3njXg+Fc6pourWE54tAEXiAprmZNxBumVNkEWpg0T",
+	"M/fz1QcSgjclRh03MJUOwdcbJwIpGEdloRg8uMyYCYODnaIHo7GThXYXoTVnkRUhNpfmHoFTLVEsxqKH",
+	"/TUAks+6/OZDS1kuijeROk3kry8g6laOnFpHfP6rtvpltWcio5q1vF0JyU5Dk+BrEcL9daJjmWhCawiA",
+	"mkYqWjRTrAUu3gUJeoiV+GdN9caGdoI2uRbn01hQixif6bGShruvLkilTQObLEJu1mixFupQ033bBdNX",
+	"aPmlBUXWUf6aOiJFzf47KiiSv0E9wB2z0ZdeXKX6LsvcHbbEWZklhgJq5ZWDxiobzK/pqybeiry2Bm1K",
+	"MIwOEWascmCQ4aMlidAAEVCFNUBiGmgWq67V5xWRMZktFzMmVNeGCoHgZiJNbUk/glfNV0a4j/FKhPeY",
+	"4wOvbcIFidBy/rhWddia68pAKCwAAkO5m3FwdElnWPZST52tJFfoLCy7Mq/D6fyrHccb04r79UXamvuL",
+	"g8jNAt3WlMO4KACuZ1UUuhaaB3b4rggE7pe2wC/ZA0tmpN0+etUM77GT2GnnDQtnICrQapJBt1hLY337",
+	"dcppZMvDFdFxwrpkQiNlsg5NXPcaF0QZ4WYtwjubvfO7q21RJMpa0arpcOtLxGI/ayJBX3I6FVJpHpQO",
+	"Jkwfk3EiwsilyqWRrVQpNh9HfhRaVmzHLJnxYJkDdSRAR+ABS69ZsYwiFhN2CzMoSyyLIlbHiGqHhzj9",
+	"5psmTFQfUm91D83nSkbsbaIXSYW49M1R9nUi8f1s5VYrX2QjxASdsj0SS3NtsGLXhlvJJAotCF+2HuZY",
+	"uZstm0Vrmr2pWXvslXm5PmjWBvBwF4dcoTnaxz4JmJfGrCK0MQ/mUMdZnIf5p2TOrKWu2TIa7XintwLz",
+	"j1F8ispZum7dIhOlO1qyGmvE1iubKrDOOOaKXRn7gVs1l2RQL+h1Q9GI6vMuNBxJIxIyTXmUHd5uAAZ6",
+	"Iy3gU/tAep/BR5kz352f/sycspNlW3mJG/ijSaTE7jdHL/NwvWuhsCs1UAaK22HlM2t8sBQoYV2ewaaM",
+	"UC8Xwk/GqEi9KENCqNLqLl8+rnY85+LSjGFYOf+62JxuzBXQnCb2wEYdXFEe1w1X8D5xUIZ/OhtqyNVG",
+	"BNlbGSVz5sd/NwnUVutNtT/5YcYb8nW4AyyocWIacAPPWpPhhW9qoeSLx0BKKmnpKma9ymAmL2wyq7zR",
+	"JVwQamU7viIcSG/MRqIItFQCrFQReIXRaS7A0IBFGhWVqiLcb/1Dvtp+/ME+IcGODcmbbbrZsN7blNgq",
+	"RRrvVyk/oZPuy4IizisF1ednmYLY4oozD0YXYsxcrsBIYDT1OLKIRH2r3kH6ivv5DeYB9kFG2h/tyW9/",
+	"++lvL98Yhu9b/GbrOMDEIxQ5ozTxL9ARUUz33O/k61fbwv39qFMWYrVi8Eth3Io273XH7zvErqrMnvSi",
+	"Mm19WC8xwteRqjAINvh+tLToWTktVcuNoqZJAoKXhPwb5iBX2fJW61M9qXFzmxCRlbltbeksXaXN6k9x",
+	"xZooY2XbUqqMlc2xZFQGRhfGVVHF7MJPz0XytZZ7GRcThUdiNW0Xo1sNIrT7kKvseTcP5caFg6uwYhmO",
+	"+8roCibCCqHmr7ERaNiExbR1VX4bGNzczUqtvZKWlHprWgOmyvpWYsgr9LKd/6V0wI8Ytb0mstLftDGb",
+	"cqHqblAxEsSG6QF91GLbSlm+yqsrAGjfhU9ndyIPris/I1YJ6qJ1TkGsFFRMj0yxHg2mwLr4GfekipL9",
+	"nAc/buaJEkS84VUtGPqNs4obFm6xs6AxjSIWdcqAxHM57SmuS5+QK/uV/aOpU+fhXAprtYiWXfDTgGIG",
+	"Blf0XXlf+GgLoFiboC0so6rlQsHfrG6ttMP+yZk8ssHb5huUYEsX5CprJff3d65JfwXfMYULV+qaSnQg",
+	"7QXWxgeni2bqqykuphGrVr6eyC6VjWqDYWqbas2rtsqtHDDZGDEQOgjYQmeRKVkJDacydO08TM1sqkZC",
+	"3XCMsg8Tm4pEGI0jzmJHSilYKMkTZ8Gw5vrOjGjdjm27KbldZE2lf/vJtZn+5do13tQ2V6DStVa5BYt7",
+	"mf2nQKuGkOvrhIWOS7EX3CuV4ro4ikz6yBUNbSOUB10TZV/S0YLFcMhXBtpjtTkpdfMNtwZLbGrlz3Kx",
+	"+td3tqN7LDLJai/9a4d66VOMXYYqYvm4X7YRG5a2DYd8juGQ9etVEnKZQiZg/UYuZizm2uRS4uuLKFFp",
+	"ER5TfOdRgjDjmvD5PB2wVwWi3BbYRlKuB1rv1o2tNPJhkyVhS5Br03jVOfFxv9qrtEE4Pbhua2OCBFsB",
+	"mpZrwJ7WQb33F6DR6jf3q+XWumwvSi38K+d2FpeZvkcU05qLqSqzmIB9tkTsvcIHpc3VMKS6ZsuW1Jzd",
+	"75eLwuVHyYnulAHOQwsGjxc+zGkF5pMZjesqf+/Szq/Nt9kffsFWcIDmTv2eTitIT9OpcqavDNG3mOlj",
+	"nnysQg25gLOY/p6wFCrE8oMH7Wu7umOxia8iVHtlIkBWuVN8JPBCsaAa9LKp+U5LMk1onFWXyy6DxNTM",
+	"XtnRjPs0nW6k2W0qqRVIBbvprixXOeVkG3MFE12DVajpNDdF9ALBmmB+PtO+wkFjZnPhwTx5bfBbYFwj",
+	"YVdZMI53IfhQyDh9u2TX4UG1zFN2bMpY7eFlgMelcye8QokROyjDcGB8KmS8Reno9cR3OckKcSHNwDmZ",
+	"1unjLCS8QIxwOTWxREwQV3CdKG5q1HOVUjHcEF2935LIG/1INLVCLWXp8Guk9wqowe4xELqdBnOjiZ7J",
+	"2OKMXGOMT/kU/monkPvAhuGrDHhrGlOhC8VMfelVNVNR2vAPJk3ZGoicw23XazBmNGbxa6ZnsuSI+hGf",
+	"Ei1v0GtJhULQxLl5PTslZoyGLO50O2MZLhHnnMXL0mztLYdWRVpWFI3XjVMRlSzg90w9XcRSm/sSE+FC",
+	"cqFz+7Mj3smt7cO2ibnqE/kF+NmHFSfWNNbFGxTVHLQLDH6XQF/7JapJeasXRLNYMduq2TvroOYYjY9r",
+	"+Mv791f2FbhX9AlWyLDFc1ypQnjx7UWiZ2S/P9hPgc2pif8eJ0YAp85vHC2MMeZM03iZxYyHTOFl9+Lq",
+	"UtnqS7Y4pVSe7ws2OOsvj0fqcI3RSN5xgYJ2absdw7efQyYMBnEepzmFFTY0hYjFNvqnAzuZktjnOQs5",
+	"dbjJtrfPDGH/P2spP0c0xmDmRCxiCV2CHvc5kEIzoc11Z8zDkIlS/sHRfs7tV3H7PrJ4DItiycEFajqk",
+	"HWyhXIzENGCfy2yyH7DeLMEXPHTN1Pzg+WPW387cYq9Oo0wbeWhZshLKRiLwE1Cwni1B5Psu6MG22CbW",
+	"eZjIrMaX1S08lMWR4CJkX7LoObgMA+X3O3lfx6B3dtH7B+39+9Nf/uc8+633uf/p66B7PLz33qjw4TVY",
+	"CfiVh1dOwjlgh9XFeLtg4vIloXoG+xn4Zw8JuQrgSr/cCPPjn1w2UHaXMrTqjIYQOxSvn62Q/5xy4CNJ",
+	"cNdtXLmg73Mni3uvwTmOoFaPMxNsuvR2kM6nW7GZJeNas/gP5GMf+m0NMslj1GGqiG8pwhM2Rv3z5GVO",
+	"3V+bdbkeEa8G8p2bAXHNwNGYG5eFUEvHAzcK1W+4X5tBZB5jq2pSyerm1cRB3MWWZV1tu1tuNDvZKPf1",
+	"L1gGe11ygSmUnQXg500wTp+ySWGdbse8v0TD0jSmIQvdAf/QG8BK6MWqs3hl3TBxKopAUSysmMnKiblm",
+	"JVa6tRrVe58GvEcWjlEujN85WrqCaiay1ZT/B5V2LmNTj5x90WvdGY9cpPWJDE44m0/b7fWVw+7bUFd6",
+	"4b1Xn1azpBH/e/9XpN6QFR7vlJwfXTzCcvDg3Wrg0tcVqo9YddIfLDN6IXMyEIxPXuXTesF8s4LU2fGR",
+	"nRNq9/nNfbROSyi15AwovlJYi23PBgy9f9CBkGmE1XaVt5cvX5jjR6W5AAVR66uMDWP4G4yVzW9ZBQL6",
+	"nArNg9Q2au9iQJbkdtjf7x/0RwLSIWIGMbXMHAMWhNxYK4TUJA3gyoxFhWvc7WgU/tdo1Pf+eehVrYJP",
+	"H1O5XSMMLGxAFRI/xgzczWQKyVY0b66shMNFbypdbAf1pUtVTY/EmC3SxqtCyqypfePMXcXrjTN3LW6Y",
+	"Oc3P2za/ZQQuBkvllryGbDF+LidguMqZPCzP/ytR1ltiXPihFD9oJwVGAtw7ucMY3vF0yEQZQ9+YCTbh",
+	"aUErFxYAJclHIh2CmXh/JDoPu0dqWgpojD4ruljgOOMx1zFYGa1pR7oCiC6baUZvGRHSmBdpROaMCgSm",
+	"RsknliTlSZQj8P8Y8xta4ZgoBrKaiRB+jLELGoZpmhWNRsJqhfgoXfk82K+WJKCaTUHOMsJ13SiAC8cA",
+	"MOtKo8NtuakMiBQfOZ+pptPaZe5Nm58evIWbPEqgzz6G5V7TGifWhqRxDGPRLNBJXFbj++oD8d/w1dUv",
+	"p8efjw873Q6FN44Pa+idG8ayATjhRQ4ooQQcAm3TatOHm8kjbWkzadSb0bUBJC3HgzJjU+YV4K2FFKok",
+	"jiCJK4J+P7z7K/Kl9ejNWLHRzTPOFwTccrJZndziJM2TJ8mDqLxU1MqG2GK+W+dLbNtXg/UtMvfOpp5r",
+	"GIzcNGYw52h99LgZpzvAKQlZaMqTloCdeOj3wSL5ic55VFrpaBIzq0eDsJrge7mcKIxhncuQRRmeVkGk",
+	"reqEi2RjsNmLqw8Vic8uyXxd7W62AGN7DGkAXN0QLsjPP5a3Nl0kO9276SJxENhzNpfxctNQzVs4RP5j",
+	"jXA6XLy0cbsc3Twx7ogh1ObaV9uevLX6f/DxO10kECFeigsBcdc+3fY7Dz1gXW+bFJZiz4+0hunkd7CK",
+	"5aIRJpLz5pfAs8kpOFNfALVXYDybNzzW//nqQ1rcLWKEKqIYSy/1b6/LGbmK23C1N/GYSTtYTyflyUKz",
+	"pdowQfdKcYZ/CWgcqv/IZlo+sFsmQhnvmjI+mlaLwsV25pbDEzP5iXbzG/tgeZONqHQJYQ/M0HwV+c3H",
+	"y5eXF51u5+L1y4erxykcyEpgFj75o6lXpqxgo2INW7S/g7IOzXv9eZGs7qMjI5tqwycuraYsvNS8tLER",
+	"a27MqsQaGk1lYpVZiEWPI+lddMK3ERl20Xazh2+vKyK5C+UfvTfKAA1DVmUVyRRbeMu46VCXvaOxXu6N",
+	"uRQVG/jIhTQnqS6+w+atgg8IvSwWLNpx87+aRteVAfVX3L5k1jtk6kbLxd4aQOvKiqAf8xH9K9RhgWv2",
+	"D/uDw1GnpO0CLdvFSTehW69c6JaCt8FZ82RXzV1fh1KBDKUyH+GEeXsNLSv+b/Yz/7EkNMDUbDG3QHgr",
+	"c1zZ5Dad5h2u0w6VnOg7GrtA/91OZKVxIHke64RG1qe2+3X7mG+/yAhuQVcGgru469tmqiuwNXmh6gdF",
+	"IofIn6FBryJBGvcH/oilGtfhQG470Cr7Bb6QovGWFrjbdbGCbO1K4Fj0rnbn4wo9Fu1QVKcZZD5QrOUt",
+	"tEn5+5XSlYkkTC1c3Q4Vyx3t1Fr7hXkj82gX4+VRp1tEVLsc+d3f0LlDFXzQ9byiXEX5ZTtloAW8VFJC",
+	"ye3PVcpP7xJhA2Agd3/h/bgTllrcHlrozNID8fLq9tDVvsg5ReHDB5tsbC73Sx6zNdgJoXucpg4mEcvn",
+	"FSASL/zF/PBpRwODCG4ZVOGRRHTJYnLwX2RhX7OoEfLOHxzwU7fDg/kCliuA/yYh/Pc2jhcPH2mqu5ZC",
+	"mkOj4wTXzjkf3bhiGdzAyJJxInSyi4GsMWPjE9i+oo6oXKJnFvYfsglCbpikveAG0ZKMS9ofPgtn1GTS",
+	"jjkVuxj/r6luXhy/UUxTEH03hoiL5MvDezaPf2JUJzFTa0KBJvYVD2UeE2Rtdiw6qSNeji7vDEgWDEGt",
+	"S5aE27Qwzgsrob0ObWyO8gxrtkmDcyEFAxAHAGUfeyGC1h1vkUYduIGtfcjnmJxu4IFYDAfWSJT1Cakd",
+	"PTypPNBUCHbI1Zz2e4UBEZoN9uNfL94gqsFIlLhjirFjxUV78GluHlehSmYFxJ81kuQWM34aR6LX1yp5",
+	"r5ThyghsdcUnHjfueClSRvdKd+y4C4QdqCjukc5sR6v9vrL6iHnu4WWtCFBoUGkagActi5felURdq3/a",
+	"Vx5Hs/S4/KHqZS6/GyC4y/GTConaoCH9oIq5njZS3oLhUU3eXl86LQalKB1Dpv5IAHbpnGsXZ7eI2YR/",
+	"cWVLUXYP+vi/vYEx8qDW4zANl3cgw0vMur6at7O1XtEhEc+kDG3zSsbaeYHgLDIzP0zVN9UFu7SQ2stl",
+	"nQNqACxWFBFoVVmERVOK9vjo6OBoU2la+Ow1/bI6njn9koB1ZLFpXLDgXARREmK8IhVTtsUwcBN3e4Hy",
+	"Lg/YQ6Yt73p7U028KNUyovIG8GDRVsJ1G8K0SxhQpXByjyNlykTDTsXNx/3qIt7F+X4HaLAPX4in0WKq",
+	"u94yKr1Zf9Yr+fUpCpIWlnJd/clP3Q00WDzv+p0db0SV1p4fxpOwwOaUzyfgiUd0Xxd7empHdtlMz79u",
+	"QYB5SsBT4bHPgPIMc+x55+vykBrIm9jmkSogP0J53m0L6j5k6auL8P7JTuZvcCar6rOgHGdLPYECaMf0",
+	"cAUQ/rEGr3IeSbGxLHXvpIzX9oO8qCwAVpaTZj/yCzClUyJa1knx2gExrR1+CV3BOxaeNWLk9cWLPa8k",
+	"+V/wRvgfZAHrDBNbUEyViGUytY40JywXMi6RBAEPK6KtsP6Q79UoKxFS6T+CFl5fvEgHuqahwirjiB57",
+	"nTcFClsqToeP6/tYnLyeInbK1Zvm7ez5TzBVQ0FfsuqCa0sNbtHPVQ042ZxMq4KCrQkomyaESPc9mGzS",
+	"Rh8IKrttxdRSLcHFe/1hLyvwz6PeUbCDp7+aYLf+0b/NpbQqX7sO4uejHYm5WTWDMn1UaZVf7d0I4+o7",
+	"pZVEG+6StSoE2ICJNcGd9SoCbGhEeE7lxzkonErXvADobg6NItLuo1OZm/CzrLJZVVIxIyePJnYlGyrh",
+	"+TOOqQj7W7iIrJ1tWEXQFzpBsH7n5VUVZBI+Jp76vpm9HNFXNJlpLDVbvH/wjpTE42aACle5xd9Vbo6B",
+	"n7kvWoGxHBtZxCzNJ0lhaNy/7izegUFYzX5ly9JAuevrX8gNW5YQn9nx0u9g++BDRxW2gU2gdmmDZaxl",
+	"Z12u9/1oatKJkGTVbTKxkFWyiW95Gdo/XXB/ywuLcHXpltyL68SVa4jrjuDs67R174VqUI1JZUDV24Ut",
+	"UuYFVNnhWu272XhjZqKNygdr0WVASscyIu5logsTAfgZqBlo4FmaZWIUF8W+uJmY/KXO2vem5K1jN7f/",
+	"pbRniouXYf7iE698Ugb2hHZigwkMBPjxtYWu9jKc81QI0fGrfbxMcwxq53JjQ2XzuGPjmZQ3L1nEAYC3",
+	"lOHZLRPaEE6A0W6mbAAJzUdleW1UazZflIF4QOXDOVb75nOmXBtLpAn7FQvLZtStAhD/bWZg1iOqtGti",
+	"Xdk9nM7lehAnM+UKBCd8+L5GPJNd3Ffp+3DC0SXUlCnv3XQLsPZdoiTh2lUF4ULZAreImLSAwIny2elS",
+	"ECgsv5pf6zEVoRRbA0C5VfSXw/bezba/m2Jwu3nXIMJN1yGzt246nKkumUulScwCWD4saFn7jlRkgBKh",
+	"t7KNpXvnchKyyHdcGD8IzBWfz8M/ur+yW47hHX1XvTjspHWJ+wbiqu9hnqax9BYesWbBnN+8ydia7Ve5",
+	"4ZS88MqO7IU3MP81W0TT4CK+zIbov+Oqor10o80WtspwYx8/ieWmNsZvLfONHXkzk4z7aAdmFm9hNxa5",
+	"Mq+qpgxT5YLxp14mhmKDTH4348HMcIiBRVxzmJi31klMGARe8GwrWXgb0xjQVtVRkxnnxHhx2mtRnbze",
+	"F1KZMuV9Ql5RtwRYMZ1PhStKAceZ7fUHqLXKgtgV9/nf3gtTsbB3zacC9RViCqJkt+NRR83o/tHxf486",
+	"ZCKtbX+8NIHmM/aFuHvzL68vXvSuf7nYPzp2Vyk4fPqEvAVVBYpuXOPYHEpl10S+YzsgcjEMHRY6ZkpG",
+	"UEYPzycTqtY1MHORlIsxDW66JOLipgeGhojIeCTcXaAs6SaJeR4Qc6b1Qp3v7W2NgZVnr/Kyu27Js6Oy",
+	"6qJtzqCX6RHU8JiprnJkX6ys42qfP8Mq5Uik5Qt7w2yNCy2Rzi1H5GqM2mxZKYiDlTT5DpAgIRj4LGOm",
+	"k9hqLH6t7+PVgw+Bt4GGO+c6Ttg2Yruxn90znF1Dg2b1TTUcKBKT1b0ou6e5crm2T5UVlsnXG6K5lpBn",
+	"Inm3Wh3jha1um/vjB5BSHcdKBndeL/viRvVZAkTTu2NKH/aFCmjEQBHZM+Pfu93fy7WU1mnonH8FMoax",
+	"Pah1bCHHEviocw9/gqt7hdvWlm+9Nhd5BGK3QfjK3e7dpRcEoVpFDwWFjaDGBuXhBJ2yOTOIWa5xl8qD",
+	"CjfXEUMwwpWOvWvleWfYHx70B8AYlnc6552D/qB/YMTaDHdsr3/HoqiHeOF7ppRKL63p0auu/XEJepiB",
+	"fkfQ5NWKXjCktKwKjHtaxpvvEKkewY6gmfQDssBcWlOXYIkLVVaMDNpNCz3DjarzM9O/sSj6FSb0tqI0",
+	"TLfjwBFxDfYHgyq+TN/be3hFmne2LSSxL72ZKXqE0gF+F7LnmLdnWXButA6UH/fdzh5d8L3b4Z4jhr2v",
+	"9qfLl/d7LkVr76sruXK/N5ZST7jgasbWFJ6Ht+BGJ2OTK2hJ1rcfGFvfeJnV6MbSkFnV25HASvO2L3N1",
+	"VL6kMJ9TolKVYcoEi90DPUuNNhHWg6ZZySsqUkRK4FADUx3TOYM1qIzOzV7ZS1fpyv0NQ243fOWWsdFH",
+	"6fS8rz51OwupSmk/kHFozRrpUhJ/JQnGx3mYhnliv5JKXyz4x6G9JqkXbqp2c9UvdhY/+qSwQv/7O6V/",
+	"V1A/I/hu57AOj9lK2D/S8J1RJfItHOx0lGndsXwnhzvtREj9k0xEbimOdixuuNAsFjQy1aKwKt0aUeML",
+	"Ev/Kqfa++r+CSHFypgQH1zzJZEWVeMdKknD9cW3h7cLC2/n9lQpyJO23/iDf5oboqL6xRJhwFoUqz6PN",
+	"jwRLrm4Uuyb34U6pJBHuAGVhy1Y7YCt3WCO9levY//x0/2mF/5rSap4rG51GzRDAr1nEAi1jny3qCwsb",
+	"eKT2vtqfmkuQJ1uXdIR1TmmTEqQIJYLdOTm25iheI6+u7Bpduf49AWYvzz9CQdlKMnavcJAeOK4XORlk",
+	"5Ygt29fwhA8KTbXSrFKandVfTVtJ9XuUVDtifv+akhZhKnMq4t8JreYx88bWXJYqx9+rAtxqBH9QjWBL",
+	"7fpnphH7Xht35C1nd86IXslDNdTqbRiosbr8Ekfd0ner8T62ZtfdykAE+mBZ7RiTC5idUv6FVqEGzcL0",
+	"mTHmlmmLyW64sNnC8slrgNgo3nu/teLZnqzfm+Q5HNa/T1zFLJDCxKv+hAdVqwsbkz0N5UJ/B1fj7QVo",
+	"6YX6xxjdOQ7MBSvtOccDixVJRJiKTucHyy4FBsTOvWtB6BB4zlN7snxZ48FFZCET0yDjG4gCIwspox+U",
+	"y92AlwwetfV63PEoGgmDlcexTCZ4DEmyWG0lBcVLRwUfQ8ycptMp5DQqMmdY/YTIiQldgO+c3yOLxYKC",
+	"QhM8UzA2Rc/YEkM2zFqAn/CGYR0+qWc7t0Gkx8oFkuUWBwPSM4ZDt4dBq4b+KUR4QEVQBmP6R5fhL3De",
+	"BZHrh8CakKA+IW8kmSQxenNT5zFiUNuquhCGFTOMxe+C3IsY0TOpmIuYQFxzc0jgdzHTlEMIjjkInKZt",
+	"sU+MG1uNxAwg8qhJsTBjATmLiMX4rZlAZIIJIqo0nDua56ZEMJn/i/YL1T6GwDVjaY1SrbT8g0vLqjDa",
+	"Zu5kK2FyUVSm5TSpyvbZJSoJZqaqmtHMxgzetrKnm0oeCGK0QexGmwMlCwIskxgDYV5l4bNO/tjCkxGf",
+	"cwyn5XP2SMY20/l2JjcXPQ9/bxm/Zfw/rLXuccQVD/6E9/PUD2cv6KnaZivZ+zdxe1MmKxflUN7hjXwk",
+	"cndlZZW7LI6QxYwsaAw9PZZ+hclD21xoXT5Ue6FtJfWfRkUzJP8QLe0dXsKcbWvqwyT4V0TXlcsbMbGu",
+	"Cxb3XFGlMVVcPZpW5Sa6jWJlR5g20nJsy7GtbtVAzjgF4KGXQRQqTpngmNYF6eW+zlEIiYAEwZDFJu8A",
+	"n8PGERfu3x8JFzvvUt8nPNL+B11nbYKbok2Lfhwh5UbSWMOEYf4tYfGy0e7bhTQJis0/N0tR/vUOAqzd",
+	"YrSytpW1razdQtbufbU/4ZtSKBkxmejSMJdGMWg2ewvaI6ZBax1ziUuEIPyHyQTnYtotTYcnieJiOhKG",
+	"EnrXTGhreesTciHIqGMaH3XM5y7dHEx6OAQDc1McCldEMaHBmztnIaeaRcuuEfp0Srnwm0F8HIjzjoyj",
+	"QmVOWEjo1Uz0CbnWMaNzHPxIBJFUkDQPzWkf3NRptZrPYZUJi+hCuRJqtnQcNsy+WGAVLQnGSggW6Ec+",
+	"UF47QniRI4PthDS28BZbaGVzK5v/qLK5tv7U8KsIUQQafWIE6bc8NxRT6oFmApNY4/JqrMy27RbOj6cV",
+	"huncHp4nvgHj0M762nTYCs9WeLbC81upw3FYBovyB3H2bLn8leE/uFqZgHZB8L5zyLzDQveOUXZnDGoV",
+	"0+AGvUkjYUJrjCnFOONDqyLD2wZAywbVT2TsOZe6JBERUwrUZ+t6Ggk0KdvYIK4c3ko2TC0NlOEtU5pP",
+	"Mf7IhRwxEjODR+biM0cimFExZeqx/FIl5w8SYetlao+bP7aXqVQEh0zTYNaK4Hoi+B2by1vmyba8dx4l",
+	"MpgdMKwJTBtcd8mMihB+lneCxWrGF0YUa2lc9Ymq69YvWNg9KzxCuzqH/ki8zwe4kxiHrfwvflDpoG2Y",
+	"PAxM06nKh8NzNOUIORJQHi5NEXCRoK7/mM0RgpAXMwKQh4gDBUOMIpyk0iZcfyRsCBih0EGGg/g4Of/V",
+	"x8BLwwjtMdAeA8/mGLAIZWOMnXnic4HTqZBK80C1h0Nd/TwCpRmSw9PFI+NEhBHLW1YgQpZrOnZ/xyp9",
+	"aE+XDnKVaB7cMK36I2GbxQonEEurNGGTiYx1FwNmQ6ppCUh7YL5iAKRpA/RZaMXzSJhR/aAIA1JVxEd+",
+	"gwhcZ9z3wEWfRgh7VPeACBGvmVbQtoL2OenbMxqHMQP0x1as1hOrv9AYrRRS6nW2j6cSUb9kG9jqiq0I",
+	"a3XF+z1Tn4sv1uNMYSlir1KouzvHicAogFQ5itmUxmFkA1i5Vi5tPP10JLLSpWQhIx4s7X1U3rI45qGt",
+	"FWSKA2B9YCc3INbAAYwj8DGNQxaOBJ/k7tNGaYpoYPIWV6yqARVW0ZrLkE94WZ7iroCzVkTQlVvvVgC1",
+	"Auj7QtRq1ZkLvSIItfwji8FH0sNaIdgKwVYL87SwmJWXDWzFcKmxDr3MKOyyStJrXeumiJVGqxjEFzlT",
+	"nSJQiSHnjLFIRErLxQKS283W2LKkTGnqjHEoWrsGWeiOKwbuFgOCNGbEpcmnLhEI2TKDfTIp+84Q1RZp",
+	"nHYxTANtLmcrrP/MVj8lJ7q1+jWRz9dyop+R1e8628BWhLUirNU37/dQjWnFWU1xBotFqFMJn4FAw91r",
+	"ZVkry1pZBrJMLlpRVleUycWqvfJbSjLZGgFbQdYKMvhjItqcmibC7INdrzV3zK4tQG3CucGTImQ8p5EH",
+	"lt4fiQuxJAtmAr1deo2MrQ0vzNDJjUPm6dwkboKthGwl5B/e8gajFVQEbG6roFdFo7ynN341GZlYzMW0",
+	"AfBnsuacb3PXNnP77mJD8nNuubzl8jYi5FuBuF4l2pMqXGi5IlPQkQjJr1ysPCNCpqEVI+Hh9XcznGwP",
+	"O9t6FW3ibqKlCihG7nNFVKJAJsHTmbxjt1DePZ+YZdHUAik0F4kJERmzx0bdb+VVK6/+XFoJAjDvfYV/",
+	"3tA5u8fJU83HEesZZ/5D4RhdPSVlq3eA3Ej7yKIHbPSYw+vCwktdQuNgxjULdBKz7kiEXN2gPPn56gPI",
+	"BqVj4NjHwoO9gsW5skvzIh30T3ZdHh0Kxi5cKx5a8fDnxYBxoumxIWDWSUKURg8XhKaZRnLQiIBnKggv",
+	"zbI8uhw069aKwVYMtmLwycXghMfsjkZRnEQ7EIEY0WpbJNiks0KZPIMchshTSLOfctPbRpS56byDFloh",
+	"1QqpVkjVyjQKQ0VoXhjUkgG7MfVsEAINw8l9GWAwTKtjyofNREorUb5tWfPBWf2iBFJMIh7o1rhUR5fY",
+	"++qT+eXL+3UusXcWI6woMGyW9gaRsSt/VrXQ+Ck3ldZw3GobraPrWeojmz/KS6Unv29NZRQyYUxOf+I4",
+	"qSaq5LWgCzXDVJxRx6zfqEO4UBqdl2AnS1QK6JtEBvsSFtgin+WPD4NQmX4+T5Q2EMHYgqJzRuxKYNM2",
+	"39LUBfEyMl/nfKVCalPJI+ARC73C4soNHrPZaZjWIYmzHEssguKZBx1GMlE6pppNl+CCnVA7My2JFIxQ",
+	"WA/N54zwCRHS5Msrpp9Eo/4ZdwENhNvo0zBNr4k2QbM9V/+sOvNC3rG4PQhY7TymLqYx2fBWKbWt/5eC",
+	"hogVgZ/JapS6jOsZRKQYIclCIgV8BWOKIhZ1jbNmDFTLQvC+GGdNsOxCpznRa8aywBJWVDvrp9KukLwD",
+	"REl0IOfmNGKAv5JHOPHRMYnjgCcR41dIfFsKcPy4WnTX4HGvlVYgtwL52wjkmN1ydvfnq/l+ZSaOQodN",
+	"JqDuIgiJbcTG4qWg8uDGWZpY5D4hPzlJZrHguRoJI8kU1MVDPF8D3k7D0EQOgoEnBAnqwJowOJDMAUY4",
+	"qw1vQ5dt6OFIyDiLPgRt3ODCr7y/GphoRa+JiQbh+nsiNfVBqxQOL1IylcEIEA/rwecLGmgSUGEah4WC",
+	"YrBsIo1ff8416OKPJqQtUW4hmc3KvciVUX2QkM5XZLUjawV2K7C/kcCOZRRBCYs/n8R+J6PIN0L4lTyI",
+	"WrCAT+x2gOyd0RAVVUEYjSPOYjJlwoqqPiFvBVRPKhboz15R1kKhKRdO+MLbbvlBeHKtWDSBb2UMujJV",
+	"hI4EAEVl7aBMxfwVmcpTGYGNBFp5LAH6zhFJUwrIBr62knVrl2il6h9Kqv7Z8VTQDPNahvXtEKt2B/OH",
+	"fJkkW1wuMxv/uHS23FyVvK3METRCAtP8lkVLU7Z6TpcgYl1jIyFFlcmCbGexGImnNllUoMXUkY9WaW1t",
+	"DK1w/abCVS5a2VpXtsrFNqK1u1r+joqlnqFtVsbGEAB/jRkUuKMkkAlE1HvGYaPsQqM8BljqG1Oj9PIK",
+	"jBgxU8oWLTUydiQclCqdwmcRXSvgSS35PhINBTzZKN9H4rmbpMsxdFrx3or3byne0V6YkkmJ+DYPjF1x",
+	"c3z8O2sdBYbyu/pB2RaAEy23ySQOmCK2a2JNlkwZHGgBl2wXlSBChyBN49QIgPf1zLCpPEOrjLPQB8SI",
+	"sTw0EqmwQrlKXW6Su7SnyeBYYRnhJiIO+wsGiGDGgpvUPApvotjNpoIN3mGJuAxuGiQSyeyxW6UD/A13",
+	"ye5F5wH2TdNQK0VaKVImRVQyn9N4aWgyZUwjIjrdjqZTUNg6hog6n54yAQAH8Y5Nt/zSZDtvGwhnxFBJ",
+	"3tBFEFiFiUx4pFnMQhJxU23dfoQSL1E2OTLkkwnDnEhn3dTLxcZ8I7cTVvz6KZe2l62kyjs7rabbyCdv",
+	"pGCvqQ5ma82Hu86gtHP1WemgDrsKqV9bb1wr+Sol33cglYAbHMV78sjR8Q4FUnPhsPc1ttLpfq8ac8Iy",
+	"snmhbroh5Ak4EeCxfg6RAnSqRLGYzMA9gWKJaPkQseCEbQYU8X1IiRK8iVZKtPrRI0iiScoZThI5XnlS",
+	"1She1Yp2Ir726C3lER3zCNdmN7IsvaN517OJsc1Uijh3OXM3xHAkpvyWibJbpoOcMLfNRNGpsQVhEEwk",
+	"75R/oaO3koPzAHQyuOvlJCoG3fD5nIWcajBM7eIiVy5bL/yF3ip7e7WdVoa1Mqy2DCM0T4F/LHlWCXxj",
+	"BQ4+f6Ay5qPiPJ4ulmLVfB+q2CrkTauJtVLsEaQYd3zhBJdllO9Ibt2x8UzKmxIx9Zt5QoTUWehbLWmF",
+	"wso1jBdnZWxUzgrvD2Ir+fSbG/U2ssSODEba8vn3Z5d5LDSY6shQS8CQ+mRIp0/IO+t9IRGfsGAZRAzc",
+	"6KDg2yIDRTo3cUjQg4Frgue2uR8U+fDur12i+FSwEBvAotCKBfG2Kac5DmkY5W6H9SAUlrSNlsEqD9IW",
+	"HKX8LNr7an/agGtikEk8ttwSu8Txym+u1xaCpFUznzEEyXaaGfhWU1bpEi6CKAltJJc7u/AeGWAkgS3+",
+	"H7KI37KYhQ/S09Zw1qA9S1pu+U7hAdNjqqBGJmXlqEzGp69DXkJGqFHyIDIQOC6NMTKZo1+4wmBL6WIH",
+	"TcJQiUqYbMmLu1YNW3Zu2flx1Mf9PRrOudhL495Ksq4jqicyntsA3rruocywaoNnMfIv8xTRIJbKmGBz",
+	"Gqzz8ACL8BjBisjCDSGWESPTmApk4GkkxzRCjKLMNOv6PceJVR6w+xfw+F067YcJuL8lLF5uZWBq/iX1",
+	"B/4rF2HzJhaxvOWKS8HF9Brr2jRvY8ZopGflX29ljc7Nq7Uj/SHsSJ6ccWKg2nUTNAHuXhEv1Zzuovcb",
+	"83iDBdR0es0iFmgZN+Kih4qRNCnmKSWQYBryWrb6lM7Zt5FXNhr8YqJZ3PxrJWPd/KsJZ1GoHioYLYV/",
+	"3G+FYqvg1b+vlZrdDRR4narjvthsDuniSHYH0ONpWy3p/xH1AT/ttJZdupJyM7v0/krCXmt8bsXzc8a/",
+	"bqoCG7tzJSsUVd81fDBoJXBL3d/WWFwFKbXW4lutwCTVtN801K40zG5bXcgM/UHI0S0nPpMaLMP92kt+",
+	"FbNAipADgf5EecTCP6DmtgaUdM3Rthsx8bRYodCgSOZjFkODmT07yzPIpZ6nAKDZm/DSSIyZgwm1wNAJ",
+	"j6BvRDvOKnI6FNIxgmm4YcOTHA5ojXvcTqA668qyJlpFC9DZKhe7E0QmavjrLhRsYDhoLic5AE5CimlW",
+	"H9e5zsgtixWXwoDe3LGYWc+UbqCfv4fRb8NNbhTQQMtJLSc9oZoOOnJpNcUuIlfRgJkTDg4ywkXIb3mY",
+	"0MiylvAOZXca4ynnCsxMkijKY8X2RwKjPFY4jyuCroLQh391jUMCz5gxkaJ5E8VFwLqWiZHuLVgguFRc",
+	"zDAlgY0TJQyW3m+YM6GJmmFoV8x6yO6p0KBYiUfHy5KzGZZsgwBoeDL7/I/NP+hsbqVJW+7xmZ3rd+Vi",
+	"pvHB/hu048kcSJ9R6FO0yPsQgfLKRG7S2IvVtDWvzLx718D65rX+SFyQUcfBUnVM3CeIDU25yIkx1ynW",
+	"vRLaT0l2RbYQme9uxgS7BYwrrq1Ms+5PO9YuMfEXXXNFySMEYskCC46Xm1qfkIuRGFkbe5gO1Q0Hus3J",
+	"zIBRxTCqBqPmMtmndMzoHD4MIqlY2B+Ja/yTWTTzx6w9k0P4g0rlrOZzBpKeRXShmDINu2xuaIF9WaAQ",
+	"HgktTZkywYImmhTu88PMnb8ZOdqKv1aZehplalUGajaH4DdW40rjXq0bP1P4bHMATTaWB3DVe9tIG8vw",
+	"R0kUrBVnkJIZhHfaH42gXyTjiKuZMXEtirGmqEabepVwBI5tRfYowqR8tdnslSfa7cxdbsC7CGPI2mpp",
+	"/w8XzJAS297XwnY3DG7I2KVGlEPa64tin23UQ6sjfUdRD/U1mFz4wxpmqdJganDKoBXpLRc8o5tCRqtb",
+	"REn46tcrl/+WJuZYbyWZxHJu7JeOERFeQUid2kw3hltsYLHHUsBabm259TkoeQ3SXEpPu92KhnpXM2R7",
+	"6osIE4NA4zSQAerkhWzCRRaJ4F7vQh0oaJpG0dK5TDJo7CxUwtoowbx6aUHaTMKM6SlmSka3COOyWuRv",
+	"DpY4DM/ALy0SjM1D+UFZHOEGt8EV6bSDaPe0MYz00LwNfG9F1TcSVWmw0RqgRPtKw3S7tOVqZfsy7bxN",
+	"uPsTJ9xhDS34mP2ZkvVS/miFdiu066BdesIyBbxM//Zpo4FdpC2kpZaMFxidullxJQwoNWDdoRlU5IMx",
+	"wD3P0/UoGYPGlfl78VeLlpLEAovVu2ESkDFGiVPJZMK/2NAZVON4DCE/7IvT2bAhuIVyrLhvquSlZxFX",
+	"WeisjInAOnHxmjJuDzhsXKcvYLUeGOOftvXwdMdiU60U+bNB/WUSwjK5I4kKEVGm9+19dT/W9Dx4cmSd",
+	"yyHt9zJtvnUytAfq82AXS8sb2KX74AsReh/WMczKTWgdtzRQKlsWaFlgc62/jfS/nZ7UyPGwjjucx6Cc",
+	"O75FiqYb6w4yNFtWbRM0n5rpLdM9VE3cC6RQMmIy0aW8vd1BieHEpmFiWsaQ65KL60TaOr1dh5xbEn89",
+	"EiUB2IRcCDLqmOarArBdNanCYGzs80hUxWJ7zUgRLYlgdyQyNdKVyfWCYd7FXGsm+oR4cdAjsbtAaFIv",
+	"DrpEqL7Ibet2BYexhbfYQivZWiWkvhJSYLfH1Ek2m3gjJqZ61ugTI5QqgrTXy1HFlML1ebggTX22IHzc",
+	"itr2V8TpFrLBDfXRq0jZsV+b/lpR0oqSLUTJxzcvHvVus5nD53waU8161jHXkMV3dP8q9Qu8hiRbTxpg",
+	"uLyQaGy3o3WmeEXnruY4gi57ozWY6IpwrUbCOAz0skvGibY1dGCDU7SLmDnXgcl+RyllO+sSJbEYwiLm",
+	"t+ZqGI4EBv0H5PKK0DCMTdl1bM3kqcFLBGpzRiTk6gZVMFsGyPQYSaVR61sSR1QjMY1lslCEak2DWVYP",
+	"KJ3UPFFQfQGz97UsDrSOjyGTm68NAbwx33YecOe0TdgGH3T3bI2qbXLvs5PglrAzNhQpz2x3SzWygy/W",
+	"ezVABhBKMkFjotw8wejABgz0TpiC/4AgtFLJwgFEjMIlDrF8QADZKi4xS+DPfJKJHLwxNnWgXLkJtTzf",
+	"al3PxJGC7JMyzy48KY+p9FzoFXZHtacOs3PgcgLFJm5phIYgLfMAJK6RH5STXdxoEM6G4/XbTItoOb/l",
+	"/OfF+ZaTNnA+hJ4K2RujslsZe5o/tmM2llI//U2pRiUTGofvcHSNPjMTer9csHr1TuHtgtn7xyVE1dMk",
+	"0ggyaLSLBYsxBZoSJSf6jsaMXLy4uiSmv/5I/F0mJKDCRnfZaPzlgpkge3ipS1h/2ieUwNQIBmISLKfa",
+	"NcFdv0NwJEnn0kxomZm0IqsVWc9DZFnOWu/92kZiKUEXaibXB19iPorNoCnGyD+22vOe3oBN2I0TYQo9",
+	"nQc9SWUj5boZx1+7hXiAmcO18aDYyEamZpxwKz5a8bFefDjCfLj7XKnZDVvuwt3zjumYs1uGR/v19S/k",
+	"hi0f5Oa5NkN7dPeOUrNf2bJlupbpGrh1LIF/Y5eO0jTWz8iRcw3jgdNdy8WChevi6dYd3TirVldv+f55",
+	"HLZI1I+gqmu5eFa8KxeAT5wIDBuDjwVtzrqyNQy2nPtsOFcuHoFx16P1N480zeD63bc7xesvYdMWsb/l",
+	"v+cKHVV5aj0csn/Fs7ZTzP609WcJ2r9OCrSw/a1I+ZPC9kPXmglgiDsuQnmnyspyIavHxHu5JgKN/4Vt",
+	"v/qgfr06lm0YyuvzN2ymha3+c8BWrxIbZinxCB6aP8DJRQPNb0HFxApzLHRlF1SGnEgTLbFYQ67QW9ee",
+	"NAsZ60J3aS4anoKMrqvtVkHmDQ+hFSp/kJOmpLWWX/44UNerUn7v68qW14W7XmWzLmHChmcRRuNouTaa",
+	"cpX+X68OpTWitJe4Z4yCvZ1KZBCwS46pBipRLV4ZtBK/5YTnYc4oOWaaYGGXHjYQJ4flrTQTYXlkTNKU",
+	"fx5P/WqZsWXGx1fxXBMmoa7aPO/eI/hidmYRcp17YoAM5lTQaYYfbSJJRsJ+hRY9ZSpbO3sgpg6CwW+K",
+	"ZX9umMC4V9MQGUvtV9XGvEI9K47KQjTEDPGoA1Z5oKKNofBt9WF6nV+iFuj2kYFut8Jidbv5M25SKwe/",
+	"v2toASy1wJ6eG7IgcGoApxalF3o1NucCrzB+4+M+R5U7AAzNtdcS+fdM5JY285S5lsorT+29rzm6qGuQ",
+	"yXe91vaS54TrfG+tzaVVbp8VKGgDnuo2VHfXm2g2cVS5RrmRnQbtwdAyys5jshtxSbMrT+E4amK42cRC",
+	"zkKzmYUeoqrtABy05ciWI5vjem6nDtr4qpLgZHNuES4gzdgEZ1UnItFQEYRMMA7rRGg+z32LeUlgdwnZ",
+	"IpJLsNqYDqqPuo92aNscanZa34L0vxMZfpuurqMTt96f7u/v7/+/AQD5gYJmkFIDAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -6,6 +6,7 @@ package openapi
 import (
 	"encoding/json"
 	"fmt"
+	"time"
 
 	"github.com/oapi-codegen/runtime"
 	externalRef0 "github.com/unikorn-cloud/core/pkg/openapi"
@@ -16,6 +17,98 @@ const (
 	Oauth2AuthenticationScopes = "oauth2Authentication.Scopes"
 )
 
+// Defines values for AdminResourceKind.
+const (
+	AdminResourceKindCluster  AdminResourceKind = "cluster"
+	AdminResourceKindInstance AdminResourceKind = "instance"
+)
+
+// Defines values for ClusterEventType.
+const (
+	Normal  ClusterEventType = "normal"
+	Warning ClusterEventType = "warning"
+)
+
+// Defines values for ComputeClusterConditionStatus.
+const (
+	False   ComputeClusterConditionStatus = "False"
+	True    ComputeClusterConditionStatus = "True"
+	Unknown ComputeClusterConditionStatus = "Unknown"
+)
+
+// Defines values for ComputeClusterConditionType.
+const (
+	IdentityReady       ComputeClusterConditionType = "IdentityReady"
+	NetworkReady        ComputeClusterConditionType = "NetworkReady"
+	SecurityGroupsReady ComputeClusterConditionType = "SecurityGroupsReady"
+	ServersReady        ComputeClusterConditionType = "ServersReady"
+)
+
+// Defines values for ComputeClusterPoolChange.
+const (
+	PoolChangeAdded     ComputeClusterPoolChange = "added"
+	PoolChangeModified  ComputeClusterPoolChange = "modified"
+	PoolChangeRemoved   ComputeClusterPoolChange = "removed"
+	PoolChangeUnchanged ComputeClusterPoolChange = "unchanged"
+)
+
+// Defines values for ComputeErrorError.
+const (
+	AccessDenied          ComputeErrorError = "access_denied"
+	Conflict              ComputeErrorError = "conflict"
+	Forbidden             ComputeErrorError = "forbidden"
+	InvalidRequest        ComputeErrorError = "invalid_request"
+	MethodNotAllowed      ComputeErrorError = "method_not_allowed"
+	NotFound              ComputeErrorError = "not_found"
+	RequestEntityTooLarge ComputeErrorError = "request_entity_too_large"
+	ServerError           ComputeErrorError = "server_error"
+	UnprocessableContent  ComputeErrorError = "unprocessable_content"
+	UnsupportedMediaType  ComputeErrorError = "unsupported_media_type"
+)
+
+// Defines values for ErrorCode.
+const (
+	ComputeClusterDeleting              ErrorCode = "compute.cluster.deleting"
+	ComputeClusterInvalidFlavor         ErrorCode = "compute.cluster.invalid_flavor"
+	ComputeClusterInvalidGoldenImage    ErrorCode = "compute.cluster.invalid_golden_image"
+	ComputeClusterInvalidImage          ErrorCode = "compute.cluster.invalid_image"
+	ComputeClusterInvalidPowerAction    ErrorCode = "compute.cluster.invalid_power_action"
+	ComputeClusterInvalidRegion         ErrorCode = "compute.cluster.invalid_region"
+	ComputeClusterInvalidSecurityGroup  ErrorCode = "compute.cluster.invalid_security_group"
+	ComputeClusterInvalidSpec           ErrorCode = "compute.cluster.invalid_spec"
+	ComputeClusterOperationPending      ErrorCode = "compute.cluster.operation_pending"
+	ComputeClusterPoolAutoscaled        ErrorCode = "compute.cluster.pool_autoscaled"
+	ComputeClusterPoolNotFound          ErrorCode = "compute.cluster.pool_not_found"
+	ComputeClusterTemplateDeleting      ErrorCode = "compute.cluster_template.deleting"
+	ComputeClusterTemplateInvalidImage  ErrorCode = "compute.cluster_template.invalid_image"
+	ComputeClusterTemplateInvalidSpec   ErrorCode = "compute.cluster_template.invalid_spec"
+	ComputeClusterTemplateUnknownPool   ErrorCode = "compute.cluster_template.unknown_pool"
+	ComputeClusterUnknownGeneration     ErrorCode = "compute.cluster.unknown_generation"
+	ComputeInstanceDeleting             ErrorCode = "compute.instance.deleting"
+	ComputeInstanceInvalidFlavor        ErrorCode = "compute.instance.invalid_flavor"
+	ComputeInstanceInvalidImage         ErrorCode = "compute.instance.invalid_image"
+	ComputeInstanceInvalidNetwork       ErrorCode = "compute.instance.invalid_network"
+	ComputeInstanceInvalidRebootType    ErrorCode = "compute.instance.invalid_reboot_type"
+	ComputeInstanceInvalidSecurityGroup ErrorCode = "compute.instance.invalid_security_group"
+	ComputeInstanceInvalidSpec          ErrorCode = "compute.instance.invalid_spec"
+	ComputeMachineAmbiguousHostName     ErrorCode = "compute.machine.ambiguous_host_name"
+	ComputeMachineCordoned              ErrorCode = "compute.machine.cordoned"
+	ComputeMachineNotFound              ErrorCode = "compute.machine.not_found"
+	ComputeMachineNotSpecified          ErrorCode = "compute.machine.not_specified"
+	ComputeMaintenanceWindowDeleting    ErrorCode = "compute.maintenance_window.deleting"
+	ComputeMaintenanceWindowInvalidSpec ErrorCode = "compute.maintenance_window.invalid_spec"
+	ComputeQuotaRegionNotSpecified      ErrorCode = "compute.quota.region_not_specified"
+	ComputeResourceReservedTag          ErrorCode = "compute.resource.reserved_tag"
+	ComputeResourceVersionConflict      ErrorCode = "compute.resource.version_conflict"
+	ComputeServerDuplicated             ErrorCode = "compute.server.duplicated"
+	ComputeServerNotAdoptable           ErrorCode = "compute.server.not_adoptable"
+	ComputeServerNotFound               ErrorCode = "compute.server.not_found"
+	ComputeWebhookAlreadyExists         ErrorCode = "compute.webhook.already_exists"
+	ComputeWebhookDeleting              ErrorCode = "compute.webhook.deleting"
+	ComputeWebhookInvalidSecret         ErrorCode = "compute.webhook.invalid_secret"
+	ComputeWebhookInvalidUrl            ErrorCode = "compute.webhook.invalid_url"
+)
+
 // Defines values for FirewallRuleDirection.
 const (
 	Egress  FirewallRuleDirection = "egress"
@@ -28,6 +121,97 @@ const (
 	Udp FirewallRuleProtocol = "udp"
 )
 
+// Defines values for InstancePowerScheduleStatusLastAction.
+const (
+	Start InstancePowerScheduleStatusLastAction = "start"
+	Stop  InstancePowerScheduleStatusLastAction = "stop"
+)
+
+// Defines values for ListSort.
+const (
+	ListSortCreated ListSort = "created"
+	ListSortName    ListSort = "name"
+	ListSortStatus  ListSort = "status"
+)
+
+// Defines values for MachineEvictionStatusStatus.
+const (
+	Deleted  MachineEvictionStatusStatus = "deleted"
+	Deleting MachineEvictionStatusStatus = "deleting"
+	Failed   MachineEvictionStatusStatus = "failed"
+	Pending  MachineEvictionStatusStatus = "pending"
+)
+
+// Defines values for PoolPowerMode.
+const (
+	PoolPowerModeParallel PoolPowerMode = "parallel"
+	PoolPowerModeRolling  PoolPowerMode = "rolling"
+)
+
+// Defines values for PoolPowerResultStatus.
+const (
+	PoolPowerAccepted PoolPowerResultStatus = "accepted"
+	PoolPowerFailed   PoolPowerResultStatus = "failed"
+	PoolPowerSkipped  PoolPowerResultStatus = "skipped"
+)
+
+// Defines values for PoolPowerWriteAction.
+const (
+	PoolPowerActionReboot PoolPowerWriteAction = "reboot"
+	PoolPowerActionStart  PoolPowerWriteAction = "start"
+	PoolPowerActionStop   PoolPowerWriteAction = "stop"
+)
+
+// Defines values for RebootType.
+const (
+	RebootTypeHard RebootType = "hard"
+	RebootTypeSoft RebootType = "soft"
+)
+
+// Defines values for WebhookEventType.
+const (
+	WebhookEventClusterEvictionCompleted WebhookEventType = "cluster.eviction.completed"
+	WebhookEventClusterProvisioned       WebhookEventType = "cluster.provisioned"
+	WebhookEventInstanceDeleted          WebhookEventType = "instance.deleted"
+	WebhookEventMachineHealthDegraded    WebhookEventType = "machine.health.degraded"
+)
+
+// AdminResourceKind The kind of compute resource.
+type AdminResourceKind string
+
+// AdminResourceRead A compute resource in any organization.
+type AdminResourceRead struct {
+	// Metadata Metadata required by project scoped resource reads.
+	Metadata externalRef0.ProjectScopedResourceReadMetadata `json:"metadata"`
+
+	// Status A summary of a compute resource's status.
+	Status AdminResourceStatus `json:"status"`
+}
+
+// AdminResourceReadList A list of compute resources.
+type AdminResourceReadList = []AdminResourceRead
+
+// AdminResourceStatus A summary of a compute resource's status.
+type AdminResourceStatus struct {
+	// ApiVersion The API version the resource was created with.
+	ApiVersion *int `json:"apiVersion,omitempty"`
+
+	// Kind The kind of compute resource.
+	Kind AdminResourceKind `json:"kind"`
+
+	// RegionId The region the resource is provisioned in.
+	RegionId string `json:"regionId"`
+}
+
+// AdoptionWrite A set of existing servers to adopt into a cluster's workload pool.
+type AdoptionWrite struct {
+	// Pool The name of the workload pool to adopt the servers into.
+	Pool string `json:"pool"`
+
+	// ServerIDs A list of region server IDs.
+	ServerIDs []string `json:"serverIDs"`
+}
+
 // AllowedAddressPair Allow multiple MAC/IP address (range) pairs to pass through this network port.
 // Typically required when the machine is operating as a router.
 type AllowedAddressPair struct {
@@ -44,9 +228,128 @@ type AllowedAddressPairList = []AllowedAddressPair
 // AllowedSourceAddresses A list of network prefixes that are allowed to egress from the server.
 // By default, only packets from the server's network interface's IP address
 // are allowed to enter the network.  Use of this option allows the server
-// to act as a router without SNAT rules.
+// to act as a router without SNAT rules.  Prefixes are applied as allowed
+// address pairs on the server's port, security group rules still apply.
+// Each prefix must be a unique IPv4 CIDR with no host bits set.
 type AllowedSourceAddresses = []string
 
+// ClusterEvent A notable action taken, or problem encountered, while provisioning a cluster.
+type ClusterEvent struct {
+	// Count The number of times the event has occurred.
+	Count *int `json:"count,omitempty"`
+
+	// Message A human readable description of the event.
+	Message string `json:"message"`
+
+	// Reason A short, machine readable, reason for the event.
+	Reason string `json:"reason"`
+
+	// Time When the event last occurred.
+	Time time.Time `json:"time"`
+
+	// Type The event type, warnings indicate a problem.
+	Type ClusterEventType `json:"type"`
+}
+
+// ClusterEventType The event type, warnings indicate a problem.
+type ClusterEventType string
+
+// ClusterEvents A list of cluster events, most recent first.
+type ClusterEvents = []ClusterEvent
+
+// ClusterTemplateInstantiate A request to create a cluster from a template.
+type ClusterTemplateInstantiate struct {
+	// Metadata Metadata required for all API resource reads and writes.
+	Metadata externalRef0.ResourceWriteMetadata `json:"metadata"`
+
+	// Spec Where to create a cluster from a template.
+	Spec ClusterTemplateInstantiateSpec `json:"spec"`
+}
+
+// ClusterTemplateInstantiateSpec Where to create a cluster from a template.
+type ClusterTemplateInstantiateSpec struct {
+	// NetworkId The network ID to attach the cluster to.
+	NetworkId string `json:"networkId"`
+
+	// OrganizationId The organization to provision the resource in.
+	OrganizationId string `json:"organizationId"`
+
+	// Pools A list of template pool overrides.
+	Pools *ClusterTemplatePoolOverrideList `json:"pools,omitempty"`
+
+	// ProjectId The project to provision the resource in.
+	ProjectId string `json:"projectId"`
+}
+
+// ClusterTemplatePool A blessed workload pool configuration.  Exactly one of an image ID or image
+// selector must be specified.
+type ClusterTemplatePool struct {
+	// FlavorId The flavor CPU/RAM of a compute instance.
+	FlavorId string `json:"flavorId"`
+
+	// ImageId The image of a compute instance.
+	ImageId *string `json:"imageId,omitempty"`
+
+	// ImageSelector A server image selector.
+	ImageSelector *ImageSelector `json:"imageSelector,omitempty"`
+
+	// Name The name of the pool.  Instances will inherit this name plus a short suffix.
+	Name string `json:"name"`
+
+	// Networking A compute instance's network  configuration.
+	Networking *InstanceNetworking `json:"networking,omitempty"`
+
+	// Replicas The default number of instances to maintain.
+	Replicas int `json:"replicas"`
+
+	// UserData Contains base64-encoded configuration information or scripts to use upon launch.
+	// The format of the data is governed by the cloud-init standard, and may be a script,
+	// a MIME multipart archive, etc.
+	UserData *[]byte `json:"userData,omitempty"`
+}
+
+// ClusterTemplatePoolList A list of template workload pools.
+type ClusterTemplatePoolList = []ClusterTemplatePool
+
+// ClusterTemplatePoolOverride Overrides a template pool's configuration.
+type ClusterTemplatePoolOverride struct {
+	// Name The name of the template pool to override.
+	Name string `json:"name"`
+
+	// Replicas The number of instances to maintain.
+	Replicas int `json:"replicas"`
+}
+
+// ClusterTemplatePoolOverrideList A list of template pool overrides.
+type ClusterTemplatePoolOverrideList = []ClusterTemplatePoolOverride
+
+// ClusterTemplateRead A cluster template.
+type ClusterTemplateRead struct {
+	// Metadata Metadata required by all resource reads.
+	Metadata externalRef0.ResourceReadMetadata `json:"metadata"`
+
+	// Spec A cluster template specification.
+	Spec ClusterTemplateSpec `json:"spec"`
+}
+
+// ClusterTemplateReadList A list of cluster templates.
+type ClusterTemplateReadList = []ClusterTemplateRead
+
+// ClusterTemplateSpec A cluster template specification.
+type ClusterTemplateSpec struct {
+	// Pools A list of template workload pools.
+	Pools ClusterTemplatePoolList `json:"pools"`
+}
+
+// ClusterTemplateWrite A cluster template create or update request.
+type ClusterTemplateWrite struct {
+	// Metadata Metadata required for all API resource reads and writes.
+	Metadata externalRef0.ResourceWriteMetadata `json:"metadata"`
+
+	// Spec A cluster template specification.
+	Spec ClusterTemplateSpec `json:"spec"`
+}
+
 // ClusterV2Create A cluster creation request.
 type ClusterV2Create struct {
 	// Metadata Metadata required for all API resource reads and writes.
@@ -71,6 +374,30 @@ type ClusterV2CreateSpec struct {
 	ProjectId string `json:"projectId"`
 }
 
+// ClusterV2PoolPreview The actions required to update a pool.
+type ClusterV2PoolPreview struct {
+	// Create The number of instances that will be created.
+	Create int `json:"create"`
+
+	// Delete The IDs of instances that will be deleted.
+	Delete []string `json:"delete"`
+
+	// Name The name of the pool.
+	Name string `json:"name"`
+
+	// Rebuild The IDs of instances that will be rebuilt with the new pool configuration.
+	Rebuild []string `json:"rebuild"`
+}
+
+// ClusterV2PoolPreviewList A list of pool update previews.
+type ClusterV2PoolPreviewList = []ClusterV2PoolPreview
+
+// ClusterV2Preview The effect of a cluster update.
+type ClusterV2Preview struct {
+	// Pools A list of pool update previews.
+	Pools ClusterV2PoolPreviewList `json:"pools"`
+}
+
 // ClusterV2Read A compute cluster.
 type ClusterV2Read struct {
 	// Metadata Metadata required by project scoped resource reads.
@@ -113,8 +440,122 @@ type ClusterV2Update struct {
 	Spec ClusterV2Spec `json:"spec"`
 }
 
+// ComputeClusterAllocationDelta The change in quota allocation for a kind of resource.
+type ComputeClusterAllocationDelta struct {
+	// Committed The change in the amount of the resource always in use.
+	Committed int `json:"committed"`
+
+	// Kind The kind of resource.
+	Kind string `json:"kind"`
+
+	// Reserved The change in the amount of the resource that may be used e.g. autoscaled.
+	Reserved int `json:"reserved"`
+}
+
+// ComputeClusterAllocationDeltaList A list of quota allocation changes, ordered by kind.
+type ComputeClusterAllocationDeltaList = []ComputeClusterAllocationDelta
+
+// ComputeClusterCancellationStatus Reported when the cluster's most recent update was cancelled before it completed.
+type ComputeClusterCancellationStatus struct {
+	// Message How far the update progressed before it was cancelled.
+	Message string `json:"message"`
+}
+
+// ComputeClusterCondition The readiness of a cluster dependency.
+type ComputeClusterCondition struct {
+	// LastTransitionTime When the status last changed.
+	LastTransitionTime time.Time `json:"lastTransitionTime"`
+
+	// Message A human readable explanation of the status.
+	Message string `json:"message"`
+
+	// Reason A terse reason for the status.
+	Reason string `json:"reason"`
+
+	// Status Whether the dependency is ready.
+	Status ComputeClusterConditionStatus `json:"status"`
+
+	// Type The dependency the condition refers to.
+	Type ComputeClusterConditionType `json:"type"`
+}
+
+// ComputeClusterConditionStatus Whether the dependency is ready.
+type ComputeClusterConditionStatus string
+
+// ComputeClusterConditionType The dependency the condition refers to.
+type ComputeClusterConditionType string
+
+// ComputeClusterConditions The readiness of dependencies the cluster is provisioned on, these explain
+// what provisioning is waiting on, or why it failed.
+type ComputeClusterConditions = []ComputeClusterCondition
+
+// ComputeClusterMachine defines model for computeClusterMachine.
+type ComputeClusterMachine struct {
+	// BootFinished Whether the machine has reported that cloud-init has finished.  This is only
+	// reported when the platform has boot reporting enabled.
+	BootFinished *bool `json:"bootFinished,omitempty"`
+
+	// Cordoned Whether the machine is cordoned.  Cordoned machines are excluded from
+	// updates, rebuilds and scale down.
+	Cordoned *bool `json:"cordoned,omitempty"`
+
+	// CreationTime When the machine was created.
+	CreationTime *time.Time `json:"creationTime,omitempty"`
+
+	// FlavorID Machine flavorID.
+	FlavorID string `json:"flavorID"`
+
+	// HealthStatus The health state of a resource.
+	HealthStatus externalRef0.ResourceHealthStatus `json:"healthStatus"`
+
+	// Hostname Machine hostname.
+	Hostname string `json:"hostname"`
+
+	// Id Machine ID.
+	Id string `json:"id"`
+
+	// ImageID Machine image ID.
+	ImageID string `json:"imageID"`
+
+	// Labels A list of tags.
+	Labels *externalRef0.TagList `json:"labels,omitempty"`
+
+	// Maintenance Whether the machine is under provider maintenance.  Machines under maintenance
+	// are not auto healed or rebuilt until the maintenance window ends.
+	Maintenance *bool `json:"maintenance,omitempty"`
+
+	// Pool The workload pool the machine belongs to.
+	Pool string `json:"pool"`
+
+	// PrivateIP Machine private IP address.
+	PrivateIP *string `json:"privateIP,omitempty"`
+
+	// ProvisioningStatus The provisioning state of a resource.
+	ProvisioningStatus externalRef0.ResourceProvisioningStatus `json:"provisioningStatus"`
+
+	// PublicIP Machine public IP address.
+	PublicIP *string `json:"publicIP,omitempty"`
+
+	// Status The lifecycle phase of an instance.
+	Status externalRef1.InstanceLifecyclePhase `json:"status"`
+}
+
+// ComputeClusterMachineList A list of compute cluster machines.
+type ComputeClusterMachineList = []ComputeClusterMachine
+
 // ComputeClusterMachineStatus Compute cluster machine status.
 type ComputeClusterMachineStatus struct {
+	// BootFinished Whether the machine has reported that cloud-init has finished.  This is only
+	// reported when the platform has boot reporting enabled.
+	BootFinished *bool `json:"bootFinished,omitempty"`
+
+	// Cordoned Whether the machine is cordoned.  Cordoned machines are excluded from
+	// updates, rebuilds and scale down.
+	Cordoned *bool `json:"cordoned,omitempty"`
+
+	// CreationTime When the machine was created.
+	CreationTime *time.Time `json:"creationTime,omitempty"`
+
 	// FlavorID Machine flavorID.
 	FlavorID string `json:"flavorID"`
 
@@ -130,6 +571,13 @@ type ComputeClusterMachineStatus struct {
 	// ImageID Machine image ID.
 	ImageID string `json:"imageID"`
 
+	// Labels A list of tags.
+	Labels *externalRef0.TagList `json:"labels,omitempty"`
+
+	// Maintenance Whether the machine is under provider maintenance.  Machines under maintenance
+	// are not auto healed or rebuilt until the maintenance window ends.
+	Maintenance *bool `json:"maintenance,omitempty"`
+
 	// PrivateIP Machine private IP address.
 	PrivateIP *string `json:"privateIP,omitempty"`
 
@@ -146,6 +594,55 @@ type ComputeClusterMachineStatus struct {
 // ComputeClusterMachinesStatus A list of Compute cluster machines status.
 type ComputeClusterMachinesStatus = []ComputeClusterMachineStatus
 
+// ComputeClusterNetworkStatus The network that cluster machines are attached to.
+type ComputeClusterNetworkStatus struct {
+	// DnsNameservers DNS nameservers provided to machines on the network.
+	DnsNameservers []string `json:"dnsNameservers"`
+
+	// NetworkId The network ID, present once the network has been created.
+	NetworkId *string `json:"networkId,omitempty"`
+
+	// Prefix The IPv4 prefix of the node network.
+	Prefix string `json:"prefix"`
+}
+
+// ComputeClusterPoolChange How a pool is changed by an update.
+type ComputeClusterPoolChange string
+
+// ComputeClusterPoolPreview The effect of an update on a pool.
+type ComputeClusterPoolPreview struct {
+	// Change How a pool is changed by an update.
+	Change ComputeClusterPoolChange `json:"change"`
+
+	// Create The number of machines that will be created.
+	Create int `json:"create"`
+
+	// Delete The number of machines that will be deleted.
+	Delete int `json:"delete"`
+
+	// Name The name of the pool.
+	Name string `json:"name"`
+
+	// Rebuild The IDs of machines that will be rebuilt with a new image.  Cordoned machines
+	// are never updated, so are omitted.
+	Rebuild []string `json:"rebuild"`
+
+	// Resize The IDs of machines that will be resized to a new flavor.
+	Resize []string `json:"resize"`
+}
+
+// ComputeClusterPoolPreviewList A list of pool update previews.
+type ComputeClusterPoolPreviewList = []ComputeClusterPoolPreview
+
+// ComputeClusterPreview The effect of a cluster update.
+type ComputeClusterPreview struct {
+	// Allocations A list of quota allocation changes, ordered by kind.
+	Allocations ComputeClusterAllocationDeltaList `json:"allocations"`
+
+	// Pools A list of pool update previews.
+	Pools ComputeClusterPoolPreviewList `json:"pools"`
+}
+
 // ComputeClusterRead Compute cluster read.
 type ComputeClusterRead struct {
 	// Metadata Metadata required by project scoped resource reads.
@@ -169,6 +666,20 @@ type ComputeClusterSpec struct {
 
 // ComputeClusterStatus Compute cluster status.
 type ComputeClusterStatus struct {
+	// Cancellation Reported when the cluster's most recent update was cancelled before it completed.
+	Cancellation *ComputeClusterCancellationStatus `json:"cancellation,omitempty"`
+
+	// Conditions The readiness of dependencies the cluster is provisioned on, these explain
+	// what provisioning is waiting on, or why it failed.
+	Conditions *ComputeClusterConditions `json:"conditions,omitempty"`
+
+	// MaintenanceMode Whether the cluster is in maintenance mode, and machines are not being
+	// created, deleted, rebuilt or resized.
+	MaintenanceMode *bool `json:"maintenanceMode,omitempty"`
+
+	// Network The network that cluster machines are attached to.
+	Network *ComputeClusterNetworkStatus `json:"network,omitempty"`
+
 	// SshPrivateKey SSH private key that allows access to the cluster.
 	SshPrivateKey *string `json:"sshPrivateKey,omitempty"`
 
@@ -178,16 +689,83 @@ type ComputeClusterStatus struct {
 
 // ComputeClusterWorkloadPool A Compute cluster workload pool.
 type ComputeClusterWorkloadPool struct {
+	// AutoHealing Replaces machines that remain unhealthy for longer than the grace period, by deleting
+	// and recreating them.  Cordoned machines are never replaced.
+	AutoHealing *ComputeClusterWorkloadPoolAutoHealing `json:"autoHealing,omitempty"`
+
+	// Autoscaling Allows the pool's replicas to be adjusted automatically based on observed CPU utilization.
+	// When set, the machine replicas are only used as the initial pool size, and are clamped to
+	// the given bounds.  Updates preserve the current, autoscaled, replica count.
+	Autoscaling *ComputeClusterWorkloadPoolAutoscaling `json:"autoscaling,omitempty"`
+
+	// Domain The DNS domain the pool's machines belong to.  Each machine's fully qualified
+	// domain name is its host name within this domain, and is made available to user
+	// data templates and recorded against the machine.
+	Domain *string `json:"domain,omitempty"`
+
+	// HostnamePrefix Used in place of the pool name when generating machine host names, a short random
+	// suffix is appended to make each unique.  Changes only apply to new machines.
+	HostnamePrefix *string `json:"hostnamePrefix,omitempty"`
+
+	// Labels A list of tags.
+	Labels *externalRef0.TagList `json:"labels,omitempty"`
+
 	// Machine A Compute cluster machine pool.
 	Machine MachinePool `json:"machine"`
 
 	// Name A valid Kubernetes label value, typically used for resource names that can be
 	// indexed in the database.
 	Name externalRef0.KubernetesLabelValue `json:"name"`
+
+	// UpdateStrategy Controls how machines are rebuilt or resized when the pool's image or flavor changes.
+	// When not set all machines are updated at once.  Surge machines count towards quota.
+	UpdateStrategy *ComputeClusterWorkloadPoolUpdateStrategy `json:"updateStrategy,omitempty"`
+}
+
+// ComputeClusterWorkloadPoolAutoHealing Replaces machines that remain unhealthy for longer than the grace period, by deleting
+// and recreating them.  Cordoned machines are never replaced.
+type ComputeClusterWorkloadPoolAutoHealing struct {
+	// GracePeriodSeconds How long a machine must be continuously unhealthy before it is replaced.
+	GracePeriodSeconds *int `json:"gracePeriodSeconds,omitempty"`
+}
+
+// ComputeClusterWorkloadPoolAutoscaling Allows the pool's replicas to be adjusted automatically based on observed CPU utilization.
+// When set, the machine replicas are only used as the initial pool size, and are clamped to
+// the given bounds.  Updates preserve the current, autoscaled, replica count.
+type ComputeClusterWorkloadPoolAutoscaling struct {
+	// MaxReplicas The maximum number of machines.
+	MaxReplicas int `json:"maxReplicas"`
+
+	// MinReplicas The minimum number of machines.
+	MinReplicas int `json:"minReplicas"`
+
+	// TargetCpuUtilization The average CPU utilization percentage to maintain across the pool.
+	TargetCpuUtilization *int `json:"targetCpuUtilization,omitempty"`
+}
+
+// ComputeClusterWorkloadPoolAutoscalingStatus The last scaling decision made for an autoscaled pool.
+type ComputeClusterWorkloadPoolAutoscalingStatus struct {
+	// CpuUtilization The average CPU utilization percentage that triggered scaling.
+	CpuUtilization *int `json:"cpuUtilization,omitempty"`
+
+	// DesiredReplicas The number of machines after scaling.
+	DesiredReplicas int `json:"desiredReplicas"`
+
+	// LastScaleTime When the pool was last scaled.
+	LastScaleTime time.Time `json:"lastScaleTime"`
+
+	// Message Why the scaling decision was made.
+	Message *string `json:"message,omitempty"`
+
+	// PreviousReplicas The number of machines before scaling.
+	PreviousReplicas int `json:"previousReplicas"`
 }
 
 // ComputeClusterWorkloadPoolStatus Compute cluster workload pool status.
 type ComputeClusterWorkloadPoolStatus struct {
+	// Autoscaling The last scaling decision made for an autoscaled pool.
+	Autoscaling *ComputeClusterWorkloadPoolAutoscalingStatus `json:"autoscaling,omitempty"`
+
 	// Machines A list of Compute cluster machines status.
 	Machines *ComputeClusterMachinesStatus `json:"machines,omitempty"`
 
@@ -197,6 +775,21 @@ type ComputeClusterWorkloadPoolStatus struct {
 
 	// Replicas Number of machines.
 	Replicas int `json:"replicas"`
+
+	// SecurityGroupId The security group managed for the pool, if it has firewall rules.  This may
+	// be referenced by external firewall automation or attached to other resources.
+	SecurityGroupId *string `json:"securityGroupId,omitempty"`
+}
+
+// ComputeClusterWorkloadPoolUpdateStrategy Controls how machines are rebuilt or resized when the pool's image or flavor changes.
+// When not set all machines are updated at once.  Surge machines count towards quota.
+type ComputeClusterWorkloadPoolUpdateStrategy struct {
+	// MaxSurge The number of machines that may be created above the desired replica count
+	// during an update, so replacements are brought up before old machines are deleted.
+	MaxSurge *int `json:"maxSurge,omitempty"`
+
+	// MaxUnavailable The number of machines that may be unavailable during an update.
+	MaxUnavailable *int `json:"maxUnavailable,omitempty"`
 }
 
 // ComputeClusterWorkloadPools A list of Compute cluster workload pools.
@@ -217,6 +810,26 @@ type ComputeClusterWrite struct {
 // ComputeClusters A list of Compute clusters.
 type ComputeClusters = []ComputeClusterRead
 
+// ComputeError defines model for computeError.
+type ComputeError struct {
+	// Code A stable, machine readable code that refines the generic error, allowing clients
+	// to branch on specific failures without parsing the description.  New codes may
+	// be added over time, so clients must tolerate unknown values.
+	Code *ErrorCode `json:"code,omitempty"`
+
+	// Error A terse error string expanding on the HTTP error code. Errors are based on the OAuth 2.02 specification, but are expanded with proprietary status codes for APIs other than those specified by OAuth 2.02.
+	Error ComputeErrorError `json:"error"`
+
+	// ErrorDescription Verbose message describing the error.
+	ErrorDescription string `json:"error_description"`
+
+	// TraceId Unique trace identifier for the request.
+	TraceId *string `json:"trace_id,omitempty"`
+}
+
+// ComputeErrorError A terse error string expanding on the HTTP error code. Errors are based on the OAuth 2.02 specification, but are expanded with proprietary status codes for APIs other than those specified by OAuth 2.02.
+type ComputeErrorError string
+
 // ComputeImage The image to use for a server.
 type ComputeImage struct {
 	// Id The image ID.
@@ -233,10 +846,50 @@ type ComputeImage0 = interface{}
 // ComputeImage1 defines model for .
 type ComputeImage1 = interface{}
 
-// EvictionWrite A set of machines to evict from a cluster.
+// ComputeQuota An organization's quota for a resource allocated by compute.
+type ComputeQuota struct {
+	// Committed The amount of that resource always in use.
+	Committed int `json:"committed"`
+
+	// Free The amount of that resource that is free.
+	Free int `json:"free"`
+
+	// Kind The kind of resource.
+	Kind string `json:"kind"`
+
+	// Quantity The maximum amount of that resource.
+	Quantity int `json:"quantity"`
+
+	// Reserved The amount of that resource that may be used e.g. autoscaled.
+	Reserved int `json:"reserved"`
+}
+
+// ComputeQuotaList A list of compute quotas.
+type ComputeQuotaList = []ComputeQuota
+
+// ComputeQuotas An organization's compute quotas.
+type ComputeQuotas struct {
+	// Flavor The number of instances of a flavor that can be allocated.
+	Flavor *FlavorAvailability `json:"flavor,omitempty"`
+
+	// Quotas A list of compute quotas.
+	Quotas ComputeQuotaList `json:"quotas"`
+}
+
+// ErrorCode A stable, machine readable code that refines the generic error, allowing clients
+// to branch on specific failures without parsing the description.  New codes may
+// be added over time, so clients must tolerate unknown values.
+type ErrorCode string
+
+// EvictionWrite A set of machines to evict from a cluster.  Machines may be identified by ID, host
+// name, or a mixture of both, at least one machine must be specified.
 type EvictionWrite struct {
+	// Hostnames A list of machine host names, these are the machine names returned in the cluster
+	// status.  Each must identify exactly one machine, otherwise use machine IDs.
+	Hostnames *[]string `json:"hostnames,omitempty"`
+
 	// MachineIDs A list of machine IDs, these are returned in the cluster status.
-	MachineIDs MachineIDList `json:"machineIDs"`
+	MachineIDs *MachineIDList `json:"machineIDs,omitempty"`
 }
 
 // FirewallRule A firewall rule applied to a workload pool.
@@ -263,9 +916,38 @@ type FirewallRuleDirection string
 // FirewallRuleProtocol The protocol to allow.
 type FirewallRuleProtocol string
 
+// FirewallRuleRead A firewall rule applied to a workload pool, with its identifier.
+type FirewallRuleRead struct {
+	// Id The firewall rule ID, this is derived from the rule itself so is stable
+	// as long as the rule exists.
+	Id string `json:"id"`
+
+	// Spec A firewall rule applied to a workload pool.
+	Spec FirewallRule `json:"spec"`
+}
+
 // FirewallRules A list of firewall rules applied to a workload pool.
 type FirewallRules = []FirewallRule
 
+// FirewallRulesRead A list of firewall rules applied to a workload pool.
+type FirewallRulesRead = []FirewallRuleRead
+
+// FlavorAvailability The number of instances of a flavor that can be allocated.
+type FlavorAvailability struct {
+	// Available The number of instances of the flavor that can be allocated within the
+	// organization's quota.  When not present, no quota limits allocation.
+	Available *int `json:"available,omitempty"`
+
+	// FlavorId The flavor ID.
+	FlavorId string `json:"flavorId"`
+
+	// LimitedBy The quota kind that limits availability, if any.
+	LimitedBy *string `json:"limitedBy,omitempty"`
+}
+
+// FlavorsAvailability A list of flavor availabilities.
+type FlavorsAvailability = []FlavorAvailability
+
 // ImageSelector A server image selector.
 type ImageSelector struct {
 	// Distro A distribution name.
@@ -289,6 +971,11 @@ type InstanceCreate struct {
 
 // InstanceCreateSpec defines model for instanceCreateSpec.
 type InstanceCreateSpec struct {
+	// BootstrapProfile The name of an operator provided bootstrap profile e.g. GPU driver and container
+	// runtime installation.  This is rendered for the selected image and flavor and
+	// runs before any user data.
+	BootstrapProfile *string `json:"bootstrapProfile,omitempty"`
+
 	// FlavorId The flavor CPU/RAM of a compute instance.
 	FlavorId string `json:"flavorId"`
 
@@ -304,21 +991,45 @@ type InstanceCreateSpec struct {
 	// OrganizationId The organization to provision the resource in.
 	OrganizationId string `json:"organizationId"`
 
+	// PowerSchedule Powers the instance on and off at set times, for example outside of working hours.
+	// Each schedule is a standard 5 field cron expression.  Only the most recent scheduled
+	// action is applied, so an instance may be manually started or stopped in between.
+	PowerSchedule *InstancePowerSchedule `json:"powerSchedule,omitempty"`
+
 	// ProjectId The project to provision the resource in.
 	ProjectId string `json:"projectId"`
 
+	// SnapshotPolicy Snapshots the instance at set times.  The schedule is a standard 5 field cron
+	// expression.  Only the most recent scheduled snapshots are retained, older ones
+	// are deleted automatically.  Manually created snapshots are unaffected.
+	SnapshotPolicy *InstanceSnapshotPolicy `json:"snapshotPolicy,omitempty"`
+
 	// UserData Contains base64-encoded configuration information or scripts to use upon launch.
 	// The format of the data is governed by the cloud-init standard, and may be a script,
 	// a MIME multipart archive, etc.
 	UserData *[]byte `json:"userData,omitempty"`
 }
 
+// InstanceNetworkMigrate A request to move an instance to another network.
+type InstanceNetworkMigrate struct {
+	// NetworkId The network to move the instance to, this must be in the same region.
+	NetworkId string `json:"networkId"`
+
+	// SecurityGroups Security groups to apply on the new network, replacing the instance's existing
+	// ones.  Security groups are scoped to a network, so these must belong to the new
+	// network.  If not specified, the existing security groups must already belong to
+	// the new network.  An empty list removes all security groups.
+	SecurityGroups *[]string `json:"securityGroups,omitempty"`
+}
+
 // InstanceNetworking A compute instance's network  configuration.
 type InstanceNetworking struct {
 	// AllowedSourceAddresses A list of network prefixes that are allowed to egress from the server.
 	// By default, only packets from the server's network interface's IP address
 	// are allowed to enter the network.  Use of this option allows the server
-	// to act as a router without SNAT rules.
+	// to act as a router without SNAT rules.  Prefixes are applied as allowed
+	// address pairs on the server's port, security group rules still apply.
+	// Each prefix must be a unique IPv4 CIDR with no host bits set.
 	AllowedSourceAddresses *AllowedSourceAddresses `json:"allowedSourceAddresses,omitempty"`
 
 	// PublicIP Whether or not to provision a public IP.
@@ -328,6 +1039,32 @@ type InstanceNetworking struct {
 	SecurityGroups *SecurityGroupIDList `json:"securityGroups,omitempty"`
 }
 
+// InstancePowerSchedule Powers the instance on and off at set times, for example outside of working hours.
+// Each schedule is a standard 5 field cron expression.  Only the most recent scheduled
+// action is applied, so an instance may be manually started or stopped in between.
+type InstancePowerSchedule struct {
+	// Start When to power the instance on.
+	Start *string `json:"start,omitempty"`
+
+	// Stop When to power the instance off.
+	Stop *string `json:"stop,omitempty"`
+
+	// TimeZone The IANA time zone the schedule is evaluated in, defaulting to UTC.
+	TimeZone *string `json:"timeZone,omitempty"`
+}
+
+// InstancePowerScheduleStatus The last scheduled power action applied to an instance.
+type InstancePowerScheduleStatus struct {
+	// LastAction The action that was applied.
+	LastAction InstancePowerScheduleStatusLastAction `json:"lastAction"`
+
+	// LastActionTime When the action was scheduled.
+	LastActionTime time.Time `json:"lastActionTime"`
+}
+
+// InstancePowerScheduleStatusLastAction The action that was applied.
+type InstancePowerScheduleStatusLastAction string
+
 // InstanceRead A compute instance.
 type InstanceRead struct {
 	// Metadata Metadata required by project scoped resource reads.
@@ -346,8 +1083,36 @@ type InstanceSnapshotCreate struct {
 	Metadata externalRef0.ResourceWriteMetadata `json:"metadata"`
 }
 
+// InstanceSnapshotPolicy Snapshots the instance at set times.  The schedule is a standard 5 field cron
+// expression.  Only the most recent scheduled snapshots are retained, older ones
+// are deleted automatically.  Manually created snapshots are unaffected.
+type InstanceSnapshotPolicy struct {
+	// Retention The number of scheduled snapshots to keep.
+	Retention int `json:"retention"`
+
+	// Schedule When to snapshot the instance.
+	Schedule string `json:"schedule"`
+
+	// TimeZone The IANA time zone the schedule is evaluated in, defaulting to UTC.
+	TimeZone *string `json:"timeZone,omitempty"`
+}
+
+// InstanceSnapshotPolicyStatus The last scheduled snapshot taken of an instance.
+type InstanceSnapshotPolicyStatus struct {
+	// LastSnapshotId The image ID of the snapshot.
+	LastSnapshotId string `json:"lastSnapshotId"`
+
+	// LastSnapshotTime When the snapshot was taken.
+	LastSnapshotTime time.Time `json:"lastSnapshotTime"`
+}
+
 // InstanceSpec A compute instance.
 type InstanceSpec struct {
+	// BootstrapProfile The name of an operator provided bootstrap profile e.g. GPU driver and container
+	// runtime installation.  This is rendered for the selected image and flavor and
+	// runs before any user data.
+	BootstrapProfile *string `json:"bootstrapProfile,omitempty"`
+
 	// FlavorId The flavor CPU/RAM of a compute instance.
 	FlavorId string `json:"flavorId"`
 
@@ -357,6 +1122,16 @@ type InstanceSpec struct {
 	// Networking A compute instance's network  configuration.
 	Networking *InstanceNetworking `json:"networking,omitempty"`
 
+	// PowerSchedule Powers the instance on and off at set times, for example outside of working hours.
+	// Each schedule is a standard 5 field cron expression.  Only the most recent scheduled
+	// action is applied, so an instance may be manually started or stopped in between.
+	PowerSchedule *InstancePowerSchedule `json:"powerSchedule,omitempty"`
+
+	// SnapshotPolicy Snapshots the instance at set times.  The schedule is a standard 5 field cron
+	// expression.  Only the most recent scheduled snapshots are retained, older ones
+	// are deleted automatically.  Manually created snapshots are unaffected.
+	SnapshotPolicy *InstanceSnapshotPolicy `json:"snapshotPolicy,omitempty"`
+
 	// UserData Contains base64-encoded configuration information or scripts to use upon launch.
 	// The format of the data is governed by the cloud-init standard, and may be a script,
 	// a MIME multipart archive, etc.
@@ -368,6 +1143,9 @@ type InstanceStatus struct {
 	// NetworkId The network a security group belongs to.
 	NetworkId string `json:"networkId"`
 
+	// PowerSchedule The last scheduled power action applied to an instance.
+	PowerSchedule *InstancePowerScheduleStatus `json:"powerSchedule,omitempty"`
+
 	// PowerState The lifecycle phase of an instance.
 	PowerState *externalRef1.InstanceLifecyclePhase `json:"powerState,omitempty"`
 
@@ -379,6 +1157,9 @@ type InstanceStatus struct {
 
 	// RegionId The region a security group belongs to.
 	RegionId string `json:"regionId"`
+
+	// SnapshotPolicy The last scheduled snapshot taken of an instance.
+	SnapshotPolicy *InstanceSnapshotPolicyStatus `json:"snapshotPolicy,omitempty"`
 }
 
 // InstanceUpdate A compute instance update request.
@@ -396,6 +1177,79 @@ type InstancesRead = []InstanceRead
 // KubernetesNameParameter A Kubernetes name. Must be a valid DNS containing only lower case characters, numbers or hyphens, start and end with a character or number, and be at most 63 characters in length.
 type KubernetesNameParameter = string
 
+// ListSort The order in which to list resources.
+type ListSort string
+
+// MachineCondition A machine status condition, recording when the machine last changed state.
+type MachineCondition struct {
+	// LastTransitionTime When the condition last changed.
+	LastTransitionTime time.Time `json:"lastTransitionTime"`
+
+	// Message A human readable description of the condition.
+	Message string `json:"message"`
+
+	// Reason A short, machine readable, reason for the condition.
+	Reason string `json:"reason"`
+
+	// Status Whether the condition is true, false or unknown.
+	Status string `json:"status"`
+
+	// Type The condition type.
+	Type string `json:"type"`
+}
+
+// MachineConditions A list of machine conditions.
+type MachineConditions = []MachineCondition
+
+// MachineDiagnostics A diagnostic bundle for a machine, assembled from the cluster's status, the region
+// service and controller events.
+type MachineDiagnostics struct {
+	// CollectionTime When the bundle was collected.
+	CollectionTime time.Time `json:"collectionTime"`
+
+	// Conditions A list of machine conditions.
+	Conditions *MachineConditions `json:"conditions,omitempty"`
+
+	// ConsoleOutput The most recent console output from the machine.
+	ConsoleOutput *string `json:"consoleOutput,omitempty"`
+
+	// Errors Any data sources that could not be collected, and why.
+	Errors *[]string `json:"errors,omitempty"`
+
+	// Events A list of cluster events, most recent first.
+	Events ClusterEvents `json:"events"`
+
+	// Machine Compute cluster machine status.
+	Machine *ComputeClusterMachineStatus `json:"machine,omitempty"`
+
+	// MachineId The machine the bundle describes.
+	MachineId string `json:"machineId"`
+
+	// SecurityGroupIds Security groups the machine is a member of.
+	SecurityGroupIds []string `json:"securityGroupIds"`
+
+	// Server A server.
+	Server *externalRef1.ServerRead `json:"server,omitempty"`
+}
+
+// MachineEvictionStatus The progress of a machine eviction.
+type MachineEvictionStatus struct {
+	// Id Machine ID.
+	Id string `json:"id"`
+
+	// Message Additional detail when the eviction has failed.
+	Message *string `json:"message,omitempty"`
+
+	// Status The current state of the eviction.
+	Status MachineEvictionStatusStatus `json:"status"`
+}
+
+// MachineEvictionStatusStatus The current state of the eviction.
+type MachineEvictionStatusStatus string
+
+// MachineEvictionsStatus A list of machine eviction statuses.
+type MachineEvictionsStatus = []MachineEvictionStatus
+
 // MachineIDList A list of machine IDs, these are returned in the cluster status.
 type MachineIDList = []string
 
@@ -404,6 +1258,11 @@ type MachinePool struct {
 	// AllowedAddressPairs A list of allowed address pairs.
 	AllowedAddressPairs *AllowedAddressPairList `json:"allowedAddressPairs,omitempty"`
 
+	// BootstrapProfile The name of an operator provided bootstrap profile e.g. GPU driver and container
+	// runtime installation.  This is rendered for the selected image and flavor and
+	// runs before any user data.
+	BootstrapProfile *string `json:"bootstrapProfile,omitempty"`
+
 	// Disk A volume.  This is currently only valid for VM based flavors.
 	Disk *Volume `json:"disk,omitempty"`
 
@@ -413,21 +1272,133 @@ type MachinePool struct {
 	// FlavorId Flavor ID.
 	FlavorId string `json:"flavorId"`
 
-	// Image The image to use for a server.
-	Image ComputeImage `json:"image"`
+	// Image The image to use for a server.
+	Image ComputeImage `json:"image"`
+
+	// PublicIPAllocation A public IP allocation settings.
+	PublicIPAllocation *PublicIPAllocation `json:"publicIPAllocation,omitempty"`
+
+	// Replicas Number of machines.
+	Replicas int `json:"replicas"`
+
+	// SecurityGroupIds Pre-existing security groups to attach to machines, in addition to any that are
+	// managed for the firewall rules.  These must belong to the same project and region
+	// as the cluster.
+	SecurityGroupIds *[]string `json:"securityGroupIds,omitempty"`
+
+	// UserData UserData contains base64-encoded configuration information or scripts to use upon launch.
+	UserData *[]byte `json:"userData,omitempty"`
+
+	// UserDataTemplate When true, user data is expanded as a Go template for each machine.  Available
+	// variables are .ClusterID, .ClusterName, .PoolName, .MachineName, .FQDN and
+	// .PrivateNetworkCIDR e.g. "hostnamectl set-hostname {{ .FQDN }}".
+	UserDataTemplate *bool `json:"userDataTemplate,omitempty"`
+}
+
+// MachineResizeWrite A request to change the flavor of a machine.
+type MachineResizeWrite struct {
+	// FlavorId The flavor to resize the machine to.
+	FlavorId string `json:"flavorId"`
+}
+
+// MaintenanceWindowRead A maintenance window.
+type MaintenanceWindowRead struct {
+	// Metadata Metadata required by all resource reads.
+	Metadata externalRef0.ResourceReadMetadata `json:"metadata"`
+
+	// Spec A period of provider maintenance.  At least one of a region or set of machines
+	// must be specified.  If only a region is specified, all machines in that region
+	// are affected.
+	Spec MaintenanceWindowSpec `json:"spec"`
+}
+
+// MaintenanceWindowReadList A list of maintenance windows.
+type MaintenanceWindowReadList = []MaintenanceWindowRead
+
+// MaintenanceWindowSpec A period of provider maintenance.  At least one of a region or set of machines
+// must be specified.  If only a region is specified, all machines in that region
+// are affected.
+type MaintenanceWindowSpec struct {
+	// End When maintenance is expected to be complete.
+	End time.Time `json:"end"`
+
+	// MachineIds The machines under maintenance.
+	MachineIds *[]string `json:"machineIds,omitempty"`
+
+	// Reason A description of the maintenance.
+	Reason *string `json:"reason,omitempty"`
+
+	// RegionId The region under maintenance.
+	RegionId *string `json:"regionId,omitempty"`
+
+	// Start When maintenance begins.
+	Start time.Time `json:"start"`
+}
+
+// MaintenanceWindowWrite A maintenance window create or update request.
+type MaintenanceWindowWrite struct {
+	// Metadata Metadata required for all API resource reads and writes.
+	Metadata externalRef0.ResourceWriteMetadata `json:"metadata"`
+
+	// Spec A period of provider maintenance.  At least one of a region or set of machines
+	// must be specified.  If only a region is specified, all machines in that region
+	// are affected.
+	Spec MaintenanceWindowSpec `json:"spec"`
+}
+
+// PoolGoldenImageWrite A request to build a workload pool's image from an instance.
+type PoolGoldenImageWrite struct {
+	// InstanceId The instance to snapshot.
+	InstanceId string `json:"instanceId"`
+}
+
+// PoolPowerMode How to apply the operation.  Parallel operates on machines concurrently, while
+// rolling operates on one machine at a time and stops at the first failure.
+type PoolPowerMode string
+
+// PoolPowerResult The outcome of a power operation on a single machine.
+type PoolPowerResult struct {
+	// Id Machine ID.
+	Id string `json:"id"`
+
+	// Message Additional detail when the operation has failed.
+	Message *string `json:"message,omitempty"`
+
+	// Pool The workload pool the machine is a member of.
+	Pool *string `json:"pool,omitempty"`
+
+	// Status Whether the operation was accepted by the machine's provider, failed, or was
+	// skipped due to an earlier failure during a rolling operation.
+	Status PoolPowerResultStatus `json:"status"`
+}
+
+// PoolPowerResultStatus Whether the operation was accepted by the machine's provider, failed, or was
+// skipped due to an earlier failure during a rolling operation.
+type PoolPowerResultStatus string
 
-	// PublicIPAllocation A public IP allocation settings.
-	PublicIPAllocation *PublicIPAllocation `json:"publicIPAllocation,omitempty"`
+// PoolPowerResults A list of per-machine power operation outcomes.
+type PoolPowerResults = []PoolPowerResult
 
-	// Replicas Number of machines.
-	Replicas int `json:"replicas"`
+// PoolPowerWrite A power operation to apply to all machines in a workload pool.
+type PoolPowerWrite struct {
+	// Action The power operation to perform.
+	Action PoolPowerWriteAction `json:"action"`
 
-	// UserData UserData contains base64-encoded configuration information or scripts to use upon launch.
-	UserData *[]byte `json:"userData,omitempty"`
+	// Mode How to apply the operation.  Parallel operates on machines concurrently, while
+	// rolling operates on one machine at a time and stops at the first failure.
+	Mode *PoolPowerMode `json:"mode,omitempty"`
 }
 
+// PoolPowerWriteAction The power operation to perform.
+type PoolPowerWriteAction string
+
 // PoolV2 A workload pool.
 type PoolV2 struct {
+	// BootstrapProfile The name of an operator provided bootstrap profile e.g. GPU driver and container
+	// runtime installation.  This is rendered for the selected image and flavor and
+	// runs before any user data.
+	BootstrapProfile *string `json:"bootstrapProfile,omitempty"`
+
 	// FlavorId The flavor CPU/RAM of a compute instance.
 	FlavorId string `json:"flavorId"`
 
@@ -470,21 +1441,175 @@ type PublicIPAllocation struct {
 	Enabled bool `json:"enabled"`
 }
 
+// RebootType The type of reboot.
+type RebootType string
+
+// ResourceTags The tags of a resource.
+type ResourceTags struct {
+	// ResourceVersion An opaque version of the resource the tags were read at.  This may be provided
+	// when patching tags to guard against concurrent modification.
+	ResourceVersion string `json:"resourceVersion"`
+
+	// Tags A list of tags.
+	Tags externalRef0.TagList `json:"tags"`
+}
+
+// ResourceTagsPatch A set of tag modifications.  Tags are set before any are removed.  System tags
+// may be neither set nor removed.
+type ResourceTagsPatch struct {
+	// Remove The names of tags to remove.  Names that do not exist are ignored.
+	Remove *[]string `json:"remove,omitempty"`
+
+	// ResourceVersion If set, the patch is only applied if the resource has not been modified since this version was read.
+	ResourceVersion *string `json:"resourceVersion,omitempty"`
+
+	// Set A list of tags.
+	Set *externalRef0.TagList `json:"set,omitempty"`
+}
+
 // SecurityGroupIDList A list of security group IDs.
 type SecurityGroupIDList = []string
 
+// VersionRead Build and runtime information for the service.
+type VersionRead struct {
+	// ApiVersions API versions that are served.
+	ApiVersions []string `json:"apiVersions"`
+
+	// Application The application name.
+	Application string `json:"application"`
+
+	// Features Optional features that are enabled.
+	Features []string `json:"features"`
+
+	// Revision The source control revision the application was built from.
+	Revision string `json:"revision"`
+
+	// Version The application version.
+	Version string `json:"version"`
+}
+
 // Volume A volume.  This is currently only valid for VM based flavors.
 type Volume struct {
 	// Size Disk size in GiB.
 	Size int `json:"size"`
 }
 
+// WebhookDelivery An event that could not be delivered.
+type WebhookDelivery struct {
+	// Attempts How many times delivery was attempted.
+	Attempts int `json:"attempts"`
+
+	// Error Why the last attempt failed.
+	Error string `json:"error"`
+
+	// EventId The unique event ID.
+	EventId string `json:"eventId"`
+
+	// EventType The type of lifecycle event.
+	EventType WebhookEventType `json:"eventType"`
+
+	// Payload The event body, so it may be inspected or replayed.
+	Payload string `json:"payload"`
+
+	// Time When delivery was abandoned.
+	Time time.Time `json:"time"`
+}
+
+// WebhookDeliveryList A list of failed deliveries, most recent first.
+type WebhookDeliveryList = []WebhookDelivery
+
+// WebhookEventType The type of lifecycle event.
+type WebhookEventType string
+
+// WebhookRead A webhook.
+type WebhookRead struct {
+	// Metadata Metadata required by organization scoped resource reads.
+	Metadata externalRef0.OrganizationScopedResourceReadMetadata `json:"metadata"`
+
+	// Spec Where and which events are delivered.
+	Spec WebhookSpec `json:"spec"`
+
+	// Status The webhook's delivery status.
+	Status WebhookStatus `json:"status"`
+}
+
+// WebhookReadList A list of webhooks.
+type WebhookReadList = []WebhookRead
+
+// WebhookSpec Where and which events are delivered.
+type WebhookSpec struct {
+	// Events The event types to deliver, if not set all events are delivered.
+	Events *[]WebhookEventType `json:"events,omitempty"`
+
+	// Url The URL events are posted to.  Each event is signed with the webhook's
+	// secret, the X-Compute-Signature header contains "sha256=" followed by
+	// the hex encoded HMAC-SHA256 of the body.  Only HTTPS is supported, and
+	// the host must not resolve, or redirect, to a loopback, link-local or
+	// private address.
+	Url string `json:"url"`
+}
+
+// WebhookStatus The webhook's delivery status.
+type WebhookStatus struct {
+	// FailedDeliveries A list of failed deliveries, most recent first.
+	FailedDeliveries *WebhookDeliveryList `json:"failedDeliveries,omitempty"`
+}
+
+// WebhookWrite A webhook create or update request.
+type WebhookWrite struct {
+	// Metadata Metadata required for all API resource reads and writes.
+	Metadata externalRef0.ResourceWriteMetadata `json:"metadata"`
+
+	// Secret The key used to sign events.  This is required on creation, and is
+	// never returned.
+	Secret *string `json:"secret,omitempty"`
+
+	// Spec Where and which events are delivered.
+	Spec WebhookSpec `json:"spec"`
+}
+
+// AdminResourceKindQueryParameter defines model for adminResourceKindQueryParameter.
+type AdminResourceKindQueryParameter = []AdminResourceKind
+
 // ClusterIDParameter A Kubernetes name. Must be a valid DNS containing only lower case characters, numbers or hyphens, start and end with a character or number, and be at most 63 characters in length.
 type ClusterIDParameter = KubernetesNameParameter
 
+// ClusterTemplateIDParameter A Kubernetes name. Must be a valid DNS containing only lower case characters, numbers or hyphens, start and end with a character or number, and be at most 63 characters in length.
+type ClusterTemplateIDParameter = KubernetesNameParameter
+
+// CreatedAfterQueryParameter defines model for createdAfterQueryParameter.
+type CreatedAfterQueryParameter = time.Time
+
+// FieldsParameter defines model for fieldsParameter.
+type FieldsParameter = []string
+
+// FirewallRuleIDParameter defines model for firewallRuleIDParameter.
+type FirewallRuleIDParameter = string
+
+// FollowParameter defines model for followParameter.
+type FollowParameter = bool
+
+// GenerationParameter defines model for generationParameter.
+type GenerationParameter = int64
+
 // HardRebootParameter defines model for hardRebootParameter.
 type HardRebootParameter = bool
 
+// HealthStatusQueryParameter defines model for healthStatusQueryParameter.
+type HealthStatusQueryParameter = []externalRef0.ResourceHealthStatus
+
+// HostnameParameter A Kubernetes name. Must be a valid DNS containing only lower case characters, numbers or hyphens, start and end with a character or number, and be at most 63 characters in length.
+type HostnameParameter = KubernetesNameParameter
+
+// IfMatchParameter defines model for ifMatchParameter.
+type IfMatchParameter = string
+
+// IfNoneMatchParameter defines model for ifNoneMatchParameter.
+type IfNoneMatchParameter = string
+
+// InstanceCountParameter defines model for instanceCountParameter.
+type InstanceCountParameter = int
+
 // InstanceIDParameter A Kubernetes name. Must be a valid DNS containing only lower case characters, numbers or hyphens, start and end with a character or number, and be at most 63 characters in length.
 type InstanceIDParameter = KubernetesNameParameter
 
@@ -494,48 +1619,190 @@ type LengthParameter = int
 // MachineIDParameter A Kubernetes name. Must be a valid DNS containing only lower case characters, numbers or hyphens, start and end with a character or number, and be at most 63 characters in length.
 type MachineIDParameter = KubernetesNameParameter
 
+// MachineStatusQueryParameter defines model for machineStatusQueryParameter.
+type MachineStatusQueryParameter = []externalRef1.InstanceLifecyclePhase
+
+// MaintenanceWindowIDParameter A Kubernetes name. Must be a valid DNS containing only lower case characters, numbers or hyphens, start and end with a character or number, and be at most 63 characters in length.
+type MaintenanceWindowIDParameter = KubernetesNameParameter
+
+// NameQueryParameter defines model for nameQueryParameter.
+type NameQueryParameter = string
+
 // NetworkIDQueryParameter defines model for networkIDQueryParameter.
-type NetworkIDQueryParameter = []string
+type NetworkIDQueryParameter = []KubernetesNameParameter
 
 // OrganizationIDParameter A Kubernetes name. Must be a valid DNS containing only lower case characters, numbers or hyphens, start and end with a character or number, and be at most 63 characters in length.
 type OrganizationIDParameter = KubernetesNameParameter
 
 // OrganizationIDQueryParameter defines model for organizationIDQueryParameter.
-type OrganizationIDQueryParameter = []string
+type OrganizationIDQueryParameter = []KubernetesNameParameter
+
+// PoolNameParameter A Kubernetes name. Must be a valid DNS containing only lower case characters, numbers or hyphens, start and end with a character or number, and be at most 63 characters in length.
+type PoolNameParameter = KubernetesNameParameter
+
+// PoolQueryParameter defines model for poolQueryParameter.
+type PoolQueryParameter = []string
+
+// PowerModeParameter How to apply the operation.  Parallel operates on machines concurrently, while
+// rolling operates on one machine at a time and stops at the first failure.
+type PowerModeParameter = PoolPowerMode
+
+// PowerStateQueryParameter defines model for powerStateQueryParameter.
+type PowerStateQueryParameter = []externalRef1.InstanceLifecyclePhase
 
 // ProjectIDParameter A Kubernetes name. Must be a valid DNS containing only lower case characters, numbers or hyphens, start and end with a character or number, and be at most 63 characters in length.
 type ProjectIDParameter = KubernetesNameParameter
 
 // ProjectIDQueryParameter defines model for projectIDQueryParameter.
-type ProjectIDQueryParameter = []string
+type ProjectIDQueryParameter = []KubernetesNameParameter
+
+// ProvisioningStatusQueryParameter defines model for provisioningStatusQueryParameter.
+type ProvisioningStatusQueryParameter = []externalRef0.ResourceProvisioningStatus
+
+// QuotaFlavorIDParameter defines model for quotaFlavorIDParameter.
+type QuotaFlavorIDParameter = string
+
+// QuotaRegionIDParameter defines model for quotaRegionIDParameter.
+type QuotaRegionIDParameter = string
+
+// RebootTypeParameter The type of reboot.
+type RebootTypeParameter = RebootType
 
 // RegionIDParameter A Kubernetes name. Must be a valid DNS containing only lower case characters, numbers or hyphens, start and end with a character or number, and be at most 63 characters in length.
 type RegionIDParameter = KubernetesNameParameter
 
 // RegionIDQueryParameter defines model for regionIDQueryParameter.
-type RegionIDQueryParameter = []string
+type RegionIDQueryParameter = []KubernetesNameParameter
+
+// SecurityGroupIDParameter A Kubernetes name. Must be a valid DNS containing only lower case characters, numbers or hyphens, start and end with a character or number, and be at most 63 characters in length.
+type SecurityGroupIDParameter = KubernetesNameParameter
+
+// SignatureParameter defines model for signatureParameter.
+type SignatureParameter = string
+
+// SortQueryParameter The order in which to list resources.
+type SortQueryParameter = ListSort
+
+// WebhookIDParameter A Kubernetes name. Must be a valid DNS containing only lower case characters, numbers or hyphens, start and end with a character or number, and be at most 63 characters in length.
+type WebhookIDParameter = KubernetesNameParameter
+
+// AdminResourceListResponse A list of compute resources.
+type AdminResourceListResponse = AdminResourceReadList
+
+// ClusterEventsResponse A list of cluster events, most recent first.
+type ClusterEventsResponse = ClusterEvents
+
+// ClusterPowerResponse A list of per-machine power operation outcomes.
+type ClusterPowerResponse = PoolPowerResults
+
+// ClusterTemplateListResponse A list of cluster templates.
+type ClusterTemplateListResponse = ClusterTemplateReadList
+
+// ClusterTemplateResponse A cluster template.
+type ClusterTemplateResponse = ClusterTemplateRead
 
 // ClusterV2ListResponse A list of compute clusters.
 type ClusterV2ListResponse = ClusterV2ReadList
 
+// ClusterV2PreviewResponse The effect of a cluster update.
+type ClusterV2PreviewResponse = ClusterV2Preview
+
 // ClusterV2Response A compute cluster.
 type ClusterV2Response = ClusterV2Read
 
+// ComputeBadRequestResponse A generic error, augmented with a machine readable code where one is defined.
+type ComputeBadRequestResponse = ComputeError
+
 // ComputeClusterDetailResponse Compute cluster read.
 type ComputeClusterDetailResponse = ComputeClusterRead
 
+// ComputeClusterMachinesResponse A list of compute cluster machines.
+type ComputeClusterMachinesResponse = ComputeClusterMachineList
+
+// ComputeClusterPreviewResponse The effect of a cluster update.
+type ComputeClusterPreviewResponse = ComputeClusterPreview
+
 // ComputeClusterResponse Compute cluster read.
 type ComputeClusterResponse = ComputeClusterRead
 
 // ComputeClustersResponse A list of Compute clusters.
 type ComputeClustersResponse = ComputeClusters
 
+// ComputeConflictResponse A generic error, augmented with a machine readable code where one is defined.
+type ComputeConflictResponse = ComputeError
+
+// ComputePreconditionFailedResponse A generic error, augmented with a machine readable code where one is defined.
+type ComputePreconditionFailedResponse = ComputeError
+
+// ComputeQuotasResponse An organization's compute quotas.
+type ComputeQuotasResponse = ComputeQuotas
+
+// ConsoleOutputResponse Console output
+type ConsoleOutputResponse = externalRef1.ConsoleOutput
+
+// FirewallRuleResponse A firewall rule applied to a workload pool, with its identifier.
+type FirewallRuleResponse = FirewallRuleRead
+
+// FirewallRulesResponse A list of firewall rules applied to a workload pool.
+type FirewallRulesResponse = FirewallRulesRead
+
+// FlavorsAvailabilityResponse A list of flavor availabilities.
+type FlavorsAvailabilityResponse = FlavorsAvailability
+
+// InstanceCreateResponse defines model for instanceCreateResponse.
+type InstanceCreateResponse struct {
+	union json.RawMessage
+}
+
 // InstanceResponse A compute instance.
 type InstanceResponse = InstanceRead
 
 // InstancesResponse A list of compute instances.
 type InstancesResponse = InstancesRead
 
+// MachineDiagnosticsResponse A diagnostic bundle for a machine, assembled from the cluster's status, the region
+// service and controller events.
+type MachineDiagnosticsResponse = MachineDiagnostics
+
+// MachineEvictionsResponse A list of machine eviction statuses.
+type MachineEvictionsResponse = MachineEvictionsStatus
+
+// MaintenanceWindowListResponse A list of maintenance windows.
+type MaintenanceWindowListResponse = MaintenanceWindowReadList
+
+// MaintenanceWindowResponse A maintenance window.
+type MaintenanceWindowResponse = MaintenanceWindowRead
+
+// PoolPowerResponse A list of per-machine power operation outcomes.
+type PoolPowerResponse = PoolPowerResults
+
+// ResourceTagsResponse The tags of a resource.
+type ResourceTagsResponse = ResourceTags
+
+// SecurityGroupResponse A security group.
+type SecurityGroupResponse = externalRef1.SecurityGroupV2Read
+
+// SecurityGroupsResponse A list of security groups.
+type SecurityGroupsResponse = externalRef1.SecurityGroupsV2Read
+
+// VersionResponse Build and runtime information for the service.
+type VersionResponse = VersionRead
+
+// WebhookListResponse A list of webhooks.
+type WebhookListResponse = WebhookReadList
+
+// WebhookResponse A webhook.
+type WebhookResponse = WebhookRead
+
+// AdoptionRequest A set of existing servers to adopt into a cluster's workload pool.
+type AdoptionRequest = AdoptionWrite
+
+// ClusterTemplateInstantiateRequest A request to create a cluster from a template.
+type ClusterTemplateInstantiateRequest = ClusterTemplateInstantiate
+
+// ClusterTemplateRequest A cluster template create or update request.
+type ClusterTemplateRequest = ClusterTemplateWrite
+
 // ClusterV2CreateRequest A cluster creation request.
 type ClusterV2CreateRequest = ClusterV2Create
 
@@ -545,29 +1812,164 @@ type ClusterV2UpdateRequest = ClusterV2Update
 // CreateComputeClusterRequest Compute cluster create or update.
 type CreateComputeClusterRequest = ComputeClusterWrite
 
-// EvictionRequest A set of machines to evict from a cluster.
+// EvictionRequest A set of machines to evict from a cluster.  Machines may be identified by ID, host
+// name, or a mixture of both, at least one machine must be specified.
 type EvictionRequest = EvictionWrite
 
+// FirewallRuleCreateRequest A firewall rule applied to a workload pool.
+type FirewallRuleCreateRequest = FirewallRule
+
 // InstanceCreateRequest A compute instance creation request.
 type InstanceCreateRequest = InstanceCreate
 
+// InstanceNetworkMigrateRequest A request to move an instance to another network.
+type InstanceNetworkMigrateRequest = InstanceNetworkMigrate
+
 // InstanceSnapshotRequest A compute instance snapshot request.
 type InstanceSnapshotRequest = InstanceSnapshotCreate
 
 // InstanceUpdateRequest A compute instance update request.
 type InstanceUpdateRequest = InstanceUpdate
 
+// MachineResizeRequest A request to change the flavor of a machine.
+type MachineResizeRequest = MachineResizeWrite
+
+// MaintenanceWindowRequest A maintenance window create or update request.
+type MaintenanceWindowRequest = MaintenanceWindowWrite
+
+// PoolGoldenImageRequest A request to build a workload pool's image from an instance.
+type PoolGoldenImageRequest = PoolGoldenImageWrite
+
+// PoolPowerRequest A power operation to apply to all machines in a workload pool.
+type PoolPowerRequest = PoolPowerWrite
+
+// ResourceTagsPatchRequest A set of tag modifications.  Tags are set before any are removed.  System tags
+// may be neither set nor removed.
+type ResourceTagsPatchRequest = ResourceTagsPatch
+
+// SecurityGroupCreateRequest A security group request.
+type SecurityGroupCreateRequest = externalRef1.SecurityGroupV2Create
+
+// SecurityGroupUpdateRequest A security group request.
+type SecurityGroupUpdateRequest = externalRef1.SecurityGroupV2Update
+
+// WebhookRequest A webhook create or update request.
+type WebhookRequest = WebhookWrite
+
+// PostApiV1ClustersClusterIDMachinesHostnameBootfinishedParams defines parameters for PostApiV1ClustersClusterIDMachinesHostnameBootfinished.
+type PostApiV1ClustersClusterIDMachinesHostnameBootfinishedParams struct {
+	// Signature A signature that authenticates the request.
+	Signature SignatureParameter `form:"signature" json:"signature"`
+}
+
 // GetApiV1OrganizationsOrganizationIDClustersParams defines parameters for GetApiV1OrganizationsOrganizationIDClusters.
 type GetApiV1OrganizationsOrganizationIDClustersParams struct {
+	// Fields Trims list items down to the selected fields to reduce the response size.
+	// Fields are dot separated paths e.g. "metadata.name" or "status", a path that
+	// passes through an array applies to each element.  The resource ID is always
+	// returned, and sparse items will not contain all fields marked as required.
+	Fields *FieldsParameter `form:"fields,omitempty" json:"fields,omitempty"`
+
 	// Tag A set of tags to match against resources in the form "name=value",
 	// thus when encoded you get "?tag=foo%3Dcat&tag=bar%3Ddog".
 	Tag *externalRef0.TagSelectorParameter `form:"tag,omitempty" json:"tag,omitempty"`
 }
 
+// PutApiV1OrganizationsOrganizationIDProjectsProjectIDClustersClusterIDParams defines parameters for PutApiV1OrganizationsOrganizationIDProjectsProjectIDClustersClusterID.
+type PutApiV1OrganizationsOrganizationIDProjectsProjectIDClustersClusterIDParams struct {
+	// IfMatch Only apply the update if the resource's entity tag, as returned in the ETag
+	// header when it was read, matches.  This guards against concurrent modification.
+	IfMatch *IfMatchParameter `json:"If-Match,omitempty"`
+}
+
+// GetApiV1OrganizationsOrganizationIDProjectsProjectIDClustersClusterIDMachinesParams defines parameters for GetApiV1OrganizationsOrganizationIDProjectsProjectIDClustersClusterIDMachines.
+type GetApiV1OrganizationsOrganizationIDProjectsProjectIDClustersClusterIDMachinesParams struct {
+	// Pool Allows machines to be filtered by workload pool name.
+	Pool *PoolQueryParameter `form:"pool,omitempty" json:"pool,omitempty"`
+
+	// Status Allows machines to be filtered by status.
+	Status *MachineStatusQueryParameter `form:"status,omitempty" json:"status,omitempty"`
+
+	// HealthStatus Allows resources to be filtered by health status.
+	HealthStatus *HealthStatusQueryParameter `form:"healthStatus,omitempty" json:"healthStatus,omitempty"`
+}
+
 // GetApiV1OrganizationsOrganizationIDProjectsProjectIDClustersClusterIDMachinesMachineIDConsoleoutputParams defines parameters for GetApiV1OrganizationsOrganizationIDProjectsProjectIDClustersClusterIDMachinesMachineIDConsoleoutput.
 type GetApiV1OrganizationsOrganizationIDProjectsProjectIDClustersClusterIDMachinesMachineIDConsoleoutputParams struct {
 	// Length The requested output length.
 	Length *LengthParameter `form:"length,omitempty" json:"length,omitempty"`
+
+	// Follow Stream new output as it is written.
+	Follow *FollowParameter `form:"follow,omitempty" json:"follow,omitempty"`
+}
+
+// PostApiV1OrganizationsOrganizationIDProjectsProjectIDClustersClusterIDRollbackParams defines parameters for PostApiV1OrganizationsOrganizationIDProjectsProjectIDClustersClusterIDRollback.
+type PostApiV1OrganizationsOrganizationIDProjectsProjectIDClustersClusterIDRollbackParams struct {
+	// Generation The cluster generation whose specification is to be restored.
+	Generation GenerationParameter `form:"generation" json:"generation"`
+}
+
+// PostApiV1OrganizationsOrganizationIDProjectsProjectIDClustersClusterIDStartParams defines parameters for PostApiV1OrganizationsOrganizationIDProjectsProjectIDClustersClusterIDStart.
+type PostApiV1OrganizationsOrganizationIDProjectsProjectIDClustersClusterIDStartParams struct {
+	// Mode How to apply the power operation, by default machines are operated on in parallel.
+	Mode *PowerModeParameter `form:"mode,omitempty" json:"mode,omitempty"`
+}
+
+// PostApiV1OrganizationsOrganizationIDProjectsProjectIDClustersClusterIDStopParams defines parameters for PostApiV1OrganizationsOrganizationIDProjectsProjectIDClustersClusterIDStop.
+type PostApiV1OrganizationsOrganizationIDProjectsProjectIDClustersClusterIDStopParams struct {
+	// Mode How to apply the power operation, by default machines are operated on in parallel.
+	Mode *PowerModeParameter `form:"mode,omitempty" json:"mode,omitempty"`
+}
+
+// GetApiV1OrganizationsOrganizationIDQuotasComputeParams defines parameters for GetApiV1OrganizationsOrganizationIDQuotasCompute.
+type GetApiV1OrganizationsOrganizationIDQuotasComputeParams struct {
+	// RegionID The region a flavor belongs to, required when a flavor is specified.
+	RegionID *QuotaRegionIDParameter `form:"regionID,omitempty" json:"regionID,omitempty"`
+
+	// FlavorID The flavor to report availability for.
+	FlavorID *QuotaFlavorIDParameter `form:"flavorID,omitempty" json:"flavorID,omitempty"`
+}
+
+// GetApiV1OrganizationsOrganizationIDRegionsParams defines parameters for GetApiV1OrganizationsOrganizationIDRegions.
+type GetApiV1OrganizationsOrganizationIDRegionsParams struct {
+	// IfNoneMatch Only return the response body if its entity tag, as returned in the ETag header
+	// of a previous read, no longer matches.  This allows clients to cheaply revalidate
+	// cached responses.
+	IfNoneMatch *IfNoneMatchParameter `json:"If-None-Match,omitempty"`
+}
+
+// GetApiV1OrganizationsOrganizationIDRegionsRegionIDFlavorsParams defines parameters for GetApiV1OrganizationsOrganizationIDRegionsRegionIDFlavors.
+type GetApiV1OrganizationsOrganizationIDRegionsRegionIDFlavorsParams struct {
+	// IfNoneMatch Only return the response body if its entity tag, as returned in the ETag header
+	// of a previous read, no longer matches.  This allows clients to cheaply revalidate
+	// cached responses.
+	IfNoneMatch *IfNoneMatchParameter `json:"If-None-Match,omitempty"`
+}
+
+// GetApiV1OrganizationsOrganizationIDRegionsRegionIDImagesParams defines parameters for GetApiV1OrganizationsOrganizationIDRegionsRegionIDImages.
+type GetApiV1OrganizationsOrganizationIDRegionsRegionIDImagesParams struct {
+	// IfNoneMatch Only return the response body if its entity tag, as returned in the ETag header
+	// of a previous read, no longer matches.  This allows clients to cheaply revalidate
+	// cached responses.
+	IfNoneMatch *IfNoneMatchParameter `json:"If-None-Match,omitempty"`
+}
+
+// GetApiV2AdminResourcesParams defines parameters for GetApiV2AdminResources.
+type GetApiV2AdminResourcesParams struct {
+	// OrganizationID Allows resources to be filtered by organization.
+	OrganizationID *OrganizationIDQueryParameter `form:"organizationID,omitempty" json:"organizationID,omitempty"`
+
+	// RegionID Allows resources to be filtered by region.
+	RegionID *RegionIDQueryParameter `form:"regionID,omitempty" json:"regionID,omitempty"`
+
+	// Kind Allows resources to be filtered by kind.
+	Kind *AdminResourceKindQueryParameter `form:"kind,omitempty" json:"kind,omitempty"`
+
+	// ProvisioningStatus Allows resources to be filtered by provisioning status.
+	ProvisioningStatus *ProvisioningStatusQueryParameter `form:"provisioningStatus,omitempty" json:"provisioningStatus,omitempty"`
+
+	// HealthStatus Allows resources to be filtered by health status.
+	HealthStatus *HealthStatusQueryParameter `form:"healthStatus,omitempty" json:"healthStatus,omitempty"`
 }
 
 // GetApiV2ClustersParams defines parameters for GetApiV2Clusters.
@@ -587,6 +1989,32 @@ type GetApiV2ClustersParams struct {
 
 	// NetworkID Allows resources to be filtered by network.
 	NetworkID *NetworkIDQueryParameter `form:"networkID,omitempty" json:"networkID,omitempty"`
+
+	// Name Allows resources to be filtered by a case insensitive substring of their name.
+	Name *NameQueryParameter `form:"name,omitempty" json:"name,omitempty"`
+
+	// ProvisioningStatus Allows resources to be filtered by provisioning status.
+	ProvisioningStatus *ProvisioningStatusQueryParameter `form:"provisioningStatus,omitempty" json:"provisioningStatus,omitempty"`
+
+	// CreatedAfter Allows resources to be filtered to those created after the given time.
+	CreatedAfter *CreatedAfterQueryParameter `form:"createdAfter,omitempty" json:"createdAfter,omitempty"`
+
+	// Sort Orders the results by name, creation time or provisioning status.  By default
+	// results are ordered by ID.
+	Sort *SortQueryParameter `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Fields Trims list items down to the selected fields to reduce the response size.
+	// Fields are dot separated paths e.g. "metadata.name" or "status", a path that
+	// passes through an array applies to each element.  The resource ID is always
+	// returned, and sparse items will not contain all fields marked as required.
+	Fields *FieldsParameter `form:"fields,omitempty" json:"fields,omitempty"`
+}
+
+// PutApiV2ClustersClusterIDParams defines parameters for PutApiV2ClustersClusterID.
+type PutApiV2ClustersClusterIDParams struct {
+	// IfMatch Only apply the update if the resource's entity tag, as returned in the ETag
+	// header when it was read, matches.  This guards against concurrent modification.
+	IfMatch *IfMatchParameter `json:"If-Match,omitempty"`
 }
 
 // GetApiV2InstancesParams defines parameters for GetApiV2Instances.
@@ -606,18 +2034,80 @@ type GetApiV2InstancesParams struct {
 
 	// NetworkID Allows resources to be filtered by network.
 	NetworkID *NetworkIDQueryParameter `form:"networkID,omitempty" json:"networkID,omitempty"`
+
+	// Name Allows resources to be filtered by a case insensitive substring of their name.
+	Name *NameQueryParameter `form:"name,omitempty" json:"name,omitempty"`
+
+	// ProvisioningStatus Allows resources to be filtered by provisioning status.
+	ProvisioningStatus *ProvisioningStatusQueryParameter `form:"provisioningStatus,omitempty" json:"provisioningStatus,omitempty"`
+
+	// PowerState Allows resources to be filtered by power state.
+	PowerState *PowerStateQueryParameter `form:"powerState,omitempty" json:"powerState,omitempty"`
+
+	// CreatedAfter Allows resources to be filtered to those created after the given time.
+	CreatedAfter *CreatedAfterQueryParameter `form:"createdAfter,omitempty" json:"createdAfter,omitempty"`
+
+	// Sort Orders the results by name, creation time or provisioning status.  By default
+	// results are ordered by ID.
+	Sort *SortQueryParameter `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Fields Trims list items down to the selected fields to reduce the response size.
+	// Fields are dot separated paths e.g. "metadata.name" or "status", a path that
+	// passes through an array applies to each element.  The resource ID is always
+	// returned, and sparse items will not contain all fields marked as required.
+	Fields *FieldsParameter `form:"fields,omitempty" json:"fields,omitempty"`
+}
+
+// PostApiV2InstancesParams defines parameters for PostApiV2Instances.
+type PostApiV2InstancesParams struct {
+	// Count The number of identical instances to create.
+	Count *InstanceCountParameter `form:"count,omitempty" json:"count,omitempty"`
+}
+
+// PutApiV2InstancesInstanceIDParams defines parameters for PutApiV2InstancesInstanceID.
+type PutApiV2InstancesInstanceIDParams struct {
+	// IfMatch Only apply the update if the resource's entity tag, as returned in the ETag
+	// header when it was read, matches.  This guards against concurrent modification.
+	IfMatch *IfMatchParameter `json:"If-Match,omitempty"`
 }
 
 // GetApiV2InstancesInstanceIDConsoleoutputParams defines parameters for GetApiV2InstancesInstanceIDConsoleoutput.
 type GetApiV2InstancesInstanceIDConsoleoutputParams struct {
 	// Length The requested output length.
 	Length *LengthParameter `form:"length,omitempty" json:"length,omitempty"`
+
+	// Follow Stream new output as it is written.
+	Follow *FollowParameter `form:"follow,omitempty" json:"follow,omitempty"`
 }
 
 // PostApiV2InstancesInstanceIDRebootParams defines parameters for PostApiV2InstancesInstanceIDReboot.
 type PostApiV2InstancesInstanceIDRebootParams struct {
-	// Hard Whether the reboot is hard.
+	// Hard Whether the reboot is hard.  Deprecated, use the type parameter instead, it
+	// must not conflict with the type parameter if both are specified.
 	Hard *HardRebootParameter `form:"hard,omitempty" json:"hard,omitempty"`
+
+	// Type The type of reboot.  Soft reboots request the operating system shuts down
+	// gracefully, while hard reboots power cycle the instance.
+	Type *RebootTypeParameter `form:"type,omitempty" json:"type,omitempty"`
+}
+
+// GetApiV2SecuritygroupsParams defines parameters for GetApiV2Securitygroups.
+type GetApiV2SecuritygroupsParams struct {
+	// Tag A set of tags to match against resources in the form "name=value",
+	// thus when encoded you get "?tag=foo%3Dcat&tag=bar%3Ddog".
+	Tag *externalRef0.TagSelectorParameter `form:"tag,omitempty" json:"tag,omitempty"`
+
+	// OrganizationID Allows resources to be filtered by organization.
+	OrganizationID *OrganizationIDQueryParameter `form:"organizationID,omitempty" json:"organizationID,omitempty"`
+
+	// ProjectID Allows resources to be filtered by project.
+	ProjectID *ProjectIDQueryParameter `form:"projectID,omitempty" json:"projectID,omitempty"`
+
+	// RegionID Allows resources to be filtered by region.
+	RegionID *RegionIDQueryParameter `form:"regionID,omitempty" json:"regionID,omitempty"`
+
+	// NetworkID Allows resources to be filtered by network.
+	NetworkID *NetworkIDQueryParameter `form:"networkID,omitempty" json:"networkID,omitempty"`
 }
 
 // PostApiV1OrganizationsOrganizationIDProjectsProjectIDClustersJSONRequestBody defines body for PostApiV1OrganizationsOrganizationIDProjectsProjectIDClusters for application/json ContentType.
@@ -626,24 +2116,81 @@ type PostApiV1OrganizationsOrganizationIDProjectsProjectIDClustersJSONRequestBod
 // PutApiV1OrganizationsOrganizationIDProjectsProjectIDClustersClusterIDJSONRequestBody defines body for PutApiV1OrganizationsOrganizationIDProjectsProjectIDClustersClusterID for application/json ContentType.
 type PutApiV1OrganizationsOrganizationIDProjectsProjectIDClustersClusterIDJSONRequestBody = ComputeClusterWrite
 
+// PostApiV1OrganizationsOrganizationIDProjectsProjectIDClustersClusterIDAdoptJSONRequestBody defines body for PostApiV1OrganizationsOrganizationIDProjectsProjectIDClustersClusterIDAdopt for application/json ContentType.
+type PostApiV1OrganizationsOrganizationIDProjectsProjectIDClustersClusterIDAdoptJSONRequestBody = AdoptionWrite
+
 // PostApiV1OrganizationsOrganizationIDProjectsProjectIDClustersClusterIDEvictJSONRequestBody defines body for PostApiV1OrganizationsOrganizationIDProjectsProjectIDClustersClusterIDEvict for application/json ContentType.
 type PostApiV1OrganizationsOrganizationIDProjectsProjectIDClustersClusterIDEvictJSONRequestBody = EvictionWrite
 
+// PostApiV1OrganizationsOrganizationIDProjectsProjectIDClustersClusterIDMachinesMachineIDResizeJSONRequestBody defines body for PostApiV1OrganizationsOrganizationIDProjectsProjectIDClustersClusterIDMachinesMachineIDResize for application/json ContentType.
+type PostApiV1OrganizationsOrganizationIDProjectsProjectIDClustersClusterIDMachinesMachineIDResizeJSONRequestBody = MachineResizeWrite
+
+// PostApiV1OrganizationsOrganizationIDProjectsProjectIDClustersClusterIDPoolsPoolNameFirewallrulesJSONRequestBody defines body for PostApiV1OrganizationsOrganizationIDProjectsProjectIDClustersClusterIDPoolsPoolNameFirewallrules for application/json ContentType.
+type PostApiV1OrganizationsOrganizationIDProjectsProjectIDClustersClusterIDPoolsPoolNameFirewallrulesJSONRequestBody = FirewallRule
+
+// PostApiV1OrganizationsOrganizationIDProjectsProjectIDClustersClusterIDPoolsPoolNameGoldenImageJSONRequestBody defines body for PostApiV1OrganizationsOrganizationIDProjectsProjectIDClustersClusterIDPoolsPoolNameGoldenImage for application/json ContentType.
+type PostApiV1OrganizationsOrganizationIDProjectsProjectIDClustersClusterIDPoolsPoolNameGoldenImageJSONRequestBody = PoolGoldenImageWrite
+
+// PostApiV1OrganizationsOrganizationIDProjectsProjectIDClustersClusterIDPoolsPoolNamePowerJSONRequestBody defines body for PostApiV1OrganizationsOrganizationIDProjectsProjectIDClustersClusterIDPoolsPoolNamePower for application/json ContentType.
+type PostApiV1OrganizationsOrganizationIDProjectsProjectIDClustersClusterIDPoolsPoolNamePowerJSONRequestBody = PoolPowerWrite
+
+// PostApiV1OrganizationsOrganizationIDProjectsProjectIDClustersClusterIDPreviewJSONRequestBody defines body for PostApiV1OrganizationsOrganizationIDProjectsProjectIDClustersClusterIDPreview for application/json ContentType.
+type PostApiV1OrganizationsOrganizationIDProjectsProjectIDClustersClusterIDPreviewJSONRequestBody = ComputeClusterWrite
+
+// PostApiV1OrganizationsOrganizationIDWebhooksJSONRequestBody defines body for PostApiV1OrganizationsOrganizationIDWebhooks for application/json ContentType.
+type PostApiV1OrganizationsOrganizationIDWebhooksJSONRequestBody = WebhookWrite
+
+// PutApiV1OrganizationsOrganizationIDWebhooksWebhookIDJSONRequestBody defines body for PutApiV1OrganizationsOrganizationIDWebhooksWebhookID for application/json ContentType.
+type PutApiV1OrganizationsOrganizationIDWebhooksWebhookIDJSONRequestBody = WebhookWrite
+
 // PostApiV2ClustersJSONRequestBody defines body for PostApiV2Clusters for application/json ContentType.
 type PostApiV2ClustersJSONRequestBody = ClusterV2Create
 
 // PutApiV2ClustersClusterIDJSONRequestBody defines body for PutApiV2ClustersClusterID for application/json ContentType.
 type PutApiV2ClustersClusterIDJSONRequestBody = ClusterV2Update
 
+// PostApiV2ClustersClusterIDPreviewJSONRequestBody defines body for PostApiV2ClustersClusterIDPreview for application/json ContentType.
+type PostApiV2ClustersClusterIDPreviewJSONRequestBody = ClusterV2Update
+
+// PatchApiV2ClustersClusterIDTagsJSONRequestBody defines body for PatchApiV2ClustersClusterIDTags for application/json ContentType.
+type PatchApiV2ClustersClusterIDTagsJSONRequestBody = ResourceTagsPatch
+
+// PostApiV2ClustertemplatesJSONRequestBody defines body for PostApiV2Clustertemplates for application/json ContentType.
+type PostApiV2ClustertemplatesJSONRequestBody = ClusterTemplateWrite
+
+// PutApiV2ClustertemplatesClusterTemplateIDJSONRequestBody defines body for PutApiV2ClustertemplatesClusterTemplateID for application/json ContentType.
+type PutApiV2ClustertemplatesClusterTemplateIDJSONRequestBody = ClusterTemplateWrite
+
+// PostApiV2ClustertemplatesClusterTemplateIDClustersJSONRequestBody defines body for PostApiV2ClustertemplatesClusterTemplateIDClusters for application/json ContentType.
+type PostApiV2ClustertemplatesClusterTemplateIDClustersJSONRequestBody = ClusterTemplateInstantiate
+
 // PostApiV2InstancesJSONRequestBody defines body for PostApiV2Instances for application/json ContentType.
 type PostApiV2InstancesJSONRequestBody = InstanceCreate
 
 // PutApiV2InstancesInstanceIDJSONRequestBody defines body for PutApiV2InstancesInstanceID for application/json ContentType.
 type PutApiV2InstancesInstanceIDJSONRequestBody = InstanceUpdate
 
+// PostApiV2InstancesInstanceIDMigrateNetworkJSONRequestBody defines body for PostApiV2InstancesInstanceIDMigrateNetwork for application/json ContentType.
+type PostApiV2InstancesInstanceIDMigrateNetworkJSONRequestBody = InstanceNetworkMigrate
+
 // PostApiV2InstancesInstanceIDSnapshotJSONRequestBody defines body for PostApiV2InstancesInstanceIDSnapshot for application/json ContentType.
 type PostApiV2InstancesInstanceIDSnapshotJSONRequestBody = InstanceSnapshotCreate
 
+// PatchApiV2InstancesInstanceIDTagsJSONRequestBody defines body for PatchApiV2InstancesInstanceIDTags for application/json ContentType.
+type PatchApiV2InstancesInstanceIDTagsJSONRequestBody = ResourceTagsPatch
+
+// PostApiV2MaintenancewindowsJSONRequestBody defines body for PostApiV2Maintenancewindows for application/json ContentType.
+type PostApiV2MaintenancewindowsJSONRequestBody = MaintenanceWindowWrite
+
+// PutApiV2MaintenancewindowsMaintenanceWindowIDJSONRequestBody defines body for PutApiV2MaintenancewindowsMaintenanceWindowID for application/json ContentType.
+type PutApiV2MaintenancewindowsMaintenanceWindowIDJSONRequestBody = MaintenanceWindowWrite
+
+// PostApiV2SecuritygroupsJSONRequestBody defines body for PostApiV2Securitygroups for application/json ContentType.
+type PostApiV2SecuritygroupsJSONRequestBody = externalRef1.SecurityGroupV2Create
+
+// PutApiV2SecuritygroupsSecurityGroupIDJSONRequestBody defines body for PutApiV2SecuritygroupsSecurityGroupID for application/json ContentType.
+type PutApiV2SecuritygroupsSecurityGroupIDJSONRequestBody = externalRef1.SecurityGroupV2Update
+
 // AsComputeImage0 returns the union data inside the ComputeImage as a ComputeImage0
 func (t ComputeImage) AsComputeImage0() (ComputeImage0, error) {
 	var body ComputeImage0
@@ -753,3 +2300,65 @@ func (t *ComputeImage) UnmarshalJSON(b []byte) error {
 
 	return err
 }
+
+// AsInstanceRead returns the union data inside the InstanceCreateResponse as a InstanceRead
+func (t InstanceCreateResponse) AsInstanceRead() (InstanceRead, error) {
+	var body InstanceRead
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromInstanceRead overwrites any union data inside the InstanceCreateResponse as the provided InstanceRead
+func (t *InstanceCreateResponse) FromInstanceRead(v InstanceRead) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeInstanceRead performs a merge with any union data inside the InstanceCreateResponse, using the provided InstanceRead
+func (t *InstanceCreateResponse) MergeInstanceRead(v InstanceRead) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsInstancesRead returns the union data inside the InstanceCreateResponse as a InstancesRead
+func (t InstanceCreateResponse) AsInstancesRead() (InstancesRead, error) {
+	var body InstancesRead
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromInstancesRead overwrites any union data inside the InstanceCreateResponse as the provided InstancesRead
+func (t *InstanceCreateResponse) FromInstancesRead(v InstancesRead) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeInstancesRead performs a merge with any union data inside the InstanceCreateResponse, using the provided InstancesRead
+func (t *InstanceCreateResponse) MergeInstancesRead(v InstancesRead) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+func (t InstanceCreateResponse) MarshalJSON() ([]byte, error) {
+	b, err := t.union.MarshalJSON()
+	return b, err
+}
+
+func (t *InstanceCreateResponse) UnmarshalJSON(b []byte) error {
+	err := t.union.UnmarshalJSON(b)
+	return err
+}
@@ -0,0 +1,244 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package clientcache shares HTTP clients between reconciles.  Building a client
+// reads TLS configuration from Kubernetes secrets and creates a new connection pool,
+// so doing it on every reconcile results in a TLS handshake storm against services
+// during busy periods, e.g. after a controller restart.  Likewise signed principals
+// are shared, as signing every request is expensive.
+package clientcache
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/spf13/pflag"
+	"golang.org/x/sync/singleflight"
+
+	coreclient "github.com/unikorn-cloud/core/pkg/client"
+	identityclient "github.com/unikorn-cloud/identity/pkg/client"
+	"github.com/unikorn-cloud/identity/pkg/principal"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// Options allows the cache to be configured.
+type Options struct {
+	// TTL is how long a client or signed principal may be used for before it
+	// must be rebuilt, this bounds how long it takes to pick up rotated certificates.
+	TTL time.Duration
+}
+
+func (o *Options) AddFlags(f *pflag.FlagSet) {
+	f.DurationVar(&o.TTL, "client-cache-ttl", 10*time.Minute, "How long HTTP clients and signed principals for other services are reused for, zero disables caching.")
+}
+
+// Factory creates a new value to be cached.
+type Factory[T any] func(ctx context.Context) (T, error)
+
+type entry[T any] struct {
+	value   T
+	created time.Time
+	// refreshing is set when a background refresh has been started.
+	refreshing bool
+}
+
+// Cache is a keyed cache of values that expire after a TTL.  Once a value has
+// lived for three quarters of its TTL it is refreshed in the background while the
+// existing value continues to be served, so callers only block when no valid value
+// exists.  Concurrent requests for the same key share a single call to the factory.
+type Cache[T any] struct {
+	options *Options
+	lock    sync.Mutex
+	entries map[string]*entry[T]
+	group   singleflight.Group
+	// now allows time to be mocked in tests.
+	now func() time.Time
+}
+
+// New returns a new cache.
+func New[T any](options *Options) *Cache[T] {
+	return &Cache[T]{
+		options: options,
+		entries: map[string]*entry[T]{},
+		now:     time.Now,
+	}
+}
+
+// lookup returns a cached entry if one exists, and whether the caller should
+// refresh it.  Only one caller is asked to refresh an entry at a time.
+func (c *Cache[T]) lookup(key string) (*entry[T], bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	e, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+
+	age := c.now().Sub(e.created)
+
+	if age >= c.options.TTL {
+		delete(c.entries, key)
+
+		return nil, false
+	}
+
+	if age < c.options.TTL*3/4 || e.refreshing {
+		return e, false
+	}
+
+	e.refreshing = true
+
+	return e, true
+}
+
+// refreshFailed allows a failed refresh to be retried.
+func (c *Cache[T]) refreshFailed(e *entry[T]) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	e.refreshing = false
+}
+
+// fill calls the factory, at most once concurrently per key, and caches the result.
+func (c *Cache[T]) fill(ctx context.Context, key string, factory Factory[T]) (T, error) {
+	value, err, _ := c.group.Do(key, func() (any, error) {
+		// The result is shared between callers, so one giving up mustn't
+		// fail the rest.
+		value, err := factory(context.WithoutCancel(ctx))
+		if err != nil {
+			return nil, err
+		}
+
+		c.lock.Lock()
+		defer c.lock.Unlock()
+
+		c.entries[key] = &entry[T]{
+			value:   value,
+			created: c.now(),
+		}
+
+		return value, nil
+	})
+
+	if err != nil {
+		var zero T
+
+		return zero, err
+	}
+
+	//nolint:forcetypeassert
+	return value.(T), nil
+}
+
+// Get returns a cached value for the key, creating one with the factory if
+// there is none.
+func (c *Cache[T]) Get(ctx context.Context, key string, factory Factory[T]) (T, error) {
+	if c.options.TTL <= 0 {
+		return factory(ctx)
+	}
+
+	e, refresh := c.lookup(key)
+	if e == nil {
+		return c.fill(ctx, key, factory)
+	}
+
+	if refresh {
+		go func() {
+			if _, err := c.fill(ctx, key, factory); err != nil {
+				log.FromContext(ctx).Error(err, "client cache refresh failed", "key", key)
+
+				c.refreshFailed(e)
+			}
+		}()
+	}
+
+	return e.value, nil
+}
+
+// HTTPClients caches HTTP clients keyed by the service host.
+type HTTPClients = Cache[*http.Client]
+
+// NewHTTPClients returns a new HTTP client cache.
+func NewHTTPClients(options *Options) *HTTPClients {
+	return New[*http.Client](options)
+}
+
+// Principals caches signed principals keyed by service and subject.
+type Principals = Cache[string]
+
+// NewPrincipals returns a new signed principal cache.
+func NewPrincipals(options *Options) *Principals {
+	return New[string](options)
+}
+
+// principalInjector is a drop in replacement for identity's ControllerInjector, that
+// reuses signed principals.  Signing loads the certificate and performs an RSA
+// signature, so doing it for every request adds up during busy periods.
+func principalInjector(principals *Principals, cli client.Client, clientOptions *coreclient.HTTPClientOptions, service string, resource metav1.Object) func(context.Context, *http.Request) error {
+	return func(ctx context.Context, r *http.Request) error {
+		subject, err := principal.FromResource(resource)
+		if err != nil {
+			return err
+		}
+
+		subjectJSON, err := json.Marshal(subject)
+		if err != nil {
+			return err
+		}
+
+		factory := func(ctx context.Context) (string, error) {
+			return clientOptions.EncodeAndSign(ctx, cli, subject)
+		}
+
+		value, err := principals.Get(ctx, service+"/"+string(subjectJSON), factory)
+		if err != nil {
+			return err
+		}
+
+		r.Header.Set(principal.Header, value)
+
+		return nil
+	}
+}
+
+// ControllerClient is a drop in replacement for identity's ControllerClient, that
+// reuses HTTP clients and signed principals.
+func ControllerClient[T any](ctx context.Context, clients *HTTPClients, principals *Principals, cli client.Client, options *coreclient.HTTPOptions, clientOptions *coreclient.HTTPClientOptions, builder identityclient.Builder[T], resource metav1.Object) (*T, error) {
+	factory := func(ctx context.Context) (*http.Client, error) {
+		return identityclient.NewBaseClient(cli, options, clientOptions).HTTPClient(ctx)
+	}
+
+	httpClient, err := clients.Get(ctx, options.Host(), factory)
+	if err != nil {
+		return nil, err
+	}
+
+	builder.WithHTTPClient(httpClient)
+	builder.WithRequestEditorFn(identityclient.TraceContextRequestMutator)
+	builder.WithRequestEditorFn(identityclient.CertificateRequestMutator)
+	builder.WithRequestEditorFn(principalInjector(principals, cli, clientOptions, options.Host(), resource))
+
+	return builder.Client(options.Host())
+}
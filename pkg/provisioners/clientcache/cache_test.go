@@ -0,0 +1,115 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clientcache_test
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/unikorn-cloud/compute/pkg/provisioners/clientcache"
+)
+
+// TestCacheSingleFlight ensures concurrent misses only create one value.
+func TestCacheSingleFlight(t *testing.T) {
+	t.Parallel()
+
+	cache := clientcache.New[int](&clientcache.Options{TTL: time.Minute})
+
+	var calls atomic.Int32
+
+	release := make(chan struct{})
+
+	factory := func(context.Context) (int, error) {
+		calls.Add(1)
+		<-release
+
+		return 42, nil
+	}
+
+	var wg sync.WaitGroup
+
+	for range 16 {
+		wg.Go(func() {
+			value, err := cache.Get(t.Context(), "region", factory)
+			if err != nil || value != 42 {
+				t.Error("unexpected result", value, err)
+			}
+		})
+	}
+
+	// Let everyone pile up behind the first caller.
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	require.Equal(t, int32(1), calls.Load())
+
+	value, err := cache.Get(t.Context(), "region", factory)
+	require.NoError(t, err)
+	require.Equal(t, 42, value)
+	require.Equal(t, int32(1), calls.Load())
+}
+
+// TestCacheExpiry ensures values are refreshed in the background before expiry,
+// and rebuilt synchronously after.
+func TestCacheExpiry(t *testing.T) {
+	t.Parallel()
+
+	cache := clientcache.New[int32](&clientcache.Options{TTL: time.Minute})
+
+	var offset atomic.Int64
+
+	start := time.Now()
+
+	cache.SetNow(func() time.Time { return start.Add(time.Duration(offset.Load())) })
+
+	var calls atomic.Int32
+
+	factory := func(context.Context) (int32, error) {
+		return calls.Add(1), nil
+	}
+
+	value, err := cache.Get(t.Context(), "region", factory)
+	require.NoError(t, err)
+	require.Equal(t, int32(1), value)
+
+	// In the refresh window the old value is served while a new one is made.
+	offset.Store(int64(50 * time.Second))
+
+	value, err = cache.Get(t.Context(), "region", factory)
+	require.NoError(t, err)
+	require.Equal(t, int32(1), value)
+
+	require.Eventually(t, func() bool {
+		value, err := cache.Get(t.Context(), "region", factory)
+
+		return err == nil && value == 2
+	}, time.Second, 10*time.Millisecond)
+
+	// Expired values are never served.
+	offset.Store(int64(time.Hour))
+
+	value, err = cache.Get(t.Context(), "region", factory)
+	require.NoError(t, err)
+	require.Equal(t, int32(3), value)
+}
@@ -0,0 +1,136 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+
+	unikornv1 "github.com/unikorn-cloud/compute/pkg/apis/unikorn/v1alpha1"
+	"github.com/unikorn-cloud/compute/pkg/provisioners/managers/cluster/util"
+	coreclient "github.com/unikorn-cloud/core/pkg/client"
+	coreconstants "github.com/unikorn-cloud/core/pkg/constants"
+	servererrors "github.com/unikorn-cloud/core/pkg/server/errors"
+	regionapi "github.com/unikorn-cloud/region/pkg/openapi"
+
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// listFlavors lists all flavors available in the cluster's region.
+func (p *Provisioner) listFlavors(ctx context.Context, client regionapi.ClientWithResponsesInterface) (regionapi.Flavors, error) {
+	response, err := client.GetApiV1OrganizationsOrganizationIDRegionsRegionIDFlavorsWithResponse(ctx, p.cluster.Labels[coreconstants.OrganizationLabel], p.cluster.Spec.RegionID)
+	if err != nil {
+		return nil, err
+	}
+
+	if response.StatusCode() != http.StatusOK {
+		return nil, servererrors.PropagateError(response.HTTPResponse, response)
+	}
+
+	return *response.JSON200, nil
+}
+
+// listImages lists all images available in the cluster's region, including any
+// owned by the organization e.g. golden images.
+func (p *Provisioner) listImages(ctx context.Context, client regionapi.ClientWithResponsesInterface) (regionapi.Images, error) {
+	params := &regionapi.GetApiV2RegionsRegionIDImagesParams{
+		OrganizationID: &regionapi.OrganizationIDQueryParameter{
+			p.cluster.Labels[coreconstants.OrganizationLabel],
+		},
+	}
+
+	response, err := client.GetApiV2RegionsRegionIDImagesWithResponse(ctx, p.cluster.Spec.RegionID, params)
+	if err != nil {
+		return nil, err
+	}
+
+	if response.StatusCode() != http.StatusOK {
+		return nil, servererrors.PropagateError(response.HTTPResponse, response)
+	}
+
+	return *response.JSON200, nil
+}
+
+// renderBootstrap renders the bootstrap profile user data for each pool that requests
+// one, keyed by pool name.  Templates are selected by the pool's flavor, so machines
+// resized to a flavor from a different GPU vendor will need rebuilding.
+func (p *Provisioner) renderBootstrap(ctx context.Context, client regionapi.ClientWithResponsesInterface) (map[string][]byte, error) {
+	result := map[string][]byte{}
+
+	var pools []*unikornv1.ComputeClusterWorkloadPoolSpec
+
+	for i := range p.cluster.Spec.WorkloadPools.Pools {
+		if pool := &p.cluster.Spec.WorkloadPools.Pools[i]; pool.BootstrapProfile != "" {
+			pools = append(pools, pool)
+		}
+	}
+
+	if len(pools) == 0 {
+		return result, nil
+	}
+
+	cli, err := coreclient.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	flavors, err := p.listFlavors(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+
+	images, err := p.listImages(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, pool := range pools {
+		profile := &unikornv1.ComputeBootstrapProfile{}
+
+		if err := cli.Get(ctx, types.NamespacedName{Namespace: p.cluster.Namespace, Name: pool.BootstrapProfile}, profile); err != nil {
+			return nil, fmt.Errorf("%w: unable to get profile %s for pool %s: %w", util.ErrBootstrap, pool.BootstrapProfile, pool.Name, err)
+		}
+
+		flavorIndex := slices.IndexFunc(flavors, func(flavor regionapi.Flavor) bool {
+			return flavor.Metadata.Id == pool.FlavorID
+		})
+
+		if flavorIndex < 0 {
+			return nil, fmt.Errorf("%w: flavor %s for pool %s not found", util.ErrBootstrap, pool.FlavorID, pool.Name)
+		}
+
+		imageIndex := slices.IndexFunc(images, func(image regionapi.Image) bool {
+			return image.Metadata.Id == pool.ImageID
+		})
+
+		if imageIndex < 0 {
+			return nil, fmt.Errorf("%w: image %s for pool %s not found", util.ErrBootstrap, pool.ImageID, pool.Name)
+		}
+
+		userData, err := util.RenderBootstrap(profile, util.NewBootstrapVariables(&flavors[flavorIndex], &images[imageIndex]))
+		if err != nil {
+			return nil, err
+		}
+
+		result[pool.Name] = userData
+	}
+
+	return result, nil
+}
@@ -0,0 +1,79 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"context"
+	"time"
+
+	unikornv1 "github.com/unikorn-cloud/compute/pkg/apis/unikorn/v1alpha1"
+	"github.com/unikorn-cloud/compute/pkg/constants"
+	coreclient "github.com/unikorn-cloud/core/pkg/client"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// recordEvent records a Kubernetes event against the cluster so operators can
+// see what the controller did and why.  Events are labeled with the cluster so
+// they can be surfaced to users via the API.  Events are best effort, so failures are
+// logged and otherwise ignored.
+func (p *Provisioner) recordEvent(ctx context.Context, eventType, reason, message string) {
+	log := log.FromContext(ctx)
+
+	cli, err := coreclient.FromContext(ctx)
+	if err != nil {
+		log.Error(err, "failed to record event", "reason", reason)
+
+		return
+	}
+
+	now := metav1.NewTime(time.Now())
+
+	event := &corev1.Event{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:    p.cluster.Namespace,
+			GenerateName: p.cluster.Name + ".",
+			Labels: map[string]string{
+				constants.ClusterLabel: p.cluster.Name,
+			},
+		},
+		InvolvedObject: corev1.ObjectReference{
+			APIVersion: unikornv1.SchemeGroupVersion.String(),
+			Kind:       "ComputeCluster",
+			Namespace:  p.cluster.Namespace,
+			Name:       p.cluster.Name,
+			UID:        p.cluster.UID,
+		},
+		Type:    eventType,
+		Reason:  reason,
+		Message: message,
+		Source: corev1.EventSource{
+			Component: constants.Application,
+		},
+		FirstTimestamp: now,
+		LastTimestamp:  now,
+		Count:          1,
+	}
+
+	if err := cli.Create(ctx, event); err != nil {
+		log.Error(err, "failed to record event", "reason", reason)
+	}
+}
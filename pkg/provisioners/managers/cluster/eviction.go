@@ -0,0 +1,99 @@
+/*
+Copyright 2025 the Unikorn Authors.
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	unikornv1 "github.com/unikorn-cloud/compute/pkg/apis/unikorn/v1alpha1"
+	"github.com/unikorn-cloud/compute/pkg/provisioners/managers/cluster/util"
+	coreapi "github.com/unikorn-cloud/core/pkg/openapi"
+	regionapi "github.com/unikorn-cloud/region/pkg/openapi"
+
+	"k8s.io/utils/ptr"
+)
+
+// evictionPhase derives the eviction phase of a server from its observed state.
+// Deletion errors are only known for the reconcile they happened in, so any
+// previously reported failure is carried forward.
+func (p *Provisioner) evictionPhase(server *regionapi.ServerRead, pending bool, previous *unikornv1.MachineEvictionStatus) (unikornv1.MachineEvictionPhase, *string) {
+	if server == nil {
+		return unikornv1.MachineEvictionPhaseDeleted, nil
+	}
+
+	if server.Metadata.DeletionTime != nil || server.Metadata.ProvisioningStatus == coreapi.ResourceProvisioningStatusDeprovisioning {
+		return unikornv1.MachineEvictionPhaseDeleting, nil
+	}
+
+	if err, ok := p.deletionErrors[server.Metadata.Id]; ok {
+		return unikornv1.MachineEvictionPhaseFailed, ptr.To(err.Error())
+	}
+
+	if pending {
+		return unikornv1.MachineEvictionPhasePending, nil
+	}
+
+	if previous != nil && previous.Phase == unikornv1.MachineEvictionPhaseFailed && previous.Message != nil {
+		return unikornv1.MachineEvictionPhaseFailed, previous.Message
+	}
+
+	return unikornv1.MachineEvictionPhaseFailed, ptr.To("machine was not selected for deletion")
+}
+
+// updateEvictionStatus reports the progress of the most recent eviction request.
+// The IDs passed in are those requested at the start of reconciliation, if none
+// were then we continue to track the previous request until it completes.
+func (p *Provisioner) updateEvictionStatus(servers serverSet, evictionIDs []string) {
+	// Statuses from the previous request, only relevant while it's still being tracked.
+	previous := map[string]*unikornv1.MachineEvictionStatus{}
+
+	if len(evictionIDs) == 0 {
+		for i := range p.cluster.Status.Evictions {
+			eviction := &p.cluster.Status.Evictions[i]
+
+			evictionIDs = append(evictionIDs, eviction.ID)
+			previous[eviction.ID] = eviction
+		}
+	}
+
+	if len(evictionIDs) == 0 {
+		return
+	}
+
+	serversByID := map[string]*regionapi.ServerRead{}
+
+	for _, server := range servers {
+		serversByID[server.Metadata.Id] = server
+	}
+
+	// If the request hasn't been consumed yet, then anything not yet touched is
+	// still pending.
+	pending := util.EvictionPending(&p.cluster)
+
+	evictions := make([]unikornv1.MachineEvictionStatus, len(evictionIDs))
+
+	for i, id := range evictionIDs {
+		phase, message := p.evictionPhase(serversByID[id], pending, previous[id])
+
+		evictions[i] = unikornv1.MachineEvictionStatus{
+			ID:      id,
+			Phase:   phase,
+			Message: message,
+		}
+	}
+
+	p.cluster.Status.Evictions = evictions
+}
@@ -0,0 +1,153 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	unikornv1 "github.com/unikorn-cloud/compute/pkg/apis/unikorn/v1alpha1"
+	"github.com/unikorn-cloud/compute/pkg/constants"
+	"github.com/unikorn-cloud/compute/pkg/provisioners/managers/cluster"
+	regionapi "github.com/unikorn-cloud/region/pkg/openapi"
+
+	"k8s.io/utils/ptr"
+)
+
+var errDeletion = errors.New("deletion failed")
+
+func evictionServer(id string) *regionapi.ServerRead {
+	server := &regionapi.ServerRead{}
+	server.Metadata.Id = id
+
+	return server
+}
+
+// TestUpdateEvictionStatus checks each eviction phase is derived correctly, and that
+// failures are still reported in the reconciles after they happened.
+func TestUpdateEvictionStatus(t *testing.T) {
+	t.Parallel()
+
+	deleting := evictionServer("a")
+	deleting.Metadata.DeletionTime = ptr.To(time.Now())
+
+	failed := []unikornv1.MachineEvictionStatus{
+		{
+			ID:      "a",
+			Phase:   unikornv1.MachineEvictionPhaseFailed,
+			Message: ptr.To(errDeletion.Error()),
+		},
+	}
+
+	tests := []struct {
+		name           string
+		annotation     string
+		previous       []unikornv1.MachineEvictionStatus
+		server         *regionapi.ServerRead
+		deletionErrors map[string]error
+		evictionIDs    []string
+		phase          unikornv1.MachineEvictionPhase
+		message        *string
+	}{
+		{
+			name:        "Deleted",
+			evictionIDs: []string{"a"},
+			phase:       unikornv1.MachineEvictionPhaseDeleted,
+		},
+		{
+			name:        "Deleting",
+			server:      deleting,
+			evictionIDs: []string{"a"},
+			phase:       unikornv1.MachineEvictionPhaseDeleting,
+		},
+		{
+			name:        "Pending",
+			annotation:  "a",
+			server:      evictionServer("a"),
+			evictionIDs: []string{"a"},
+			phase:       unikornv1.MachineEvictionPhasePending,
+		},
+		{
+			name:           "Failed",
+			server:         evictionServer("a"),
+			deletionErrors: map[string]error{"a": errDeletion},
+			evictionIDs:    []string{"a"},
+			phase:          unikornv1.MachineEvictionPhaseFailed,
+			message:        ptr.To(errDeletion.Error()),
+		},
+		{
+			name:     "FailedPersisted",
+			previous: failed,
+			server:   evictionServer("a"),
+			phase:    unikornv1.MachineEvictionPhaseFailed,
+			message:  ptr.To(errDeletion.Error()),
+		},
+		{
+			name:     "FailedThenDeleted",
+			previous: failed,
+			phase:    unikornv1.MachineEvictionPhaseDeleted,
+		},
+		{
+			name:        "NotSelected",
+			previous:    failed,
+			server:      evictionServer("a"),
+			evictionIDs: []string{"a"},
+			phase:       unikornv1.MachineEvictionPhaseFailed,
+			message:     ptr.To("machine was not selected for deletion"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			c := &unikornv1.ComputeCluster{}
+			c.Status.Evictions = test.previous
+
+			if test.annotation != "" {
+				c.Annotations = map[string]string{
+					constants.ServerDeletionHintAnnotation: test.annotation,
+				}
+			}
+
+			servers := map[string]*regionapi.ServerRead{}
+
+			if test.server != nil {
+				servers["host"] = test.server
+			}
+
+			deletionErrors := test.deletionErrors
+			if deletionErrors == nil {
+				deletionErrors = map[string]error{}
+			}
+
+			p := cluster.NewTestProvisioner(c, deletionErrors)
+			p.UpdateEvictionStatus(servers, test.evictionIDs)
+
+			status, ok := p.Object().(*unikornv1.ComputeCluster)
+			require.True(t, ok)
+			require.Len(t, status.Status.Evictions, 1)
+			require.Equal(t, "a", status.Status.Evictions[0].ID)
+			require.Equal(t, test.phase, status.Status.Evictions[0].Phase)
+			require.Equal(t, test.message, status.Status.Evictions[0].Message)
+		})
+	}
+}
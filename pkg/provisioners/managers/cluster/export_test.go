@@ -0,0 +1,52 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"context"
+	"time"
+
+	unikornv1 "github.com/unikorn-cloud/compute/pkg/apis/unikorn/v1alpha1"
+	"github.com/unikorn-cloud/compute/pkg/provisioners/managers/cluster/util"
+	regionapi "github.com/unikorn-cloud/region/pkg/openapi"
+)
+
+// NewTestProvisioner creates a provisioner for the cluster, exported for testing.
+func NewTestProvisioner(cluster *unikornv1.ComputeCluster, deletionErrors map[string]error) *Provisioner {
+	return &Provisioner{
+		cluster:        *cluster,
+		deletionErrors: deletionErrors,
+		maintenance:    util.NewMaintenance(nil, cluster.Spec.RegionID, time.Now()),
+	}
+}
+
+// UpdateEvictionStatus updates the eviction status, exported for testing.
+func (p *Provisioner) UpdateEvictionStatus(servers map[string]*regionapi.ServerRead, evictionIDs []string) {
+	p.updateEvictionStatus(servers, evictionIDs)
+}
+
+// GenerateSecurityGroup generates the security groups for a pool, exported for testing.
+func GenerateSecurityGroup(pool *unikornv1.ComputeClusterWorkloadPoolSpec, securityGroups map[string]*regionapi.SecurityGroupRead) (*regionapi.ServerSecurityGroupList, error) {
+	return generateSecurityGroup(pool, securityGroups)
+}
+
+// HealServers auto heals the pool's servers, exported for testing.  No region client
+// is provided, so it must only be used where no servers are expected to be replaced.
+func (p *Provisioner) HealServers(ctx context.Context, pool *unikornv1.ComputeClusterWorkloadPoolSpec, servers map[string]*regionapi.ServerRead, tracking util.MachineTracking, now time.Time) ([]string, error) {
+	return p.healServers(ctx, nil, pool, servers, nil, tracking, now)
+}
@@ -0,0 +1,100 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+
+	coreconstants "github.com/unikorn-cloud/core/pkg/constants"
+	"github.com/unikorn-cloud/core/pkg/provisioners"
+	servererrors "github.com/unikorn-cloud/core/pkg/server/errors"
+	regionapi "github.com/unikorn-cloud/region/pkg/openapi"
+
+	"k8s.io/utils/ptr"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+var (
+	// ErrGoldenImage is raised when a golden image snapshot cannot be used.
+	ErrGoldenImage = errors.New("golden image error")
+)
+
+// awaitGoldenImages yields until any golden image snapshots used by pools are ready,
+// otherwise servers would be rebuilt with an image that cannot yet be booted.
+func (p *Provisioner) awaitGoldenImages(ctx context.Context, client regionapi.ClientWithResponsesInterface) error {
+	log := log.FromContext(ctx)
+
+	var pending []string
+
+	for i := range p.cluster.Spec.WorkloadPools.Pools {
+		pool := &p.cluster.Spec.WorkloadPools.Pools[i]
+
+		if pool.GoldenImage != nil && pool.GoldenImage.ImageID == pool.ImageID {
+			pending = append(pending, pool.ImageID)
+		}
+	}
+
+	if len(pending) == 0 {
+		return nil
+	}
+
+	params := &regionapi.GetApiV2RegionsRegionIDImagesParams{
+		OrganizationID: &regionapi.OrganizationIDQueryParameter{
+			p.cluster.Labels[coreconstants.OrganizationLabel],
+		},
+		Scope: ptr.To(regionapi.GetApiV2RegionsRegionIDImagesParamsScopeOwned),
+	}
+
+	response, err := client.GetApiV2RegionsRegionIDImagesWithResponse(ctx, p.cluster.Spec.RegionID, params)
+	if err != nil {
+		return err
+	}
+
+	if response.StatusCode() != http.StatusOK {
+		return servererrors.PropagateError(response.HTTPResponse, response)
+	}
+
+	images := *response.JSON200
+
+	for _, id := range pending {
+		index := slices.IndexFunc(images, func(image regionapi.Image) bool {
+			return image.Metadata.Id == id
+		})
+
+		if index < 0 {
+			return fmt.Errorf("%w: snapshot %s not found", ErrGoldenImage, id)
+		}
+
+		switch images[index].Status.State {
+		case regionapi.ImageStateReady:
+		case regionapi.ImageStateFailed:
+			return fmt.Errorf("%w: snapshot %s failed", ErrGoldenImage, id)
+		case regionapi.ImageStateCreating, regionapi.ImageStatePending:
+			log.Info("awaiting golden image snapshot", "image", id)
+
+			return provisioners.ErrYield
+		}
+	}
+
+	return nil
+}
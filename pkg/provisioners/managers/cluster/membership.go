@@ -0,0 +1,167 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/unikorn-cloud/compute/pkg/provisioners/managers/cluster/util"
+	coreconstants "github.com/unikorn-cloud/core/pkg/constants"
+	coreapi "github.com/unikorn-cloud/core/pkg/openapi"
+	regionapi "github.com/unikorn-cloud/region/pkg/openapi"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// adoptServers tags any servers requested for adoption as members of their workload
+// pool and adds them to the server set, from then on they are managed like any other.
+// This is done before anything else, as the pools have already been scaled up to
+// accommodate the servers, and we'd otherwise create new ones in their place.  If a
+// server can no longer be adopted, the pool is left as it is, and a new server will
+// be created instead.  The request is recorded as consumed in the status, the
+// annotation itself belongs to the API so we must not update the cluster's metadata.
+func (p *Provisioner) adoptServers(ctx context.Context, client regionapi.ClientWithResponsesInterface, servers serverSet) error {
+	log := log.FromContext(ctx)
+
+	adoptions, err := util.GetPendingAdoptions(&p.cluster)
+	if err != nil {
+		return err
+	}
+
+	if len(adoptions) == 0 {
+		return nil
+	}
+
+	for _, serverID := range slices.Sorted(maps.Keys(adoptions)) {
+		poolName := adoptions[serverID]
+
+		pool, ok := p.cluster.GetWorkloadPool(poolName)
+		if !ok {
+			log.Info("skipping adoption of server with an unknown pool", "id", serverID, "pool", poolName)
+
+			continue
+		}
+
+		server, err := p.getServer(ctx, client, serverID)
+		if err != nil {
+			return err
+		}
+
+		if server == nil {
+			p.recordEvent(ctx, corev1.EventTypeWarning, "ServerAdoptFailed", fmt.Sprintf("Server %s no longer exists and cannot be adopted into pool %s", serverID, poolName))
+
+			continue
+		}
+
+		// Adoption may have been interrupted by an error last time around, in
+		// which case the server will have been listed as a member already.
+		if existing, ok := servers[server.Metadata.Name]; ok {
+			if existing.Metadata.Id != serverID {
+				p.recordEvent(ctx, corev1.EventTypeWarning, "ServerAdoptFailed", fmt.Sprintf("Server %s name %s is already in use and cannot be adopted into pool %s", serverID, server.Metadata.Name, poolName))
+			}
+
+			continue
+		}
+
+		tags := slices.DeleteFunc(slices.Clone(ptr.Deref(server.Metadata.Tags, nil)), func(tag coreapi.Tag) bool {
+			return tag.Name == coreconstants.ComputeClusterLabel || tag.Name == util.WorkloadPoolLabel
+		})
+
+		tags = append(tags, coreapi.Tag{Name: coreconstants.ComputeClusterLabel, Value: p.cluster.Name}, coreapi.Tag{Name: util.WorkloadPoolLabel, Value: pool.Name})
+
+		request := &regionapi.ServerWrite{
+			Metadata: coreapi.ResourceWriteMetadata{
+				Name:        server.Metadata.Name,
+				Description: server.Metadata.Description,
+				Tags:        &tags,
+			},
+			Spec: server.Spec,
+		}
+
+		log.Info("adopting server", "id", serverID, "pool", poolName)
+
+		updated, err := p.updateServer(ctx, client, serverID, request)
+		if err != nil {
+			return err
+		}
+
+		p.recordEvent(ctx, corev1.EventTypeNormal, "ServerAdopted", fmt.Sprintf("Adopted server %s (%s) into pool %s", server.Metadata.Name, serverID, poolName))
+
+		if err := servers.add(server.Metadata.Name, updated); err != nil {
+			return err
+		}
+	}
+
+	util.ConsumeAdoptions(&p.cluster)
+
+	return nil
+}
+
+// detachServers removes the membership tags from any servers detached from the
+// cluster and removes them from the server set, from then on they are owned by the
+// user.  This is done before anything else, as the pools have already been scaled
+// down, and we'd otherwise delete a server to satisfy the new replica count.  The
+// request is recorded as consumed in the status, the annotation itself belongs to
+// the API so we must not update the cluster's metadata.
+func (p *Provisioner) detachServers(ctx context.Context, client regionapi.ClientWithResponsesInterface, servers serverSet) error {
+	log := log.FromContext(ctx)
+
+	detachments := util.GetPendingDetachments(&p.cluster)
+	if len(detachments) == 0 {
+		return nil
+	}
+
+	for name, server := range servers {
+		if !slices.Contains(detachments, server.Metadata.Id) {
+			continue
+		}
+
+		tags := slices.DeleteFunc(slices.Clone(ptr.Deref(server.Metadata.Tags, nil)), func(tag coreapi.Tag) bool {
+			return tag.Name == coreconstants.ComputeClusterLabel || tag.Name == util.WorkloadPoolLabel
+		})
+
+		request := &regionapi.ServerWrite{
+			Metadata: coreapi.ResourceWriteMetadata{
+				Name:        server.Metadata.Name,
+				Description: server.Metadata.Description,
+				Tags:        &tags,
+			},
+			Spec: server.Spec,
+		}
+
+		log.Info("detaching server", "id", server.Metadata.Id)
+
+		if _, err := p.updateServer(ctx, client, server.Metadata.Id, request); err != nil {
+			return err
+		}
+
+		p.recordEvent(ctx, corev1.EventTypeNormal, "ServerDetached", fmt.Sprintf("Detached server %s (%s) from the cluster", name, server.Metadata.Id))
+
+		delete(servers, name)
+	}
+
+	util.ConsumeDetachments(&p.cluster)
+
+	return nil
+}
@@ -0,0 +1,115 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"context"
+
+	unikornv1 "github.com/unikorn-cloud/compute/pkg/apis/unikorn/v1alpha1"
+	"github.com/unikorn-cloud/compute/pkg/provisioners/notifications"
+	unikornv1core "github.com/unikorn-cloud/core/pkg/apis/unikorn/v1alpha1"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+// notify sends a lifecycle event about the cluster to any webhooks.
+func (p *Provisioner) notify(ctx context.Context, eventType unikornv1.WebhookEventType, data map[string]any) {
+	p.options.notifier.Notify(ctx, notifications.NewEvent(eventType, "cluster", &p.cluster, data))
+}
+
+// provisioned checks whether the cluster was available at the start of the reconcile.
+func (p *Provisioner) provisioned() bool {
+	condition, err := p.cluster.StatusConditionRead(unikornv1core.ConditionAvailable)
+	if err != nil {
+		return false
+	}
+
+	return condition.Status == corev1.ConditionTrue
+}
+
+// evictionsCompleted checks whether all requested evictions have reached a
+// terminal phase.
+func evictionsCompleted(evictions []unikornv1.MachineEvictionStatus) bool {
+	if len(evictions) == 0 {
+		return false
+	}
+
+	for i := range evictions {
+		switch evictions[i].Phase {
+		case unikornv1.MachineEvictionPhaseDeleted, unikornv1.MachineEvictionPhaseFailed:
+		default:
+			return false
+		}
+	}
+
+	return true
+}
+
+// healthyMachines returns the set of machine IDs that are reported as healthy.
+func healthyMachines(cluster *unikornv1.ComputeCluster) map[string]bool {
+	healthy := map[string]bool{}
+
+	for i := range cluster.Status.WorkloadPools {
+		pool := &cluster.Status.WorkloadPools[i]
+
+		for j := range pool.Machines {
+			machine := &pool.Machines[j]
+
+			if condition, err := unikornv1core.GetCondition(machine.Conditions, unikornv1core.ConditionHealthy); err == nil && condition.Status == corev1.ConditionTrue {
+				healthy[machine.ID] = true
+			}
+		}
+	}
+
+	return healthy
+}
+
+// notifyStatusChanges compares the status before and after an update and raises
+// events for any interesting transitions.
+func (p *Provisioner) notifyStatusChanges(ctx context.Context, evictionsCompletedBefore bool, healthyBefore map[string]bool) {
+	// Machines going away as the cluster is deleted are expected.
+	if p.cluster.DeletionTimestamp != nil {
+		return
+	}
+
+	if !evictionsCompletedBefore && evictionsCompleted(p.cluster.Status.Evictions) {
+		p.notify(ctx, unikornv1.WebhookEventClusterEvictionCompleted, map[string]any{
+			"evictions": p.cluster.Status.Evictions,
+		})
+	}
+
+	healthyAfter := healthyMachines(&p.cluster)
+
+	for i := range p.cluster.Status.WorkloadPools {
+		pool := &p.cluster.Status.WorkloadPools[i]
+
+		for j := range pool.Machines {
+			machine := &pool.Machines[j]
+
+			if !healthyBefore[machine.ID] || healthyAfter[machine.ID] {
+				continue
+			}
+
+			p.notify(ctx, unikornv1.WebhookEventMachineHealthDegraded, map[string]any{
+				"pool":      pool.Name,
+				"machineId": machine.ID,
+				"hostname":  machine.Hostname,
+			})
+		}
+	}
+}
@@ -19,15 +19,26 @@ package cluster
 
 import (
 	"context"
+	"crypto/rand"
+	"encoding/hex"
 	"errors"
+	"fmt"
 	"maps"
+	"net"
 	"slices"
+	"strings"
+	"time"
 
 	"github.com/spf13/pflag"
 
 	unikornv1 "github.com/unikorn-cloud/compute/pkg/apis/unikorn/v1alpha1"
 	"github.com/unikorn-cloud/compute/pkg/constants"
+	"github.com/unikorn-cloud/compute/pkg/health"
+	"github.com/unikorn-cloud/compute/pkg/metrics"
+	"github.com/unikorn-cloud/compute/pkg/provisioners/clientcache"
 	"github.com/unikorn-cloud/compute/pkg/provisioners/managers/cluster/util"
+	"github.com/unikorn-cloud/compute/pkg/provisioners/notifications"
+	"github.com/unikorn-cloud/compute/pkg/tracing"
 	unikornv1core "github.com/unikorn-cloud/core/pkg/apis/unikorn/v1alpha1"
 	coreclient "github.com/unikorn-cloud/core/pkg/client"
 	"github.com/unikorn-cloud/core/pkg/manager"
@@ -37,6 +48,9 @@ import (
 	regionclient "github.com/unikorn-cloud/region/pkg/client"
 	regionapi "github.com/unikorn-cloud/region/pkg/openapi"
 
+	"k8s.io/utils/ptr"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 )
 
@@ -44,6 +58,13 @@ var (
 	ErrAnnotation = errors.New("required annotation missing")
 
 	ErrResourceDependency = errors.New("resource dependency error")
+
+	ErrResizeUnsupported = errors.New("server resize unsupported")
+)
+
+const (
+	// defaultServerListMaxPages bounds server listing when not configured.
+	defaultServerListMaxPages = 100
 )
 
 // Options allows access to CLI options in the provisioner.
@@ -57,6 +78,38 @@ type Options struct {
 	// to ensure cloud identities and networks are provisioned, as well
 	// as deptovisioning them.
 	clientOptions coreclient.HTTPClientOptions
+	// clientCacheOptions control how long HTTP clients and signed principals
+	// are reused for.
+	clientCacheOptions clientcache.Options
+	// httpClients are shared between reconciles.
+	httpClients *clientcache.HTTPClients
+	// principals are shared between reconciles.
+	principals *clientcache.Principals
+	// phoneHomeURL, if set, is the compute API base URL that machines call
+	// when cloud-init has finished.
+	phoneHomeURL string
+	// serverTransitionGracePeriod is how long servers in a transitional provider
+	// state are left alone before being considered stuck.
+	serverTransitionGracePeriod time.Duration
+	// defaultNodeNetwork is applied to clusters created without a network,
+	// and must match the API's default.
+	defaultNodeNetwork net.IPNet
+	// defaultDNSNameservers are applied to clusters created without any,
+	// and must match the API's default.
+	defaultDNSNameservers []net.IP
+	// notificationOptions control webhook event delivery.
+	notificationOptions notifications.Options
+	// notifier delivers lifecycle events to webhooks.
+	notifier *notifications.Notifier
+	// webhookDeliveryConcurrency is the maximum number of webhooks delivered
+	// to at the same time.
+	webhookDeliveryConcurrency int
+	// serverCreateConcurrency is the maximum number of servers created at the
+	// same time by a single reconcile.
+	serverCreateConcurrency int
+	// serverListMaxPages is the maximum number of pages followed when listing
+	// a cluster's servers.
+	serverListMaxPages int
 }
 
 func (o *Options) AddFlags(f *pflag.FlagSet) {
@@ -71,6 +124,50 @@ func (o *Options) AddFlags(f *pflag.FlagSet) {
 	o.identityOptions.AddFlags(f)
 	o.regionOptions.AddFlags(f)
 	o.clientOptions.AddFlags(f)
+	o.clientCacheOptions.AddFlags(f)
+	o.notificationOptions.AddFlags(f)
+
+	o.httpClients = clientcache.NewHTTPClients(&o.clientCacheOptions)
+	o.principals = clientcache.NewPrincipals(&o.clientCacheOptions)
+	o.notifier = notifications.New(&o.notificationOptions)
+
+	f.StringVar(&o.phoneHomeURL, "phone-home-url", "", "Compute API base URL, reachable from machines, that reports cloud-init completion.  Disabled if not set.")
+	f.DurationVar(&o.serverTransitionGracePeriod, "server-transition-grace-period", 30*time.Minute, "How long to defer updates to servers in a transitional state e.g. stopping or verifying a resize.  Zero waits indefinitely.")
+	f.IntVar(&o.webhookDeliveryConcurrency, "webhook-delivery-concurrency", 4, "Maximum number of webhooks delivered to at the same time, so a slow receiver cannot delay delivery to others.")
+	f.IntVar(&o.serverCreateConcurrency, "server-create-concurrency", 8, "Maximum number of servers created at the same time for a cluster.  Zero is unlimited.")
+	f.IntVar(&o.serverListMaxPages, "server-list-max-pages", defaultServerListMaxPages, "Maximum number of pages followed when listing a cluster's servers, exceeding this is an error rather than reconciling against a partial list.")
+
+	_, nodeNetwork, _ := net.ParseCIDR("192.168.0.0/24")
+
+	dnsNameservers := []net.IP{net.ParseIP("8.8.8.8")}
+
+	f.IPNetVar(&o.defaultNodeNetwork, "default-node-network", *nodeNetwork, "Default node network to apply to clusters created without one")
+	f.IPSliceVar(&o.defaultDNSNameservers, "default-dns-nameservers", dnsNameservers, "Default DNS nameservers to apply to clusters created without any")
+}
+
+// AddReadinessChecks adds checks for the services the provisioner depends on.
+func (o *Options) AddReadinessChecks(checker *health.Checker, cli client.Client) {
+	checker.AddReadinessCheck("identity", health.IdentityCheck(cli, o.identityOptions, &o.clientOptions))
+	checker.AddReadinessCheck("region", health.RegionCheck(cli, o.regionOptions, &o.clientOptions))
+}
+
+// Notifier returns the notifier used to deliver lifecycle events to webhooks.
+func (o *Options) Notifier() *notifications.Notifier {
+	return o.notifier
+}
+
+// WebhookDeliveryConcurrency returns the maximum number of webhooks delivered to at
+// the same time.
+func (o *Options) WebhookDeliveryConcurrency() int {
+	return max(o.webhookDeliveryConcurrency, 1)
+}
+
+// DefaultNetwork returns the network applied to clusters created without one.
+func (o *Options) DefaultNetwork() *unikornv1core.NetworkGeneric {
+	return &unikornv1core.NetworkGeneric{
+		NodeNetwork:    unikornv1core.IPv4Prefix{IPNet: o.defaultNodeNetwork},
+		DNSNameservers: unikornv1core.IPv4AddressSliceFromIPSlice(o.defaultDNSNameservers),
+	}
 }
 
 // Provisioner encapsulates control plane provisioning.
@@ -82,6 +179,22 @@ type Provisioner struct {
 
 	// options are documented for the type.
 	options *Options
+
+	// deletionErrors records any server deletion failures, indexed by
+	// server ID, so they can be reported in the eviction status.
+	deletionErrors map[string]error
+
+	// cancelled records whether the update to the current generation has
+	// been cancelled, so partial completion can be reported in the status.
+	cancelled bool
+
+	// maintenance tells us which machines are under provider maintenance,
+	// these are not auto healed or rebuilt.
+	maintenance *util.Maintenance
+
+	// bootstrap is the rendered bootstrap profile user data for each pool
+	// that requests one, keyed by pool name.
+	bootstrap map[string][]byte
 }
 
 // New returns a new initialized provisioner object.
@@ -89,7 +202,8 @@ func New(options manager.ControllerOptions) provisioners.ManagerProvisioner {
 	o, _ := options.(*Options)
 
 	return &Provisioner{
-		options: o,
+		options:        o,
+		deletionErrors: map[string]error{},
 	}
 }
 
@@ -106,7 +220,9 @@ func (p *Provisioner) identityClient(ctx context.Context) (identityapi.ClientWit
 		return nil, err
 	}
 
-	return identityclient.New(client, p.options.identityOptions, &p.options.clientOptions).ControllerClient(ctx, &p.cluster)
+	builder := tracing.NewBuilder[identityapi.ClientWithResponses](identityapi.NewBuilder(), "identity")
+
+	return identityclient.ControllerClient(ctx, identityclient.NewBaseClient(client, p.options.identityOptions, &p.options.clientOptions), builder, &p.cluster)
 }
 
 // openstackIdentityStatus are acquired from the region controller at
@@ -123,11 +239,17 @@ type openstackIdentityStatus struct {
 // network to pass to the resource provisioners.
 func (p *Provisioner) getOpenstackIdentityStatus(ctx context.Context, client regionapi.ClientWithResponsesInterface) (*openstackIdentityStatus, error) {
 	identity, err := p.getIdentity(ctx, client)
+
+	util.UpdateDependencyCondition(&p.cluster.Status.Conditions, unikornv1.ConditionIdentityReady, "identity", err)
+
 	if err != nil {
 		return nil, err
 	}
 
 	network, err := p.getNetwork(ctx, client)
+
+	util.UpdateDependencyCondition(&p.cluster.Status.Conditions, unikornv1.ConditionNetworkReady, "network", err)
+
 	if err != nil {
 		return nil, err
 	}
@@ -141,7 +263,7 @@ func (p *Provisioner) getOpenstackIdentityStatus(ctx context.Context, client reg
 }
 
 // updateStatus updates the compute cluster status.
-func (p *Provisioner) updateStatus(ctx context.Context, serverSet serverSet, options *openstackIdentityStatus) {
+func (p *Provisioner) updateStatus(ctx context.Context, serverSet serverSet, options *openstackIdentityStatus, evictionIDs []string) {
 	log := log.FromContext(ctx)
 
 	// NOTE: the shared update function expects a list, but we use a map
@@ -156,17 +278,113 @@ func (p *Provisioner) updateStatus(ctx context.Context, serverSet serverSet, opt
 
 	p.cluster.Status.SSHPrivateKey = options.SSHPrivateKey
 
+	evictionsCompleted := evictionsCompleted(p.cluster.Status.Evictions)
+	healthy := healthyMachines(&p.cluster)
+
+	p.updateEvictionStatus(serverSet, evictionIDs)
+
 	if err := util.UpdateClusterStatus(&p.cluster, servers); err != nil {
 		log.Error(err, "status update error", "cluster", p.cluster.Name)
 	}
+
+	p.notifyStatusChanges(ctx, evictionsCompleted, healthy)
+
+	p.updateCancellationStatus()
+
+	if p.maintenance != nil {
+		util.UpdateMaintenanceStatus(&p.cluster, p.maintenance)
+	}
+
+	util.UpdateMaintenanceModeStatus(&p.cluster)
+}
+
+// updateCancellationStatus reports how far a cancelled update got, in terms of
+// the number of servers that exist for each pool compared to those requested.
+func (p *Provisioner) updateCancellationStatus() {
+	if !p.cancelled {
+		p.cluster.Status.Cancellation = nil
+
+		return
+	}
+
+	progress := make([]string, len(p.cluster.Spec.WorkloadPools.Pools))
+
+	for i := range p.cluster.Spec.WorkloadPools.Pools {
+		pool := &p.cluster.Spec.WorkloadPools.Pools[i]
+
+		var replicas int
+
+		if status := p.cluster.GetWorkloadPoolStatus(pool.Name); status != nil {
+			replicas = status.Replicas
+		}
+
+		progress[i] = fmt.Sprintf("pool %s has %d of %d machines", pool.Name, replicas, pool.Replicas)
+	}
+
+	p.cluster.Status.Cancellation = &unikornv1.ComputeClusterCancellationStatus{
+		Generation: p.cluster.Generation,
+		Message:    "update cancelled, " + strings.Join(progress, ", "),
+	}
+}
+
+// reconcilePhoneHomeToken ensures the token used to sign phone home URLs exists when
+// the feature is enabled.  As it's baked into servers' user data, the token is
+// persisted immediately, rather than when the status is updated after reconciliation
+// which may not happen on error.
+func (p *Provisioner) reconcilePhoneHomeToken(ctx context.Context) error {
+	if p.options.phoneHomeURL == "" {
+		p.cluster.Status.PhoneHomeToken = nil
+
+		return nil
+	}
+
+	if p.cluster.Status.PhoneHomeToken != nil {
+		return nil
+	}
+
+	token := make([]byte, 32)
+
+	if _, err := rand.Read(token); err != nil {
+		return err
+	}
+
+	p.cluster.Status.PhoneHomeToken = ptr.To(hex.EncodeToString(token))
+
+	cli, err := coreclient.FromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := cli.Status().Update(ctx, &p.cluster); err != nil {
+		return fmt.Errorf("%w: failed to persist phone home token", err)
+	}
+
+	return nil
 }
 
 // Provision implements the Provision interface.
-func (p *Provisioner) Provision(ctx context.Context) error {
+func (p *Provisioner) Provision(ctx context.Context) (err error) {
 	if _, ok := p.cluster.Labels[constants.ResourceAPIVersionLabel]; ok {
 		return nil
 	}
 
+	start := time.Now()
+
+	ctx, span := tracing.StartReconcile(ctx, "cluster", "provision", &p.cluster)
+	defer func() {
+		tracing.End(span, err)
+	}()
+
+	provisioned := p.provisioned()
+
+	defer func() {
+		metrics.ObserveReconcile("cluster", start, err)
+
+		if err == nil && !provisioned {
+			p.notify(ctx, unikornv1.WebhookEventClusterProvisioned, nil)
+		}
+	}()
+
 	// Likewise identity creation is provisioned asynchronously as it too takes a
 	// long time, especially if a physical network is being provisioned and that
 	// needs to go out and talk to switches.
@@ -190,32 +408,80 @@ func (p *Provisioner) Provision(ctx context.Context) error {
 		return err
 	}
 
+	p.maintenance, err = p.getMaintenance(ctx)
+	if err != nil {
+		return err
+	}
+
+	// In maintenance mode nothing is modified, but the status must still reflect
+	// what's happening to the servers.
+	if p.cluster.Spec.MaintenanceMode {
+		p.updateStatus(ctx, serverSet, openstackIdentityStatus, nil)
+
+		return nil
+	}
+
+	if err := p.detachServers(ctx, client, serverSet); err != nil {
+		return err
+	}
+
+	if err := p.adoptServers(ctx, client, serverSet); err != nil {
+		return err
+	}
+
+	if err := p.reconcilePhoneHomeToken(ctx); err != nil {
+		return err
+	}
+
 	// The server set will update as we reconcile, ensure we update the status
-	// regardless of what happened.
-	defer p.updateStatus(ctx, serverSet, openstackIdentityStatus)
+	// regardless of what happened.  Eviction requests are consumed during
+	// reconciliation so need to be remembered up front.
+	defer p.updateStatus(ctx, serverSet, openstackIdentityStatus, p.getPreferredDeletionIDs())
 
 	securityGroups, err := p.newSecurityGroupSet(ctx, client)
 	if err != nil {
 		return err
 	}
 
-	if err := p.reconcileSecurityGroups(ctx, client, securityGroups); err != nil {
+	err = p.reconcileSecurityGroups(ctx, client, securityGroups)
+
+	util.UpdateDependencyCondition(&p.cluster.Status.Conditions, unikornv1.ConditionSecurityGroupsReady, "security groups", err)
+
+	if err != nil {
 		return err
 	}
 
-	if err := p.reconcileServers(ctx, client, serverSet, securityGroups, openstackIdentityStatus); err != nil {
+	if err := p.awaitGoldenImages(ctx, client); err != nil {
+		util.UpdateDependencyCondition(&p.cluster.Status.Conditions, unikornv1.ConditionServersReady, "golden images", err)
+
 		return err
 	}
 
-	return nil
+	p.bootstrap, err = p.renderBootstrap(ctx, client)
+	if err != nil {
+		util.UpdateDependencyCondition(&p.cluster.Status.Conditions, unikornv1.ConditionServersReady, "bootstrap profiles", err)
+
+		return err
+	}
+
+	err = p.reconcileServers(ctx, client, serverSet, securityGroups, openstackIdentityStatus)
+
+	util.UpdateDependencyCondition(&p.cluster.Status.Conditions, unikornv1.ConditionServersReady, "servers", err)
+
+	return err
 }
 
 // Deprovision implements the Provision interface.
-func (p *Provisioner) Deprovision(ctx context.Context) error {
+func (p *Provisioner) Deprovision(ctx context.Context) (err error) {
 	if _, ok := p.cluster.Labels[constants.ResourceAPIVersionLabel]; ok {
 		return nil
 	}
 
+	ctx, span := tracing.StartReconcile(ctx, "cluster", "deprovision", &p.cluster)
+	defer func() {
+		tracing.End(span, err)
+	}()
+
 	// Clean up the identity when everything has cleanly deprovisioned.
 	// An accepted status means the API has recoded the deletion event and
 	// we can delete the cluster, a not found means it's been deleted already
@@ -236,7 +502,7 @@ func (p *Provisioner) Deprovision(ctx context.Context) error {
 		return err
 	}
 
-	defer p.updateStatus(ctx, serverSet, &openstackIdentityStatus{})
+	defer p.updateStatus(ctx, serverSet, &openstackIdentityStatus{}, nil)
 
 	if err := p.deleteIdentity(ctx, client); err != nil {
 		return err
@@ -21,29 +21,34 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"net/url"
 
+	"github.com/unikorn-cloud/compute/pkg/metrics"
+	"github.com/unikorn-cloud/compute/pkg/provisioners/clientcache"
 	"github.com/unikorn-cloud/compute/pkg/provisioners/managers/cluster/util"
+	"github.com/unikorn-cloud/compute/pkg/tracing"
 	coreclient "github.com/unikorn-cloud/core/pkg/client"
 	coreconstants "github.com/unikorn-cloud/core/pkg/constants"
 	coreapi "github.com/unikorn-cloud/core/pkg/openapi"
 	"github.com/unikorn-cloud/core/pkg/provisioners"
 	servererrors "github.com/unikorn-cloud/core/pkg/server/errors"
-	regionclient "github.com/unikorn-cloud/region/pkg/client"
 	regionapi "github.com/unikorn-cloud/region/pkg/openapi"
 
 	"sigs.k8s.io/controller-runtime/pkg/log"
 )
 
-// getRegionClient returns an authenticated client.
-// TODO: the client should be cached for an appropriate period to avoid polluting the
-// caches in identity with new tokens during busy periods.
+// getRegionClient returns an authenticated client.  The underlying HTTP client is
+// shared between reconciles to avoid a new connection pool and TLS handshakes each time,
+// and signed principals are shared to avoid signing every request.
 func (p *Provisioner) getRegionClient(ctx context.Context) (regionapi.ClientWithResponsesInterface, error) {
 	cli, err := coreclient.FromContext(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	client, err := regionclient.New(cli, p.options.regionOptions, &p.options.clientOptions).ControllerClient(ctx, &p.cluster)
+	builder := tracing.NewBuilder[regionapi.ClientWithResponses](metrics.NewBuilder[regionapi.ClientWithResponses](regionapi.NewBuilder(), "region"), "region")
+
+	client, err := clientcache.ControllerClient(ctx, p.options.httpClients, p.options.principals, cli, p.options.regionOptions, &p.options.clientOptions, builder, &p.cluster)
 	if err != nil {
 		return nil, err
 	}
@@ -136,22 +141,98 @@ func (p *Provisioner) getNetwork(ctx context.Context, client regionapi.ClientWit
 	return nil, fmt.Errorf("%w: unhandled status %s", ErrResourceDependency, resource.Metadata.ProvisioningStatus)
 }
 
-// listServers lists all servers that are part of this cluster.
+// followLink redirects a request to the next page of a paged response.
+func followLink(next *url.URL) regionapi.RequestEditorFn {
+	return func(_ context.Context, req *http.Request) error {
+		req.URL = next
+		req.Host = next.Host
+
+		return nil
+	}
+}
+
+// listServers lists all servers that are part of this cluster.  Pages are followed
+// until exhausted, reconciling against a partial list would result in servers being
+// recreated, so rather than truncating, an error is raised if the page limit is hit.
 func (p *Provisioner) listServers(ctx context.Context, client regionapi.ClientWithResponsesInterface) (regionapi.ServersResponse, error) {
 	params := &regionapi.GetApiV1OrganizationsOrganizationIDServersParams{
 		Tag: util.ClusterTagSelector(&p.cluster),
 	}
 
-	response, err := client.GetApiV1OrganizationsOrganizationIDServersWithResponse(ctx, p.cluster.Labels[coreconstants.OrganizationLabel], params)
+	maxPages := defaultServerListMaxPages
+
+	if p.options != nil && p.options.serverListMaxPages > 0 {
+		maxPages = p.options.serverListMaxPages
+	}
+
+	var result regionapi.ServersResponse
+
+	// Servers may move between pages as others are created and deleted.
+	seen := map[string]bool{}
+
+	var editors []regionapi.RequestEditorFn
+
+	for page := 1; ; page++ {
+		if page > maxPages {
+			return nil, fmt.Errorf("%w: server list exceeds %d pages", util.ErrPagination, maxPages)
+		}
+
+		response, err := client.GetApiV1OrganizationsOrganizationIDServersWithResponse(ctx, p.cluster.Labels[coreconstants.OrganizationLabel], params, editors...)
+		if err != nil {
+			return nil, err
+		}
+
+		if response.StatusCode() != http.StatusOK {
+			return nil, servererrors.PropagateError(response.HTTPResponse, response)
+		}
+
+		for _, server := range *response.JSON200 {
+			if seen[server.Metadata.Id] {
+				continue
+			}
+
+			seen[server.Metadata.Id] = true
+
+			result = append(result, server)
+		}
+
+		var next *url.URL
+
+		if response.HTTPResponse != nil && response.HTTPResponse.Request != nil {
+			if next, err = util.NextPageURL(response.HTTPResponse.Header, response.HTTPResponse.Request.URL); err != nil {
+				return nil, err
+			}
+		}
+
+		if next == nil {
+			metrics.ServerListPages.WithLabelValues("cluster").Observe(float64(page))
+
+			return result, nil
+		}
+
+		editors = []regionapi.RequestEditorFn{
+			followLink(next),
+		}
+	}
+}
+
+// getServer returns a server in the cluster's identity, or nil if it doesn't exist.
+func (p *Provisioner) getServer(ctx context.Context, client regionapi.ClientWithResponsesInterface, serverID string) (*regionapi.ServerRead, error) {
+	resp, err := client.GetApiV1OrganizationsOrganizationIDProjectsProjectIDIdentitiesIdentityIDServersServerIDWithResponse(ctx, p.cluster.Labels[coreconstants.OrganizationLabel], p.cluster.Labels[coreconstants.ProjectLabel], p.cluster.Annotations[coreconstants.IdentityAnnotation], serverID)
 	if err != nil {
 		return nil, err
 	}
 
-	if response.StatusCode() != http.StatusOK {
-		return nil, servererrors.PropagateError(response.HTTPResponse, response)
+	if resp.StatusCode() == http.StatusNotFound {
+		//nolint: nilnil
+		return nil, nil
 	}
 
-	return *response.JSON200, nil
+	if resp.StatusCode() != http.StatusOK {
+		return nil, servererrors.PropagateError(resp.HTTPResponse, resp)
+	}
+
+	return resp.JSON200, nil
 }
 
 // createServer creates a new server.
@@ -182,6 +263,53 @@ func (p *Provisioner) updateServer(ctx context.Context, client regionapi.ClientW
 	return resp.JSON202, nil
 }
 
+// resizeServer updates a server with a new flavor.  This differs from a regular update
+// in that the region may report the operation as unsupported, in which case the
+// caller should fall back to a rebuild.
+func (p *Provisioner) resizeServer(ctx context.Context, client regionapi.ClientWithResponsesInterface, serverID string, request *regionapi.ServerWrite) (*regionapi.ServerResponse, error) {
+	resp, err := client.PutApiV1OrganizationsOrganizationIDProjectsProjectIDIdentitiesIdentityIDServersServerIDWithResponse(ctx, p.cluster.Labels[coreconstants.OrganizationLabel], p.cluster.Labels[coreconstants.ProjectLabel], p.cluster.Annotations[coreconstants.IdentityAnnotation], serverID, *request)
+	if err != nil {
+		return nil, err
+	}
+
+	switch resp.StatusCode() {
+	case http.StatusAccepted:
+		return resp.JSON202, nil
+	case http.StatusNotImplemented, http.StatusUnprocessableEntity:
+		return nil, ErrResizeUnsupported
+	}
+
+	return nil, servererrors.PropagateError(resp.HTTPResponse, resp)
+}
+
+// startServer starts a stopped server.
+func (p *Provisioner) startServer(ctx context.Context, client regionapi.ClientWithResponsesInterface, serverID string) error {
+	resp, err := client.PostApiV1OrganizationsOrganizationIDProjectsProjectIDIdentitiesIdentityIDServersServerIDStartWithResponse(ctx, p.cluster.Labels[coreconstants.OrganizationLabel], p.cluster.Labels[coreconstants.ProjectLabel], p.cluster.Annotations[coreconstants.IdentityAnnotation], serverID)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode() != http.StatusAccepted {
+		return servererrors.PropagateError(resp.HTTPResponse, resp)
+	}
+
+	return nil
+}
+
+// stopServer stops a running server.
+func (p *Provisioner) stopServer(ctx context.Context, client regionapi.ClientWithResponsesInterface, serverID string) error {
+	resp, err := client.PostApiV1OrganizationsOrganizationIDProjectsProjectIDIdentitiesIdentityIDServersServerIDStopWithResponse(ctx, p.cluster.Labels[coreconstants.OrganizationLabel], p.cluster.Labels[coreconstants.ProjectLabel], p.cluster.Annotations[coreconstants.IdentityAnnotation], serverID)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode() != http.StatusAccepted {
+		return servererrors.PropagateError(resp.HTTPResponse, resp)
+	}
+
+	return nil
+}
+
 // deleteServer deletes a server.
 func (p *Provisioner) deleteServer(ctx context.Context, client regionapi.ClientWithResponsesInterface, id string) error {
 	resp, err := client.DeleteApiV1OrganizationsOrganizationIDProjectsProjectIDIdentitiesIdentityIDServersServerIDWithResponse(ctx, p.cluster.Labels[coreconstants.OrganizationLabel], p.cluster.Labels[coreconstants.ProjectLabel], p.cluster.Annotations[coreconstants.IdentityAnnotation], id)
@@ -40,6 +40,7 @@ import (
 	coreapi "github.com/unikorn-cloud/core/pkg/openapi"
 	regionapi "github.com/unikorn-cloud/region/pkg/openapi"
 
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/utils/ptr"
 
 	"sigs.k8s.io/controller-runtime/pkg/log"
@@ -207,9 +208,13 @@ func (p *Provisioner) reconcileSecurityGroups(ctx context.Context, client region
 
 		securityGroup, err := p.createSecurityGroup(ctx, client, request)
 		if err != nil {
+			p.recordEvent(ctx, corev1.EventTypeWarning, "SecurityGroupCreateFailed", fmt.Sprintf("Failed to create security group for pool %s: %v", poolName, err))
+
 			return err
 		}
 
+		p.recordEvent(ctx, corev1.EventTypeNormal, "SecurityGroupCreated", fmt.Sprintf("Created security group %s for pool %s", securityGroup.Metadata.Id, poolName))
+
 		if err := securityGroups.add(poolName, securityGroup); err != nil {
 			return err
 		}
@@ -235,9 +240,34 @@ func (p *Provisioner) reconcileSecurityGroups(ctx context.Context, client region
 		log.Info("deleting security group", "pool", poolName, "id", securityGroup.Metadata.Id, "name", securityGroup.Metadata.Name)
 
 		if err := p.deleteSecurityGroup(ctx, client, securityGroup.Metadata.Id); err != nil {
+			p.recordEvent(ctx, corev1.EventTypeWarning, "SecurityGroupDeleteFailed", fmt.Sprintf("Failed to delete security group %s for pool %s: %v", securityGroup.Metadata.Id, poolName, err))
+
 			return err
 		}
+
+		p.recordEvent(ctx, corev1.EventTypeNormal, "SecurityGroupDeleted", fmt.Sprintf("Deleted security group %s for pool %s", securityGroup.Metadata.Id, poolName))
 	}
 
+	p.updateSecurityGroupStatus(securityGroups)
+
 	return nil
 }
+
+// updateSecurityGroupStatus records the security group managed for each pool.
+func (p *Provisioner) updateSecurityGroupStatus(securityGroups securityGroupSet) {
+	p.cluster.Status.SecurityGroups = nil
+
+	for i := range p.cluster.Spec.WorkloadPools.Pools {
+		pool := &p.cluster.Spec.WorkloadPools.Pools[i]
+
+		securityGroup, ok := securityGroups[pool.Name]
+		if !ok {
+			continue
+		}
+
+		p.cluster.Status.SecurityGroups = append(p.cluster.Status.SecurityGroups, unikornv1.WorkloadPoolSecurityGroupStatus{
+			Name: pool.Name,
+			ID:   securityGroup.Metadata.Id,
+		})
+	}
+}
@@ -18,24 +18,33 @@ limitations under the License.
 package cluster
 
 import (
+	"cmp"
 	"context"
+	"errors"
 	"fmt"
 	"maps"
 	"reflect"
 	"slices"
-	"strings"
+	"time"
+
+	"golang.org/x/sync/errgroup"
 
 	unikornv1 "github.com/unikorn-cloud/compute/pkg/apis/unikorn/v1alpha1"
-	"github.com/unikorn-cloud/compute/pkg/constants"
+	"github.com/unikorn-cloud/compute/pkg/metrics"
 	"github.com/unikorn-cloud/compute/pkg/provisioners/managers/cluster/util"
 	coreclient "github.com/unikorn-cloud/core/pkg/client"
-	"github.com/unikorn-cloud/core/pkg/errors"
+	coreconstants "github.com/unikorn-cloud/core/pkg/constants"
+	coreerrors "github.com/unikorn-cloud/core/pkg/errors"
 	coreapi "github.com/unikorn-cloud/core/pkg/openapi"
+	"github.com/unikorn-cloud/core/pkg/provisioners"
 	regionapi "github.com/unikorn-cloud/region/pkg/openapi"
 
-	"k8s.io/apimachinery/pkg/util/rand"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/utils/ptr"
 
+	crclient "sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 )
 
@@ -45,7 +54,7 @@ type serverSet map[string]*regionapi.ServerRead
 // add adds a server to the set and raises an error if one already exists.
 func (s serverSet) add(serverName string, server *regionapi.ServerRead) error {
 	if _, ok := s[serverName]; ok {
-		return fmt.Errorf("%w: server %s already exists in set", errors.ErrConsistency, serverName)
+		return fmt.Errorf("%w: server %s already exists in set", coreerrors.ErrConsistency, serverName)
 	}
 
 	s[serverName] = server
@@ -54,8 +63,9 @@ func (s serverSet) add(serverName string, server *regionapi.ServerRead) error {
 }
 
 // selectDeletionCandidate picks an arbitrary server to delete after first
-// searching for preferred options.
-func (s serverSet) selectDeletionCandidate(preferredIDs []string) *regionapi.ServerRead {
+// searching for preferred options.  Cordoned servers are only considered if
+// they are preferred, and nil is returned if there are no candidates.
+func (s serverSet) selectDeletionCandidate(preferredIDs, cordonedIDs []string) *regionapi.ServerRead {
 	servers := slices.Collect(maps.Values(s))
 
 	for _, id := range preferredIDs {
@@ -68,7 +78,15 @@ func (s serverSet) selectDeletionCandidate(preferredIDs []string) *regionapi.Ser
 		}
 	}
 
-	return servers[0]
+	notCordoned := func(server *regionapi.ServerRead) bool {
+		return !slices.Contains(cordonedIDs, server.Metadata.Id)
+	}
+
+	if index := slices.IndexFunc(servers, notCordoned); index >= 0 {
+		return servers[index]
+	}
+
+	return nil
 }
 
 // newServerSet returns a new set of servers indexed by pool and by name.
@@ -90,49 +108,137 @@ func newServerSet(ctx context.Context, servers regionapi.ServersRead) (serverSet
 	return result, nil
 }
 
-// getSecurityGroupForPool returns the security group for a pool.  It assumes the main provisioner
-// has waited until all security groups are ready before proceeding.
+// generateSecurityGroup returns the security groups for a pool, the managed one first, then any
+// pre-existing ones in the order requested.  It assumes the main provisioner has waited until all
+// security groups are ready before proceeding.
 func generateSecurityGroup(pool *unikornv1.ComputeClusterWorkloadPoolSpec, securityGroups securityGroupSet) (*regionapi.ServerSecurityGroupList, error) {
-	if !pool.HasFirewallRules() {
+	if !pool.HasFirewallRules() && len(pool.SecurityGroupIDs) == 0 {
 		//nolint:nilnil
 		return nil, nil
 	}
 
-	securityGroup, ok := securityGroups[pool.Name]
-	if !ok {
-		return nil, fmt.Errorf("%w: security group for server pool %s not found", errors.ErrConsistency, pool.Name)
-	}
+	result := regionapi.ServerSecurityGroupList{}
+
+	if pool.HasFirewallRules() {
+		securityGroup, ok := securityGroups[pool.Name]
+		if !ok {
+			return nil, fmt.Errorf("%w: security group for server pool %s not found", coreerrors.ErrConsistency, pool.Name)
+		}
 
-	result := &regionapi.ServerSecurityGroupList{
-		regionapi.ServerSecurityGroup{
+		result = append(result, regionapi.ServerSecurityGroup{
 			Id: securityGroup.Metadata.Id,
-		},
+		})
 	}
 
-	return result, nil
+	for _, id := range pool.SecurityGroupIDs {
+		result = append(result, regionapi.ServerSecurityGroup{
+			Id: id,
+		})
+	}
+
+	return &result, nil
 }
 
-// generateUserData generates user data for a server request.
-func generateUserData(pool *unikornv1.ComputeClusterWorkloadPoolSpec) *[]byte {
-	if pool.UserData == nil {
-		return nil
+// expandUserData expands the pool's user data template, if enabled, for a server.
+func (p *Provisioner) expandUserData(pool *unikornv1.ComputeClusterWorkloadPoolSpec, name string) ([]byte, error) {
+	if !pool.UserDataTemplate || pool.UserData == nil {
+		return pool.UserData, nil
+	}
+
+	variables := &util.UserDataVariables{
+		ClusterID:   p.cluster.Name,
+		ClusterName: p.cluster.Labels[coreconstants.NameLabel],
+		PoolName:    pool.Name,
+		MachineName: name,
+		FQDN:        cmp.Or(util.ServerFQDN(pool, name), name),
+	}
+
+	if p.cluster.Spec.Network != nil {
+		variables.PrivateNetworkCIDR = p.cluster.Spec.Network.NodeNetwork.String()
+	}
+
+	return util.ExpandUserData(pool.UserData, variables)
+}
+
+// generateBootstrapUserData combines the pool's bootstrap profile, its own user data
+// and any phone home directive, in that order, so drivers are installed before the
+// user's configuration runs.  Unlike phone home, a bootstrap profile is explicitly
+// requested, so user data that cannot be combined is an error.
+func (p *Provisioner) generateBootstrapUserData(pool *unikornv1.ComputeClusterWorkloadPoolSpec, name string, bootstrap, userData []byte) (*[]byte, error) {
+	parts := [][]byte{bootstrap, userData}
+
+	if token := p.cluster.Status.PhoneHomeToken; token != nil {
+		parts = append(parts, util.PhoneHomeUserData(util.PhoneHomeURL(p.options.phoneHomeURL, *token, p.cluster.Name, name)))
+	}
+
+	combined, ok, err := util.CombineUserData(parts...)
+	if err != nil {
+		return nil, err
+	}
+
+	if !ok {
+		return nil, fmt.Errorf("%w: user data for pool %s cannot be combined with its bootstrap profile", util.ErrBootstrap, pool.Name)
 	}
 
-	return &pool.UserData
+	return &combined, nil
 }
 
-// generateServer generates a server request for creation and updates.
-func (p *Provisioner) generateServer(openstackIdentityStatus *openstackIdentityStatus, pool *unikornv1.ComputeClusterWorkloadPoolSpec, securityGroups securityGroupSet) (*regionapi.ServerWrite, error) {
+// generateUserData generates user data for a server request.  When phone home is
+// enabled, the server is instructed to report cloud-init completion.
+func (p *Provisioner) generateUserData(pool *unikornv1.ComputeClusterWorkloadPoolSpec, name string) (*[]byte, error) {
+	userData, err := p.expandUserData(pool, name)
+	if err != nil {
+		return nil, err
+	}
+
+	if bootstrap, ok := p.bootstrap[pool.Name]; ok {
+		return p.generateBootstrapUserData(pool, name, bootstrap, userData)
+	}
+
+	if token := p.cluster.Status.PhoneHomeToken; token != nil {
+		url := util.PhoneHomeURL(p.options.phoneHomeURL, *token, p.cluster.Name, name)
+
+		injected, ok, err := util.InjectPhoneHome(userData, url)
+		if err != nil {
+			return nil, err
+		}
+
+		if ok {
+			return &injected, nil
+		}
+	}
+
+	if userData == nil {
+		return nil, nil
+	}
+
+	return &userData, nil
+}
+
+// generateServer generates a server request for creation and updates.  The name
+// translates to a host name, so must be preserved for existing servers.
+func (p *Provisioner) generateServer(openstackIdentityStatus *openstackIdentityStatus, pool *unikornv1.ComputeClusterWorkloadPoolSpec, securityGroups securityGroupSet, name string) (*regionapi.ServerWrite, error) {
 	securityGroup, err := generateSecurityGroup(pool, securityGroups)
 	if err != nil {
 		return nil, err
 	}
 
+	userData, err := p.generateUserData(pool, name)
+	if err != nil {
+		return nil, err
+	}
+
+	tags := p.tags(pool)
+
+	if fqdn := util.ServerFQDN(pool, name); fqdn != "" {
+		*tags = append(*tags, coreapi.Tag{Name: util.FQDNLabel, Value: fqdn})
+	}
+
 	request := &regionapi.ServerWrite{
 		Metadata: coreapi.ResourceWriteMetadata{
-			Name:        pool.Name + "-" + rand.String(6),
+			Name:        name,
 			Description: ptr.To("Server for cluster " + p.cluster.Name),
-			Tags:        p.tags(pool),
+			Tags:        tags,
 		},
 		Spec: regionapi.ServerSpec{
 			FlavorId: pool.FlavorID,
@@ -146,7 +252,7 @@ func (p *Provisioner) generateServer(openstackIdentityStatus *openstackIdentityS
 				Enabled: pool.PublicIPAllocation != nil && pool.PublicIPAllocation.Enabled,
 			},
 			SecurityGroups: securityGroup,
-			UserData:       generateUserData(pool),
+			UserData:       userData,
 		},
 	}
 
@@ -163,9 +269,28 @@ func (p *Provisioner) generateServer(openstackIdentityStatus *openstackIdentityS
 	return request, nil
 }
 
-// needsUpdate compares both specifications and determines whether we need a resource update.
+// needsUpdate compares both specifications and tags and determines whether we need a
+// resource update.  Tags are compared regardless of order, so label changes propagate
+// to existing servers.
 func needsUpdate(current *regionapi.ServerRead, requested *regionapi.ServerWrite) bool {
-	return !reflect.DeepEqual(current.Spec, requested.Spec)
+	return !reflect.DeepEqual(current.Spec, requested.Spec) || !equalTags(current.Metadata.Tags, requested.Metadata.Tags)
+}
+
+// equalTags checks whether the tag lists contain the same tags, regardless of order.
+func equalTags(a, b *coreapi.TagList) bool {
+	compare := func(a, b coreapi.Tag) int {
+		return cmp.Or(cmp.Compare(a.Name, b.Name), cmp.Compare(a.Value, b.Value))
+	}
+
+	sorted := func(in *coreapi.TagList) coreapi.TagList {
+		if in == nil {
+			return nil
+		}
+
+		return slices.SortedFunc(slices.Values(*in), compare)
+	}
+
+	return slices.Equal(sorted(a), sorted(b))
 }
 
 // needsRebuild compares the current and requested specifications to determine whether
@@ -174,22 +299,85 @@ func needsUpdate(current *regionapi.ServerRead, requested *regionapi.ServerWrite
 func needsRebuild(ctx context.Context, current *regionapi.ServerRead, requested *regionapi.ServerWrite) bool {
 	log := log.FromContext(ctx)
 
-	// TODO: flavors can usually be scaled up without losing data but this requires
-	// a shutdown, resize, possible confirmation due to a cold migration, and then
-	// a restart.
-	if current.Spec.FlavorId != requested.Spec.FlavorId {
-		log.Info("server rebuild required due to flavor change", "id", current.Metadata.Id, "desiredState", requested.Spec.FlavorId, "currentState", current.Spec.FlavorId)
+	// NOTE: flavor changes are handled in place by a resize, see needsResize.
+	if current.Spec.ImageId != requested.Spec.ImageId {
+		log.Info("server rebuild required due to image change", "id", current.Metadata.Id, "desiredState", requested.Spec.ImageId, "currentState", current.Spec.ImageId)
 		return true
 	}
 
-	if current.Spec.ImageId != requested.Spec.ImageId {
-		log.Info("server rebuild required due to image change", "id", current.Metadata.Id, "desiredState", requested.Spec.ImageId, "currentState", current.Spec.ImageId)
+	return false
+}
+
+// needsResize determines whether the server's flavor needs changing.
+func needsResize(ctx context.Context, current *regionapi.ServerRead, requested *regionapi.ServerWrite) bool {
+	log := log.FromContext(ctx)
+
+	if current.Spec.FlavorId != requested.Spec.FlavorId {
+		log.Info("server resize required due to flavor change", "id", current.Metadata.Id, "desiredState", requested.Spec.FlavorId, "currentState", current.Spec.FlavorId)
 		return true
 	}
 
 	return false
 }
 
+// resizeServerWrapper performs an in place resize of a server.  Flavors can usually
+// be changed without losing data, but this requires a shutdown, the resize itself,
+// which the region will confirm once any cold migration has completed, and then a
+// restart if the server was running beforehand.  This spans multiple reconciles, so
+// progress is tracked in the cluster status, and it returns true while the resize is
+// in progress.  If the region reports resize is unsupported, then ErrResizeUnsupported
+// is propagated and the caller should rebuild the server.
+func (p *Provisioner) resizeServerWrapper(ctx context.Context, client regionapi.ClientWithResponsesInterface, server *regionapi.ServerRead, required *regionapi.ServerWrite, tracking util.MachineTracking) (*regionapi.ServerRead, bool, error) {
+	log := log.FromContext(ctx)
+
+	machine := tracking.Get(server.Metadata.Id)
+
+	action, state := util.PlanResize(server, required.Spec.FlavorId, machine.Resize)
+
+	switch action {
+	case util.ResizeWait:
+		log.Info("waiting for server resize", "id", server.Metadata.Id, "phase", state.Phase)
+	case util.ResizeStop:
+		log.Info("stopping server for resize", "id", server.Metadata.Id)
+
+		if err := p.stopServer(ctx, client, server.Metadata.Id); err != nil {
+			return nil, false, err
+		}
+	case util.ResizeApply:
+		log.Info("resizing server", "id", server.Metadata.Id, "flavorID", required.Spec.FlavorId)
+
+		metrics.ServerOperations.WithLabelValues("cluster", "resize").Inc()
+
+		p.recordEvent(ctx, corev1.EventTypeNormal, "ServerResize", fmt.Sprintf("Resizing server %s (%s) to flavor %s", server.Metadata.Name, server.Metadata.Id, required.Spec.FlavorId))
+
+		updated, err := p.resizeServer(ctx, client, server.Metadata.Id, required)
+		if err != nil {
+			// The server is about to be rebuilt instead.
+			if errors.Is(err, ErrResizeUnsupported) {
+				machine.Resize = nil
+			}
+
+			return nil, false, err
+		}
+
+		server = updated
+	case util.ResizeStart:
+		log.Info("starting server after resize", "id", server.Metadata.Id)
+
+		if err := p.startServer(ctx, client, server.Metadata.Id); err != nil {
+			return nil, false, err
+		}
+	case util.ResizeDone:
+		log.Info("server resize complete", "id", server.Metadata.Id)
+	}
+
+	machine.Resize = state
+
+	// Once complete, yield anyway so any other updates are applied to the server
+	// once the region has settled.
+	return server, true, nil
+}
+
 // deleteServerWrapper wraps up common server deletion handling as it's called from
 // multiple different places.
 func (p *Provisioner) deleteServerWrapper(ctx context.Context, client regionapi.ClientWithResponsesInterface, server *regionapi.ServerRead) error {
@@ -198,9 +386,17 @@ func (p *Provisioner) deleteServerWrapper(ctx context.Context, client regionapi.
 	log.Info("deleting server", "id", server.Metadata.Id, "name", server.Metadata.Name)
 
 	if err := p.deleteServer(ctx, client, server.Metadata.Id); err != nil {
+		p.deletionErrors[server.Metadata.Id] = err
+
+		p.recordEvent(ctx, corev1.EventTypeWarning, "ServerDeleteFailed", fmt.Sprintf("Failed to delete server %s (%s): %v", server.Metadata.Name, server.Metadata.Id, err))
+
 		return err
 	}
 
+	metrics.ServerOperations.WithLabelValues("cluster", "delete").Inc()
+
+	p.recordEvent(ctx, corev1.EventTypeNormal, "ServerDeleted", fmt.Sprintf("Deleted server %s (%s)", server.Metadata.Name, server.Metadata.Id))
+
 	server.Metadata.ProvisioningStatus = coreapi.ResourceProvisioningStatusDeprovisioning
 
 	return nil
@@ -237,13 +433,248 @@ func newServerPoolSet(servers serverSet) (serverPoolSet, error) {
 // This is set by the API during eviction while scaling down the pools in a single
 // atomic operation.
 func (p *Provisioner) getPreferredDeletionIDs() []string {
-	var out []string
+	return util.GetPendingEvictions(&p.cluster)
+}
+
+// serverUnhealthy tells us whether a server counts as unhealthy for the purposes
+// of auto healing.  Unknown health is given the benefit of the doubt.
+func serverUnhealthy(server *regionapi.ServerRead) bool {
+	return server.Metadata.HealthStatus == coreapi.ResourceHealthStatusDegraded || server.Metadata.HealthStatus == coreapi.ResourceHealthStatusError
+}
+
+// serverStopped tells us whether a server has been, or is being, powered off.  The
+// provider reports these as degraded, but they are stopped on purpose.
+func serverStopped(server *regionapi.ServerRead) bool {
+	if server.Status.Phase == nil {
+		return false
+	}
+
+	//nolint:exhaustive
+	switch *server.Status.Phase {
+	case regionapi.InstanceLifecyclePhaseStopped, regionapi.InstanceLifecyclePhaseStopping:
+		return true
+	}
+
+	return false
+}
+
+// serverAvailable tells us whether a server is running and healthy, and therefore
+// counts towards a pool's availability during a rolling update.
+func serverAvailable(server *regionapi.ServerRead) bool {
+	return ptr.Deref(server.Status.Phase, regionapi.InstanceLifecyclePhasePending) == regionapi.InstanceLifecyclePhaseRunning && !serverUnhealthy(server)
+}
+
+// serverTransitioning tells us whether the provider is part way through an operation
+// on the server e.g. booting, stopping, or migrating and verifying a resize.  During
+// these the server's reported specification may not reflect reality, so acting on it
+// can be destructive.
+func serverTransitioning(server *regionapi.ServerRead) bool {
+	//nolint:exhaustive
+	switch server.Metadata.ProvisioningStatus {
+	case coreapi.ResourceProvisioningStatusProvisioning, coreapi.ResourceProvisioningStatusDeprovisioning:
+		return true
+	}
+
+	if server.Status.Phase == nil {
+		return false
+	}
+
+	//nolint:exhaustive
+	switch *server.Status.Phase {
+	case regionapi.InstanceLifecyclePhasePending, regionapi.InstanceLifecyclePhaseStopping:
+		return true
+	}
+
+	return false
+}
+
+// deferTransitioning tells us whether an update to a server should be deferred as it's
+// transitioning.  Transitioning servers are tracked in the cluster status, and once
+// they have been transitioning for longer than the grace period they are considered
+// stuck and updated as normal.
+func (p *Provisioner) deferTransitioning(ctx context.Context, server *regionapi.ServerRead, tracking util.MachineTracking, now time.Time) bool {
+	log := log.FromContext(ctx)
+
+	id := server.Metadata.Id
+
+	if !serverTransitioning(server) {
+		tracking.Get(id).TransitioningSince = nil
+
+		return false
+	}
+
+	machine := tracking.Get(id)
+
+	if machine.TransitioningSince == nil {
+		machine.TransitioningSince = ptr.To(metav1.NewTime(now))
+
+		p.recordEvent(ctx, corev1.EventTypeNormal, "ServerTransitioning", fmt.Sprintf("Deferring update of server %s (%s) while in a transitional state", server.Metadata.Name, id))
+	}
+
+	if !tracking.TransitionExpired(id, p.options.serverTransitionGracePeriod, now) {
+		log.Info("deferring server update while transitioning", "id", id, "provisioningStatus", server.Metadata.ProvisioningStatus, "phase", ptr.Deref(server.Status.Phase, ""))
+
+		return true
+	}
+
+	log.Info("server transition grace period expired", "id", id, "since", machine.TransitioningSince.Time)
+
+	p.recordEvent(ctx, corev1.EventTypeWarning, "ServerTransitionTimeout", fmt.Sprintf("Server %s (%s) has been in a transitional state since %s, updating anyway", server.Metadata.Name, id, machine.TransitioningSince.Format(time.RFC3339)))
+
+	return false
+}
+
+// serverOutdated tells us whether a server needs rebuilding with the pool's image.
+func serverOutdated(pool *unikornv1.ComputeClusterWorkloadPoolSpec, server *regionapi.ServerRead) bool {
+	return server.Spec.ImageId != pool.ImageID
+}
+
+// newRollout creates a rolling update tracker for the pool, cordoned servers are
+// excluded as they are never updated.
+func newRollout(pool *unikornv1.ComputeClusterWorkloadPoolSpec, servers serverSet, cordonedIDs []string) *util.Rollout {
+	var outdated int
+
+	for _, server := range servers {
+		if !slices.Contains(cordonedIDs, server.Metadata.Id) && serverOutdated(pool, server) {
+			outdated++
+		}
+	}
+
+	return util.NewRollout(pool, outdated)
+}
+
+// setRolloutAvailable updates the rollout's disruption budget from the pool's servers.
+func setRolloutAvailable(rollout *util.Rollout, servers serverSet) {
+	var available int
+
+	for _, server := range servers {
+		if serverAvailable(server) {
+			available++
+		}
+	}
+
+	rollout.SetAvailable(available)
+}
+
+// healServers deletes servers in an auto healing pool that have been unhealthy for
+// longer than the pool's grace period, they are then recreated along with any other
+// missing servers during scale up.  Unhealthy servers are tracked in the cluster
+// status as servers are recreated on every reconcile.  Returns the IDs of any
+// servers that were deleted.
+func (p *Provisioner) healServers(ctx context.Context, client regionapi.ClientWithResponsesInterface, pool *unikornv1.ComputeClusterWorkloadPoolSpec, servers serverSet, cordonedIDs []string, tracking util.MachineTracking, now time.Time) ([]string, error) {
+	log := log.FromContext(ctx)
+
+	var deleted []string
+
+	for serverName, server := range servers {
+		id := server.Metadata.Id
+
+		// Cordoned servers are left alone entirely, and restart their grace
+		// period if uncordoned.
+		if pool.AutoHealing == nil || slices.Contains(cordonedIDs, id) || !serverUnhealthy(server) {
+			tracking.Get(id).UnhealthySince = nil
+
+			continue
+		}
+
+		// Stopped servers would lose their disks and addresses if replaced, so
+		// restart their grace period once they are started again.
+		if serverStopped(server) {
+			tracking.Get(id).UnhealthySince = nil
+
+			continue
+		}
+
+		// Servers under provider maintenance are expected to be unhealthy, so
+		// restart their grace period once the maintenance is over.
+		if p.maintenance.Active(id) {
+			log.V(1).Info("skipping auto healing of server under maintenance", "id", id, "pool", pool.Name)
+
+			tracking.Get(id).UnhealthySince = nil
+
+			continue
+		}
+
+		// Still coming up, so may not be healthy yet.
+		if server.Metadata.ProvisioningStatus != coreapi.ResourceProvisioningStatusProvisioned {
+			continue
+		}
+
+		machine := tracking.Get(id)
+
+		if machine.UnhealthySince == nil {
+			log.Info("server unhealthy", "id", id, "pool", pool.Name, "health", server.Metadata.HealthStatus)
+
+			machine.UnhealthySince = ptr.To(metav1.NewTime(now))
+
+			continue
+		}
+
+		since := machine.UnhealthySince.Time
+
+		if now.Sub(since) < pool.AutoHealing.GracePeriod.Duration {
+			continue
+		}
 
-	if t, ok := p.cluster.Annotations[constants.ServerDeletionHintAnnotation]; ok {
-		out = strings.Split(t, ",")
+		log.Info("deleting server due to auto healing", "id", id, "pool", pool.Name, "unhealthySince", since)
+
+		if err := p.deleteServerWrapper(ctx, client, server); err != nil {
+			return nil, err
+		}
+
+		p.recordEvent(ctx, corev1.EventTypeWarning, "AutoHealing", fmt.Sprintf("Replacing server %s (%s) in pool %s, unhealthy since %s", serverName, id, pool.Name, since.Format(time.RFC3339)))
+
+		machine.UnhealthySince = nil
+
+		delete(servers, serverName)
+
+		deleted = append(deleted, id)
+	}
+
+	return deleted, nil
+}
+
+// cancelRequested checks whether the update to the cluster's current generation has
+// been cancelled.  The cluster is read again as cancellation is expected to happen
+// part way through a long running reconcile, e.g. a large scale up.
+func (p *Provisioner) cancelRequested(ctx context.Context) (bool, error) {
+	if util.IsCancelled(&p.cluster) {
+		return true, nil
+	}
+
+	cli, err := coreclient.FromContext(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	current := &unikornv1.ComputeCluster{}
+
+	key := types.NamespacedName{
+		Namespace: p.cluster.Namespace,
+		Name:      p.cluster.Name,
+	}
+
+	if err := cli.Get(ctx, key, current); err != nil {
+		return false, err
+	}
+
+	return util.IsCancelled(current), nil
+}
+
+// getMaintenance looks up any provider maintenance affecting the cluster's region.
+func (p *Provisioner) getMaintenance(ctx context.Context) (*util.Maintenance, error) {
+	cli, err := coreclient.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	windows := &unikornv1.ComputeMaintenanceWindowList{}
+
+	if err := cli.List(ctx, windows, &crclient.ListOptions{Namespace: p.cluster.Namespace}); err != nil {
+		return nil, err
 	}
 
-	return out
+	return util.NewMaintenance(windows.Items, p.cluster.Spec.RegionID, time.Now()), nil
 }
 
 // reconcileServers creates/updates/deletes all servers for the cluster.
@@ -259,6 +690,40 @@ func (p *Provisioner) reconcileServers(ctx context.Context, client regionapi.Cli
 
 	preferredDeletionIDs := p.getPreferredDeletionIDs()
 
+	cordonedIDs := util.GetCordoned(&p.cluster)
+
+	// Pools that cannot be scaled down because the remaining servers are cordoned.
+	held := map[string]bool{}
+
+	flavorOverrides := util.GetFlavorOverrides(&p.cluster)
+
+	// Controller state that needs to persist between reconciles, this is recorded
+	// in the status regardless of how far we get.
+	tracking := util.GetMachineTracking(&p.cluster)
+
+	defer p.updateMachineTracking(servers, tracking)
+
+	// When a machine with a flavor override is rebuilt, its replacement needs to
+	// inherit the override, these are keyed by pool name.
+	rebuildFlavors := map[string][]string{}
+
+	// Public IP overrides apply to the machine only, replacements follow the pool.
+	publicIPOverrides := util.GetPublicIPOverrides(&p.cluster)
+
+	// Resizes span multiple reconciles, so we need to yield until they are done,
+	// likewise for any updates deferred while servers are transitioning.
+	var waiting bool
+
+	// Rolling updates are performed in batches across reconciles, keyed by pool name.
+	rollouts := map[string]*util.Rollout{}
+
+	// Once cancelled no more servers are created or rebuilt, scale down and
+	// in place updates are still allowed, as are resizes in flight.
+	p.cancelled, err = p.cancelRequested(ctx)
+	if err != nil {
+		return err
+	}
+
 	// Handle deletions and updates.
 	for poolName, serverSet := range serverPoolSet {
 		// Pool doesn't exist, delete all.
@@ -277,9 +742,20 @@ func (p *Provisioner) reconcileServers(ctx context.Context, client regionapi.Cli
 			continue
 		}
 
-		// Scale down.
-		for len(serverSet) > pool.Replicas {
-			server := serverSet.selectDeletionCandidate(p.getPreferredDeletionIDs())
+		rollout := newRollout(pool, serverSet, cordonedIDs)
+
+		rollouts[poolName] = rollout
+
+		// Scale down, retaining any surge servers required by a rolling update.
+		for len(serverSet) > rollout.Target() {
+			server := serverSet.selectDeletionCandidate(p.getPreferredDeletionIDs(), cordonedIDs)
+			if server == nil {
+				log.Info("scale down held by cordoned servers", "pool", poolName, "replicas", pool.Replicas, "servers", len(serverSet))
+
+				held[poolName] = true
+
+				break
+			}
 
 			log.Info("deleting server due to scale down", "id", server.Metadata.Id, "pool", poolName)
 
@@ -290,24 +766,125 @@ func (p *Provisioner) reconcileServers(ctx context.Context, client regionapi.Cli
 			delete(serverSet, server.Metadata.Name)
 		}
 
+		// Replace any servers that have been unhealthy for too long, their
+		// replacements inherit any flavor override, as with rebuilds.
+		healed, err := p.healServers(ctx, client, pool, serverSet, cordonedIDs, tracking, time.Now())
+		if err != nil {
+			return err
+		}
+
+		for _, id := range healed {
+			if override, ok := flavorOverrides[id]; ok {
+				rebuildFlavors[poolName] = append(rebuildFlavors[poolName], override.FlavorID)
+			}
+		}
+
+		setRolloutAvailable(rollout, serverSet)
+
 		// Rebuilds and updates.
 		for serverName, server := range serverSet {
-			required, err := p.generateServer(openstackIdentityStatus, pool, securityGroups)
+			if slices.Contains(cordonedIDs, server.Metadata.Id) {
+				log.V(1).Info("skipping update of cordoned server", "id", server.Metadata.Id, "pool", poolName)
+
+				continue
+			}
+
+			required, err := p.generateServer(openstackIdentityStatus, pool, securityGroups, serverName)
 			if err != nil {
 				return err
 			}
 
-			if !needsUpdate(server, required) {
+			if override, ok := flavorOverrides[server.Metadata.Id]; ok {
+				required.Spec.FlavorId = override.FlavorID
+			}
+
+			if enabled, ok := publicIPOverrides[server.Metadata.Id]; ok {
+				required.Spec.PublicIPAllocation.Enabled = enabled
+			}
+
+			// Resizes in progress need to see the server through to completion,
+			// even once it has the requested flavor.
+			resizing := tracking.Get(server.Metadata.Id).Resize != nil
+
+			if !resizing && !needsUpdate(server, required) {
+				tracking.Get(server.Metadata.Id).TransitioningSince = nil
+
+				continue
+			}
+
+			// Never act on a diff while the provider is still changing the server,
+			// it's likely to be transient.  Resizes cause transitions of their
+			// own, and wait for them as necessary.
+			if !resizing && p.deferTransitioning(ctx, server, tracking, time.Now()) {
+				waiting = true
+
 				continue
 			}
 
-			if needsRebuild(ctx, server, required) {
+			rebuild := needsRebuild(ctx, server, required)
+
+			resize := !rebuild && (resizing || needsResize(ctx, server, required))
+
+			// Resizes in progress are allowed to complete, as the server may have
+			// been stopped already.
+			disrupt := rebuild || (resize && !resizing)
+
+			if disrupt && p.cancelled {
+				log.Info("deferring server update due to cancellation", "id", server.Metadata.Id, "pool", poolName)
+
+				continue
+			}
+
+			if disrupt && p.maintenance.Active(server.Metadata.Id) {
+				log.Info("deferring server update due to provider maintenance", "id", server.Metadata.Id, "pool", poolName)
+
+				continue
+			}
+
+			if disrupt && !rollout.Disrupt(serverAvailable(server)) {
+				log.Info("deferring server update due to update strategy", "id", server.Metadata.Id, "pool", poolName)
+
+				continue
+			}
+
+			if resize {
+				updated, wait, err := p.resizeServerWrapper(ctx, client, server, required, tracking)
+				if err != nil && !errors.Is(err, ErrResizeUnsupported) {
+					return err
+				}
+
+				if err == nil {
+					waiting = waiting || wait
+
+					serverSet[serverName] = updated
+
+					continue
+				}
+
+				log.Info("server resize unsupported by region", "id", server.Metadata.Id, "pool", poolName)
+
+				rebuild = true
+			}
+
+			if rebuild {
 				log.Info("deleting server due to rebuild", "id", server.Metadata.Id, "pool", poolName)
 
+				metrics.ServerOperations.WithLabelValues("cluster", "rebuild").Inc()
+
+				p.recordEvent(ctx, corev1.EventTypeNormal, "ServerRebuild", fmt.Sprintf("Rebuilding server %s (%s) in pool %s", serverName, server.Metadata.Id, poolName))
+
 				if err := p.deleteServerWrapper(ctx, client, server); err != nil {
 					return err
 				}
 
+				if override, ok := flavorOverrides[server.Metadata.Id]; ok {
+					rebuildFlavors[poolName] = append(rebuildFlavors[poolName], override.FlavorID)
+				}
+
+				if serverOutdated(pool, server) {
+					rollout.Rebuilt()
+				}
+
 				delete(serverSet, server.Metadata.Name)
 
 				continue
@@ -317,10 +894,6 @@ func (p *Provisioner) reconcileServers(ctx context.Context, client regionapi.Cli
 			// be modified at runtime.
 			log.Info("updating server", "name", serverName)
 
-			// Preserve the existing name, this translates to a host name
-			// and should not change.
-			required.Metadata.Name = serverName
-
 			updated, err := p.updateServer(ctx, client, server.Metadata.Id, required)
 			if err != nil {
 				return err
@@ -330,19 +903,16 @@ func (p *Provisioner) reconcileServers(ctx context.Context, client regionapi.Cli
 		}
 	}
 
+	// Record the eviction request as consumed in the status, the annotation itself
+	// belongs to the API so we must not update the cluster's metadata.
 	if len(preferredDeletionIDs) > 0 {
-		delete(p.cluster.Annotations, constants.ServerDeletionHintAnnotation)
-
-		cli, err := coreclient.FromContext(ctx)
-		if err != nil {
-			return err
-		}
-
-		if err := cli.Update(ctx, &p.cluster); err != nil {
-			return err
-		}
+		util.ConsumeEvictions(&p.cluster)
 	}
 
+	// Creation errors are aggregated across all pools, so a failure in one
+	// doesn't prevent others from scaling up.
+	var createErrors []error
+
 	// Finally for each pool, scale up any instances that are missing.
 	for i := range p.cluster.Spec.WorkloadPools.Pools {
 		pool := &p.cluster.Spec.WorkloadPools.Pools[i]
@@ -350,31 +920,195 @@ func (p *Provisioner) reconcileServers(ctx context.Context, client regionapi.Cli
 		creations := pool.Replicas
 
 		if serverPool, ok := serverPoolSet[pool.Name]; ok {
-			creations = pool.Replicas - len(serverPool)
+			creations = rollouts[pool.Name].Target() - len(serverPool)
+		}
+
+		if creations < 0 && held[pool.Name] {
+			continue
 		}
 
 		if creations < 0 {
-			return fmt.Errorf("%w: observed pool size larger than required", errors.ErrConsistency)
+			return fmt.Errorf("%w: observed pool size larger than required", coreerrors.ErrConsistency)
 		}
 
-		for range creations {
-			required, err := p.generateServer(openstackIdentityStatus, pool, securityGroups)
-			if err != nil {
+		created, err := p.createServers(ctx, client, pool, creations, securityGroups, openstackIdentityStatus, rebuildFlavors)
+
+		// Record what was created, even on error, so nothing is lost, and return
+		// unused flavor overrides so they are applied on the next attempt.
+		for _, creation := range created {
+			if creation.err != nil {
+				createErrors = append(createErrors, creation.err)
+
+				if creation.flavorID != "" {
+					rebuildFlavors[pool.Name] = append(rebuildFlavors[pool.Name], creation.flavorID)
+				}
+
+				continue
+			}
+
+			if creation.flavorID != "" {
+				machine := tracking.Get(creation.server.Metadata.Id)
+				machine.Pool = pool.Name
+				machine.FlavorID = ptr.To(creation.flavorID)
+			}
+
+			if err := servers.add(creation.request.Metadata.Name, creation.server); err != nil {
 				return err
 			}
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(createErrors) > 0 {
+		return errors.Join(createErrors...)
+	}
+
+	if waiting {
+		return provisioners.ErrYield
+	}
+
+	// Cancelled rollouts will not progress until the next update.
+	if p.cancelled {
+		return nil
+	}
+
+	for poolName, rollout := range rollouts {
+		if rollout.InProgress() {
+			log.Info("yielding for rolling update", "pool", poolName)
+
+			return provisioners.ErrYield
+		}
+	}
+
+	return nil
+}
+
+// serverCreation records the outcome of a concurrent server creation.
+type serverCreation struct {
+	// request is the server that was requested.
+	request *regionapi.ServerWrite
+	// flavorID is set when a flavor override was inherited from a rebuilt server.
+	flavorID string
+	// server is the created server, if successful.
+	server *regionapi.ServerResponse
+	// err is the reason the creation failed.
+	err error
+}
+
+// createServers creates the requested number of servers for a pool concurrently.
+// Any rebuild flavor overrides are consumed by the creations, and it's up to the
+// caller to restore them on failure.  Creation errors are recorded per server,
+// while the returned error indicates a failure to generate or dispatch a request,
+// in both cases the creations that were dispatched are returned.
+func (p *Provisioner) createServers(ctx context.Context, client regionapi.ClientWithResponsesInterface, pool *unikornv1.ComputeClusterWorkloadPoolSpec, count int, securityGroups securityGroupSet, openstackIdentityStatus *openstackIdentityStatus, rebuildFlavors map[string][]string) ([]*serverCreation, error) {
+	log := log.FromContext(ctx)
+
+	group := &errgroup.Group{}
+
+	if p.options != nil && p.options.serverCreateConcurrency > 0 {
+		group.SetLimit(p.options.serverCreateConcurrency)
+	}
+
+	creations := make([]*serverCreation, 0, count)
+
+	// Errors are recorded per creation, so the group itself never fails.
+	dispatch := func() error {
+		for range count {
+			// Check between each creation, so cancellation takes effect promptly,
+			// dispatch blocks while the concurrency limit is reached.
+			if !p.cancelled {
+				cancelled, err := p.cancelRequested(ctx)
+				if err != nil {
+					return err
+				}
+
+				p.cancelled = cancelled
+			}
+
+			if p.cancelled {
+				log.Info("server creation cancelled", "pool", pool.Name)
 
-			log.Info("creating server", "name", required.Metadata.Name)
+				return nil
+			}
 
-			server, err := p.createServer(ctx, client, required)
+			required, err := p.generateServer(openstackIdentityStatus, pool, securityGroups, util.GenerateServerName(pool))
 			if err != nil {
 				return err
 			}
 
-			if err := servers.add(required.Metadata.Name, server); err != nil {
-				return err
+			creation := &serverCreation{
+				request: required,
+			}
+
+			if flavors := rebuildFlavors[pool.Name]; len(flavors) > 0 {
+				creation.flavorID = flavors[0]
+				required.Spec.FlavorId = flavors[0]
+
+				rebuildFlavors[pool.Name] = flavors[1:]
 			}
+
+			creations = append(creations, creation)
+
+			group.Go(func() error {
+				log.Info("creating server", "name", required.Metadata.Name)
+
+				server, err := p.createServer(ctx, client, required)
+				if err != nil {
+					p.recordEvent(ctx, corev1.EventTypeWarning, "ServerCreateFailed", fmt.Sprintf("Failed to create server in pool %s: %v", pool.Name, err))
+
+					creation.err = err
+
+					return nil
+				}
+
+				metrics.ServerOperations.WithLabelValues("cluster", "create").Inc()
+
+				p.recordEvent(ctx, corev1.EventTypeNormal, "ServerCreated", fmt.Sprintf("Created server %s (%s) in pool %s", required.Metadata.Name, server.Metadata.Id, pool.Name))
+
+				creation.server = server
+
+				return nil
+			})
 		}
+
+		return nil
 	}
 
-	return nil
+	err := dispatch()
+
+	_ = group.Wait()
+
+	return creations, err
+}
+
+// updateMachineTracking prunes tracking for servers that have gone, and inherited
+// flavors that now match the pool, and records it in the cluster status.  The
+// overrides requested via the API belong to it and are left alone.
+func (p *Provisioner) updateMachineTracking(servers serverSet, tracking util.MachineTracking) {
+	live := map[string]bool{}
+
+	for _, server := range servers {
+		if server.Metadata.DeletionTime == nil {
+			live[server.Metadata.Id] = true
+		}
+	}
+
+	maps.DeleteFunc(tracking, func(serverID string, _ *unikornv1.MachineTrackingStatus) bool {
+		return !live[serverID]
+	})
+
+	for _, machine := range tracking {
+		if machine.FlavorID == nil {
+			continue
+		}
+
+		if pool, ok := p.cluster.GetWorkloadPool(machine.Pool); !ok || pool.FlavorID == *machine.FlavorID {
+			machine.FlavorID = nil
+		}
+	}
+
+	util.SetMachineTracking(&p.cluster, tracking)
 }
@@ -0,0 +1,216 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	unikornv1 "github.com/unikorn-cloud/compute/pkg/apis/unikorn/v1alpha1"
+	"github.com/unikorn-cloud/compute/pkg/provisioners/managers/cluster"
+	"github.com/unikorn-cloud/compute/pkg/provisioners/managers/cluster/util"
+	coreerrors "github.com/unikorn-cloud/core/pkg/errors"
+	coreapi "github.com/unikorn-cloud/core/pkg/openapi"
+	regionapi "github.com/unikorn-cloud/region/pkg/openapi"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+func securityGroupIDs(list *regionapi.ServerSecurityGroupList) []string {
+	if list == nil {
+		return nil
+	}
+
+	ids := make([]string, len(*list))
+
+	for i := range *list {
+		ids[i] = (*list)[i].Id
+	}
+
+	return ids
+}
+
+// TestGenerateSecurityGroup checks the managed security group for the pool's firewall
+// rules comes first, followed by any pre-existing ones in the order requested.
+func TestGenerateSecurityGroup(t *testing.T) {
+	t.Parallel()
+
+	managed := &regionapi.SecurityGroupRead{}
+	managed.Metadata.Id = "managed"
+
+	securityGroups := map[string]*regionapi.SecurityGroupRead{
+		"default": managed,
+	}
+
+	firewall := []unikornv1.FirewallRule{
+		{
+			Direction: unikornv1.Ingress,
+			Protocol:  unikornv1.TCP,
+		},
+	}
+
+	tests := []struct {
+		name             string
+		firewall         []unikornv1.FirewallRule
+		securityGroupIDs []string
+		expected         []string
+	}{
+		{
+			name: "None",
+		},
+		{
+			name:     "Managed",
+			firewall: firewall,
+			expected: []string{"managed"},
+		},
+		{
+			name:             "Existing",
+			securityGroupIDs: []string{"b", "a"},
+			expected:         []string{"b", "a"},
+		},
+		{
+			name:             "Merged",
+			firewall:         firewall,
+			securityGroupIDs: []string{"b", "a"},
+			expected:         []string{"managed", "b", "a"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			pool := &unikornv1.ComputeClusterWorkloadPoolSpec{
+				Name:             "default",
+				Firewall:         test.firewall,
+				SecurityGroupIDs: test.securityGroupIDs,
+			}
+
+			result, err := cluster.GenerateSecurityGroup(pool, securityGroups)
+			require.NoError(t, err)
+			require.Equal(t, test.expected, securityGroupIDs(result))
+		})
+	}
+}
+
+// TestGenerateSecurityGroupMissing checks a pool with firewall rules cannot be
+// provisioned until its managed security group exists.
+func TestGenerateSecurityGroupMissing(t *testing.T) {
+	t.Parallel()
+
+	pool := &unikornv1.ComputeClusterWorkloadPoolSpec{
+		Name: "default",
+		Firewall: []unikornv1.FirewallRule{
+			{
+				Direction: unikornv1.Ingress,
+				Protocol:  unikornv1.TCP,
+			},
+		},
+		SecurityGroupIDs: []string{"a"},
+	}
+
+	_, err := cluster.GenerateSecurityGroup(pool, nil)
+	require.ErrorIs(t, err, coreerrors.ErrConsistency)
+}
+
+func healingServer(id string, phase regionapi.InstanceLifecyclePhase) *regionapi.ServerRead {
+	server := &regionapi.ServerRead{}
+	server.Metadata.Id = id
+	server.Metadata.ProvisioningStatus = coreapi.ResourceProvisioningStatusProvisioned
+	server.Metadata.HealthStatus = coreapi.ResourceHealthStatusDegraded
+	server.Status.Phase = ptr.To(phase)
+
+	return server
+}
+
+// TestHealServersStopped checks servers powered off on purpose, which the provider
+// reports as degraded, are not replaced by auto healing, while running ones still
+// have their grace period started.
+func TestHealServersStopped(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+
+	pool := &unikornv1.ComputeClusterWorkloadPoolSpec{
+		Name: "default",
+		AutoHealing: &unikornv1.WorkloadPoolAutoHealingSpec{
+			GracePeriod: metav1.Duration{Duration: time.Minute},
+		},
+	}
+
+	servers := map[string]*regionapi.ServerRead{
+		"stopped":  healingServer("stopped", regionapi.InstanceLifecyclePhaseStopped),
+		"stopping": healingServer("stopping", regionapi.InstanceLifecyclePhaseStopping),
+		"running":  healingServer("running", regionapi.InstanceLifecyclePhaseRunning),
+	}
+
+	// The stopped servers have been unhealthy for well beyond the grace period.
+	tracking := util.MachineTracking{}
+	tracking.Get("stopped").UnhealthySince = ptr.To(metav1.NewTime(now.Add(-time.Hour)))
+	tracking.Get("stopping").UnhealthySince = ptr.To(metav1.NewTime(now.Add(-time.Hour)))
+
+	p := cluster.NewTestProvisioner(&unikornv1.ComputeCluster{}, map[string]error{})
+
+	deleted, err := p.HealServers(t.Context(), pool, servers, tracking, now)
+	require.NoError(t, err)
+	require.Empty(t, deleted)
+	require.Len(t, servers, 3)
+	require.Nil(t, tracking.Get("stopped").UnhealthySince)
+	require.Nil(t, tracking.Get("stopping").UnhealthySince)
+	require.NotNil(t, tracking.Get("running").UnhealthySince)
+}
+
+// TestHealServersParkedCluster checks a cluster stopped as a whole keeps all its
+// machines, in every auto healing pool, however long it is parked for.
+func TestHealServersParkedCluster(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+
+	autoHealing := &unikornv1.WorkloadPoolAutoHealingSpec{
+		GracePeriod: metav1.Duration{Duration: time.Minute},
+	}
+
+	pools := map[string]*unikornv1.ComputeClusterWorkloadPoolSpec{
+		"a": {Name: "a", AutoHealing: autoHealing},
+		"b": {Name: "b", AutoHealing: autoHealing},
+	}
+
+	p := cluster.NewTestProvisioner(&unikornv1.ComputeCluster{}, map[string]error{})
+
+	tracking := util.MachineTracking{}
+
+	// Each reconcile while parked must leave the machines alone, including those
+	// after the grace period has long passed.
+	for _, at := range []time.Time{now, now.Add(time.Hour), now.Add(24 * time.Hour)} {
+		for name, pool := range pools {
+			servers := map[string]*regionapi.ServerRead{
+				name + "-0": healingServer(name+"-0", regionapi.InstanceLifecyclePhaseStopped),
+				name + "-1": healingServer(name+"-1", regionapi.InstanceLifecyclePhaseStopped),
+			}
+
+			deleted, err := p.HealServers(t.Context(), pool, servers, tracking, at)
+			require.NoError(t, err)
+			require.Empty(t, deleted)
+			require.Len(t, servers, 2)
+		}
+	}
+}
@@ -21,7 +21,9 @@ import (
 	"slices"
 
 	unikornv1 "github.com/unikorn-cloud/compute/pkg/apis/unikorn/v1alpha1"
+	"github.com/unikorn-cloud/compute/pkg/constants"
 	"github.com/unikorn-cloud/compute/pkg/provisioners/managers/cluster/util"
+	unikornv1core "github.com/unikorn-cloud/core/pkg/apis/unikorn/v1alpha1"
 	coreconstants "github.com/unikorn-cloud/core/pkg/constants"
 	coreapi "github.com/unikorn-cloud/core/pkg/openapi"
 )
@@ -34,18 +36,22 @@ func (p *Provisioner) tags(pool *unikornv1.ComputeClusterWorkloadPoolSpec) *core
 		{Name: util.WorkloadPoolLabel, Value: pool.Name},
 	}
 
-	// Propagate any additional tags from the cluster's spec, if present
-	for _, tag := range p.cluster.Spec.Tags {
-		hasTag := func(t coreapi.Tag) bool {
-			return t.Name == tag.Name
-		}
-
-		// Only add the tag if it doesn't already exist, so we prevent overwriting the default tags
-		if !slices.ContainsFunc(out, hasTag) {
-			out = append(out, coreapi.Tag{
-				Name:  tag.Name,
-				Value: tag.Value,
-			})
+	// Propagate any additional tags from the pool's labels, then the cluster's spec,
+	// so the more specific pool labels take precedence.
+	for _, tags := range []unikornv1core.TagList{pool.Labels, p.cluster.Spec.Tags} {
+		for _, tag := range tags {
+			hasTag := func(t coreapi.Tag) bool {
+				return t.Name == tag.Name
+			}
+
+			// Only add the tag if it doesn't already exist, so we prevent overwriting the default tags,
+			// and never propagate system tags, these identify the server and must come from us.
+			if !constants.IsSystemTag(tag.Name) && !slices.ContainsFunc(out, hasTag) {
+				out = append(out, coreapi.Tag{
+					Name:  tag.Name,
+					Value: tag.Value,
+				})
+			}
 		}
 	}
 
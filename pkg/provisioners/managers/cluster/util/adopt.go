@@ -0,0 +1,53 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	unikornv1 "github.com/unikorn-cloud/compute/pkg/apis/unikorn/v1alpha1"
+	"github.com/unikorn-cloud/compute/pkg/constants"
+)
+
+// Adoptions maps from server ID to the workload pool it is being adopted into.
+type Adoptions map[string]string
+
+// GetAdoptions returns the servers requested for adoption.
+func GetAdoptions(cluster *unikornv1.ComputeCluster) (Adoptions, error) {
+	return getAnnotationMap(cluster, constants.ServerAdoptionAnnotation)
+}
+
+// SetAdoptions records the servers requested for adoption.
+func SetAdoptions(cluster *unikornv1.ComputeCluster, adoptions Adoptions) {
+	setAnnotationMap(cluster, constants.ServerAdoptionAnnotation, adoptions)
+}
+
+// GetPendingAdoptions returns the servers requested for adoption that the controller
+// has yet to act upon.  Like detachments, the annotation is only ever written by the
+// API, and the controller records the request it has consumed in the cluster status.
+func GetPendingAdoptions(cluster *unikornv1.ComputeCluster) (Adoptions, error) {
+	if cluster.Annotations[constants.ServerAdoptionAnnotation] == cluster.Status.AdoptionRequest {
+		return Adoptions{}, nil
+	}
+
+	return GetAdoptions(cluster)
+}
+
+// ConsumeAdoptions records that the controller has acted upon the current
+// adoption request.  This is persisted along with the rest of the cluster's status.
+func ConsumeAdoptions(cluster *unikornv1.ComputeCluster) {
+	cluster.Status.AdoptionRequest = cluster.Annotations[constants.ServerAdoptionAnnotation]
+}
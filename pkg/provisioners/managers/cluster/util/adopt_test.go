@@ -0,0 +1,89 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	unikornv1 "github.com/unikorn-cloud/compute/pkg/apis/unikorn/v1alpha1"
+	"github.com/unikorn-cloud/compute/pkg/constants"
+	"github.com/unikorn-cloud/compute/pkg/provisioners/managers/cluster/util"
+)
+
+// TestAdoptionsRoundTrip checks adoptions survive encoding and decoding.
+func TestAdoptionsRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	adoptions := util.Adoptions{
+		"b2c0b8c4-7d0c-4c1c-9a4a-0d5f4c2b8a11": "default",
+		"0f6b1f7e-3d1b-4a9e-8a55-5d8f2d7f9c22": "gpu",
+	}
+
+	cluster := &unikornv1.ComputeCluster{}
+
+	util.SetAdoptions(cluster, adoptions)
+	require.Equal(t, "0f6b1f7e-3d1b-4a9e-8a55-5d8f2d7f9c22=gpu,b2c0b8c4-7d0c-4c1c-9a4a-0d5f4c2b8a11=default", cluster.Annotations[constants.ServerAdoptionAnnotation])
+
+	decoded, err := util.GetAdoptions(cluster)
+	require.NoError(t, err)
+	require.Equal(t, adoptions, decoded)
+
+	util.SetAdoptions(cluster, nil)
+	require.NotContains(t, cluster.Annotations, constants.ServerAdoptionAnnotation)
+
+	cluster.Annotations = map[string]string{
+		constants.ServerAdoptionAnnotation: "server-id",
+	}
+
+	_, err = util.GetAdoptions(cluster)
+	require.Error(t, err)
+}
+
+// TestConsumeAdoptions checks an adoption request is no longer pending once
+// consumed, without the annotation being modified, and that a subsequent request
+// is pending again.
+func TestConsumeAdoptions(t *testing.T) {
+	t.Parallel()
+
+	cluster := &unikornv1.ComputeCluster{}
+
+	pending, err := util.GetPendingAdoptions(cluster)
+	require.NoError(t, err)
+	require.Empty(t, pending)
+
+	util.SetAdoptions(cluster, util.Adoptions{"server-a": "default"})
+
+	pending, err = util.GetPendingAdoptions(cluster)
+	require.NoError(t, err)
+	require.Equal(t, util.Adoptions{"server-a": "default"}, pending)
+
+	util.ConsumeAdoptions(cluster)
+	require.Equal(t, "server-a=default", cluster.Annotations[constants.ServerAdoptionAnnotation])
+
+	pending, err = util.GetPendingAdoptions(cluster)
+	require.NoError(t, err)
+	require.Empty(t, pending)
+
+	util.SetAdoptions(cluster, util.Adoptions{"server-b": "gpu"})
+
+	pending, err = util.GetPendingAdoptions(cluster)
+	require.NoError(t, err)
+	require.Equal(t, util.Adoptions{"server-b": "gpu"}, pending)
+}
@@ -0,0 +1,80 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	unikornv1 "github.com/unikorn-cloud/compute/pkg/apis/unikorn/v1alpha1"
+	"github.com/unikorn-cloud/core/pkg/errors"
+)
+
+// getAnnotationList parses an annotation encoded as a comma separated list.
+func getAnnotationList(cluster *unikornv1.ComputeCluster, annotation string) []string {
+	value, ok := cluster.Annotations[annotation]
+	if !ok || value == "" {
+		return nil
+	}
+
+	return strings.Split(value, ",")
+}
+
+// setAnnotationList encodes a list into an annotation in a stable order without
+// duplicates.  An empty list removes the annotation.
+func setAnnotationList(cluster *unikornv1.ComputeCluster, annotation string, items []string) {
+	if len(items) == 0 {
+		delete(cluster.Annotations, annotation)
+		return
+	}
+
+	if cluster.Annotations == nil {
+		cluster.Annotations = map[string]string{}
+	}
+
+	cluster.Annotations[annotation] = strings.Join(slices.Compact(slices.Sorted(slices.Values(items))), ",")
+}
+
+// getAnnotationMap parses an annotation encoded as a comma separated list of
+// <key>=<value> tuples.
+func getAnnotationMap(cluster *unikornv1.ComputeCluster, annotation string) (map[string]string, error) {
+	out := map[string]string{}
+
+	for _, item := range getAnnotationList(cluster, annotation) {
+		key, value, ok := strings.Cut(item, "=")
+		if !ok || key == "" || value == "" {
+			return nil, fmt.Errorf("%w: malformed %s item %s", errors.ErrConsistency, annotation, item)
+		}
+
+		out[key] = value
+	}
+
+	return out, nil
+}
+
+// setAnnotationMap encodes a map into an annotation as <key>=<value> tuples.
+func setAnnotationMap(cluster *unikornv1.ComputeCluster, annotation string, in map[string]string) {
+	items := make([]string, 0, len(in))
+
+	for key, value := range in {
+		items = append(items, key+"="+value)
+	}
+
+	setAnnotationList(cluster, annotation, items)
+}
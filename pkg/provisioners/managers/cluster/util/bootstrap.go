@@ -0,0 +1,114 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"text/template"
+
+	unikornv1 "github.com/unikorn-cloud/compute/pkg/apis/unikorn/v1alpha1"
+	regionapi "github.com/unikorn-cloud/region/pkg/openapi"
+)
+
+var (
+	// ErrBootstrap is raised when a bootstrap profile cannot be used.
+	ErrBootstrap = errors.New("bootstrap profile error")
+)
+
+// BootstrapVariables are made available to bootstrap profile templates, and are
+// used to select the template.
+type BootstrapVariables struct {
+	// OSFamily is the image's operating system family e.g. debian.
+	OSFamily string
+	// OSDistro is the image's operating system distribution e.g. ubuntu.
+	OSDistro string
+	// OSVersion is the image's operating system version e.g. 24.04.
+	OSVersion string
+	// GPUVendor is the flavor's GPU vendor, empty if it has no GPUs.
+	GPUVendor string
+	// GPUModel is the flavor's GPU model.
+	GPUModel string
+	// GPUCount is the number of physical GPUs the flavor has.
+	GPUCount int
+	// GPUDriver is the GPU driver version preinstalled in the image, if any.
+	GPUDriver string
+}
+
+// NewBootstrapVariables describes a machine from its flavor and image.
+func NewBootstrapVariables(flavor *regionapi.Flavor, image *regionapi.Image) *BootstrapVariables {
+	variables := &BootstrapVariables{
+		OSFamily:  string(image.Spec.Os.Family),
+		OSDistro:  string(image.Spec.Os.Distro),
+		OSVersion: image.Spec.Os.Version,
+	}
+
+	if gpu := flavor.Spec.Gpu; gpu != nil {
+		variables.GPUVendor = string(gpu.Vendor)
+		variables.GPUModel = gpu.Model
+		variables.GPUCount = gpu.PhysicalCount
+	}
+
+	if gpu := image.Spec.Gpu; gpu != nil {
+		variables.GPUDriver = gpu.Driver
+	}
+
+	return variables
+}
+
+// matches tells us whether the template applies to the machine.
+func matches(t *unikornv1.ComputeBootstrapTemplate, variables *BootstrapVariables) bool {
+	if t.ImageFamily != "" && t.ImageFamily != variables.OSFamily {
+		return false
+	}
+
+	if t.GPUVendor != "" && t.GPUVendor != variables.GPUVendor {
+		return false
+	}
+
+	return true
+}
+
+// RenderBootstrap selects the first of the profile's templates that matches the
+// machine, and expands it.  It is an error for no template to match, as the user
+// asked for a profile that cannot be honoured.
+func RenderBootstrap(profile *unikornv1.ComputeBootstrapProfile, variables *BootstrapVariables) ([]byte, error) {
+	for i := range profile.Spec.Templates {
+		t := &profile.Spec.Templates[i]
+
+		if !matches(t, variables) {
+			continue
+		}
+
+		tmpl, err := template.New(profile.Name).Option("missingkey=error").Parse(t.UserData)
+		if err != nil {
+			return nil, fmt.Errorf("%w: profile %s template %d: %w", ErrBootstrap, profile.Name, i, err)
+		}
+
+		var buf bytes.Buffer
+
+		if err := tmpl.Execute(&buf, variables); err != nil {
+			return nil, fmt.Errorf("%w: profile %s template %d: %w", ErrBootstrap, profile.Name, i, err)
+		}
+
+		return buf.Bytes(), nil
+	}
+
+	return nil, fmt.Errorf("%w: profile %s has no template for %s images with %q GPUs", ErrBootstrap, profile.Name, variables.OSFamily, variables.GPUVendor)
+}
@@ -0,0 +1,105 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	unikornv1 "github.com/unikorn-cloud/compute/pkg/apis/unikorn/v1alpha1"
+	"github.com/unikorn-cloud/compute/pkg/provisioners/managers/cluster/util"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func bootstrapProfile() *unikornv1.ComputeBootstrapProfile {
+	return &unikornv1.ComputeBootstrapProfile{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "nvidia",
+		},
+		Spec: unikornv1.ComputeBootstrapProfileSpec{
+			Templates: []unikornv1.ComputeBootstrapTemplate{
+				{
+					ImageFamily: "debian",
+					GPUVendor:   "NVIDIA",
+					UserData:    "#!/bin/sh\napt-get install -y nvidia-driver-{{ .GPUDriver }} # {{ .GPUCount }}x {{ .GPUModel }}\n",
+				},
+				{
+					GPUVendor: "NVIDIA",
+					UserData:  "#!/bin/sh\necho generic\n",
+				},
+			},
+		},
+	}
+}
+
+// TestRenderBootstrap ensures the first matching template is selected and expanded,
+// and that a profile with no matching template is rejected.
+func TestRenderBootstrap(t *testing.T) {
+	t.Parallel()
+
+	profile := bootstrapProfile()
+
+	variables := &util.BootstrapVariables{
+		OSFamily:  "debian",
+		GPUVendor: "NVIDIA",
+		GPUModel:  "H100",
+		GPUCount:  8,
+		GPUDriver: "570",
+	}
+
+	out, err := util.RenderBootstrap(profile, variables)
+	require.NoError(t, err)
+	require.Equal(t, "#!/bin/sh\napt-get install -y nvidia-driver-570 # 8x H100\n", string(out))
+
+	variables.OSFamily = "redhat"
+
+	out, err = util.RenderBootstrap(profile, variables)
+	require.NoError(t, err)
+	require.Equal(t, "#!/bin/sh\necho generic\n", string(out))
+
+	variables.GPUVendor = "AMD"
+
+	_, err = util.RenderBootstrap(profile, variables)
+	require.ErrorIs(t, err, util.ErrBootstrap)
+}
+
+// TestCombineUserData ensures parts are combined in order, empty parts are ignored
+// and unsupported parts are rejected.
+func TestCombineUserData(t *testing.T) {
+	t.Parallel()
+
+	bootstrap := []byte("#!/bin/sh\necho bootstrap\n")
+	userData := []byte("#cloud-config\npackages: []\n")
+
+	out, ok, err := util.CombineUserData(bootstrap, nil, userData)
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	first := bytes.Index(out, bootstrap)
+	second := bytes.Index(out, userData)
+
+	require.GreaterOrEqual(t, first, 0)
+	require.Greater(t, second, first)
+
+	_, ok, err = util.CombineUserData(bootstrap, []byte{0x1f, 0x8b, 0x08})
+	require.NoError(t, err)
+	require.False(t, ok)
+}
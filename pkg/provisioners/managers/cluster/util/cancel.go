@@ -0,0 +1,45 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"strconv"
+
+	unikornv1 "github.com/unikorn-cloud/compute/pkg/apis/unikorn/v1alpha1"
+	"github.com/unikorn-cloud/compute/pkg/constants"
+)
+
+// IsCancelled returns whether the update to the cluster's current generation
+// has been cancelled.  Any subsequent update implicitly clears the cancellation.
+func IsCancelled(cluster *unikornv1.ComputeCluster) bool {
+	value, ok := cluster.Annotations[constants.UpdateCancelAnnotation]
+	if !ok {
+		return false
+	}
+
+	return value == strconv.FormatInt(cluster.Generation, 10)
+}
+
+// SetCancelled cancels the update to the cluster's current generation.
+func SetCancelled(cluster *unikornv1.ComputeCluster) {
+	if cluster.Annotations == nil {
+		cluster.Annotations = map[string]string{}
+	}
+
+	cluster.Annotations[constants.UpdateCancelAnnotation] = strconv.FormatInt(cluster.Generation, 10)
+}
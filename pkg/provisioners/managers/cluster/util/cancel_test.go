@@ -0,0 +1,44 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	unikornv1 "github.com/unikorn-cloud/compute/pkg/apis/unikorn/v1alpha1"
+	"github.com/unikorn-cloud/compute/pkg/provisioners/managers/cluster/util"
+)
+
+// TestCancelledUntilUpdated checks a cancellation only applies to the generation
+// it was requested against.
+func TestCancelledUntilUpdated(t *testing.T) {
+	t.Parallel()
+
+	cluster := &unikornv1.ComputeCluster{}
+	cluster.Generation = 3
+
+	require.False(t, util.IsCancelled(cluster))
+
+	util.SetCancelled(cluster)
+	require.True(t, util.IsCancelled(cluster))
+
+	cluster.Generation++
+	require.False(t, util.IsCancelled(cluster))
+}
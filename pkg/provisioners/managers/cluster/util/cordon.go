@@ -0,0 +1,40 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"slices"
+
+	unikornv1 "github.com/unikorn-cloud/compute/pkg/apis/unikorn/v1alpha1"
+	"github.com/unikorn-cloud/compute/pkg/constants"
+)
+
+// GetCordoned returns the IDs of cordoned servers.
+func GetCordoned(cluster *unikornv1.ComputeCluster) []string {
+	return getAnnotationList(cluster, constants.ServerCordonAnnotation)
+}
+
+// IsCordoned returns whether a server is cordoned.
+func IsCordoned(cluster *unikornv1.ComputeCluster, serverID string) bool {
+	return slices.Contains(GetCordoned(cluster), serverID)
+}
+
+// SetCordoned records the IDs of cordoned servers.
+func SetCordoned(cluster *unikornv1.ComputeCluster, serverIDs []string) {
+	setAnnotationList(cluster, constants.ServerCordonAnnotation, serverIDs)
+}
@@ -0,0 +1,46 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	unikornv1 "github.com/unikorn-cloud/compute/pkg/apis/unikorn/v1alpha1"
+	"github.com/unikorn-cloud/compute/pkg/constants"
+	"github.com/unikorn-cloud/compute/pkg/provisioners/managers/cluster/util"
+)
+
+// TestCordonedRoundTrip checks cordons survive encoding and decoding, and are
+// stored in a stable order without duplicates.
+func TestCordonedRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	cluster := &unikornv1.ComputeCluster{}
+
+	util.SetCordoned(cluster, []string{"server-b", "server-a", "server-b"})
+	require.Equal(t, "server-a,server-b", cluster.Annotations[constants.ServerCordonAnnotation])
+	require.Equal(t, []string{"server-a", "server-b"}, util.GetCordoned(cluster))
+	require.True(t, util.IsCordoned(cluster, "server-a"))
+	require.False(t, util.IsCordoned(cluster, "server-c"))
+
+	util.SetCordoned(cluster, nil)
+	require.NotContains(t, cluster.Annotations, constants.ServerCordonAnnotation)
+	require.Empty(t, util.GetCordoned(cluster))
+}
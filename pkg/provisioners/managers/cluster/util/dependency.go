@@ -0,0 +1,42 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"errors"
+
+	unikornv1core "github.com/unikorn-cloud/core/pkg/apis/unikorn/v1alpha1"
+	"github.com/unikorn-cloud/core/pkg/provisioners"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+// UpdateDependencyCondition records the readiness of a dependency the cluster is
+// provisioned on, so it's obvious what the provisioner is waiting on.  A nil error
+// means the dependency is ready, a yield that it's still being provisioned and
+// anything else that it failed.
+func UpdateDependencyCondition(conditions *[]unikornv1core.Condition, t unikornv1core.ConditionType, dependency string, err error) {
+	switch {
+	case err == nil:
+		unikornv1core.UpdateCondition(conditions, t, corev1.ConditionTrue, unikornv1core.ConditionReasonProvisioned, dependency+" ready")
+	case errors.Is(err, provisioners.ErrYield):
+		unikornv1core.UpdateCondition(conditions, t, corev1.ConditionFalse, unikornv1core.ConditionReasonProvisioning, "waiting for "+dependency)
+	default:
+		unikornv1core.UpdateCondition(conditions, t, corev1.ConditionFalse, unikornv1core.ConditionReasonErrored, dependency+" failed: "+err.Error())
+	}
+}
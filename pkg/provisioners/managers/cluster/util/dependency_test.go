@@ -0,0 +1,62 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	unikornv1 "github.com/unikorn-cloud/compute/pkg/apis/unikorn/v1alpha1"
+	"github.com/unikorn-cloud/compute/pkg/provisioners/managers/cluster/util"
+	unikornv1core "github.com/unikorn-cloud/core/pkg/apis/unikorn/v1alpha1"
+	"github.com/unikorn-cloud/core/pkg/provisioners"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+var errDependency = errors.New("dependency error")
+
+// TestDependencyCondition checks readiness, yields and failures are distinguished.
+func TestDependencyCondition(t *testing.T) {
+	t.Parallel()
+
+	var conditions []unikornv1core.Condition
+
+	requireCondition := func(status corev1.ConditionStatus, reason unikornv1core.ConditionReason, message string) {
+		t.Helper()
+
+		condition, err := unikornv1core.GetCondition(conditions, unikornv1.ConditionNetworkReady)
+		require.NoError(t, err)
+		require.Equal(t, status, condition.Status)
+		require.Equal(t, reason, condition.Reason)
+		require.Equal(t, message, condition.Message)
+	}
+
+	util.UpdateDependencyCondition(&conditions, unikornv1.ConditionNetworkReady, "network", fmt.Errorf("%w: pending", provisioners.ErrYield))
+	requireCondition(corev1.ConditionFalse, unikornv1core.ConditionReasonProvisioning, "waiting for network")
+
+	util.UpdateDependencyCondition(&conditions, unikornv1.ConditionNetworkReady, "network", errDependency)
+	requireCondition(corev1.ConditionFalse, unikornv1core.ConditionReasonErrored, "network failed: dependency error")
+
+	util.UpdateDependencyCondition(&conditions, unikornv1.ConditionNetworkReady, "network", nil)
+	requireCondition(corev1.ConditionTrue, unikornv1core.ConditionReasonProvisioned, "network ready")
+	require.Len(t, conditions, 1)
+}
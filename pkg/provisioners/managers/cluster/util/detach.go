@@ -0,0 +1,51 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	unikornv1 "github.com/unikorn-cloud/compute/pkg/apis/unikorn/v1alpha1"
+	"github.com/unikorn-cloud/compute/pkg/constants"
+)
+
+// GetDetachments returns the IDs of servers requested for detachment.
+func GetDetachments(cluster *unikornv1.ComputeCluster) []string {
+	return getAnnotationList(cluster, constants.ServerDetachmentAnnotation)
+}
+
+// SetDetachments records the IDs of servers requested for detachment.
+func SetDetachments(cluster *unikornv1.ComputeCluster, serverIDs []string) {
+	setAnnotationList(cluster, constants.ServerDetachmentAnnotation, serverIDs)
+}
+
+// GetPendingDetachments returns the IDs of servers requested for detachment that
+// the controller has yet to act upon.  Like evictions, the annotation is only ever
+// written by the API, and the controller records the request it has consumed in the
+// cluster status.
+func GetPendingDetachments(cluster *unikornv1.ComputeCluster) []string {
+	if cluster.Annotations[constants.ServerDetachmentAnnotation] == cluster.Status.DetachmentRequest {
+		return nil
+	}
+
+	return GetDetachments(cluster)
+}
+
+// ConsumeDetachments records that the controller has acted upon the current
+// detachment request.  This is persisted along with the rest of the cluster's status.
+func ConsumeDetachments(cluster *unikornv1.ComputeCluster) {
+	cluster.Status.DetachmentRequest = cluster.Annotations[constants.ServerDetachmentAnnotation]
+}
@@ -0,0 +1,65 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	unikornv1 "github.com/unikorn-cloud/compute/pkg/apis/unikorn/v1alpha1"
+	"github.com/unikorn-cloud/compute/pkg/constants"
+	"github.com/unikorn-cloud/compute/pkg/provisioners/managers/cluster/util"
+)
+
+// TestDetachmentsRoundTrip checks detachments survive encoding and decoding, and
+// are stored in a stable order without duplicates.
+func TestDetachmentsRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	cluster := &unikornv1.ComputeCluster{}
+
+	util.SetDetachments(cluster, []string{"server-b", "server-a", "server-b"})
+	require.Equal(t, "server-a,server-b", cluster.Annotations[constants.ServerDetachmentAnnotation])
+	require.Equal(t, []string{"server-a", "server-b"}, util.GetDetachments(cluster))
+
+	util.SetDetachments(cluster, nil)
+	require.NotContains(t, cluster.Annotations, constants.ServerDetachmentAnnotation)
+	require.Empty(t, util.GetDetachments(cluster))
+}
+
+// TestConsumeDetachments checks a detachment request is no longer pending once
+// consumed, without the annotation being modified, and that a subsequent request
+// is pending again.
+func TestConsumeDetachments(t *testing.T) {
+	t.Parallel()
+
+	cluster := &unikornv1.ComputeCluster{}
+
+	require.Empty(t, util.GetPendingDetachments(cluster))
+
+	util.SetDetachments(cluster, []string{"server-a", "server-b"})
+	require.Equal(t, []string{"server-a", "server-b"}, util.GetPendingDetachments(cluster))
+
+	util.ConsumeDetachments(cluster)
+	require.Equal(t, "server-a,server-b", cluster.Annotations[constants.ServerDetachmentAnnotation])
+	require.Empty(t, util.GetPendingDetachments(cluster))
+
+	util.SetDetachments(cluster, []string{"server-c"})
+	require.Equal(t, []string{"server-c"}, util.GetPendingDetachments(cluster))
+}
@@ -0,0 +1,69 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	unikornv1 "github.com/unikorn-cloud/compute/pkg/apis/unikorn/v1alpha1"
+	"github.com/unikorn-cloud/compute/pkg/constants"
+)
+
+// GetPendingEvictions returns the server IDs of the most recent eviction request
+// that the controller has yet to act upon.  The deletion hint annotation is only
+// ever written by the API, and the controller records the hint it has consumed in
+// the cluster status, so concurrent evictions and reconciles cannot clobber one
+// another's updates.
+func GetPendingEvictions(cluster *unikornv1.ComputeCluster) []string {
+	value := cluster.Annotations[constants.ServerDeletionHintAnnotation]
+	if value == "" || value == cluster.Status.EvictionRequest {
+		return nil
+	}
+
+	// Requests made before they were uniquely identified are just the list.
+	if _, ids, ok := strings.Cut(value, ":"); ok {
+		value = ids
+	}
+
+	return strings.Split(value, ",")
+}
+
+// RequestEvictions records an eviction request for the machines.  Each request is
+// prefixed with a unique identifier, otherwise evicting the same machines again,
+// e.g. after a failure, would look like it had already been consumed.
+func RequestEvictions(cluster *unikornv1.ComputeCluster, machineIDs []string, now time.Time) {
+	if cluster.Annotations == nil {
+		cluster.Annotations = map[string]string{}
+	}
+
+	cluster.Annotations[constants.ServerDeletionHintAnnotation] = strconv.FormatInt(now.UnixNano(), 10) + ":" + strings.Join(machineIDs, ",")
+}
+
+// EvictionPending tells us whether there is an eviction request that the controller
+// has yet to act upon.
+func EvictionPending(cluster *unikornv1.ComputeCluster) bool {
+	return len(GetPendingEvictions(cluster)) > 0
+}
+
+// ConsumeEvictions records that the controller has acted upon the current eviction
+// request.  This is persisted along with the rest of the cluster's status.
+func ConsumeEvictions(cluster *unikornv1.ComputeCluster) {
+	cluster.Status.EvictionRequest = cluster.Annotations[constants.ServerDeletionHintAnnotation]
+}
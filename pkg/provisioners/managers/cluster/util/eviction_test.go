@@ -0,0 +1,76 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	unikornv1 "github.com/unikorn-cloud/compute/pkg/apis/unikorn/v1alpha1"
+	"github.com/unikorn-cloud/compute/pkg/constants"
+	"github.com/unikorn-cloud/compute/pkg/provisioners/managers/cluster/util"
+)
+
+// TestConsumeEvictions checks an eviction request is no longer pending once
+// consumed, without the annotation being modified, and that a subsequent request
+// is pending again.
+func TestConsumeEvictions(t *testing.T) {
+	t.Parallel()
+
+	cluster := &unikornv1.ComputeCluster{}
+
+	require.False(t, util.EvictionPending(cluster))
+
+	cluster.Annotations = map[string]string{
+		constants.ServerDeletionHintAnnotation: "server-a,server-b",
+	}
+
+	require.True(t, util.EvictionPending(cluster))
+	require.Equal(t, []string{"server-a", "server-b"}, util.GetPendingEvictions(cluster))
+
+	util.ConsumeEvictions(cluster)
+	require.Equal(t, "server-a,server-b", cluster.Annotations[constants.ServerDeletionHintAnnotation])
+	require.False(t, util.EvictionPending(cluster))
+	require.Empty(t, util.GetPendingEvictions(cluster))
+
+	cluster.Annotations[constants.ServerDeletionHintAnnotation] = "server-c"
+
+	require.Equal(t, []string{"server-c"}, util.GetPendingEvictions(cluster))
+}
+
+// TestRequestEvictionsRepeated checks evicting the same machines again, e.g. after
+// the previous request failed, is seen as a new request.
+func TestRequestEvictionsRepeated(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+
+	cluster := &unikornv1.ComputeCluster{}
+
+	util.RequestEvictions(cluster, []string{"server-a", "server-b"}, now)
+	require.Equal(t, []string{"server-a", "server-b"}, util.GetPendingEvictions(cluster))
+
+	util.ConsumeEvictions(cluster)
+	require.False(t, util.EvictionPending(cluster))
+
+	util.RequestEvictions(cluster, []string{"server-a", "server-b"}, now.Add(time.Second))
+	require.True(t, util.EvictionPending(cluster))
+	require.Equal(t, []string{"server-a", "server-b"}, util.GetPendingEvictions(cluster))
+}
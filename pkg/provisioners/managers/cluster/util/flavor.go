@@ -0,0 +1,75 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	unikornv1 "github.com/unikorn-cloud/compute/pkg/apis/unikorn/v1alpha1"
+)
+
+// FlavorOverride records a machine that has a flavor other than its pool's.
+type FlavorOverride struct {
+	// Pool is the pool the machine belongs to.
+	Pool string
+	// FlavorID is the flavor the machine should have.
+	FlavorID string
+}
+
+// FlavorOverrides maps from server ID to the flavor override.
+type FlavorOverrides map[string]FlavorOverride
+
+// GetFlavorOverrides returns the flavor of any machine that differs from its pool.
+// Machines are either resized via the API, or inherit the flavor of a machine they
+// replaced when that was rebuilt, and the former takes precedence.
+func GetFlavorOverrides(cluster *unikornv1.ComputeCluster) FlavorOverrides {
+	overrides := FlavorOverrides{}
+
+	for i := range cluster.Status.MachineTracking {
+		machine := &cluster.Status.MachineTracking[i]
+
+		if machine.FlavorID != nil {
+			overrides[machine.ID] = FlavorOverride{
+				Pool:     machine.Pool,
+				FlavorID: *machine.FlavorID,
+			}
+		}
+	}
+
+	for i := range cluster.Spec.Machines {
+		machine := &cluster.Spec.Machines[i]
+
+		if machine.FlavorID != nil {
+			overrides[machine.ID] = FlavorOverride{
+				Pool:     machine.Pool,
+				FlavorID: *machine.FlavorID,
+			}
+		}
+	}
+
+	return overrides
+}
+
+// inheritedFlavor returns the flavor a machine inherited from one it replaced.
+func inheritedFlavor(cluster *unikornv1.ComputeCluster, serverID string) *string {
+	for i := range cluster.Status.MachineTracking {
+		if cluster.Status.MachineTracking[i].ID == serverID {
+			return cluster.Status.MachineTracking[i].FlavorID
+		}
+	}
+
+	return nil
+}
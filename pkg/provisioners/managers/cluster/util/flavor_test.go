@@ -0,0 +1,60 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	unikornv1 "github.com/unikorn-cloud/compute/pkg/apis/unikorn/v1alpha1"
+	"github.com/unikorn-cloud/compute/pkg/provisioners/managers/cluster/util"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+// TestFlavorOverrides checks flavors requested via the API take precedence over
+// those inherited from rebuilt machines.
+func TestFlavorOverrides(t *testing.T) {
+	t.Parallel()
+
+	cluster := &unikornv1.ComputeCluster{
+		Spec: unikornv1.ComputeClusterSpec{
+			Machines: []unikornv1.ComputeClusterMachineSpec{
+				{ID: "server-a", Pool: "default", FlavorID: ptr.To("flavor-a")},
+				{ID: "server-b", Pool: "default", FlavorID: ptr.To("flavor-b")},
+			},
+		},
+		Status: unikornv1.ComputeClusterStatus{
+			MachineTracking: []unikornv1.MachineTrackingStatus{
+				{ID: "server-b", Pool: "default", FlavorID: ptr.To("flavor-c")},
+				{ID: "server-c", Pool: "gpu", FlavorID: ptr.To("flavor-d")},
+				{ID: "server-d", UnhealthySince: ptr.To(metav1.Now())},
+			},
+		},
+	}
+
+	expected := util.FlavorOverrides{
+		"server-a": {Pool: "default", FlavorID: "flavor-a"},
+		"server-b": {Pool: "default", FlavorID: "flavor-b"},
+		"server-c": {Pool: "gpu", FlavorID: "flavor-d"},
+	}
+
+	require.Equal(t, expected, util.GetFlavorOverrides(cluster))
+}
@@ -0,0 +1,128 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	unikornv1 "github.com/unikorn-cloud/compute/pkg/apis/unikorn/v1alpha1"
+	"github.com/unikorn-cloud/compute/pkg/constants"
+)
+
+// SpecHistoryMaxSize is the largest the encoded specification history may be.  All of
+// a resource's annotations share a 256KiB limit, so this leaves plenty of room for
+// everything else.
+const SpecHistoryMaxSize = 64 * 1024
+
+// SpecHistoryEntry is the specification a cluster had at a specific generation.
+type SpecHistoryEntry struct {
+	Generation int64                        `json:"generation"`
+	Spec       unikornv1.ComputeClusterSpec `json:"spec"`
+}
+
+// GetSpecHistory parses the specification history annotation, this is encoded
+// as a JSON list ordered from oldest to newest generation.
+func GetSpecHistory(cluster *unikornv1.ComputeCluster) ([]SpecHistoryEntry, error) {
+	value, ok := cluster.Annotations[constants.SpecHistoryAnnotation]
+	if !ok || value == "" {
+		return nil, nil
+	}
+
+	var history []SpecHistoryEntry
+
+	if err := json.Unmarshal([]byte(value), &history); err != nil {
+		return nil, fmt.Errorf("%w: failed to parse spec history", err)
+	}
+
+	return history, nil
+}
+
+// GetSpecHistoryGeneration returns the specification the cluster had at the
+// requested generation, if it's still retained.
+func GetSpecHistoryGeneration(cluster *unikornv1.ComputeCluster, generation int64) (*unikornv1.ComputeClusterSpec, bool, error) {
+	history, err := GetSpecHistory(cluster)
+	if err != nil {
+		return nil, false, err
+	}
+
+	i := slices.IndexFunc(history, func(entry SpecHistoryEntry) bool {
+		return entry.Generation == generation
+	})
+
+	if i < 0 {
+		return nil, false, nil
+	}
+
+	return &history[i].Spec, true, nil
+}
+
+// RecordSpecHistory appends the previous cluster's specification to its history, and
+// records that on the updated cluster, retaining at most limit generations.  A limit
+// of zero disables history, removing the annotation entirely.  History that cannot be
+// parsed is discarded rather than blocking the update.  Specifications can be large,
+// especially with user data, so the oldest generations are also discarded to keep the
+// annotation within SpecHistoryMaxSize.
+func RecordSpecHistory(updated, previous *unikornv1.ComputeCluster, limit int) error {
+	if limit <= 0 {
+		delete(updated.Annotations, constants.SpecHistoryAnnotation)
+		return nil
+	}
+
+	history, err := GetSpecHistory(previous)
+	if err != nil {
+		history = nil
+	}
+
+	history = slices.DeleteFunc(history, func(entry SpecHistoryEntry) bool {
+		return entry.Generation == previous.Generation
+	})
+
+	history = append(history, SpecHistoryEntry{
+		Generation: previous.Generation,
+		Spec:       *previous.Spec.DeepCopy(),
+	})
+
+	if len(history) > limit {
+		history = history[len(history)-limit:]
+	}
+
+	for ; len(history) > 0; history = history[1:] {
+		data, err := json.Marshal(history)
+		if err != nil {
+			return fmt.Errorf("%w: failed to encode spec history", err)
+		}
+
+		if len(data) > SpecHistoryMaxSize {
+			continue
+		}
+
+		if updated.Annotations == nil {
+			updated.Annotations = map[string]string{}
+		}
+
+		updated.Annotations[constants.SpecHistoryAnnotation] = string(data)
+
+		return nil
+	}
+
+	delete(updated.Annotations, constants.SpecHistoryAnnotation)
+
+	return nil
+}
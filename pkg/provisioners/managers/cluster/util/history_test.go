@@ -0,0 +1,131 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	unikornv1 "github.com/unikorn-cloud/compute/pkg/apis/unikorn/v1alpha1"
+	"github.com/unikorn-cloud/compute/pkg/constants"
+	"github.com/unikorn-cloud/compute/pkg/provisioners/managers/cluster/util"
+)
+
+func clusterAtGeneration(generation int64, pools ...string) *unikornv1.ComputeCluster {
+	cluster := &unikornv1.ComputeCluster{}
+	cluster.Generation = generation
+	cluster.Spec.WorkloadPools = &unikornv1.ComputeClusterWorkloadPoolsSpec{}
+
+	for _, pool := range pools {
+		cluster.Spec.WorkloadPools.Pools = append(cluster.Spec.WorkloadPools.Pools, unikornv1.ComputeClusterWorkloadPoolSpec{Name: pool})
+	}
+
+	return cluster
+}
+
+// TestSpecHistoryRetention checks only the most recent generations are retained,
+// and they can be looked up by generation.
+func TestSpecHistoryRetention(t *testing.T) {
+	t.Parallel()
+
+	cluster := clusterAtGeneration(1, "a")
+
+	for generation := int64(2); generation <= 4; generation++ {
+		updated := clusterAtGeneration(generation)
+		updated.Annotations = cluster.Annotations
+
+		require.NoError(t, util.RecordSpecHistory(updated, cluster, 2))
+
+		cluster = updated
+	}
+
+	history, err := util.GetSpecHistory(cluster)
+	require.NoError(t, err)
+	require.Len(t, history, 2)
+	require.Equal(t, int64(2), history[0].Generation)
+	require.Equal(t, int64(3), history[1].Generation)
+
+	_, ok, err := util.GetSpecHistoryGeneration(cluster, 1)
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	_, ok, err = util.GetSpecHistoryGeneration(cluster, 3)
+	require.NoError(t, err)
+	require.True(t, ok)
+}
+
+// TestSpecHistoryRecordsSpec checks the previous specification is what's recorded,
+// and that corrupt history doesn't prevent new history being recorded.
+func TestSpecHistoryRecordsSpec(t *testing.T) {
+	t.Parallel()
+
+	previous := clusterAtGeneration(5, "a", "b")
+	previous.Annotations = map[string]string{
+		constants.SpecHistoryAnnotation: "not json",
+	}
+
+	updated := clusterAtGeneration(6, "a")
+
+	require.NoError(t, util.RecordSpecHistory(updated, previous, 3))
+
+	spec, ok, err := util.GetSpecHistoryGeneration(updated, 5)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Len(t, spec.WorkloadPools.Pools, 2)
+
+	require.NoError(t, util.RecordSpecHistory(updated, previous, 0))
+	require.NotContains(t, updated.Annotations, constants.SpecHistoryAnnotation)
+}
+
+// TestSpecHistorySizeLimit checks the oldest generations are discarded to keep the
+// history within its size limit, and that history is dropped entirely if even the
+// most recent generation is too large.
+func TestSpecHistorySizeLimit(t *testing.T) {
+	t.Parallel()
+
+	withUserData := func(generation int64, size int) *unikornv1.ComputeCluster {
+		cluster := clusterAtGeneration(generation, "a")
+		cluster.Spec.WorkloadPools.Pools[0].UserData = make([]byte, size)
+
+		return cluster
+	}
+
+	// Each of these encodes to a little over a third of the limit, so only two fit.
+	cluster := withUserData(1, util.SpecHistoryMaxSize/4)
+
+	for generation := int64(2); generation <= 4; generation++ {
+		updated := withUserData(generation, util.SpecHistoryMaxSize/4)
+
+		require.NoError(t, util.RecordSpecHistory(updated, cluster, 10))
+		require.LessOrEqual(t, len(updated.Annotations[constants.SpecHistoryAnnotation]), util.SpecHistoryMaxSize)
+
+		cluster = updated
+	}
+
+	history, err := util.GetSpecHistory(cluster)
+	require.NoError(t, err)
+	require.Len(t, history, 2)
+	require.Equal(t, int64(2), history[0].Generation)
+	require.Equal(t, int64(3), history[1].Generation)
+
+	updated := withUserData(5, 0)
+
+	require.NoError(t, util.RecordSpecHistory(updated, withUserData(4, util.SpecHistoryMaxSize), 10))
+	require.NotContains(t, updated.Annotations, constants.SpecHistoryAnnotation)
+}
@@ -0,0 +1,101 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"cmp"
+	"slices"
+
+	unikornv1 "github.com/unikorn-cloud/compute/pkg/apis/unikorn/v1alpha1"
+)
+
+// GetMachine returns a copy of the per-machine overrides of a server, if there
+// are none, then an empty specification is returned.
+func GetMachine(cluster *unikornv1.ComputeCluster, serverID, pool string) *unikornv1.ComputeClusterMachineSpec {
+	for i := range cluster.Spec.Machines {
+		if cluster.Spec.Machines[i].ID == serverID {
+			return cluster.Spec.Machines[i].DeepCopy()
+		}
+	}
+
+	return &unikornv1.ComputeClusterMachineSpec{
+		ID:   serverID,
+		Pool: pool,
+	}
+}
+
+// SetMachine records the per-machine overrides of a server, replacing any that
+// already exist, in a stable order.  Machines with no overrides are removed.
+func SetMachine(cluster *unikornv1.ComputeCluster, machine *unikornv1.ComputeClusterMachineSpec) {
+	cluster.Spec.Machines = slices.DeleteFunc(cluster.Spec.Machines, func(m unikornv1.ComputeClusterMachineSpec) bool {
+		return m.ID == machine.ID
+	})
+
+	if machine.FlavorID != nil || machine.PublicIP != nil {
+		cluster.Spec.Machines = append(cluster.Spec.Machines, *machine)
+	}
+
+	slices.SortFunc(cluster.Spec.Machines, func(a, b unikornv1.ComputeClusterMachineSpec) int {
+		return cmp.Compare(a.ID, b.ID)
+	})
+
+	if len(cluster.Spec.Machines) == 0 {
+		cluster.Spec.Machines = nil
+	}
+}
+
+// PruneMachines removes per-machine overrides that are no longer required.  That is
+// when the machine no longer exists, its pool has been removed, or the override now
+// matches the pool.  Flavors that match the pool are retained while the machine has
+// inherited a different one, as they override that instead.  Whether a machine exists
+// is only known when the servers have been listed, so a nil set of live server IDs
+// skips that check.
+func PruneMachines(cluster *unikornv1.ComputeCluster, live []string) {
+	var machines []unikornv1.ComputeClusterMachineSpec
+
+	for i := range cluster.Spec.Machines {
+		machine := cluster.Spec.Machines[i]
+
+		if live != nil && !slices.Contains(live, machine.ID) {
+			continue
+		}
+
+		pool, ok := cluster.GetWorkloadPool(machine.Pool)
+		if !ok {
+			continue
+		}
+
+		if machine.FlavorID != nil && *machine.FlavorID == pool.FlavorID {
+			if inherited := inheritedFlavor(cluster, machine.ID); inherited == nil || *inherited == pool.FlavorID {
+				machine.FlavorID = nil
+			}
+		}
+
+		if machine.PublicIP != nil && *machine.PublicIP == PublicIPEnabled(pool) {
+			machine.PublicIP = nil
+		}
+
+		if machine.FlavorID == nil && machine.PublicIP == nil {
+			continue
+		}
+
+		machines = append(machines, machine)
+	}
+
+	cluster.Spec.Machines = machines
+}
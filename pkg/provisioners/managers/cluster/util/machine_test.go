@@ -0,0 +1,126 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	unikornv1 "github.com/unikorn-cloud/compute/pkg/apis/unikorn/v1alpha1"
+	"github.com/unikorn-cloud/compute/pkg/provisioners/managers/cluster/util"
+	unikornv1core "github.com/unikorn-cloud/core/pkg/apis/unikorn/v1alpha1"
+
+	"k8s.io/utils/ptr"
+)
+
+func machineTestCluster() *unikornv1.ComputeCluster {
+	return &unikornv1.ComputeCluster{
+		Spec: unikornv1.ComputeClusterSpec{
+			WorkloadPools: &unikornv1.ComputeClusterWorkloadPoolsSpec{
+				Pools: []unikornv1.ComputeClusterWorkloadPoolSpec{
+					{
+						Name: "default",
+						MachineGeneric: unikornv1core.MachineGeneric{
+							FlavorID: "flavor-pool",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// TestSetMachine checks machines are stored in a stable order, and removed when they
+// no longer have any overrides.
+func TestSetMachine(t *testing.T) {
+	t.Parallel()
+
+	cluster := machineTestCluster()
+
+	machine := util.GetMachine(cluster, "server-b", "default")
+	require.Equal(t, &unikornv1.ComputeClusterMachineSpec{ID: "server-b", Pool: "default"}, machine)
+
+	machine.FlavorID = ptr.To("flavor-b")
+	util.SetMachine(cluster, machine)
+
+	machine = util.GetMachine(cluster, "server-a", "default")
+	machine.FlavorID = ptr.To("flavor-a")
+	util.SetMachine(cluster, machine)
+
+	expected := []unikornv1.ComputeClusterMachineSpec{
+		{ID: "server-a", Pool: "default", FlavorID: ptr.To("flavor-a")},
+		{ID: "server-b", Pool: "default", FlavorID: ptr.To("flavor-b")},
+	}
+
+	require.Equal(t, expected, cluster.Spec.Machines)
+
+	// Modifications must not leak into the specification until they are set.
+	machine = util.GetMachine(cluster, "server-a", "default")
+	machine.FlavorID = nil
+
+	require.Equal(t, expected, cluster.Spec.Machines)
+
+	util.SetMachine(cluster, machine)
+	require.Equal(t, expected[1:], cluster.Spec.Machines)
+
+	machine = util.GetMachine(cluster, "server-b", "default")
+	machine.FlavorID = nil
+
+	util.SetMachine(cluster, machine)
+	require.Nil(t, cluster.Spec.Machines)
+}
+
+// TestPruneMachines checks overrides are removed once they are no longer required.
+func TestPruneMachines(t *testing.T) {
+	t.Parallel()
+
+	cluster := machineTestCluster()
+	cluster.Spec.Machines = []unikornv1.ComputeClusterMachineSpec{
+		{ID: "server-a", Pool: "default", FlavorID: ptr.To("flavor-a")},
+		{ID: "server-b", Pool: "default", FlavorID: ptr.To("flavor-pool")},
+		{ID: "server-c", Pool: "default", FlavorID: ptr.To("flavor-pool")},
+		{ID: "server-d", Pool: "missing", FlavorID: ptr.To("flavor-d")},
+		{ID: "server-e", Pool: "default", FlavorID: ptr.To("flavor-e"), PublicIP: ptr.To(false)},
+		{ID: "server-f", Pool: "default", PublicIP: ptr.To(true)},
+		{ID: "server-g", Pool: "default", PublicIP: ptr.To(false)},
+	}
+
+	// Server C inherited a different flavor, so resizing back to the pool's flavor
+	// must be retained.
+	cluster.Status.MachineTracking = []unikornv1.MachineTrackingStatus{
+		{ID: "server-c", Pool: "default", FlavorID: ptr.To("flavor-inherited")},
+	}
+
+	util.PruneMachines(cluster, nil)
+
+	expected := []unikornv1.ComputeClusterMachineSpec{
+		{ID: "server-a", Pool: "default", FlavorID: ptr.To("flavor-a")},
+		{ID: "server-c", Pool: "default", FlavorID: ptr.To("flavor-pool")},
+		{ID: "server-e", Pool: "default", FlavorID: ptr.To("flavor-e")},
+		{ID: "server-f", Pool: "default", PublicIP: ptr.To(true)},
+	}
+
+	require.Equal(t, expected, cluster.Spec.Machines)
+
+	util.PruneMachines(cluster, []string{"server-a", "server-c"})
+	require.Equal(t, expected[:2], cluster.Spec.Machines)
+
+	util.PruneMachines(cluster, []string{})
+	require.Nil(t, cluster.Spec.Machines)
+}
@@ -0,0 +1,160 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	unikornv1 "github.com/unikorn-cloud/compute/pkg/apis/unikorn/v1alpha1"
+	unikornv1core "github.com/unikorn-cloud/core/pkg/apis/unikorn/v1alpha1"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+// Maintenance answers whether machines are under provider maintenance at a point in time.
+type Maintenance struct {
+	// windows are the active windows affecting the cluster's region.
+	windows []*unikornv1.ComputeMaintenanceWindow
+}
+
+// NewMaintenance filters maintenance windows down to those that are active and may
+// affect machines in the region.
+func NewMaintenance(windows []unikornv1.ComputeMaintenanceWindow, regionID string, now time.Time) *Maintenance {
+	m := &Maintenance{}
+
+	for i := range windows {
+		window := &windows[i]
+
+		if now.Before(window.Spec.Start.Time) || !now.Before(window.Spec.End.Time) {
+			continue
+		}
+
+		if window.Spec.RegionID != "" && window.Spec.RegionID != regionID {
+			continue
+		}
+
+		m.windows = append(m.windows, window)
+	}
+
+	return m
+}
+
+// NextMaintenanceChange returns when the next maintenance window affecting the region
+// starts or ends, so the cluster can be reconciled at that point, without it nothing
+// would update the machine conditions when a window opens or closes.
+func NextMaintenanceChange(windows []unikornv1.ComputeMaintenanceWindow, regionID string, now time.Time) (time.Time, bool) {
+	var next time.Time
+
+	update := func(t time.Time) {
+		if t.After(now) && (next.IsZero() || t.Before(next)) {
+			next = t
+		}
+	}
+
+	for i := range windows {
+		window := &windows[i]
+
+		if window.Spec.RegionID != "" && window.Spec.RegionID != regionID {
+			continue
+		}
+
+		update(window.Spec.Start.Time)
+		update(window.Spec.End.Time)
+	}
+
+	return next, !next.IsZero()
+}
+
+// Get returns the maintenance window affecting the machine, if there are multiple
+// then the one that ends last is returned.
+func (m *Maintenance) Get(machineID string) *unikornv1.ComputeMaintenanceWindow {
+	var result *unikornv1.ComputeMaintenanceWindow
+
+	for _, window := range m.windows {
+		if len(window.Spec.MachineIDs) != 0 && !slices.Contains(window.Spec.MachineIDs, machineID) {
+			continue
+		}
+
+		if result == nil || window.Spec.End.After(result.Spec.End.Time) {
+			result = window
+		}
+	}
+
+	return result
+}
+
+// Active returns whether the machine is under maintenance.
+func (m *Maintenance) Active(machineID string) bool {
+	return m.Get(machineID) != nil
+}
+
+// UpdateMaintenanceStatus reports maintenance on each affected machine, and on the cluster
+// as a whole, this must be called after the machine statuses have been updated.
+func UpdateMaintenanceStatus(cluster *unikornv1.ComputeCluster, maintenance *Maintenance) {
+	var affected int
+
+	for i := range cluster.Status.WorkloadPools {
+		pool := &cluster.Status.WorkloadPools[i]
+
+		for j := range pool.Machines {
+			machine := &pool.Machines[j]
+
+			window := maintenance.Get(machine.ID)
+			if window == nil {
+				continue
+			}
+
+			message := "under provider maintenance until " + window.Spec.End.UTC().Format(time.RFC3339)
+
+			if window.Spec.Reason != "" {
+				message += ": " + window.Spec.Reason
+			}
+
+			unikornv1core.UpdateCondition(&machine.Conditions, unikornv1.ConditionMaintenance, corev1.ConditionTrue, unikornv1.ConditionReasonMaintenance, message)
+
+			affected++
+		}
+	}
+
+	if affected == 0 {
+		cluster.Status.Conditions = slices.DeleteFunc(cluster.Status.Conditions, func(c unikornv1core.Condition) bool {
+			return c.Type == unikornv1.ConditionMaintenance
+		})
+
+		return
+	}
+
+	message := fmt.Sprintf("%d machines under provider maintenance", affected)
+
+	unikornv1core.UpdateCondition(&cluster.Status.Conditions, unikornv1.ConditionMaintenance, corev1.ConditionTrue, unikornv1.ConditionReasonMaintenance, message)
+}
+
+// UpdateMaintenanceModeStatus reports whether the cluster is in maintenance mode.
+func UpdateMaintenanceModeStatus(cluster *unikornv1.ComputeCluster) {
+	if !cluster.Spec.MaintenanceMode {
+		cluster.Status.Conditions = slices.DeleteFunc(cluster.Status.Conditions, func(c unikornv1core.Condition) bool {
+			return c.Type == unikornv1.ConditionMaintenanceMode
+		})
+
+		return
+	}
+
+	unikornv1core.UpdateCondition(&cluster.Status.Conditions, unikornv1.ConditionMaintenanceMode, corev1.ConditionTrue, unikornv1.ConditionReasonMaintenanceMode, "servers are not being created, deleted or rebuilt")
+}
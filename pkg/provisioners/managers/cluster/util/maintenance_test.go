@@ -0,0 +1,159 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	unikornv1 "github.com/unikorn-cloud/compute/pkg/apis/unikorn/v1alpha1"
+	"github.com/unikorn-cloud/compute/pkg/provisioners/managers/cluster/util"
+	unikornv1core "github.com/unikorn-cloud/core/pkg/apis/unikorn/v1alpha1"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func maintenanceWindow(regionID string, machineIDs []string, start, end time.Time) unikornv1.ComputeMaintenanceWindow {
+	return unikornv1.ComputeMaintenanceWindow{
+		Spec: unikornv1.ComputeMaintenanceWindowSpec{
+			RegionID:   regionID,
+			MachineIDs: machineIDs,
+			Start:      metav1.NewTime(start),
+			End:        metav1.NewTime(end),
+		},
+	}
+}
+
+// TestMaintenanceActive checks windows are scoped by time, region and machine.
+func TestMaintenanceActive(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+
+	windows := []unikornv1.ComputeMaintenanceWindow{
+		maintenanceWindow("other", nil, now.Add(-time.Hour), now.Add(time.Hour)),
+		maintenanceWindow("region", []string{"a"}, now.Add(-time.Hour), now.Add(time.Hour)),
+		maintenanceWindow("", []string{"b"}, now.Add(time.Minute), now.Add(time.Hour)),
+		maintenanceWindow("", []string{"c"}, now.Add(-time.Hour), now),
+	}
+
+	m := util.NewMaintenance(windows, "region", now)
+
+	require.True(t, m.Active("a"))
+	require.False(t, m.Active("b"))
+	require.False(t, m.Active("c"))
+	require.False(t, m.Active("d"))
+
+	windows = append(windows, maintenanceWindow("region", nil, now.Add(-time.Hour), now.Add(time.Hour)))
+
+	require.True(t, util.NewMaintenance(windows, "region", now).Active("d"))
+}
+
+// TestNextMaintenanceChange checks the next window boundary in the region is found.
+func TestNextMaintenanceChange(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+
+	windows := []unikornv1.ComputeMaintenanceWindow{
+		maintenanceWindow("other", nil, now.Add(time.Minute), now.Add(time.Hour)),
+		maintenanceWindow("region", nil, now.Add(-time.Hour), now.Add(2*time.Hour)),
+		maintenanceWindow("", []string{"a"}, now.Add(30*time.Minute), now.Add(3*time.Hour)),
+		maintenanceWindow("", nil, now.Add(-2*time.Hour), now.Add(-time.Hour)),
+	}
+
+	next, ok := util.NextMaintenanceChange(windows, "region", now)
+	require.True(t, ok)
+	require.Equal(t, now.Add(30*time.Minute), next)
+
+	next, ok = util.NextMaintenanceChange(windows, "other", now)
+	require.True(t, ok)
+	require.Equal(t, now.Add(time.Minute), next)
+
+	_, ok = util.NextMaintenanceChange(windows[3:], "region", now)
+	require.False(t, ok)
+}
+
+// TestUpdateMaintenanceStatus checks conditions are added to affected machines and the
+// cluster, and removed from the cluster once maintenance is over.
+func TestUpdateMaintenanceStatus(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+
+	cluster := &unikornv1.ComputeCluster{
+		Status: unikornv1.ComputeClusterStatus{
+			WorkloadPools: []unikornv1.WorkloadPoolStatus{
+				{
+					Name: "pool",
+					Machines: []unikornv1.MachineStatus{
+						{ID: "a"},
+						{ID: "b"},
+					},
+				},
+			},
+		},
+	}
+
+	windows := []unikornv1.ComputeMaintenanceWindow{
+		maintenanceWindow("", []string{"a"}, now.Add(-time.Hour), now.Add(time.Hour)),
+	}
+
+	util.UpdateMaintenanceStatus(cluster, util.NewMaintenance(windows, "region", now))
+
+	_, err := unikornv1core.GetCondition(cluster.Status.WorkloadPools[0].Machines[0].Conditions, unikornv1.ConditionMaintenance)
+	require.NoError(t, err)
+
+	_, err = unikornv1core.GetCondition(cluster.Status.WorkloadPools[0].Machines[1].Conditions, unikornv1.ConditionMaintenance)
+	require.Error(t, err)
+
+	_, err = cluster.StatusConditionRead(unikornv1.ConditionMaintenance)
+	require.NoError(t, err)
+
+	util.UpdateMaintenanceStatus(cluster, util.NewMaintenance(windows, "region", now.Add(time.Hour)))
+
+	_, err = cluster.StatusConditionRead(unikornv1.ConditionMaintenance)
+	require.Error(t, err)
+}
+
+// TestUpdateMaintenanceModeStatus checks the maintenance mode condition follows the
+// cluster specification.
+func TestUpdateMaintenanceModeStatus(t *testing.T) {
+	t.Parallel()
+
+	cluster := &unikornv1.ComputeCluster{
+		Spec: unikornv1.ComputeClusterSpec{
+			MaintenanceMode: true,
+		},
+	}
+
+	util.UpdateMaintenanceModeStatus(cluster)
+
+	condition, err := unikornv1core.GetCondition(cluster.Status.Conditions, unikornv1.ConditionMaintenanceMode)
+	require.NoError(t, err)
+	require.Equal(t, unikornv1.ConditionReasonMaintenanceMode, condition.Reason)
+
+	cluster.Spec.MaintenanceMode = false
+
+	util.UpdateMaintenanceModeStatus(cluster)
+
+	_, err = unikornv1core.GetCondition(cluster.Status.Conditions, unikornv1.ConditionMaintenanceMode)
+	require.Error(t, err)
+}
@@ -0,0 +1,101 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	unikornv1 "github.com/unikorn-cloud/compute/pkg/apis/unikorn/v1alpha1"
+	"github.com/unikorn-cloud/compute/pkg/constants"
+
+	"k8s.io/apimachinery/pkg/util/rand"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+const (
+	// FQDNLabel is the label key for a server's fully qualified domain name, this
+	// is only set when the pool defines a domain.
+	FQDNLabel = constants.PlatformTagPrefix + "fqdn"
+
+	// serverNameSuffixLength is the length of the random suffix that makes server
+	// names unique within a pool.
+	serverNameSuffixLength = 6
+
+	// MaxHostnamePrefixLength is the longest prefix that still yields a valid host
+	// name once the random suffix is appended.
+	MaxHostnamePrefixLength = validation.DNS1123LabelMaxLength - serverNameSuffixLength - 1
+)
+
+var (
+	// ErrNaming is raised when a pool's naming configuration is invalid.
+	ErrNaming = errors.New("naming error")
+)
+
+// ServerNamePrefix returns the prefix of generated server names for the pool.
+func ServerNamePrefix(pool *unikornv1.ComputeClusterWorkloadPoolSpec) string {
+	if pool.HostnamePrefix != "" {
+		return pool.HostnamePrefix
+	}
+
+	return pool.Name
+}
+
+// GenerateServerName generates a unique server name for the pool, this translates
+// to the server's host name.
+func GenerateServerName(pool *unikornv1.ComputeClusterWorkloadPoolSpec) string {
+	return ServerNamePrefix(pool) + "-" + rand.String(serverNameSuffixLength)
+}
+
+// ServerFQDN returns the fully qualified domain name of a server in the pool, or
+// an empty string if the pool doesn't define a domain.
+func ServerFQDN(pool *unikornv1.ComputeClusterWorkloadPoolSpec, name string) string {
+	if pool.Domain == "" {
+		return ""
+	}
+
+	return name + "." + pool.Domain
+}
+
+// ValidateNaming checks a host name prefix and domain will result in valid fully
+// qualified domain names for generated servers.
+func ValidateNaming(hostnamePrefix, domain string) error {
+	if hostnamePrefix != "" {
+		if len(hostnamePrefix) > MaxHostnamePrefixLength {
+			return fmt.Errorf("%w: host name prefix must be at most %d characters", ErrNaming, MaxHostnamePrefixLength)
+		}
+
+		if errs := validation.IsDNS1123Label(hostnamePrefix); len(errs) != 0 {
+			return fmt.Errorf("%w: host name prefix %s", ErrNaming, strings.Join(errs, ", "))
+		}
+	}
+
+	if domain != "" {
+		if errs := validation.IsDNS1123Subdomain(domain); len(errs) != 0 {
+			return fmt.Errorf("%w: domain %s", ErrNaming, strings.Join(errs, ", "))
+		}
+
+		// The longest host name must still fit within a domain name.
+		if length := validation.DNS1123LabelMaxLength + 1 + len(domain); length > validation.DNS1123SubdomainMaxLength {
+			return fmt.Errorf("%w: domain must be at most %d characters", ErrNaming, validation.DNS1123SubdomainMaxLength-validation.DNS1123LabelMaxLength-1)
+		}
+	}
+
+	return nil
+}
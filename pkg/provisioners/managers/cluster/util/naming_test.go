@@ -0,0 +1,63 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	unikornv1 "github.com/unikorn-cloud/compute/pkg/apis/unikorn/v1alpha1"
+	"github.com/unikorn-cloud/compute/pkg/provisioners/managers/cluster/util"
+)
+
+// TestGenerateServerName checks server names use the host name prefix when set,
+// falling back to the pool name.
+func TestGenerateServerName(t *testing.T) {
+	t.Parallel()
+
+	pool := &unikornv1.ComputeClusterWorkloadPoolSpec{
+		Name: "default",
+	}
+
+	require.True(t, strings.HasPrefix(util.GenerateServerName(pool), "default-"))
+	require.Empty(t, util.ServerFQDN(pool, "default-abcdef"))
+
+	pool.HostnamePrefix = "web"
+	pool.Domain = "example.com"
+
+	name := util.GenerateServerName(pool)
+
+	require.True(t, strings.HasPrefix(name, "web-"))
+	require.Equal(t, name+".example.com", util.ServerFQDN(pool, name))
+}
+
+// TestValidateNaming checks invalid prefixes and domains are rejected.
+func TestValidateNaming(t *testing.T) {
+	t.Parallel()
+
+	require.NoError(t, util.ValidateNaming("", ""))
+	require.NoError(t, util.ValidateNaming("web", "corp.example.com"))
+	require.NoError(t, util.ValidateNaming(strings.Repeat("a", util.MaxHostnamePrefixLength), ""))
+
+	require.ErrorIs(t, util.ValidateNaming(strings.Repeat("a", util.MaxHostnamePrefixLength+1), ""), util.ErrNaming)
+	require.ErrorIs(t, util.ValidateNaming("Web_", ""), util.ErrNaming)
+	require.ErrorIs(t, util.ValidateNaming("", "-example.com"), util.ErrNaming)
+	require.ErrorIs(t, util.ValidateNaming("", strings.Repeat("a.", 95)+"com"), util.ErrNaming)
+}
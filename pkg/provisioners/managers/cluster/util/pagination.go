@@ -0,0 +1,89 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+var (
+	// ErrPagination is raised when a paged response cannot be followed safely.
+	ErrPagination = errors.New("pagination error")
+)
+
+// NextPageURL returns the next page of a paged response as advertised by an RFC 8288
+// "next" link header, or nil if this is the last page.  Relative links are resolved
+// against the request URL, and links to other hosts are rejected so credentials are
+// never sent elsewhere.
+func NextPageURL(header http.Header, requestURL *url.URL) (*url.URL, error) {
+	for _, value := range header.Values("Link") {
+		for link := range strings.SplitSeq(value, ",") {
+			target, params, ok := strings.Cut(strings.TrimSpace(link), ";")
+			if !ok || !isNextLink(params) {
+				continue
+			}
+
+			target = strings.TrimSpace(target)
+
+			if !strings.HasPrefix(target, "<") || !strings.HasSuffix(target, ">") {
+				return nil, fmt.Errorf("%w: malformed link %s", ErrPagination, link)
+			}
+
+			next, err := url.Parse(target[1 : len(target)-1])
+			if err != nil {
+				return nil, fmt.Errorf("%w: malformed link %s", ErrPagination, link)
+			}
+
+			if requestURL != nil {
+				next = requestURL.ResolveReference(next)
+
+				if next.Scheme != requestURL.Scheme || next.Host != requestURL.Host {
+					return nil, fmt.Errorf("%w: link %s refers to another host", ErrPagination, next)
+				}
+			}
+
+			return next, nil
+		}
+	}
+
+	//nolint: nilnil
+	return nil, nil
+}
+
+// isNextLink checks a link's parameters for a next relation, relations may be a
+// space separated list.
+func isNextLink(params string) bool {
+	for param := range strings.SplitSeq(params, ";") {
+		name, value, ok := strings.Cut(strings.TrimSpace(param), "=")
+		if !ok || !strings.EqualFold(strings.TrimSpace(name), "rel") {
+			continue
+		}
+
+		for rel := range strings.FieldsSeq(strings.Trim(strings.TrimSpace(value), `"`)) {
+			if strings.EqualFold(rel, "next") {
+				return true
+			}
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,54 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util_test
+
+import (
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/unikorn-cloud/compute/pkg/provisioners/managers/cluster/util"
+)
+
+// TestNextPageURL checks next links are found, resolved and restricted to the
+// original host.
+func TestNextPageURL(t *testing.T) {
+	t.Parallel()
+
+	requestURL, err := url.Parse("https://region.example.com/api/v1/organizations/foo/servers?tag=a%3Db")
+	require.NoError(t, err)
+
+	header := http.Header{}
+
+	next, err := util.NextPageURL(header, requestURL)
+	require.NoError(t, err)
+	require.Nil(t, next)
+
+	header.Set("Link", `<https://region.example.com/api/v1/organizations/foo/servers?page=1>; rel="prev", </api/v1/organizations/foo/servers?page=3>; rel="next"`)
+
+	next, err = util.NextPageURL(header, requestURL)
+	require.NoError(t, err)
+	require.Equal(t, "https://region.example.com/api/v1/organizations/foo/servers?page=3", next.String())
+
+	header.Set("Link", `<https://evil.example.com/servers?page=3>; rel="next"`)
+
+	_, err = util.NextPageURL(header, requestURL)
+	require.ErrorIs(t, err, util.ErrPagination)
+}
@@ -0,0 +1,187 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"mime/multipart"
+	"net/textproto"
+	"net/url"
+	"strings"
+
+	unikornv1 "github.com/unikorn-cloud/compute/pkg/apis/unikorn/v1alpha1"
+	"github.com/unikorn-cloud/compute/pkg/constants"
+)
+
+const (
+	// phoneHomeBoundary separates the MIME parts of user data.  This must be
+	// stable, or the user data would change on every reconcile.  It predates
+	// other uses of multipart user data, so is retained to avoid updating
+	// existing servers.
+	phoneHomeBoundary = "unikorn-compute-phone-home"
+)
+
+// PhoneHomeSignature generates a signature that authenticates a machine's boot
+// completion callback.
+func PhoneHomeSignature(token, clusterID, hostname string) string {
+	mac := hmac.New(sha256.New, []byte(token))
+	mac.Write([]byte(clusterID + "/" + hostname))
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// VerifyPhoneHomeSignature checks the signature of a machine's boot completion callback.
+func VerifyPhoneHomeSignature(token, clusterID, hostname, signature string) bool {
+	return hmac.Equal([]byte(PhoneHomeSignature(token, clusterID, hostname)), []byte(signature))
+}
+
+// PhoneHomeURL returns the signed URL a machine calls on boot completion.
+func PhoneHomeURL(base, token, clusterID, hostname string) string {
+	query := url.Values{
+		"signature": []string{PhoneHomeSignature(token, clusterID, hostname)},
+	}
+
+	return strings.TrimSuffix(base, "/") + "/api/v1/clusters/" + clusterID + "/machines/" + hostname + "/bootfinished?" + query.Encode()
+}
+
+// userDataContentType maps cloud-init user data to its MIME type, as defined by
+// cloud-init.  Anything else, e.g. compressed or existing multipart data, cannot
+// be safely combined.
+func userDataContentType(userData []byte) (string, bool) {
+	prefixes := []struct {
+		prefix      string
+		contentType string
+	}{
+		{"#cloud-config", "text/cloud-config"},
+		{"#cloud-boothook", "text/cloud-boothook"},
+		{"#include", "text/x-include-url"},
+		{"#!", "text/x-shellscript"},
+	}
+
+	for _, p := range prefixes {
+		if bytes.HasPrefix(userData, []byte(p.prefix)) {
+			return p.contentType, true
+		}
+	}
+
+	return "", false
+}
+
+// PhoneHomeUserData returns cloud-init configuration that reports when cloud-init
+// has finished.
+func PhoneHomeUserData(phoneHomeURL string) []byte {
+	return fmt.Appendf(nil, "#cloud-config\nphone_home:\n  url: %q\n  post:\n  - instance_id\n  tries: 10\n", phoneHomeURL)
+}
+
+// InjectPhoneHome adds a cloud-init phone home directive to the user data, so the
+// machine reports when cloud-init has finished.  Any existing user data is retained
+// as a separate MIME part.  If the user data cannot be combined then it is returned
+// unmodified, and the boolean is false.
+func InjectPhoneHome(userData []byte, phoneHomeURL string) ([]byte, bool, error) {
+	if bytes.Contains(userData, []byte(phoneHomeBoundary)) {
+		return userData, false, nil
+	}
+
+	out, ok, err := CombineUserData(userData, PhoneHomeUserData(phoneHomeURL))
+	if err != nil {
+		return nil, false, err
+	}
+
+	if !ok {
+		return userData, false, nil
+	}
+
+	return out, true, nil
+}
+
+// CombineUserData combines user data into a MIME multipart archive, so cloud-init
+// runs each part in order.  Empty parts are ignored.  If any part cannot be combined,
+// e.g. it's compressed or already a multipart archive, the boolean is false.
+func CombineUserData(parts ...[]byte) ([]byte, bool, error) {
+	contentTypes := make([]string, len(parts))
+
+	for i, part := range parts {
+		if len(part) == 0 {
+			continue
+		}
+
+		t, ok := userDataContentType(part)
+		if !ok || bytes.Contains(part, []byte(phoneHomeBoundary)) {
+			return nil, false, nil
+		}
+
+		contentTypes[i] = t
+	}
+
+	var buf bytes.Buffer
+
+	writer := multipart.NewWriter(&buf)
+
+	if err := writer.SetBoundary(phoneHomeBoundary); err != nil {
+		return nil, false, err
+	}
+
+	fmt.Fprintf(&buf, "Content-Type: multipart/mixed; boundary=\"%s\"\r\nMIME-Version: 1.0\r\n\r\n", phoneHomeBoundary)
+
+	for i, part := range parts {
+		if len(part) == 0 {
+			continue
+		}
+
+		if err := writePart(writer, contentTypes[i], part); err != nil {
+			return nil, false, err
+		}
+	}
+
+	if err := writer.Close(); err != nil {
+		return nil, false, err
+	}
+
+	return buf.Bytes(), true, nil
+}
+
+func writePart(writer *multipart.Writer, contentType string, data []byte) error {
+	header := textproto.MIMEHeader{}
+	header.Set("Content-Type", contentType+"; charset=\"utf-8\"")
+	header.Set("MIME-Version", "1.0")
+
+	part, err := writer.CreatePart(header)
+	if err != nil {
+		return err
+	}
+
+	if _, err := part.Write(data); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetBootFinished returns the host names of machines that have finished booting.
+func GetBootFinished(cluster *unikornv1.ComputeCluster) []string {
+	return getAnnotationList(cluster, constants.ServerBootFinishedAnnotation)
+}
+
+// SetBootFinished records the host names of machines that have finished booting.
+func SetBootFinished(cluster *unikornv1.ComputeCluster, hostnames []string) {
+	setAnnotationList(cluster, constants.ServerBootFinishedAnnotation, hostnames)
+}
@@ -0,0 +1,93 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util_test
+
+import (
+	"bytes"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/mail"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/unikorn-cloud/compute/pkg/provisioners/managers/cluster/util"
+)
+
+// TestPhoneHomeSignature ensures signatures are bound to the machine.
+func TestPhoneHomeSignature(t *testing.T) {
+	t.Parallel()
+
+	signature := util.PhoneHomeSignature("token", "cluster", "host-a")
+
+	require.True(t, util.VerifyPhoneHomeSignature("token", "cluster", "host-a", signature))
+	require.False(t, util.VerifyPhoneHomeSignature("token", "cluster", "host-b", signature))
+	require.False(t, util.VerifyPhoneHomeSignature("other", "cluster", "host-a", signature))
+}
+
+// TestInjectPhoneHome ensures user data is preserved alongside the phone home
+// directive, and that unsupported user data is left alone.
+func TestInjectPhoneHome(t *testing.T) {
+	t.Parallel()
+
+	script := []byte("#!/bin/sh\necho hello\n")
+
+	out, ok, err := util.InjectPhoneHome(script, "https://compute.example.com/callback")
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	again, _, err := util.InjectPhoneHome(script, "https://compute.example.com/callback")
+	require.NoError(t, err)
+	require.Equal(t, out, again)
+
+	message, err := mail.ReadMessage(bytes.NewReader(out))
+	require.NoError(t, err)
+
+	mediaType, params, err := mime.ParseMediaType(message.Header.Get("Content-Type"))
+	require.NoError(t, err)
+	require.Equal(t, "multipart/mixed", mediaType)
+
+	reader := multipart.NewReader(message.Body, params["boundary"])
+
+	part, err := reader.NextPart()
+	require.NoError(t, err)
+	require.Contains(t, part.Header.Get("Content-Type"), "text/x-shellscript")
+
+	data, err := io.ReadAll(part)
+	require.NoError(t, err)
+	require.Equal(t, script, data)
+
+	part, err = reader.NextPart()
+	require.NoError(t, err)
+	require.Contains(t, part.Header.Get("Content-Type"), "text/cloud-config")
+
+	data, err = io.ReadAll(part)
+	require.NoError(t, err)
+	require.Contains(t, string(data), "phone_home:")
+
+	_, err = reader.NextPart()
+	require.ErrorIs(t, err, io.EOF)
+
+	binary := []byte{0x1f, 0x8b, 0x08}
+
+	out, ok, err = util.InjectPhoneHome(binary, "https://compute.example.com/callback")
+	require.NoError(t, err)
+	require.False(t, ok)
+	require.Equal(t, binary, out)
+}
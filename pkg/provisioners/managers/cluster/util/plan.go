@@ -0,0 +1,146 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"cmp"
+	"slices"
+
+	unikornv1 "github.com/unikorn-cloud/compute/pkg/apis/unikorn/v1alpha1"
+	"github.com/unikorn-cloud/compute/pkg/constants"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+)
+
+// PoolPlan describes the actions required to reconcile an instance pool with its
+// specification.
+type PoolPlan struct {
+	// Name is the pool name.
+	Name string
+	// Create is the number of instances to create.
+	Create int
+	// Delete are the instances to delete, either because the pool is too large
+	// or has been removed.
+	Delete []string
+	// Rebuild are the instances whose template is out of date.
+	Rebuild []string
+}
+
+// InstanceOutOfDate returns true if the instance no longer matches the pool's template.
+func InstanceOutOfDate(pool *unikornv1.InstancePoolSpec, instance *unikornv1.ComputeInstance) bool {
+	return !equality.Semantic.DeepEqual(pool.Template.MachineGeneric, instance.Spec.MachineGeneric) ||
+		!equality.Semantic.DeepEqual(pool.Template.Networking, instance.Spec.Networking) ||
+		!equality.Semantic.DeepEqual(pool.Template.UserData, instance.Spec.UserData)
+}
+
+// PlanPools decides what to do with each pool given the cluster's instances.  When a
+// pool is scaled down out of date instances are deleted first as they would otherwise
+// need a rebuild, then the newest, as the oldest have proven themselves stable.  Any
+// instances already being deleted are ignored.  Plans for removed pools are ordered
+// after those in the specification.
+func PlanPools(pools []unikornv1.InstancePoolSpec, instances []unikornv1.ComputeInstance) []PoolPlan {
+	byPool := map[string][]*unikornv1.ComputeInstance{}
+
+	for i := range instances {
+		instance := &instances[i]
+
+		if instance.DeletionTimestamp != nil {
+			continue
+		}
+
+		name := instance.Labels[constants.PoolLabel]
+
+		byPool[name] = append(byPool[name], instance)
+	}
+
+	out := make([]PoolPlan, 0, len(pools))
+
+	for i := range pools {
+		pool := &pools[i]
+
+		out = append(out, planPool(pool, byPool[pool.Name]))
+
+		delete(byPool, pool.Name)
+	}
+
+	removed := make([]PoolPlan, 0, len(byPool))
+
+	for name, members := range byPool {
+		plan := PoolPlan{
+			Name: name,
+		}
+
+		for _, instance := range members {
+			plan.Delete = append(plan.Delete, instance.Name)
+		}
+
+		slices.Sort(plan.Delete)
+
+		removed = append(removed, plan)
+	}
+
+	slices.SortFunc(removed, func(a, b PoolPlan) int {
+		return cmp.Compare(a.Name, b.Name)
+	})
+
+	return append(out, removed...)
+}
+
+func planPool(pool *unikornv1.InstancePoolSpec, members []*unikornv1.ComputeInstance) PoolPlan {
+	plan := PoolPlan{
+		Name: pool.Name,
+	}
+
+	// Order by deletion preference.
+	slices.SortStableFunc(members, func(a, b *unikornv1.ComputeInstance) int {
+		aOutdated := InstanceOutOfDate(pool, a)
+		bOutdated := InstanceOutOfDate(pool, b)
+
+		if aOutdated != bOutdated {
+			if aOutdated {
+				return -1
+			}
+
+			return 1
+		}
+
+		if c := b.CreationTimestamp.Compare(a.CreationTimestamp.Time); c != 0 {
+			return c
+		}
+
+		return cmp.Compare(a.Name, b.Name)
+	})
+
+	if excess := len(members) - pool.Replicas; excess > 0 {
+		for _, instance := range members[:excess] {
+			plan.Delete = append(plan.Delete, instance.Name)
+		}
+
+		members = members[excess:]
+	}
+
+	plan.Create = max(pool.Replicas-len(members), 0)
+
+	for _, instance := range members {
+		if InstanceOutOfDate(pool, instance) {
+			plan.Rebuild = append(plan.Rebuild, instance.Name)
+		}
+	}
+
+	return plan
+}
@@ -0,0 +1,123 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	unikornv1 "github.com/unikorn-cloud/compute/pkg/apis/unikorn/v1alpha1"
+	"github.com/unikorn-cloud/compute/pkg/constants"
+	"github.com/unikorn-cloud/compute/pkg/provisioners/managers/cluster/util"
+	unikornv1core "github.com/unikorn-cloud/core/pkg/apis/unikorn/v1alpha1"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func planPool(name string, replicas int, imageID string) unikornv1.InstancePoolSpec {
+	return unikornv1.InstancePoolSpec{
+		Name:     name,
+		Replicas: replicas,
+		Template: unikornv1.ComputeInstanceSpec{
+			MachineGeneric: unikornv1core.MachineGeneric{
+				FlavorID: "flavor",
+				ImageID:  imageID,
+			},
+		},
+	}
+}
+
+func planInstance(name, pool, imageID string, age time.Duration) unikornv1.ComputeInstance {
+	return unikornv1.ComputeInstance{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              name,
+			Labels:            map[string]string{constants.PoolLabel: pool},
+			CreationTimestamp: metav1.NewTime(time.Now().Add(-age)),
+		},
+		Spec: unikornv1.ComputeInstanceSpec{
+			MachineGeneric: unikornv1core.MachineGeneric{
+				FlavorID: "flavor",
+				ImageID:  imageID,
+			},
+		},
+	}
+}
+
+// TestPlanPoolsScaleUp checks missing instances are created and out of date ones rebuilt.
+func TestPlanPoolsScaleUp(t *testing.T) {
+	t.Parallel()
+
+	pools := []unikornv1.InstancePoolSpec{
+		planPool("pool", 3, "new"),
+	}
+
+	instances := []unikornv1.ComputeInstance{
+		planInstance("a", "pool", "old", time.Hour),
+		planInstance("b", "pool", "new", time.Hour),
+	}
+
+	plans := util.PlanPools(pools, instances)
+	require.Len(t, plans, 1)
+	require.Equal(t, 1, plans[0].Create)
+	require.Empty(t, plans[0].Delete)
+	require.Equal(t, []string{"a"}, plans[0].Rebuild)
+}
+
+// TestPlanPoolsScaleDown checks out of date instances are deleted first, then the
+// newest.
+func TestPlanPoolsScaleDown(t *testing.T) {
+	t.Parallel()
+
+	pools := []unikornv1.InstancePoolSpec{
+		planPool("pool", 1, "new"),
+	}
+
+	instances := []unikornv1.ComputeInstance{
+		planInstance("oldest", "pool", "new", 3*time.Hour),
+		planInstance("newest", "pool", "new", time.Hour),
+		planInstance("outdated", "pool", "old", 2*time.Hour),
+	}
+
+	plans := util.PlanPools(pools, instances)
+	require.Len(t, plans, 1)
+	require.Zero(t, plans[0].Create)
+	require.Equal(t, []string{"outdated", "newest"}, plans[0].Delete)
+	require.Empty(t, plans[0].Rebuild)
+}
+
+// TestPlanPoolsRemoved checks instances in removed pools are deleted, and those
+// already being deleted are ignored.
+func TestPlanPoolsRemoved(t *testing.T) {
+	t.Parallel()
+
+	deleting := planInstance("c", "pool", "new", time.Hour)
+	deleting.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+
+	instances := []unikornv1.ComputeInstance{
+		planInstance("b", "removed", "new", time.Hour),
+		planInstance("a", "removed", "new", time.Hour),
+		deleting,
+	}
+
+	plans := util.PlanPools([]unikornv1.InstancePoolSpec{planPool("pool", 0, "new")}, instances)
+	require.Len(t, plans, 2)
+	require.Equal(t, util.PoolPlan{Name: "pool"}, plans[0])
+	require.Equal(t, util.PoolPlan{Name: "removed", Delete: []string{"a", "b"}}, plans[1])
+}
@@ -0,0 +1,45 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	unikornv1 "github.com/unikorn-cloud/compute/pkg/apis/unikorn/v1alpha1"
+)
+
+// PublicIPOverrides maps from server ID to whether a public IP should be allocated.
+type PublicIPOverrides map[string]bool
+
+// GetPublicIPOverrides returns any machines that have had a public IP attached or
+// detached via the API.  These apply to the machine only, replacements follow the
+// pool's policy.
+func GetPublicIPOverrides(cluster *unikornv1.ComputeCluster) PublicIPOverrides {
+	overrides := PublicIPOverrides{}
+
+	for i := range cluster.Spec.Machines {
+		if cluster.Spec.Machines[i].PublicIP != nil {
+			overrides[cluster.Spec.Machines[i].ID] = *cluster.Spec.Machines[i].PublicIP
+		}
+	}
+
+	return overrides
+}
+
+// PublicIPEnabled returns whether a pool allocates public IPs by default.
+func PublicIPEnabled(pool *unikornv1.ComputeClusterWorkloadPoolSpec) bool {
+	return pool.PublicIPAllocation != nil && pool.PublicIPAllocation.Enabled
+}
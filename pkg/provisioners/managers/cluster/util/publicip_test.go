@@ -0,0 +1,51 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	unikornv1 "github.com/unikorn-cloud/compute/pkg/apis/unikorn/v1alpha1"
+	"github.com/unikorn-cloud/compute/pkg/provisioners/managers/cluster/util"
+
+	"k8s.io/utils/ptr"
+)
+
+// TestPublicIPOverrides checks only machines with a public IP override are returned.
+func TestPublicIPOverrides(t *testing.T) {
+	t.Parallel()
+
+	cluster := &unikornv1.ComputeCluster{
+		Spec: unikornv1.ComputeClusterSpec{
+			Machines: []unikornv1.ComputeClusterMachineSpec{
+				{ID: "server-a", Pool: "default", PublicIP: ptr.To(true)},
+				{ID: "server-b", Pool: "default", PublicIP: ptr.To(false)},
+				{ID: "server-c", Pool: "default", FlavorID: ptr.To("flavor-c")},
+			},
+		},
+	}
+
+	expected := util.PublicIPOverrides{
+		"server-a": true,
+		"server-b": false,
+	}
+
+	require.Equal(t, expected, util.GetPublicIPOverrides(cluster))
+}
@@ -0,0 +1,105 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	unikornv1 "github.com/unikorn-cloud/compute/pkg/apis/unikorn/v1alpha1"
+	coreapi "github.com/unikorn-cloud/core/pkg/openapi"
+	regionapi "github.com/unikorn-cloud/region/pkg/openapi"
+
+	"k8s.io/utils/ptr"
+)
+
+// ResizeAction is the next step to take in an in place resize.
+type ResizeAction int
+
+const (
+	// ResizeWait waits for the server to stop, or the region to complete the resize.
+	ResizeWait ResizeAction = iota
+	// ResizeStop stops the server so it can be resized.
+	ResizeStop
+	// ResizeApply requests the region resizes the server.
+	ResizeApply
+	// ResizeStart starts the server again, completing the resize.
+	ResizeStart
+	// ResizeDone completes the resize leaving the server as it is.
+	ResizeDone
+)
+
+// PlanResize determines the next step of an in place resize, this spans multiple
+// reconciles so is driven by the server's observed state and the resize state that
+// was recorded last time.  Resizing requires the server to be stopped, and once the
+// region has completed the resize, the server is only started again if it was
+// running beforehand.  The returned state is nil once the resize is complete, and
+// the caller must only record it if the action was successful.
+func PlanResize(server *regionapi.ServerRead, flavorID string, state *unikornv1.MachineResizeStatus) (ResizeAction, *unikornv1.MachineResizeStatus) {
+	phase := ptr.Deref(server.Status.Phase, regionapi.InstanceLifecyclePhasePending)
+
+	switch {
+	case state == nil:
+		// A pending server is booting, so will be running shortly.
+		state = &unikornv1.MachineResizeStatus{
+			Phase:   unikornv1.MachineResizePhaseStopping,
+			Restart: phase == regionapi.InstanceLifecyclePhaseRunning || phase == regionapi.InstanceLifecyclePhasePending,
+		}
+	case state.FlavorID != flavorID:
+		// The flavor has changed part way through, so start again, but remember
+		// the power state from before the server was first stopped.
+		state = &unikornv1.MachineResizeStatus{
+			Phase:   unikornv1.MachineResizePhaseStopping,
+			Restart: state.Restart,
+		}
+	default:
+		state = state.DeepCopy()
+	}
+
+	state.FlavorID = flavorID
+
+	// Nothing to do if the server already has the flavor, other than wait for
+	// the region to settle.
+	if state.Phase == unikornv1.MachineResizePhaseStopping && server.Spec.FlavorId == flavorID {
+		state.Phase = unikornv1.MachineResizePhaseResizing
+	}
+
+	if state.Phase == unikornv1.MachineResizePhaseStopping {
+		//nolint:exhaustive
+		switch phase {
+		case regionapi.InstanceLifecyclePhaseStopped:
+			state.Phase = unikornv1.MachineResizePhaseResizing
+
+			return ResizeApply, state
+		case regionapi.InstanceLifecyclePhaseRunning:
+			return ResizeStop, state
+		}
+
+		return ResizeWait, state
+	}
+
+	resized := server.Spec.FlavorId == flavorID && server.Metadata.ProvisioningStatus == coreapi.ResourceProvisioningStatusProvisioned
+
+	switch {
+	case !resized:
+		return ResizeWait, state
+	case phase == regionapi.InstanceLifecyclePhaseStopped && state.Restart:
+		return ResizeStart, nil
+	case phase == regionapi.InstanceLifecyclePhaseStopped, phase == regionapi.InstanceLifecyclePhaseRunning:
+		return ResizeDone, nil
+	}
+
+	return ResizeWait, state
+}
@@ -0,0 +1,192 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	unikornv1 "github.com/unikorn-cloud/compute/pkg/apis/unikorn/v1alpha1"
+	"github.com/unikorn-cloud/compute/pkg/provisioners/managers/cluster/util"
+	coreapi "github.com/unikorn-cloud/core/pkg/openapi"
+	regionapi "github.com/unikorn-cloud/region/pkg/openapi"
+
+	"k8s.io/utils/ptr"
+)
+
+func resizeTestServer(flavorID string, status coreapi.ResourceProvisioningStatus, phase regionapi.InstanceLifecyclePhase) *regionapi.ServerRead {
+	return &regionapi.ServerRead{
+		Metadata: coreapi.ProjectScopedResourceReadMetadata{
+			ProvisioningStatus: status,
+		},
+		Spec: regionapi.ServerSpec{
+			FlavorId: flavorID,
+		},
+		Status: regionapi.ServerStatus{
+			Phase: ptr.To(phase),
+		},
+	}
+}
+
+// TestPlanResize checks each step of the resize state machine.
+func TestPlanResize(t *testing.T) {
+	t.Parallel()
+
+	const (
+		provisioned  = coreapi.ResourceProvisioningStatusProvisioned
+		provisioning = coreapi.ResourceProvisioningStatusProvisioning
+		pending      = regionapi.InstanceLifecyclePhasePending
+		running      = regionapi.InstanceLifecyclePhaseRunning
+		stopping     = regionapi.InstanceLifecyclePhaseStopping
+		stopped      = regionapi.InstanceLifecyclePhaseStopped
+	)
+
+	stoppingState := func(restart bool) *unikornv1.MachineResizeStatus {
+		return &unikornv1.MachineResizeStatus{
+			FlavorID: "new",
+			Phase:    unikornv1.MachineResizePhaseStopping,
+			Restart:  restart,
+		}
+	}
+
+	resizingState := func(restart bool) *unikornv1.MachineResizeStatus {
+		return &unikornv1.MachineResizeStatus{
+			FlavorID: "new",
+			Phase:    unikornv1.MachineResizePhaseResizing,
+			Restart:  restart,
+		}
+	}
+
+	tests := []struct {
+		name           string
+		server         *regionapi.ServerRead
+		state          *unikornv1.MachineResizeStatus
+		expectedAction util.ResizeAction
+		expectedState  *unikornv1.MachineResizeStatus
+	}{
+		{
+			name:           "RunningServerIsStopped",
+			server:         resizeTestServer("old", provisioned, running),
+			expectedAction: util.ResizeStop,
+			expectedState:  stoppingState(true),
+		},
+		{
+			name:           "BootingServerIsRestarted",
+			server:         resizeTestServer("old", provisioned, pending),
+			expectedAction: util.ResizeWait,
+			expectedState:  stoppingState(true),
+		},
+		{
+			name:           "StoppedServerIsResized",
+			server:         resizeTestServer("old", provisioned, stopped),
+			expectedAction: util.ResizeApply,
+			expectedState:  resizingState(false),
+		},
+		{
+			name:           "UserStoppingServerIsNotRestarted",
+			server:         resizeTestServer("old", provisioned, stopping),
+			expectedAction: util.ResizeWait,
+			expectedState:  stoppingState(false),
+		},
+		{
+			name:           "WaitForStop",
+			server:         resizeTestServer("old", provisioned, stopping),
+			state:          stoppingState(true),
+			expectedAction: util.ResizeWait,
+			expectedState:  stoppingState(true),
+		},
+		{
+			name:           "ResizeOnceStopped",
+			server:         resizeTestServer("old", provisioned, stopped),
+			state:          stoppingState(true),
+			expectedAction: util.ResizeApply,
+			expectedState:  resizingState(true),
+		},
+		{
+			name:           "WaitForRegionToAcceptResize",
+			server:         resizeTestServer("old", provisioned, stopped),
+			state:          resizingState(true),
+			expectedAction: util.ResizeWait,
+			expectedState:  resizingState(true),
+		},
+		{
+			name:           "WaitForRegionToCompleteResize",
+			server:         resizeTestServer("new", provisioning, stopped),
+			state:          resizingState(true),
+			expectedAction: util.ResizeWait,
+			expectedState:  resizingState(true),
+		},
+		{
+			name:           "RestartOnceResized",
+			server:         resizeTestServer("new", provisioned, stopped),
+			state:          resizingState(true),
+			expectedAction: util.ResizeStart,
+		},
+		{
+			name:           "LeaveStoppedOnceResized",
+			server:         resizeTestServer("new", provisioned, stopped),
+			state:          resizingState(false),
+			expectedAction: util.ResizeDone,
+		},
+		{
+			name:           "DoneIfAlreadyRunning",
+			server:         resizeTestServer("new", provisioned, running),
+			state:          resizingState(true),
+			expectedAction: util.ResizeDone,
+		},
+		{
+			name:   "FlavorChangeRestartsResize",
+			server: resizeTestServer("new", provisioned, stopped),
+			state: &unikornv1.MachineResizeStatus{
+				FlavorID: "newer",
+				Phase:    unikornv1.MachineResizePhaseResizing,
+				Restart:  true,
+			},
+			expectedAction: util.ResizeStart,
+		},
+		{
+			name:   "FlavorChangeRemembersPowerState",
+			server: resizeTestServer("newer", provisioned, stopped),
+			state: &unikornv1.MachineResizeStatus{
+				FlavorID: "newer",
+				Phase:    unikornv1.MachineResizePhaseResizing,
+				Restart:  true,
+			},
+			expectedAction: util.ResizeApply,
+			expectedState:  resizingState(true),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			var previous *unikornv1.MachineResizeStatus
+
+			if test.state != nil {
+				previous = test.state.DeepCopy()
+			}
+
+			action, state := util.PlanResize(test.server, "new", test.state)
+			require.Equal(t, test.expectedAction, action)
+			require.Equal(t, test.expectedState, state)
+			require.Equal(t, previous, test.state, "state must not be modified in place")
+		})
+	}
+}
@@ -0,0 +1,97 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	unikornv1 "github.com/unikorn-cloud/compute/pkg/apis/unikorn/v1alpha1"
+)
+
+// Rollout tracks a pool's rolling update across a single reconcile.  Each reconcile
+// is a batch: servers that can be disrupted without breaching the update strategy
+// are rebuilt or resized, anything else is deferred until replacements are available.
+// Pools without an update strategy are updated all at once.
+type Rollout struct {
+	// strategy is the pool's update strategy, may be nil.
+	strategy *unikornv1.WorkloadPoolUpdateStrategy
+	// replicas is the desired pool size.
+	replicas int
+	// budget is the number of available servers that may be disrupted.
+	budget int
+	// outdated is the number of servers awaiting a rebuild.
+	outdated int
+	// deferred records whether any updates were held back.
+	deferred bool
+}
+
+// NewRollout creates a rollout for a pool given the number of its servers that need
+// rebuilding.
+func NewRollout(pool *unikornv1.ComputeClusterWorkloadPoolSpec, outdated int) *Rollout {
+	return &Rollout{
+		strategy: pool.UpdateStrategy,
+		replicas: pool.Replicas,
+		outdated: outdated,
+	}
+}
+
+// SetAvailable sets the disruption budget from the number of servers that are
+// currently available, this must be called once any scaling is complete.
+func (r *Rollout) SetAvailable(available int) {
+	if r.strategy != nil {
+		r.budget = available - (r.replicas - r.strategy.MaxUnavailable)
+	}
+}
+
+// Target is the number of servers the pool should have, this includes any surge
+// servers that are created ahead of rebuilds.
+func (r *Rollout) Target() int {
+	if r.strategy == nil {
+		return r.replicas
+	}
+
+	return r.replicas + min(r.strategy.MaxSurge, r.outdated)
+}
+
+// Disrupt returns whether a server may be taken down for a rebuild or resize,
+// consuming the disruption budget if it is currently available.
+func (r *Rollout) Disrupt(available bool) bool {
+	if r.strategy == nil || !available {
+		return true
+	}
+
+	if r.budget <= 0 {
+		r.deferred = true
+
+		return false
+	}
+
+	r.budget--
+
+	return true
+}
+
+// Rebuilt records that an outdated server has been deleted for rebuild.
+func (r *Rollout) Rebuilt() {
+	if r.outdated > 0 {
+		r.outdated--
+	}
+}
+
+// InProgress returns whether the rollout needs another reconcile to complete.
+func (r *Rollout) InProgress() bool {
+	return r.strategy != nil && (r.deferred || r.outdated > 0)
+}
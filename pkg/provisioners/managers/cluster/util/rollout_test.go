@@ -0,0 +1,123 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	unikornv1 "github.com/unikorn-cloud/compute/pkg/apis/unikorn/v1alpha1"
+	"github.com/unikorn-cloud/compute/pkg/provisioners/managers/cluster/util"
+)
+
+func rolloutPool(replicas int, strategy *unikornv1.WorkloadPoolUpdateStrategy) *unikornv1.ComputeClusterWorkloadPoolSpec {
+	pool := &unikornv1.ComputeClusterWorkloadPoolSpec{
+		UpdateStrategy: strategy,
+	}
+
+	pool.Replicas = replicas
+
+	return pool
+}
+
+// TestRolloutNoStrategy checks pools without a strategy are updated all at once.
+func TestRolloutNoStrategy(t *testing.T) {
+	t.Parallel()
+
+	r := util.NewRollout(rolloutPool(3, nil), 3)
+	r.SetAvailable(3)
+
+	require.Equal(t, 3, r.Target())
+
+	for range 3 {
+		require.True(t, r.Disrupt(true))
+		r.Rebuilt()
+	}
+
+	require.False(t, r.InProgress())
+}
+
+// TestRolloutMaxUnavailable checks disruptions are limited by the budget, and
+// that servers that are already unavailable don't consume it.
+func TestRolloutMaxUnavailable(t *testing.T) {
+	t.Parallel()
+
+	strategy := &unikornv1.WorkloadPoolUpdateStrategy{
+		MaxUnavailable: 1,
+	}
+
+	r := util.NewRollout(rolloutPool(3, strategy), 3)
+	r.SetAvailable(3)
+
+	require.Equal(t, 3, r.Target())
+	require.True(t, r.Disrupt(true))
+	r.Rebuilt()
+	require.False(t, r.Disrupt(true))
+	require.True(t, r.Disrupt(false))
+	r.Rebuilt()
+	require.True(t, r.InProgress())
+
+	// A replacement is still coming up, so nothing may be disrupted.
+	r = util.NewRollout(rolloutPool(3, strategy), 1)
+	r.SetAvailable(2)
+
+	require.False(t, r.Disrupt(true))
+	require.True(t, r.InProgress())
+
+	// All done.
+	r = util.NewRollout(rolloutPool(3, strategy), 0)
+	r.SetAvailable(3)
+
+	require.False(t, r.InProgress())
+}
+
+// TestRolloutMaxSurge checks surge servers are created ahead of rebuilds, and
+// outdated servers are only deleted once they are available.
+func TestRolloutMaxSurge(t *testing.T) {
+	t.Parallel()
+
+	strategy := &unikornv1.WorkloadPoolUpdateStrategy{
+		MaxSurge: 1,
+	}
+
+	// Surge server requested, nothing can be disrupted yet.
+	r := util.NewRollout(rolloutPool(3, strategy), 3)
+	r.SetAvailable(3)
+
+	require.Equal(t, 4, r.Target())
+	require.False(t, r.Disrupt(true))
+
+	// Surge server is available, so one outdated server can go and be replaced.
+	r = util.NewRollout(rolloutPool(3, strategy), 3)
+	r.SetAvailable(4)
+
+	require.True(t, r.Disrupt(true))
+	r.Rebuilt()
+	require.False(t, r.Disrupt(true))
+	require.Equal(t, 4, r.Target())
+
+	// Last outdated server replaced, the surge is no longer required.
+	r = util.NewRollout(rolloutPool(3, strategy), 1)
+	r.SetAvailable(4)
+
+	require.True(t, r.Disrupt(true))
+	r.Rebuilt()
+	require.Equal(t, 3, r.Target())
+	require.False(t, r.InProgress())
+}
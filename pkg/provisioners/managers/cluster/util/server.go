@@ -23,6 +23,7 @@ import (
 	"strings"
 
 	unikornv1 "github.com/unikorn-cloud/compute/pkg/apis/unikorn/v1alpha1"
+	"github.com/unikorn-cloud/compute/pkg/constants"
 	unikornv1core "github.com/unikorn-cloud/core/pkg/apis/unikorn/v1alpha1"
 	coreconstants "github.com/unikorn-cloud/core/pkg/constants"
 	"github.com/unikorn-cloud/core/pkg/errors"
@@ -31,11 +32,13 @@ import (
 	regionapi "github.com/unikorn-cloud/region/pkg/openapi"
 
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 )
 
 const (
 	// WorkloadPoolLabel is the label key for the workload pool.
-	WorkloadPoolLabel = "unikorn-cloud.org/workloadpool"
+	WorkloadPoolLabel = constants.PlatformTagPrefix + "workloadpool"
 )
 
 // ClusterTagSelector allows us to select only servers for a specific cluster.
@@ -64,6 +67,12 @@ func GetWorkloadPoolTag(tags *coreapi.TagList) (string, error) {
 		return "", fmt.Errorf("%w: workload pool tag missing", errors.ErrConsistency)
 	}
 
+	// The tag is reserved, so there should only ever be one, if not we cannot trust
+	// which pool the server belongs to.
+	if slices.IndexFunc(t[index+1:], isWorkloadPoolTag) >= 0 {
+		return "", fmt.Errorf("%w: workload pool tag is ambiguous", errors.ErrConsistency)
+	}
+
 	return t[index].Value, nil
 }
 
@@ -118,6 +127,28 @@ func ConvertHealthStatusCondition(in coreapi.ResourceHealthStatus) (corev1.Condi
 	return corev1.ConditionFalse, unikornv1core.ConditionReasonUnknown, "health unknown"
 }
 
+// serverLabels returns the user visible tags of a server.
+func serverLabels(tags *coreapi.TagList) unikornv1core.TagList {
+	if tags == nil {
+		return nil
+	}
+
+	var out unikornv1core.TagList
+
+	for _, tag := range *tags {
+		if constants.IsSystemTag(tag.Name) {
+			continue
+		}
+
+		out = append(out, unikornv1core.Tag{
+			Name:  tag.Name,
+			Value: tag.Value,
+		})
+	}
+
+	return out
+}
+
 // UpdateServerStatus adds a server to the cluster's status.
 // The boolean returned indicates whether the service is successfully provisioned or not.
 func UpdateServerStatus(cluster *unikornv1.ComputeCluster, server *regionapi.ServerRead) error {
@@ -137,6 +168,12 @@ func UpdateServerStatus(cluster *unikornv1.ComputeCluster, server *regionapi.Ser
 		PrivateIP: server.Status.PrivateIP,
 		PublicIP:  server.Status.PublicIP,
 		Status:    convertMachineStatusStatus(server.Status.Phase),
+		Cordoned:  IsCordoned(cluster, server.Metadata.Id),
+		Labels:    serverLabels(server.Metadata.Tags),
+	}
+
+	if !server.Metadata.CreationTime.IsZero() {
+		status.CreationTime = ptr.To(metav1.NewTime(server.Metadata.CreationTime))
 	}
 
 	provisioningStatus, provisioningReason, provisioningMessage := ConvertProvisioningStatusCondition(server.Metadata.ProvisioningStatus)
@@ -0,0 +1,56 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	unikornv1 "github.com/unikorn-cloud/compute/pkg/apis/unikorn/v1alpha1"
+	"github.com/unikorn-cloud/compute/pkg/provisioners/managers/cluster/util"
+	unikornv1core "github.com/unikorn-cloud/core/pkg/apis/unikorn/v1alpha1"
+	coreconstants "github.com/unikorn-cloud/core/pkg/constants"
+	coreapi "github.com/unikorn-cloud/core/pkg/openapi"
+	regionapi "github.com/unikorn-cloud/region/pkg/openapi"
+)
+
+// TestUpdateServerStatusLabels checks machine labels reflect the server's tags,
+// without those used by the platform to identify it.
+func TestUpdateServerStatusLabels(t *testing.T) {
+	t.Parallel()
+
+	server := &regionapi.ServerRead{
+		Metadata: coreapi.ProjectScopedResourceReadMetadata{
+			Id:   "a",
+			Name: "machine",
+			Tags: &coreapi.TagList{
+				{Name: coreconstants.ComputeClusterLabel, Value: "cluster"},
+				{Name: util.WorkloadPoolLabel, Value: "pool"},
+				{Name: "cost-centre", Value: "research"},
+			},
+		},
+	}
+
+	cluster := &unikornv1.ComputeCluster{}
+
+	require.NoError(t, util.UpdateServerStatus(cluster, server))
+	require.Len(t, cluster.Status.WorkloadPools, 1)
+	require.Len(t, cluster.Status.WorkloadPools[0].Machines, 1)
+	require.Equal(t, unikornv1core.TagList{{Name: "cost-centre", Value: "research"}}, cluster.Status.WorkloadPools[0].Machines[0].Labels)
+}
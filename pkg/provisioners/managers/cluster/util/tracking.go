@@ -0,0 +1,85 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"maps"
+	"slices"
+	"time"
+
+	unikornv1 "github.com/unikorn-cloud/compute/pkg/apis/unikorn/v1alpha1"
+)
+
+// MachineTracking maps from server ID to what the controller needs to remember
+// about it between reconciles.
+type MachineTracking map[string]*unikornv1.MachineTrackingStatus
+
+// GetMachineTracking returns a copy of the cluster's machine tracking status that
+// can be freely modified during reconciliation.
+func GetMachineTracking(cluster *unikornv1.ComputeCluster) MachineTracking {
+	tracking := MachineTracking{}
+
+	for i := range cluster.Status.MachineTracking {
+		tracking[cluster.Status.MachineTracking[i].ID] = cluster.Status.MachineTracking[i].DeepCopy()
+	}
+
+	return tracking
+}
+
+// SetMachineTracking records machine tracking in the cluster's status in a stable
+// order, omitting any machines with nothing left to remember.
+func SetMachineTracking(cluster *unikornv1.ComputeCluster, tracking MachineTracking) {
+	var out []unikornv1.MachineTrackingStatus
+
+	for _, serverID := range slices.Sorted(maps.Keys(tracking)) {
+		t := tracking[serverID]
+
+		if t.FlavorID == nil && t.UnhealthySince == nil && t.TransitioningSince == nil && t.Resize == nil {
+			continue
+		}
+
+		t.ID = serverID
+
+		out = append(out, *t)
+	}
+
+	cluster.Status.MachineTracking = out
+}
+
+// Get returns the tracking for a server, creating it if it doesn't exist.
+func (t MachineTracking) Get(serverID string) *unikornv1.MachineTrackingStatus {
+	if _, ok := t[serverID]; !ok {
+		t[serverID] = &unikornv1.MachineTrackingStatus{
+			ID: serverID,
+		}
+	}
+
+	return t[serverID]
+}
+
+// TransitionExpired tells us whether the server has been transitioning for longer
+// than the grace period, at which point it's considered stuck.  A zero grace period
+// never expires.
+func (t MachineTracking) TransitionExpired(serverID string, grace time.Duration, now time.Time) bool {
+	tracking, ok := t[serverID]
+	if !ok || tracking.TransitioningSince == nil || grace == 0 {
+		return false
+	}
+
+	return now.Sub(tracking.TransitioningSince.Time) >= grace
+}
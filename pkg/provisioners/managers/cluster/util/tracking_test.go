@@ -0,0 +1,90 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	unikornv1 "github.com/unikorn-cloud/compute/pkg/apis/unikorn/v1alpha1"
+	"github.com/unikorn-cloud/compute/pkg/provisioners/managers/cluster/util"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+// TestMachineTrackingRoundTrip checks tracking survives being recorded in the status,
+// is stored in a stable order, and that empty records are dropped.
+func TestMachineTrackingRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	since := metav1.NewTime(time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC))
+
+	cluster := &unikornv1.ComputeCluster{}
+
+	tracking := util.GetMachineTracking(cluster)
+	require.Empty(t, tracking)
+
+	tracking.Get("server-b").UnhealthySince = &since
+	tracking.Get("server-a").FlavorID = ptr.To("flavor-a")
+	tracking.Get("server-c")
+
+	util.SetMachineTracking(cluster, tracking)
+
+	expected := []unikornv1.MachineTrackingStatus{
+		{
+			ID:       "server-a",
+			FlavorID: ptr.To("flavor-a"),
+		},
+		{
+			ID:             "server-b",
+			UnhealthySince: &since,
+		},
+	}
+
+	require.Equal(t, expected, cluster.Status.MachineTracking)
+
+	// Modifications must not leak into the status until they are recorded.
+	decoded := util.GetMachineTracking(cluster)
+	decoded.Get("server-a").FlavorID = nil
+
+	require.Equal(t, expected, cluster.Status.MachineTracking)
+
+	util.SetMachineTracking(cluster, decoded)
+	require.Equal(t, expected[1:], cluster.Status.MachineTracking)
+}
+
+// TestMachineTrackingTransitionExpired checks transitioning servers only expire once
+// the grace period has elapsed.
+func TestMachineTrackingTransitionExpired(t *testing.T) {
+	t.Parallel()
+
+	since := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+
+	tracking := util.MachineTracking{}
+	tracking.Get("server-a").TransitioningSince = ptr.To(metav1.NewTime(since))
+	tracking.Get("server-c")
+
+	require.False(t, tracking.TransitionExpired("server-a", time.Hour, since.Add(59*time.Minute)))
+	require.True(t, tracking.TransitionExpired("server-a", time.Hour, since.Add(time.Hour)))
+	require.False(t, tracking.TransitionExpired("server-a", 0, since.Add(24*time.Hour)))
+	require.False(t, tracking.TransitionExpired("server-b", time.Hour, since.Add(24*time.Hour)))
+	require.False(t, tracking.TransitionExpired("server-c", time.Hour, since.Add(24*time.Hour)))
+}
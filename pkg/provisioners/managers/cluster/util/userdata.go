@@ -0,0 +1,72 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"text/template"
+)
+
+var (
+	// ErrUserDataTemplate is raised when user data cannot be expanded.
+	ErrUserDataTemplate = errors.New("user data template error")
+)
+
+// UserDataVariables are made available to user data templates.
+type UserDataVariables struct {
+	// ClusterID is the cluster's unique identifier.
+	ClusterID string
+	// ClusterName is the cluster's user provided name.
+	ClusterName string
+	// PoolName is the name of the workload pool the machine belongs to.
+	PoolName string
+	// MachineName is the name of the machine, and its host name.
+	MachineName string
+	// FQDN is the fully qualified domain name of the machine, or its host name
+	// if the pool doesn't define a domain.
+	FQDN string
+	// PrivateNetworkCIDR is the prefix of the cluster's private network.
+	PrivateNetworkCIDR string
+}
+
+// ValidateUserDataTemplate checks user data can be expanded, so errors can be
+// reported to the user before anything is provisioned.
+func ValidateUserDataTemplate(userData []byte) error {
+	_, err := ExpandUserData(userData, &UserDataVariables{})
+
+	return err
+}
+
+// ExpandUserData expands user data as a Go template with the provided variables.
+// Referencing an undefined variable is an error.
+func ExpandUserData(userData []byte, variables *UserDataVariables) ([]byte, error) {
+	tmpl, err := template.New("userData").Option("missingkey=error").Parse(string(userData))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrUserDataTemplate, err)
+	}
+
+	var buf bytes.Buffer
+
+	if err := tmpl.Execute(&buf, variables); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrUserDataTemplate, err)
+	}
+
+	return buf.Bytes(), nil
+}
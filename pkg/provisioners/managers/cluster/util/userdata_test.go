@@ -0,0 +1,49 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/unikorn-cloud/compute/pkg/provisioners/managers/cluster/util"
+)
+
+// TestExpandUserData ensures variables are substituted and bad templates rejected.
+func TestExpandUserData(t *testing.T) {
+	t.Parallel()
+
+	variables := &util.UserDataVariables{
+		ClusterID:          "c-1",
+		ClusterName:        "prod",
+		PoolName:           "gpu",
+		MachineName:        "gpu-abcde",
+		PrivateNetworkCIDR: "192.168.0.0/24",
+	}
+
+	out, err := util.ExpandUserData([]byte("#!/bin/sh\necho {{ .ClusterName }}/{{ .PoolName }}/{{ .MachineName }} {{ .PrivateNetworkCIDR }}\n"), variables)
+	require.NoError(t, err)
+	require.Equal(t, "#!/bin/sh\necho prod/gpu/gpu-abcde 192.168.0.0/24\n", string(out))
+
+	_, err = util.ExpandUserData([]byte("{{ .Missing }}"), variables)
+	require.ErrorIs(t, err, util.ErrUserDataTemplate)
+
+	require.ErrorIs(t, util.ValidateUserDataTemplate([]byte("{{ .PoolName")), util.ErrUserDataTemplate)
+	require.NoError(t, util.ValidateUserDataTemplate([]byte("{{ .PoolName }}")))
+}
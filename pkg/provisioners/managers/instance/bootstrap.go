@@ -0,0 +1,134 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instance
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+
+	unikornv1 "github.com/unikorn-cloud/compute/pkg/apis/unikorn/v1alpha1"
+	"github.com/unikorn-cloud/compute/pkg/provisioners/managers/cluster/util"
+	coreclient "github.com/unikorn-cloud/core/pkg/client"
+	coreconstants "github.com/unikorn-cloud/core/pkg/constants"
+	servererrors "github.com/unikorn-cloud/core/pkg/server/errors"
+	regionconstants "github.com/unikorn-cloud/region/pkg/constants"
+	regionapi "github.com/unikorn-cloud/region/pkg/openapi"
+
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// getFlavor looks up the instance's flavor.
+func (p *Provisioner) getFlavor(ctx context.Context, client regionapi.ClientWithResponsesInterface) (*regionapi.Flavor, error) {
+	response, err := client.GetApiV1OrganizationsOrganizationIDRegionsRegionIDFlavorsWithResponse(ctx, p.instance.Labels[coreconstants.OrganizationLabel], p.instance.Labels[regionconstants.RegionLabel])
+	if err != nil {
+		return nil, err
+	}
+
+	if response.StatusCode() != http.StatusOK {
+		return nil, servererrors.PropagateError(response.HTTPResponse, response)
+	}
+
+	flavors := *response.JSON200
+
+	index := slices.IndexFunc(flavors, func(flavor regionapi.Flavor) bool {
+		return flavor.Metadata.Id == p.instance.Spec.FlavorID
+	})
+
+	if index < 0 {
+		return nil, fmt.Errorf("%w: flavor %s not found", util.ErrBootstrap, p.instance.Spec.FlavorID)
+	}
+
+	return &flavors[index], nil
+}
+
+// getImage looks up the instance's image, which may be owned by the organization.
+func (p *Provisioner) getImage(ctx context.Context, client regionapi.ClientWithResponsesInterface) (*regionapi.Image, error) {
+	params := &regionapi.GetApiV2RegionsRegionIDImagesParams{
+		OrganizationID: &regionapi.OrganizationIDQueryParameter{
+			p.instance.Labels[coreconstants.OrganizationLabel],
+		},
+	}
+
+	response, err := client.GetApiV2RegionsRegionIDImagesWithResponse(ctx, p.instance.Labels[regionconstants.RegionLabel], params)
+	if err != nil {
+		return nil, err
+	}
+
+	if response.StatusCode() != http.StatusOK {
+		return nil, servererrors.PropagateError(response.HTTPResponse, response)
+	}
+
+	images := *response.JSON200
+
+	index := slices.IndexFunc(images, func(image regionapi.Image) bool {
+		return image.Metadata.Id == p.instance.Spec.ImageID
+	})
+
+	if index < 0 {
+		return nil, fmt.Errorf("%w: image %s not found", util.ErrBootstrap, p.instance.Spec.ImageID)
+	}
+
+	return &images[index], nil
+}
+
+// renderUserData generates the instance's user data.  When a bootstrap profile is
+// requested its user data runs before the instance's own.
+func (p *Provisioner) renderUserData(ctx context.Context, client regionapi.ClientWithResponsesInterface) ([]byte, error) {
+	if p.instance.Spec.BootstrapProfile == "" {
+		return p.instance.Spec.UserData, nil
+	}
+
+	cli, err := coreclient.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	profile := &unikornv1.ComputeBootstrapProfile{}
+
+	if err := cli.Get(ctx, types.NamespacedName{Namespace: p.instance.Namespace, Name: p.instance.Spec.BootstrapProfile}, profile); err != nil {
+		return nil, fmt.Errorf("%w: unable to get profile %s: %w", util.ErrBootstrap, p.instance.Spec.BootstrapProfile, err)
+	}
+
+	flavor, err := p.getFlavor(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+
+	image, err := p.getImage(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+
+	bootstrap, err := util.RenderBootstrap(profile, util.NewBootstrapVariables(flavor, image))
+	if err != nil {
+		return nil, err
+	}
+
+	userData, ok, err := util.CombineUserData(bootstrap, p.instance.Spec.UserData)
+	if err != nil {
+		return nil, err
+	}
+
+	if !ok {
+		return nil, fmt.Errorf("%w: user data cannot be combined with bootstrap profile", util.ErrBootstrap)
+	}
+
+	return userData, nil
+}
@@ -20,12 +20,18 @@ package instance
 import (
 	"context"
 	"reflect"
+	"time"
 
 	"github.com/spf13/pflag"
 
 	unikornv1 "github.com/unikorn-cloud/compute/pkg/apis/unikorn/v1alpha1"
 	"github.com/unikorn-cloud/compute/pkg/constants"
+	"github.com/unikorn-cloud/compute/pkg/health"
+	"github.com/unikorn-cloud/compute/pkg/metrics"
+	"github.com/unikorn-cloud/compute/pkg/provisioners/clientcache"
 	"github.com/unikorn-cloud/compute/pkg/provisioners/managers/cluster/util"
+	"github.com/unikorn-cloud/compute/pkg/provisioners/notifications"
+	"github.com/unikorn-cloud/compute/pkg/tracing"
 	unikornv1core "github.com/unikorn-cloud/core/pkg/apis/unikorn/v1alpha1"
 	coreclient "github.com/unikorn-cloud/core/pkg/client"
 	coreconstants "github.com/unikorn-cloud/core/pkg/constants"
@@ -40,6 +46,8 @@ import (
 	regionapi "github.com/unikorn-cloud/region/pkg/openapi"
 
 	"k8s.io/utils/ptr"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 // Options allows access to CLI options in the provisioner.
@@ -53,6 +61,17 @@ type Options struct {
 	// to ensure cloud identities and networks are provisioned, as well
 	// as deptovisioning them.
 	clientOptions coreclient.HTTPClientOptions
+	// clientCacheOptions control how long HTTP clients and signed principals
+	// are reused for.
+	clientCacheOptions clientcache.Options
+	// httpClients are shared between reconciles.
+	httpClients *clientcache.HTTPClients
+	// principals are shared between reconciles.
+	principals *clientcache.Principals
+	// notificationOptions control webhook event delivery.
+	notificationOptions notifications.Options
+	// notifier delivers lifecycle events to webhooks.
+	notifier *notifications.Notifier
 }
 
 func (o *Options) AddFlags(f *pflag.FlagSet) {
@@ -67,6 +86,18 @@ func (o *Options) AddFlags(f *pflag.FlagSet) {
 	o.identityOptions.AddFlags(f)
 	o.regionOptions.AddFlags(f)
 	o.clientOptions.AddFlags(f)
+	o.clientCacheOptions.AddFlags(f)
+	o.notificationOptions.AddFlags(f)
+
+	o.httpClients = clientcache.NewHTTPClients(&o.clientCacheOptions)
+	o.principals = clientcache.NewPrincipals(&o.clientCacheOptions)
+	o.notifier = notifications.New(&o.notificationOptions)
+}
+
+// AddReadinessChecks adds checks for the services the provisioner depends on.
+func (o *Options) AddReadinessChecks(checker *health.Checker, cli client.Client) {
+	checker.AddReadinessCheck("identity", health.IdentityCheck(cli, o.identityOptions, &o.clientOptions))
+	checker.AddReadinessCheck("region", health.RegionCheck(cli, o.regionOptions, &o.clientOptions))
 }
 
 // Provisioner encapsulates control plane provisioning.
@@ -78,6 +109,9 @@ type Provisioner struct {
 
 	// options are documented for the type.
 	options *Options
+
+	// userData is the rendered user data, including any bootstrap profile.
+	userData []byte
 }
 
 // New returns a new initialized provisioner object.
@@ -102,7 +136,9 @@ func (p *Provisioner) identityClient(ctx context.Context) (identityapi.ClientWit
 		return nil, err
 	}
 
-	return identityclient.New(client, p.options.identityOptions, &p.options.clientOptions).ControllerClient(ctx, &p.instance)
+	builder := tracing.NewBuilder[identityapi.ClientWithResponses](identityapi.NewBuilder(), "identity")
+
+	return identityclient.ControllerClient(ctx, identityclient.NewBaseClient(client, p.options.identityOptions, &p.options.clientOptions), builder, &p.instance)
 }
 
 func (p *Provisioner) generateServerNetworking() *regionapi.ServerV2Networking {
@@ -122,6 +158,8 @@ func (p *Provisioner) generateServerNetworking() *regionapi.ServerV2Networking {
 		out.PublicIP = &in.PublicIP
 	}
 
+	// The region applies these as allowed address pairs on the server's port,
+	// so they are owned by, and deleted with, the server.
 	if len(in.AllowedSourceAddresses) > 0 {
 		temp := make([]string, len(in.AllowedSourceAddresses))
 
@@ -140,11 +178,11 @@ func (p *Provisioner) generateServerNetworking() *regionapi.ServerV2Networking {
 }
 
 func (p *Provisioner) generateUserData() *[]byte {
-	if len(p.instance.Spec.UserData) == 0 {
+	if len(p.userData) == 0 {
 		return nil
 	}
 
-	return &p.instance.Spec.UserData
+	return &p.userData
 }
 
 func (p *Provisioner) generateServerCreateRequest() *regionapi.ServerV2Create {
@@ -211,6 +249,17 @@ func (p *Provisioner) createOrUpdateServer(ctx context.Context, region regionapi
 		return p.createServer(ctx, region, p.generateServerCreateRequest())
 	}
 
+	// Servers cannot be moved between networks, so must be rebuilt.
+	if server.Status.NetworkId != "" && server.Status.NetworkId != p.instance.Labels[regionconstants.NetworkLabel] {
+		metrics.ServerOperations.WithLabelValues("instance", "rebuild").Inc()
+
+		if err := p.deleteServer(ctx, region, server.Metadata.Id); err != nil {
+			return nil, err
+		}
+
+		return nil, provisioners.ErrYield
+	}
+
 	request := p.generateServerUpdateRequest()
 
 	if reflect.DeepEqual(server.Spec, request.Spec) {
@@ -218,6 +267,8 @@ func (p *Provisioner) createOrUpdateServer(ctx context.Context, region regionapi
 	}
 
 	if needsRebuild(&server.Spec, &request.Spec) {
+		metrics.ServerOperations.WithLabelValues("instance", "rebuild").Inc()
+
 		if err := p.deleteServer(ctx, region, server.Metadata.Id); err != nil {
 			return nil, provisioners.ErrYield
 		}
@@ -245,7 +296,18 @@ func convertPowerState(in *regionapi.InstanceLifecyclePhase) *regionv1.InstanceL
 }
 
 // Provision implements the Provision interface.
-func (p *Provisioner) Provision(ctx context.Context) error {
+func (p *Provisioner) Provision(ctx context.Context) (err error) {
+	start := time.Now()
+
+	ctx, span := tracing.StartReconcile(ctx, "instance", "provision", &p.instance)
+	defer func() {
+		tracing.End(span, err)
+	}()
+
+	defer func() {
+		metrics.ObserveReconcile("instance", start, err)
+	}()
+
 	region, err := p.getRegionClient(ctx)
 	if err != nil {
 		return err
@@ -256,6 +318,11 @@ func (p *Provisioner) Provision(ctx context.Context) error {
 		return err
 	}
 
+	p.userData, err = p.renderUserData(ctx, region)
+	if err != nil {
+		return err
+	}
+
 	server, err = p.createOrUpdateServer(ctx, region, server)
 	if err != nil {
 		return err
@@ -276,7 +343,12 @@ func (p *Provisioner) Provision(ctx context.Context) error {
 }
 
 // Deprovision implements the Provision interface.
-func (p *Provisioner) Deprovision(ctx context.Context) error {
+func (p *Provisioner) Deprovision(ctx context.Context) (err error) {
+	ctx, span := tracing.StartReconcile(ctx, "instance", "deprovision", &p.instance)
+	defer func() {
+		tracing.End(span, err)
+	}()
+
 	region, err := p.getRegionClient(ctx)
 	if err != nil {
 		return err
@@ -309,5 +381,7 @@ func (p *Provisioner) Deprovision(ctx context.Context) error {
 		return err
 	}
 
+	p.options.notifier.Notify(ctx, notifications.NewEvent(unikornv1.WebhookEventInstanceDeleted, "instance", &p.instance, nil))
+
 	return nil
 }
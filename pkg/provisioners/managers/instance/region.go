@@ -22,25 +22,29 @@ import (
 	"net/http"
 
 	"github.com/unikorn-cloud/compute/pkg/constants"
+	"github.com/unikorn-cloud/compute/pkg/metrics"
+	"github.com/unikorn-cloud/compute/pkg/provisioners/clientcache"
+	"github.com/unikorn-cloud/compute/pkg/tracing"
 	coreclient "github.com/unikorn-cloud/core/pkg/client"
 	coreconstants "github.com/unikorn-cloud/core/pkg/constants"
 	coreapi "github.com/unikorn-cloud/core/pkg/openapi"
 	servererrors "github.com/unikorn-cloud/core/pkg/server/errors"
-	regionclient "github.com/unikorn-cloud/region/pkg/client"
 	regionconstants "github.com/unikorn-cloud/region/pkg/constants"
 	regionapi "github.com/unikorn-cloud/region/pkg/openapi"
 )
 
-// getRegionClient returns an authenticated client.
-// TODO: the client should be cached for an appropriate period to avoid polluting the
-// caches in identity with new tokens during busy periods.
+// getRegionClient returns an authenticated client.  The underlying HTTP client is
+// shared between reconciles to avoid a new connection pool and TLS handshakes each time,
+// and signed principals are shared to avoid signing every request.
 func (p *Provisioner) getRegionClient(ctx context.Context) (regionapi.ClientWithResponsesInterface, error) {
 	cli, err := coreclient.FromContext(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	client, err := regionclient.New(cli, p.options.regionOptions, &p.options.clientOptions).ControllerClient(ctx, &p.instance)
+	builder := tracing.NewBuilder[regionapi.ClientWithResponses](metrics.NewBuilder[regionapi.ClientWithResponses](regionapi.NewBuilder(), "region"), "region")
+
+	client, err := clientcache.ControllerClient(ctx, p.options.httpClients, p.options.principals, cli, p.options.regionOptions, &p.options.clientOptions, builder, &p.instance)
 	if err != nil {
 		return nil, err
 	}
@@ -48,7 +52,9 @@ func (p *Provisioner) getRegionClient(ctx context.Context) (regionapi.ClientWith
 	return client, nil
 }
 
-// getServer lists all servers that are part of this cluster.
+// getServer looks up the instance's server.  This is not constrained to the
+// instance's network, as it may have been migrated, and the server will need
+// to be rebuilt on the new network.
 func (p *Provisioner) getServer(ctx context.Context, client regionapi.ClientWithResponsesInterface) (*regionapi.ServerV2Response, error) {
 	params := &regionapi.GetApiV2ServersParams{
 		OrganizationID: &regionapi.OrganizationIDQueryParameter{
@@ -60,9 +66,6 @@ func (p *Provisioner) getServer(ctx context.Context, client regionapi.ClientWith
 		RegionID: &regionapi.RegionIDQueryParameter{
 			p.instance.Labels[regionconstants.RegionLabel],
 		},
-		NetworkID: &regionapi.NetworkIDQueryParameter{
-			p.instance.Labels[regionconstants.NetworkLabel],
-		},
 		Tag: &coreapi.TagSelectorParameter{
 			constants.InstanceLabel + "=" + p.instance.Name,
 		},
@@ -98,6 +101,8 @@ func (p *Provisioner) createServer(ctx context.Context, client regionapi.ClientW
 		return nil, servererrors.PropagateError(resp.HTTPResponse, resp)
 	}
 
+	metrics.ServerOperations.WithLabelValues("instance", "create").Inc()
+
 	return resp.JSON201, nil
 }
 
@@ -131,6 +136,8 @@ func (p *Provisioner) deleteServer(ctx context.Context, client regionapi.ClientW
 		return servererrors.PropagateError(resp.HTTPResponse, resp)
 	}
 
+	metrics.ServerOperations.WithLabelValues("instance", "delete").Inc()
+
 	// TODO: add to the status in a deprovisioning state.
 	return nil
 }
@@ -0,0 +1,38 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notifications
+
+import (
+	"net/http"
+)
+
+// NewInsecure returns a notifier that will deliver to any destination, so
+// tests can use a local server.
+func NewInsecure(options *Options) *Notifier {
+	n := New(options)
+	n.client = &http.Client{}
+	n.validateURL = func(string) error { return nil }
+
+	return n
+}
+
+//nolint:gochecknoglobals
+var DialControl = dialControl
+
+//nolint:gochecknoglobals
+var CheckRedirect = checkRedirect
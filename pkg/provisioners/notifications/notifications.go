@@ -0,0 +1,566 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package notifications delivers resource lifecycle events to organization
+// registered webhooks.  Events are queued in the webhook's status, so they survive
+// controller restarts, and a controller delivers them.  Events are signed with the
+// webhook's secret so receivers can verify their origin, delivery is retried with
+// exponential backoff, and events that cannot be delivered are dead lettered in the
+// webhook's status.
+package notifications
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/spf13/pflag"
+
+	unikornv1 "github.com/unikorn-cloud/compute/pkg/apis/unikorn/v1alpha1"
+	coreclient "github.com/unikorn-cloud/core/pkg/client"
+	coreconstants "github.com/unikorn-cloud/core/pkg/constants"
+	"github.com/unikorn-cloud/core/pkg/util"
+
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/util/retry"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+var (
+	// ErrDelivery is raised when a receiver rejects an event.
+	ErrDelivery = errors.New("event delivery failed")
+
+	// ErrDestination is raised when a webhook's URL, or anything it resolves
+	// or redirects to, is not allowed.
+	ErrDestination = errors.New("webhook destination not allowed")
+)
+
+const (
+	// SecretKey is the key in the webhook's secret that holds the signing key.
+	SecretKey = "secret"
+
+	// EventHeader identifies the event type.
+	EventHeader = "X-Compute-Event"
+
+	// DeliveryHeader identifies the event, and is constant across retries
+	// so receivers can deduplicate.
+	DeliveryHeader = "X-Compute-Delivery"
+
+	// SignatureHeader is the hex encoded HMAC-SHA256 of the body, keyed with
+	// the webhook's secret, and prefixed with "sha256=".
+	SignatureHeader = "X-Compute-Signature"
+
+	// maxPendingDeliveries bounds the events queued per webhook.
+	maxPendingDeliveries = 100
+
+	// maxFailedDeliveries bounds the dead letters recorded per webhook.
+	maxFailedDeliveries = 10
+
+	// maxRedirects bounds how many redirects a receiver may issue.
+	maxRedirects = 5
+)
+
+// Options allows delivery to be configured.
+type Options struct {
+	// Attempts is the number of times delivery is tried before the event
+	// is dead lettered.
+	Attempts int
+	// Backoff is the delay before the first retry, this doubles for each
+	// subsequent retry.
+	Backoff time.Duration
+	// Timeout bounds how long a receiver may take to accept an event.
+	Timeout time.Duration
+}
+
+func (o *Options) AddFlags(f *pflag.FlagSet) {
+	f.IntVar(&o.Attempts, "webhook-delivery-attempts", 5, "Number of times to try delivering an event to a webhook before dead lettering it")
+	f.DurationVar(&o.Backoff, "webhook-delivery-backoff", 2*time.Second, "Delay before retrying webhook delivery, doubled on each subsequent retry")
+	f.DurationVar(&o.Timeout, "webhook-delivery-timeout", 10*time.Second, "How long to wait for a webhook to accept an event")
+}
+
+// Resource identifies the resource an event relates to.
+type Resource struct {
+	Type string `json:"type"`
+	ID   string `json:"id"`
+}
+
+// Event is posted to webhooks.
+type Event struct {
+	// ID uniquely identifies the event.
+	ID string `json:"id"`
+	// Type is the type of event.
+	Type unikornv1.WebhookEventType `json:"type"`
+	// Time is when the event occurred.
+	Time time.Time `json:"time"`
+	// OrganizationID is the organization the resource belongs to.
+	OrganizationID string `json:"organizationId"`
+	// ProjectID is the project the resource belongs to.
+	ProjectID string `json:"projectId,omitempty"`
+	// Resource is the resource the event relates to.
+	Resource Resource `json:"resource"`
+	// Data is event specific information.
+	Data map[string]any `json:"data,omitempty"`
+}
+
+// NewEvent creates a new event for a resource, ownership is derived from the
+// resource's labels.
+func NewEvent(eventType unikornv1.WebhookEventType, resourceType string, resource metav1.Object, data map[string]any) *Event {
+	labels := resource.GetLabels()
+
+	return &Event{
+		ID:             util.GenerateResourceID(),
+		Type:           eventType,
+		Time:           time.Now().UTC(),
+		OrganizationID: labels[coreconstants.OrganizationLabel],
+		ProjectID:      labels[coreconstants.ProjectLabel],
+		Resource: Resource{
+			Type: resourceType,
+			ID:   resource.GetName(),
+		},
+		Data: data,
+	}
+}
+
+// Sign returns the signature of the payload.
+func Sign(secret, payload []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(payload)
+
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// subscribed checks whether a webhook wants the event.
+func subscribed(webhook *unikornv1.ComputeWebhook, eventType unikornv1.WebhookEventType) bool {
+	return len(webhook.Spec.Events) == 0 || slices.Contains(webhook.Spec.Events, eventType)
+}
+
+// allowedIP checks an address is publicly routable, webhooks must not be used to
+// reach the platform's own services, or anything else on internal networks.
+func allowedIP(ip net.IP) bool {
+	return !ip.IsLoopback() && !ip.IsPrivate() && !ip.IsLinkLocalUnicast() && !ip.IsLinkLocalMulticast() &&
+		!ip.IsInterfaceLocalMulticast() && !ip.IsMulticast() && !ip.IsUnspecified()
+}
+
+// ValidateURL checks a webhook URL is allowed.  Only HTTPS is permitted, and IP
+// addresses must be publicly routable.  Host names may resolve to anything, so
+// the resolved addresses are checked again on connection.
+func ValidateURL(raw string) error {
+	u, err := url.Parse(raw)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrDestination, err)
+	}
+
+	if u.Scheme != "https" {
+		return fmt.Errorf("%w: scheme must be https", ErrDestination)
+	}
+
+	host := u.Hostname()
+	if host == "" {
+		return fmt.Errorf("%w: host must be specified", ErrDestination)
+	}
+
+	if host == "localhost" || strings.HasSuffix(host, ".localhost") {
+		return fmt.Errorf("%w: host %s is not allowed", ErrDestination, host)
+	}
+
+	if ip := net.ParseIP(host); ip != nil && !allowedIP(ip) {
+		return fmt.Errorf("%w: address %s is not allowed", ErrDestination, host)
+	}
+
+	return nil
+}
+
+// dialControl is called once the destination has been resolved, but before
+// connecting, so catches host names that resolve to internal addresses.
+func dialControl(_, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrDestination, err)
+	}
+
+	if ip := net.ParseIP(host); ip == nil || !allowedIP(ip) {
+		return fmt.Errorf("%w: address %s is not allowed", ErrDestination, host)
+	}
+
+	return nil
+}
+
+// checkRedirect applies the same rules to redirects as to the webhook's URL.
+func checkRedirect(request *http.Request, via []*http.Request) error {
+	if len(via) >= maxRedirects {
+		return fmt.Errorf("%w: too many redirects", ErrDestination)
+	}
+
+	return ValidateURL(request.URL.String())
+}
+
+// newHTTPClient returns a client that will only talk to allowed destinations.
+// Proxies are ignored, as they would connect on our behalf.
+func newHTTPClient() *http.Client {
+	dialer := &net.Dialer{
+		Timeout: 30 * time.Second,
+		Control: dialControl,
+	}
+
+	transport, _ := http.DefaultTransport.(*http.Transport)
+
+	transport = transport.Clone()
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+
+	return &http.Client{
+		Transport:     transport,
+		CheckRedirect: checkRedirect,
+	}
+}
+
+// Notifier queues and delivers events.
+type Notifier struct {
+	options *Options
+	client  *http.Client
+	// validateURL allows destination checks to be relaxed in tests.
+	validateURL func(string) error
+}
+
+// New returns a new notifier.
+func New(options *Options) *Notifier {
+	return &Notifier{
+		options:     options,
+		client:      newHTTPClient(),
+		validateURL: ValidateURL,
+	}
+}
+
+// Notify queues the event for delivery to all subscribed webhooks.  Delivery is
+// handled by the webhook controller, so slow or broken receivers don't hold up
+// reconciliation.  Failures are logged rather than returned, as notifications must
+// never block provisioning.
+func (n *Notifier) Notify(ctx context.Context, event *Event) {
+	log := log.FromContext(ctx)
+
+	if n == nil || event.OrganizationID == "" {
+		return
+	}
+
+	cli, err := coreclient.FromContext(ctx)
+	if err != nil {
+		log.Error(err, "failed to get client for notification")
+
+		return
+	}
+
+	namespace, err := coreclient.NamespaceFromContext(ctx)
+	if err != nil {
+		log.Error(err, "failed to get namespace for notification")
+
+		return
+	}
+
+	webhooks := &unikornv1.ComputeWebhookList{}
+
+	options := &client.ListOptions{
+		Namespace: namespace,
+		LabelSelector: labels.SelectorFromSet(map[string]string{
+			coreconstants.OrganizationLabel: event.OrganizationID,
+		}),
+	}
+
+	if err := cli.List(ctx, webhooks, options); err != nil {
+		log.Error(err, "failed to list webhooks for notification")
+
+		return
+	}
+
+	payload, err := json.Marshal(event)
+	if err != nil {
+		log.Error(err, "failed to marshal notification")
+
+		return
+	}
+
+	delivery := unikornv1.WebhookDelivery{
+		EventID:   event.ID,
+		EventType: event.Type,
+		Time:      metav1.Now(),
+		Payload:   string(payload),
+	}
+
+	for i := range webhooks.Items {
+		webhook := &webhooks.Items[i]
+
+		if webhook.DeletionTimestamp != nil || !subscribed(webhook, event.Type) {
+			continue
+		}
+
+		if err := enqueue(ctx, cli, webhook, delivery); err != nil {
+			log.Error(err, "failed to queue webhook event", "webhook", webhook.Name)
+		}
+	}
+}
+
+// updateStatus applies a modification to the webhook's status, retrying if it
+// has been modified concurrently, as the delivery lists are replaced wholesale.
+func updateStatus(ctx context.Context, cli client.Client, key client.ObjectKey, mutate func(*unikornv1.ComputeWebhook)) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		current := &unikornv1.ComputeWebhook{}
+
+		if err := cli.Get(ctx, key, current); err != nil {
+			return err
+		}
+
+		updated := current.DeepCopy()
+
+		mutate(updated)
+
+		return cli.Status().Patch(ctx, updated, client.MergeFromWithOptions(current, &client.MergeFromWithOptimisticLock{}))
+	})
+}
+
+// deadLetter records an undeliverable event, most recent first.
+func deadLetter(webhook *unikornv1.ComputeWebhook, delivery unikornv1.WebhookDelivery) {
+	delivery.Time = metav1.Now()
+	delivery.NextAttempt = nil
+
+	webhook.Status.FailedDeliveries = slices.Insert(webhook.Status.FailedDeliveries, 0, delivery)
+
+	if len(webhook.Status.FailedDeliveries) > maxFailedDeliveries {
+		webhook.Status.FailedDeliveries = webhook.Status.FailedDeliveries[:maxFailedDeliveries]
+	}
+}
+
+// enqueue adds an event to the webhook's pending deliveries.  If the queue is full,
+// then the receiver has been broken for some time, and the oldest events are dead
+// lettered to make room.
+func enqueue(ctx context.Context, cli client.Client, webhook *unikornv1.ComputeWebhook, delivery unikornv1.WebhookDelivery) error {
+	return updateStatus(ctx, cli, client.ObjectKeyFromObject(webhook), func(webhook *unikornv1.ComputeWebhook) {
+		webhook.Status.PendingDeliveries = append(webhook.Status.PendingDeliveries, delivery)
+
+		for len(webhook.Status.PendingDeliveries) > maxPendingDeliveries {
+			dropped := webhook.Status.PendingDeliveries[0]
+			dropped.Error = "delivery queue is full"
+
+			deadLetter(webhook, dropped)
+
+			webhook.Status.PendingDeliveries = webhook.Status.PendingDeliveries[1:]
+		}
+	})
+}
+
+// post makes a single delivery attempt.
+func (n *Notifier) post(ctx context.Context, webhook *unikornv1.ComputeWebhook, delivery *unikornv1.WebhookDelivery, signature string) error {
+	if err := n.validateURL(webhook.Spec.URL); err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, n.options.Timeout)
+	defer cancel()
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook.Spec.URL, strings.NewReader(delivery.Payload))
+	if err != nil {
+		return err
+	}
+
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set(EventHeader, string(delivery.EventType))
+	request.Header.Set(DeliveryHeader, delivery.EventID)
+	request.Header.Set(SignatureHeader, signature)
+
+	response, err := n.client.Do(request)
+	if err != nil {
+		return err
+	}
+
+	defer response.Body.Close()
+
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return fmt.Errorf("%w: receiver responded with status %d", ErrDelivery, response.StatusCode)
+	}
+
+	return nil
+}
+
+// backoff returns the delay before the next attempt, this doubles with each
+// failed attempt.
+func (n *Notifier) backoff(attempts int) time.Duration {
+	return n.options.Backoff << min(attempts-1, 16)
+}
+
+// Deliverer is a controller that delivers events queued on webhooks.
+type Deliverer struct {
+	client   client.Client
+	notifier *Notifier
+}
+
+var _ reconcile.Reconciler = &Deliverer{}
+
+// NewDeliverer returns a new controller that delivers events using the notifier.
+func NewDeliverer(client client.Client, notifier *Notifier) *Deliverer {
+	return &Deliverer{
+		client:   client,
+		notifier: notifier,
+	}
+}
+
+// Reconcile attempts delivery of any pending events that are due, and requeues
+// for the next event that isn't.  As the outcome is recorded after delivery, an
+// event may be delivered more than once, receivers can deduplicate using the
+// delivery header.  If the receiver cannot be reached, the remaining events are
+// backed off without being attempted, rather than each waiting for a timeout.
+func (d *Deliverer) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := log.FromContext(ctx)
+
+	webhook := &unikornv1.ComputeWebhook{}
+
+	if err := d.client.Get(ctx, request.NamespacedName, webhook); err != nil {
+		if kerrors.IsNotFound(err) {
+			return reconcile.Result{}, nil
+		}
+
+		return reconcile.Result{}, err
+	}
+
+	if webhook.DeletionTimestamp != nil || len(webhook.Status.PendingDeliveries) == 0 {
+		return reconcile.Result{}, nil
+	}
+
+	secret := &corev1.Secret{}
+
+	if err := d.client.Get(ctx, request.NamespacedName, secret); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	signingKey := secret.Data[SecretKey]
+
+	attempts := max(d.notifier.options.Attempts, 1)
+
+	// Record the outcome of each attempt by event ID, as the pending list
+	// may be modified while we are delivering.
+	delivered := map[string]bool{}
+	failed := map[string]error{}
+
+	// When the receiver is unreachable, anything else due is deferred until
+	// the failed event is next retried.
+	var deferUntil *metav1.Time
+
+	deferred := map[string]bool{}
+
+	now := time.Now()
+
+	for i := range webhook.Status.PendingDeliveries {
+		delivery := &webhook.Status.PendingDeliveries[i]
+
+		if delivery.NextAttempt != nil && delivery.NextAttempt.After(now) {
+			continue
+		}
+
+		if deferUntil != nil {
+			deferred[delivery.EventID] = true
+
+			continue
+		}
+
+		if err := d.notifier.post(ctx, webhook, delivery, Sign(signingKey, []byte(delivery.Payload))); err != nil {
+			log.Info("webhook delivery failed", "webhook", webhook.Name, "event", delivery.EventID, "attempt", delivery.Attempts+1, "error", err)
+
+			failed[delivery.EventID] = err
+
+			// The receiver responded, so may accept other events.
+			if !errors.Is(err, ErrDelivery) {
+				deferUntil = &metav1.Time{Time: now.Add(d.notifier.backoff(delivery.Attempts + 1))}
+			}
+
+			continue
+		}
+
+		delivered[delivery.EventID] = true
+	}
+
+	var next *time.Time
+
+	mutate := func(webhook *unikornv1.ComputeWebhook) {
+		next = nil
+
+		pending := make([]unikornv1.WebhookDelivery, 0, len(webhook.Status.PendingDeliveries))
+
+		for _, delivery := range webhook.Status.PendingDeliveries {
+			if delivered[delivery.EventID] {
+				continue
+			}
+
+			if err, ok := failed[delivery.EventID]; ok {
+				delivery.Attempts++
+				delivery.Error = err.Error()
+
+				if delivery.Attempts >= attempts {
+					deadLetter(webhook, delivery)
+
+					continue
+				}
+
+				delivery.NextAttempt = &metav1.Time{Time: now.Add(d.notifier.backoff(delivery.Attempts))}
+			}
+
+			if deferred[delivery.EventID] {
+				delivery.NextAttempt = deferUntil
+			}
+
+			if t := ptrTime(delivery.NextAttempt); next == nil || t.Before(*next) {
+				next = &t
+			}
+
+			pending = append(pending, delivery)
+		}
+
+		webhook.Status.PendingDeliveries = pending
+	}
+
+	if err := updateStatus(ctx, d.client, request.NamespacedName, mutate); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	if next == nil {
+		return reconcile.Result{}, nil
+	}
+
+	return reconcile.Result{RequeueAfter: max(time.Until(*next), time.Second)}, nil
+}
+
+// ptrTime returns the time, or the zero time if not set, i.e. due now.
+func ptrTime(t *metav1.Time) time.Time {
+	if t == nil {
+		return time.Time{}
+	}
+
+	return t.Time
+}
@@ -0,0 +1,423 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notifications_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	unikornv1 "github.com/unikorn-cloud/compute/pkg/apis/unikorn/v1alpha1"
+	"github.com/unikorn-cloud/compute/pkg/provisioners/notifications"
+	coreclient "github.com/unikorn-cloud/core/pkg/client"
+	coreconstants "github.com/unikorn-cloud/core/pkg/constants"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+const secret = "correct-horse-battery-staple"
+
+func newEvent(t *testing.T) (*notifications.Event, string) {
+	t.Helper()
+
+	cluster := &unikornv1.ComputeCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "bar",
+			Labels: map[string]string{
+				coreconstants.OrganizationLabel: "acme",
+			},
+		},
+	}
+
+	event := notifications.NewEvent(unikornv1.WebhookEventClusterProvisioned, "cluster", cluster, nil)
+
+	payload, err := json.Marshal(event)
+	require.NoError(t, err)
+
+	return event, string(payload)
+}
+
+func newWebhook(name, url string, events ...unikornv1.WebhookEventType) *unikornv1.ComputeWebhook {
+	return &unikornv1.ComputeWebhook{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      name,
+			Labels: map[string]string{
+				coreconstants.OrganizationLabel: "acme",
+			},
+		},
+		Spec: unikornv1.ComputeWebhookSpec{
+			URL:    url,
+			Events: events,
+		},
+	}
+}
+
+func newClient(t *testing.T, webhooks ...*unikornv1.ComputeWebhook) client.Client {
+	t.Helper()
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, unikornv1.AddToScheme(scheme))
+	require.NoError(t, corev1.AddToScheme(scheme))
+
+	builder := fake.NewClientBuilder().WithScheme(scheme)
+
+	for _, webhook := range webhooks {
+		builder = builder.WithObjects(webhook, &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: webhook.Namespace,
+				Name:      webhook.Name,
+			},
+			Data: map[string][]byte{
+				notifications.SecretKey: []byte(secret),
+			},
+		}).WithStatusSubresource(webhook)
+	}
+
+	return builder.Build()
+}
+
+// setup creates a webhook, pointing at a server using the handler, with the
+// event queued for delivery.
+func setup(t *testing.T, handler http.HandlerFunc) (client.Client, *unikornv1.ComputeWebhook, *notifications.Event, string) {
+	t.Helper()
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	event, payload := newEvent(t)
+
+	webhook := newWebhook("foo", server.URL)
+	webhook.Status.PendingDeliveries = []unikornv1.WebhookDelivery{
+		{
+			EventID:   event.ID,
+			EventType: event.Type,
+			Time:      metav1.Now(),
+			Payload:   payload,
+		},
+	}
+
+	return newClient(t, webhook), webhook, event, payload
+}
+
+func options() *notifications.Options {
+	return &notifications.Options{
+		Attempts: 3,
+		Timeout:  time.Second,
+	}
+}
+
+func deliver(t *testing.T, cli client.Client, n *notifications.Notifier, webhook *unikornv1.ComputeWebhook) (reconcile.Result, *unikornv1.ComputeWebhook) {
+	t.Helper()
+
+	result, err := notifications.NewDeliverer(cli, n).Reconcile(t.Context(), reconcile.Request{NamespacedName: client.ObjectKeyFromObject(webhook)})
+	require.NoError(t, err)
+
+	updated := &unikornv1.ComputeWebhook{}
+	require.NoError(t, cli.Get(t.Context(), client.ObjectKeyFromObject(webhook), updated))
+
+	return result, updated
+}
+
+// TestNotifyQueues ensures events are persisted for delivery to subscribed webhooks only.
+func TestNotifyQueues(t *testing.T) {
+	t.Parallel()
+
+	subscribed := newWebhook("foo", "https://example.com")
+	unsubscribed := newWebhook("bar", "https://example.com", unikornv1.WebhookEventInstanceDeleted)
+
+	cli := newClient(t, subscribed, unsubscribed)
+
+	ctx := coreclient.NewContext(t.Context(), cli)
+	ctx = coreclient.NewContextWithNamespace(ctx, "default")
+
+	event, payload := newEvent(t)
+
+	notifications.New(options()).Notify(ctx, event)
+
+	result := &unikornv1.ComputeWebhook{}
+	require.NoError(t, cli.Get(t.Context(), client.ObjectKeyFromObject(subscribed), result))
+	require.Len(t, result.Status.PendingDeliveries, 1)
+	require.Equal(t, event.ID, result.Status.PendingDeliveries[0].EventID)
+	require.JSONEq(t, payload, result.Status.PendingDeliveries[0].Payload)
+
+	require.NoError(t, cli.Get(t.Context(), client.ObjectKeyFromObject(unsubscribed), result))
+	require.Empty(t, result.Status.PendingDeliveries)
+}
+
+// TestDeliverRetry ensures signed events are retried until accepted.
+func TestDeliverRetry(t *testing.T) {
+	t.Parallel()
+
+	var calls atomic.Int32
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		if err != nil || r.Header.Get(notifications.SignatureHeader) != notifications.Sign([]byte(secret), body) {
+			w.WriteHeader(http.StatusUnauthorized)
+
+			return
+		}
+
+		if calls.Add(1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+
+			return
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	}
+
+	cli, webhook, _, _ := setup(t, handler)
+
+	n := notifications.NewInsecure(options())
+
+	result, updated := deliver(t, cli, n, webhook)
+	require.NotZero(t, result.RequeueAfter)
+	require.Len(t, updated.Status.PendingDeliveries, 1)
+	require.Equal(t, 1, updated.Status.PendingDeliveries[0].Attempts)
+	require.NotEmpty(t, updated.Status.PendingDeliveries[0].Error)
+
+	result, updated = deliver(t, cli, n, webhook)
+	require.Zero(t, result.RequeueAfter)
+	require.Empty(t, updated.Status.PendingDeliveries)
+	require.Empty(t, updated.Status.FailedDeliveries)
+	require.Equal(t, int32(2), calls.Load())
+}
+
+// TestDeliverBackoff ensures failed events aren't retried until their backoff
+// has elapsed.
+func TestDeliverBackoff(t *testing.T) {
+	t.Parallel()
+
+	var calls atomic.Int32
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusInternalServerError)
+	}
+
+	cli, webhook, _, _ := setup(t, handler)
+
+	o := options()
+	o.Backoff = time.Hour
+
+	n := notifications.NewInsecure(o)
+
+	deliver(t, cli, n, webhook)
+
+	result, updated := deliver(t, cli, n, webhook)
+	require.Greater(t, result.RequeueAfter, 59*time.Minute)
+	require.Len(t, updated.Status.PendingDeliveries, 1)
+	require.Equal(t, int32(1), calls.Load())
+}
+
+// TestDeliverDeadLetter ensures undeliverable events are recorded.
+func TestDeliverDeadLetter(t *testing.T) {
+	t.Parallel()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}
+
+	cli, webhook, event, payload := setup(t, handler)
+
+	n := notifications.NewInsecure(options())
+
+	var updated *unikornv1.ComputeWebhook
+
+	for range 3 {
+		_, updated = deliver(t, cli, n, webhook)
+	}
+
+	require.Empty(t, updated.Status.PendingDeliveries)
+	require.Len(t, updated.Status.FailedDeliveries, 1)
+
+	delivery := updated.Status.FailedDeliveries[0]
+	require.Equal(t, event.ID, delivery.EventID)
+	require.Equal(t, unikornv1.WebhookEventClusterProvisioned, delivery.EventType)
+	require.Equal(t, 3, delivery.Attempts)
+	require.JSONEq(t, payload, delivery.Payload)
+}
+
+// queueEvents adds further events to the webhook's pending deliveries.
+func queueEvents(t *testing.T, webhook *unikornv1.ComputeWebhook, count int) {
+	t.Helper()
+
+	for range count {
+		event, payload := newEvent(t)
+
+		webhook.Status.PendingDeliveries = append(webhook.Status.PendingDeliveries, unikornv1.WebhookDelivery{
+			EventID:   event.ID,
+			EventType: event.Type,
+			Time:      metav1.Now(),
+			Payload:   payload,
+		})
+	}
+}
+
+// TestDeliverUnreachable ensures an unreachable receiver is only tried once per
+// reconcile, with the remaining events backed off alongside the failed one, while
+// a receiver that responds with an error is still offered every event.
+func TestDeliverUnreachable(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.NotFoundHandler())
+	server.Close()
+
+	webhook := newWebhook("foo", server.URL)
+	queueEvents(t, webhook, 3)
+
+	cli := newClient(t, webhook)
+
+	opts := options()
+	opts.Backoff = time.Minute
+
+	result, updated := deliver(t, cli, notifications.NewInsecure(opts), webhook)
+	require.NotZero(t, result.RequeueAfter)
+	require.Len(t, updated.Status.PendingDeliveries, 3)
+
+	failed := updated.Status.PendingDeliveries[0]
+	require.Equal(t, 1, failed.Attempts)
+	require.NotEmpty(t, failed.Error)
+	require.NotNil(t, failed.NextAttempt)
+
+	for _, delivery := range updated.Status.PendingDeliveries[1:] {
+		require.Zero(t, delivery.Attempts)
+		require.Empty(t, delivery.Error)
+		require.NotNil(t, delivery.NextAttempt)
+		require.True(t, failed.NextAttempt.Equal(delivery.NextAttempt))
+	}
+
+	var calls atomic.Int32
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}
+
+	cli, webhook, _, _ = setup(t, handler)
+
+	queueEvents(t, webhook, 2)
+	require.NoError(t, cli.Status().Update(t.Context(), webhook))
+
+	_, updated = deliver(t, cli, notifications.NewInsecure(options()), webhook)
+	require.Len(t, updated.Status.PendingDeliveries, 3)
+	require.Equal(t, int32(3), calls.Load())
+}
+
+// TestDeliverRejectsInsecureDestinations ensures events aren't posted to anything
+// other than public HTTPS endpoints, even if the webhook was created before these
+// rules applied.
+func TestDeliverRejectsInsecureDestinations(t *testing.T) {
+	t.Parallel()
+
+	var calls atomic.Int32
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusNoContent)
+	}
+
+	cli, webhook, _, _ := setup(t, handler)
+
+	_, updated := deliver(t, cli, notifications.New(options()), webhook)
+	require.Len(t, updated.Status.PendingDeliveries, 1)
+	require.Contains(t, updated.Status.PendingDeliveries[0].Error, notifications.ErrDestination.Error())
+	require.Zero(t, calls.Load())
+}
+
+// TestValidateURL ensures only HTTPS URLs that aren't obviously internal are allowed.
+func TestValidateURL(t *testing.T) {
+	t.Parallel()
+
+	allowed := []string{
+		"https://example.com/hooks",
+		"https://example.com:8443",
+		"https://8.8.8.8/hooks",
+	}
+
+	for _, u := range allowed {
+		require.NoError(t, notifications.ValidateURL(u), u)
+	}
+
+	rejected := []string{
+		"http://example.com/hooks",
+		"ftp://example.com",
+		"https:///hooks",
+		"https://localhost/hooks",
+		"https://foo.localhost/hooks",
+		"https://127.0.0.1/hooks",
+		"https://[::1]/hooks",
+		"https://10.0.0.1/hooks",
+		"https://192.168.1.1/hooks",
+		"https://172.16.0.1/hooks",
+		"https://169.254.169.254/latest/meta-data",
+		"https://[fe80::1]/hooks",
+		"https://0.0.0.0/hooks",
+	}
+
+	for _, u := range rejected {
+		require.ErrorIs(t, notifications.ValidateURL(u), notifications.ErrDestination, u)
+	}
+}
+
+// TestDialControl ensures host names resolving to internal addresses are caught
+// when connecting.
+func TestDialControl(t *testing.T) {
+	t.Parallel()
+
+	require.NoError(t, notifications.DialControl("tcp4", "8.8.8.8:443", nil))
+
+	for _, address := range []string{"127.0.0.1:443", "[::1]:443", "10.1.2.3:443", "169.254.169.254:80", "[fd00::1]:443"} {
+		require.ErrorIs(t, notifications.DialControl("tcp", address, nil), notifications.ErrDestination, address)
+	}
+}
+
+// TestCheckRedirect ensures receivers cannot redirect events to somewhere that
+// would have been rejected in the first place.
+func TestCheckRedirect(t *testing.T) {
+	t.Parallel()
+
+	request := func(raw string) *http.Request {
+		u, err := url.Parse(raw)
+		require.NoError(t, err)
+
+		return &http.Request{URL: u}
+	}
+
+	via := []*http.Request{request("https://example.com")}
+
+	require.NoError(t, notifications.CheckRedirect(request("https://example.org/hooks"), via))
+	require.ErrorIs(t, notifications.CheckRedirect(request("http://example.org/hooks"), via), notifications.ErrDestination)
+	require.ErrorIs(t, notifications.CheckRedirect(request("https://169.254.169.254/latest"), via), notifications.ErrDestination)
+	require.ErrorIs(t, notifications.CheckRedirect(request("https://example.org/hooks"), make([]*http.Request, 5)), notifications.ErrDestination)
+}
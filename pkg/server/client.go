@@ -0,0 +1,68 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"context"
+
+	handlerutil "github.com/unikorn-cloud/compute/pkg/server/handler/util"
+	coreclient "github.com/unikorn-cloud/core/pkg/client"
+
+	"k8s.io/client-go/rest"
+
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// NewClient returns a caching client as per the core library, but with indexes
+// registered on the cache so tag filtered lists are served from an index rather
+// than by scanning every resource in the namespace.
+func NewClient(ctx context.Context, schemes ...coreclient.SchemeAdder) (client.Client, error) {
+	config, err := rest.InClusterConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	scheme, err := coreclient.NewScheme(schemes...)
+	if err != nil {
+		return nil, err
+	}
+
+	cache, err := cache.New(config, cache.Options{Scheme: scheme})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := handlerutil.AddTagIndexes(ctx, cache); err != nil {
+		return nil, err
+	}
+
+	go func() {
+		_ = cache.Start(ctx)
+	}()
+
+	clientOptions := client.Options{
+		Scheme: scheme,
+		Cache: &client.CacheOptions{
+			Reader:       cache,
+			Unstructured: true,
+		},
+	}
+
+	return client.NewWithWatch(config, clientOptions)
+}
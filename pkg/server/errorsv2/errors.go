@@ -0,0 +1,163 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package errorsv2 augments the generic server errors with machine readable
+// codes, so clients can reliably react to specific failures.
+package errorsv2
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/unikorn-cloud/compute/pkg/openapi"
+	servererrors "github.com/unikorn-cloud/core/pkg/server/errors"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// Error wraps a generic server error with a code.  It unwraps to the generic
+// error so existing error checks continue to work.
+type Error struct {
+	// err is the generic error that defines the status and description.
+	err *servererrors.Error
+
+	// code is the machine readable code.
+	code openapi.ErrorCode
+}
+
+// New adds a code to a generic error.
+func New(code openapi.ErrorCode, err *servererrors.Error) *Error {
+	return &Error{
+		err:  err,
+		code: code,
+	}
+}
+
+// InvalidRequest is a coded version of servererrors.OAuth2InvalidRequest.
+func InvalidRequest(code openapi.ErrorCode, a ...any) *Error {
+	return New(code, servererrors.OAuth2InvalidRequest(a...))
+}
+
+// WithError augments the error with an error from a library.
+func (e *Error) WithError(err error) *Error {
+	e.err = e.err.WithError(err)
+
+	return e
+}
+
+// Error implements the error interface.
+func (e *Error) Error() string {
+	return e.err.Error()
+}
+
+// Unwrap implements Go 1.13 errors.
+func (e *Error) Unwrap() error {
+	return e.err
+}
+
+// Code returns the error's code.
+func (e *Error) Code() openapi.ErrorCode {
+	return e.code
+}
+
+// CodeOf returns the code of an error, if it has one.
+func CodeOf(err error) (openapi.ErrorCode, bool) {
+	var e *Error
+
+	if !errors.As(err, &e) {
+		return "", false
+	}
+
+	return e.code, true
+}
+
+// recorder captures the generic error response so it can be augmented.
+type recorder struct {
+	header http.Header
+	status int
+	body   bytes.Buffer
+}
+
+func (r *recorder) Header() http.Header {
+	return r.header
+}
+
+func (r *recorder) WriteHeader(status int) {
+	r.status = status
+}
+
+func (r *recorder) Write(b []byte) (int, error) {
+	return r.body.Write(b)
+}
+
+// Write returns the error to the client.  The generic error does all the heavy
+// lifting e.g. logging and tracing, and the code is added to its response.
+func (e *Error) Write(w http.ResponseWriter, r *http.Request) {
+	rec := &recorder{
+		header: http.Header{},
+	}
+
+	e.err.Write(rec, r)
+
+	var body openapi.ComputeError
+
+	if err := json.Unmarshal(rec.body.Bytes(), &body); err != nil {
+		log.FromContext(r.Context()).Error(err, "failed to unmarshal error response")
+	} else {
+		body.Code = &e.code
+
+		if data, err := json.Marshal(body); err != nil {
+			log.FromContext(r.Context()).Error(err, "failed to marshal error response")
+		} else {
+			rec.body.Reset()
+			rec.body.Write(data)
+		}
+	}
+
+	for header, values := range rec.header {
+		for _, value := range values {
+			w.Header().Add(header, value)
+		}
+	}
+
+	w.WriteHeader(rec.status)
+
+	if _, err := w.Write(rec.body.Bytes()); err != nil {
+		log.FromContext(r.Context()).Error(err, "failed to write error response")
+	}
+}
+
+// HandleError is the top level error handler that should be called from all
+// path handlers on error.  Coded errors are handled here, everything else is
+// deferred to the generic handler.
+func HandleError(w http.ResponseWriter, r *http.Request, err error) {
+	var e *Error
+
+	var ge *servererrors.Error
+
+	// Only use the code if it's for the outermost generic error, otherwise
+	// it describes something else.
+	if errors.As(err, &e) && errors.As(err, &ge) && ge == e.err {
+		e.Write(w, r)
+
+		return
+	}
+
+	servererrors.HandleError(w, r, err)
+}
@@ -0,0 +1,77 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package errorsv2_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/unikorn-cloud/compute/pkg/openapi"
+	"github.com/unikorn-cloud/compute/pkg/server/errorsv2"
+	servererrors "github.com/unikorn-cloud/core/pkg/server/errors"
+)
+
+func handle(t *testing.T, err error) (int, *openapi.ComputeError) {
+	t.Helper()
+
+	w := httptest.NewRecorder()
+	r := httptest.NewRequestWithContext(t.Context(), http.MethodGet, "/", nil)
+
+	errorsv2.HandleError(w, r, err)
+
+	var body openapi.ComputeError
+
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+
+	return w.Code, &body
+}
+
+// TestHandleError checks codes are returned to the client, while the status and
+// description are those of the generic error.
+func TestHandleError(t *testing.T) {
+	t.Parallel()
+
+	err := fmt.Errorf("%w: wrapped", errorsv2.InvalidRequest(openapi.ComputeClusterDeleting, "cluster is being deleted"))
+
+	require.True(t, servererrors.IsBadRequest(err))
+
+	code, ok := errorsv2.CodeOf(err)
+	require.True(t, ok)
+	require.Equal(t, openapi.ComputeClusterDeleting, code)
+
+	status, body := handle(t, err)
+	require.Equal(t, http.StatusBadRequest, status)
+	require.Equal(t, openapi.InvalidRequest, body.Error)
+	require.Equal(t, "cluster is being deleted", body.ErrorDescription)
+	require.NotNil(t, body.Code)
+	require.Equal(t, openapi.ComputeClusterDeleting, *body.Code)
+
+	// Codes don't leak when a coded error is the cause of another error.
+	status, body = handle(t, servererrors.HTTPConflict().WithError(err))
+	require.Equal(t, http.StatusConflict, status)
+	require.Nil(t, body.Code)
+
+	status, body = handle(t, servererrors.HTTPNotFound())
+	require.Equal(t, http.StatusNotFound, status)
+	require.Nil(t, body.Code)
+}
@@ -0,0 +1,176 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package admin
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+
+	computev1 "github.com/unikorn-cloud/compute/pkg/apis/unikorn/v1alpha1"
+	"github.com/unikorn-cloud/compute/pkg/constants"
+	computeapi "github.com/unikorn-cloud/compute/pkg/openapi"
+	"github.com/unikorn-cloud/compute/pkg/server/handler/util"
+	corev1 "github.com/unikorn-cloud/core/pkg/apis/unikorn/v1alpha1"
+	coreconstants "github.com/unikorn-cloud/core/pkg/constants"
+	"github.com/unikorn-cloud/core/pkg/server/conversion"
+	identityapi "github.com/unikorn-cloud/identity/pkg/openapi"
+	"github.com/unikorn-cloud/identity/pkg/rbac"
+	regionconstants "github.com/unikorn-cloud/region/pkg/constants"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/utils/ptr"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Client provides platform operators with a view of compute resources across all
+// organizations.  All operations require global permissions, so organization scoped
+// credentials are not required.
+type Client struct {
+	// client allows Compute API access.
+	client client.Client
+	// namespace the controller runs in.
+	namespace string
+}
+
+// NewClient returns a new client with required parameters.
+func NewClient(client client.Client, namespace string) *Client {
+	return &Client{
+		client:    client,
+		namespace: namespace,
+	}
+}
+
+func convert(in metav1.Object, tags corev1.TagList, kind computeapi.AdminResourceKind) *computeapi.AdminResourceRead {
+	labels := in.GetLabels()
+
+	out := &computeapi.AdminResourceRead{
+		Metadata: conversion.ProjectScopedResourceReadMetadata(in, tags),
+		Status: computeapi.AdminResourceStatus{
+			Kind:     kind,
+			RegionId: labels[regionconstants.RegionLabel],
+		},
+	}
+
+	if version, err := constants.UnmarshalAPIVersion(labels[constants.ResourceAPIVersionLabel]); err == nil {
+		out.Status.ApiVersion = ptr.To(version)
+	}
+
+	return out
+}
+
+// wantKind returns whether resources of the kind should be listed.
+func wantKind(params computeapi.GetApiV2AdminResourcesParams, kind computeapi.AdminResourceKind) bool {
+	return params.Kind == nil || slices.Contains(*params.Kind, kind)
+}
+
+// filterStatus removes resources that don't match the requested status filters, these
+// are derived from status conditions so cannot be selected by label.
+func filterStatus(in computeapi.AdminResourceReadList, params computeapi.GetApiV2AdminResourcesParams) computeapi.AdminResourceReadList {
+	return slices.DeleteFunc(in, func(resource computeapi.AdminResourceRead) bool {
+		if params.ProvisioningStatus != nil && !slices.Contains(*params.ProvisioningStatus, resource.Metadata.ProvisioningStatus) {
+			return true
+		}
+
+		if params.HealthStatus != nil && !slices.Contains(*params.HealthStatus, resource.Metadata.HealthStatus) {
+			return true
+		}
+
+		return false
+	})
+}
+
+// sortResources orders resources by organization, project, kind then name for
+// predictable output.
+func sortResources(in computeapi.AdminResourceReadList) {
+	slices.SortStableFunc(in, func(a, b computeapi.AdminResourceRead) int {
+		return cmp.Or(
+			cmp.Compare(a.Metadata.OrganizationId, b.Metadata.OrganizationId),
+			cmp.Compare(a.Metadata.ProjectId, b.Metadata.ProjectId),
+			cmp.Compare(a.Status.Kind, b.Status.Kind),
+			cmp.Compare(a.Metadata.Name, b.Metadata.Name),
+		)
+	})
+}
+
+// List returns all clusters and instances matching the filters.
+func (c *Client) List(ctx context.Context, params computeapi.GetApiV2AdminResourcesParams) (computeapi.AdminResourceReadList, error) {
+	if err := rbac.AllowGlobalScope(ctx, "compute:admin", identityapi.Read); err != nil {
+		return nil, err
+	}
+
+	var err error
+
+	selector := labels.Everything()
+
+	if organizationIDs := util.OrganizationIDQuery(params.OrganizationID); organizationIDs != nil {
+		selector, err = rbac.AddQuery(selector, coreconstants.OrganizationLabel, organizationIDs)
+		if err != nil {
+			return nil, fmt.Errorf("%w: failed to add organization label selector", err)
+		}
+	}
+
+	selector, err = util.AddRegionIDQuery(selector, params.RegionID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to add region label selector", err)
+	}
+
+	options := &client.ListOptions{
+		Namespace:     c.namespace,
+		LabelSelector: selector,
+	}
+
+	var out computeapi.AdminResourceReadList
+
+	if wantKind(params, computeapi.AdminResourceKindCluster) {
+		clusters := &computev1.ComputeClusterList{}
+
+		if err := c.client.List(ctx, clusters, options); err != nil {
+			return nil, fmt.Errorf("%w: unable to list clusters", err)
+		}
+
+		for i := range clusters.Items {
+			out = append(out, *convert(&clusters.Items[i], clusters.Items[i].Spec.Tags, computeapi.AdminResourceKindCluster))
+		}
+	}
+
+	if wantKind(params, computeapi.AdminResourceKindInstance) {
+		instances := &computev1.ComputeInstanceList{}
+
+		if err := c.client.List(ctx, instances, options); err != nil {
+			return nil, fmt.Errorf("%w: unable to list instances", err)
+		}
+
+		for i := range instances.Items {
+			out = append(out, *convert(&instances.Items[i], instances.Items[i].Spec.Tags, computeapi.AdminResourceKindInstance))
+		}
+	}
+
+	out = filterStatus(out, params)
+
+	sortResources(out)
+
+	if out == nil {
+		out = computeapi.AdminResourceReadList{}
+	}
+
+	return out, nil
+}
@@ -0,0 +1,135 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package admin_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	computev1 "github.com/unikorn-cloud/compute/pkg/apis/unikorn/v1alpha1"
+	computeapi "github.com/unikorn-cloud/compute/pkg/openapi"
+	"github.com/unikorn-cloud/compute/pkg/server/handler/admin"
+	coreconstants "github.com/unikorn-cloud/core/pkg/constants"
+	coreapi "github.com/unikorn-cloud/core/pkg/openapi"
+	coreerrors "github.com/unikorn-cloud/core/pkg/server/errors"
+	identityapi "github.com/unikorn-cloud/identity/pkg/openapi"
+	"github.com/unikorn-cloud/identity/pkg/rbac"
+	regionconstants "github.com/unikorn-cloud/region/pkg/constants"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+const namespace = "compute"
+
+func objectMeta(id, name, organizationID, regionID string) metav1.ObjectMeta {
+	return metav1.ObjectMeta{
+		Namespace: namespace,
+		Name:      id,
+		Labels: map[string]string{
+			coreconstants.NameLabel:         name,
+			coreconstants.OrganizationLabel: organizationID,
+			coreconstants.ProjectLabel:      "project",
+			regionconstants.RegionLabel:     regionID,
+		},
+	}
+}
+
+func newClient(t *testing.T) *admin.Client {
+	t.Helper()
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, computev1.AddToScheme(scheme))
+
+	objects := []client.Object{
+		&computev1.ComputeCluster{ObjectMeta: objectMeta("c1", "cluster-b", "org-a", "region-a")},
+		&computev1.ComputeCluster{ObjectMeta: objectMeta("c2", "cluster-a", "org-b", "region-b")},
+		&computev1.ComputeInstance{ObjectMeta: objectMeta("i1", "instance-a", "org-a", "region-a")},
+	}
+
+	cli := fake.NewClientBuilder().WithScheme(scheme).WithObjects(objects...).Build()
+
+	return admin.NewClient(cli, namespace)
+}
+
+func adminACL() *identityapi.Acl {
+	return &identityapi.Acl{
+		Global: &identityapi.AclEndpoints{
+			{
+				Name:       "compute:admin",
+				Operations: identityapi.AclOperations{identityapi.Read},
+			},
+		},
+	}
+}
+
+// TestListForbidden ensures organization scoped users cannot see other tenants.
+func TestListForbidden(t *testing.T) {
+	t.Parallel()
+
+	ctx := rbac.NewContext(t.Context(), &identityapi.Acl{})
+
+	_, err := newClient(t).List(ctx, computeapi.GetApiV2AdminResourcesParams{})
+	require.True(t, coreerrors.IsForbidden(err))
+}
+
+// TestList ensures resources across all organizations are returned in a
+// predictable order.
+func TestList(t *testing.T) {
+	t.Parallel()
+
+	ctx := rbac.NewContext(t.Context(), adminACL())
+
+	result, err := newClient(t).List(ctx, computeapi.GetApiV2AdminResourcesParams{})
+	require.NoError(t, err)
+	require.Len(t, result, 3)
+	require.Equal(t, "c1", result[0].Metadata.Id)
+	require.Equal(t, "i1", result[1].Metadata.Id)
+	require.Equal(t, computeapi.AdminResourceKindInstance, result[1].Status.Kind)
+	require.Equal(t, "c2", result[2].Metadata.Id)
+}
+
+// TestListFiltered ensures filters are applied.
+func TestListFiltered(t *testing.T) {
+	t.Parallel()
+
+	ctx := rbac.NewContext(t.Context(), adminACL())
+
+	params := computeapi.GetApiV2AdminResourcesParams{
+		RegionID: &computeapi.RegionIDQueryParameter{"region-a"},
+		Kind:     &computeapi.AdminResourceKindQueryParameter{computeapi.AdminResourceKindCluster},
+	}
+
+	result, err := newClient(t).List(ctx, params)
+	require.NoError(t, err)
+	require.Len(t, result, 1)
+	require.Equal(t, "c1", result[0].Metadata.Id)
+
+	// Nothing has been provisioned yet.
+	params = computeapi.GetApiV2AdminResourcesParams{
+		ProvisioningStatus: &computeapi.ProvisioningStatusQueryParameter{coreapi.ResourceProvisioningStatusProvisioned},
+	}
+
+	result, err = newClient(t).List(ctx, params)
+	require.NoError(t, err)
+	require.Empty(t, result)
+}
@@ -0,0 +1,138 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package handler
+
+import (
+	"context"
+	"net/http"
+	"slices"
+
+	"github.com/unikorn-cloud/compute/pkg/openapi"
+	"github.com/unikorn-cloud/core/pkg/server/errors"
+	identityapi "github.com/unikorn-cloud/identity/pkg/openapi"
+	regionapi "github.com/unikorn-cloud/region/pkg/openapi"
+
+	"k8s.io/utils/ptr"
+)
+
+const (
+	// clustersQuota is the quota kind consumed by every cluster.
+	clustersQuota = "clusters"
+
+	// serversQuota is the quota kind consumed by every instance.
+	serversQuota = "servers"
+
+	// gpusQuota is the quota kind consumed by GPU flavors, per physical GPU
+	// as accounted for by allocations.
+	gpusQuota = "gpus"
+
+	// floatingIPsQuota is the quota kind consumed by instances with a public IP.
+	floatingIPsQuota = "floatingips"
+)
+
+// quotaList returns the organization's quotas from identity.
+func (h *Handler) quotaList(ctx context.Context, organizationID string) (identityapi.QuotaReadList, error) {
+	resp, err := h.identity.GetApiV1OrganizationsOrganizationIDQuotasWithResponse(ctx, organizationID)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode() != http.StatusOK {
+		return nil, errors.PropagateError(resp.HTTPResponse, resp)
+	}
+
+	return resp.JSON200.Quotas, nil
+}
+
+// freeQuotas returns the free amount of each quota kind.
+func freeQuotas(quotas identityapi.QuotaReadList) map[string]int {
+	free := map[string]int{}
+
+	for _, quota := range quotas {
+		free[quota.Kind] = max(quota.Free, 0)
+	}
+
+	return free
+}
+
+// quotas returns the free amount of each quota kind for the organization.
+func (h *Handler) quotas(ctx context.Context, organizationID string) (map[string]int, error) {
+	quotas, err := h.quotaList(ctx, organizationID)
+	if err != nil {
+		return nil, err
+	}
+
+	return freeQuotas(quotas), nil
+}
+
+// computeQuotas selects the quotas that compute allocates against, so clients
+// don't need to know compute's allocation model.
+func computeQuotas(quotas identityapi.QuotaReadList) openapi.ComputeQuotaList {
+	kinds := []string{clustersQuota, serversQuota, gpusQuota, floatingIPsQuota}
+
+	out := openapi.ComputeQuotaList{}
+
+	for _, quota := range quotas {
+		if !slices.Contains(kinds, quota.Kind) {
+			continue
+		}
+
+		out = append(out, openapi.ComputeQuota{
+			Kind:      quota.Kind,
+			Quantity:  quota.Quantity,
+			Committed: quota.Committed,
+			Reserved:  quota.Reserved,
+			Free:      max(quota.Free, 0),
+		})
+	}
+
+	return out
+}
+
+// flavorAvailability calculates how many instances of each flavor can be allocated
+// given the free quota.  Quota kinds that aren't present are treated as unlimited.
+func flavorAvailability(flavors []regionapi.Flavor, free map[string]int) openapi.FlavorsAvailability {
+	result := make(openapi.FlavorsAvailability, len(flavors))
+
+	for i := range flavors {
+		flavor := &flavors[i]
+
+		result[i] = openapi.FlavorAvailability{
+			FlavorId: flavor.Metadata.Id,
+		}
+
+		limit := func(kind string, available int) {
+			if result[i].Available == nil || available < *result[i].Available {
+				result[i].Available = ptr.To(available)
+				result[i].LimitedBy = ptr.To(kind)
+			}
+		}
+
+		if servers, ok := free[serversQuota]; ok {
+			limit(serversQuota, servers)
+		}
+
+		if flavor.Spec.Gpu != nil && flavor.Spec.Gpu.PhysicalCount > 0 {
+			if gpus, ok := free[gpusQuota]; ok {
+				limit(gpusQuota, gpus/flavor.Spec.Gpu.PhysicalCount)
+			}
+		}
+	}
+
+	return result
+}
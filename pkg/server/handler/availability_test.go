@@ -0,0 +1,75 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package handler_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/unikorn-cloud/compute/pkg/server/handler"
+	identityapi "github.com/unikorn-cloud/identity/pkg/openapi"
+	regionapi "github.com/unikorn-cloud/region/pkg/openapi"
+)
+
+// TestFlavorAvailability ensures availability is limited by the scarcest quota.
+func TestFlavorAvailability(t *testing.T) {
+	t.Parallel()
+
+	cpu := regionapi.Flavor{}
+	cpu.Metadata.Id = "cpu"
+
+	gpu := regionapi.Flavor{}
+	gpu.Metadata.Id = "gpu"
+	gpu.Spec.Gpu = &regionapi.GpuSpec{
+		PhysicalCount: 4,
+	}
+
+	flavors := []regionapi.Flavor{cpu, gpu}
+
+	result := handler.FlavorAvailability(flavors, map[string]int{"servers": 5, "gpus": 10})
+	require.Len(t, result, 2)
+	require.Equal(t, 5, *result[0].Available)
+	require.Equal(t, "servers", *result[0].LimitedBy)
+	require.Equal(t, 2, *result[1].Available)
+	require.Equal(t, "gpus", *result[1].LimitedBy)
+
+	result = handler.FlavorAvailability(flavors, map[string]int{})
+	require.Nil(t, result[0].Available)
+	require.Nil(t, result[1].Available)
+}
+
+// TestComputeQuotas ensures only quotas compute allocates against are reported,
+// and that over-committed quotas don't report negative free space.
+func TestComputeQuotas(t *testing.T) {
+	t.Parallel()
+
+	quotas := identityapi.QuotaReadList{
+		{Kind: "clusters", Quantity: 5, Committed: 2, Free: 3},
+		{Kind: "storage", Quantity: 100, Free: 100},
+		{Kind: "gpus", Quantity: 8, Committed: 8, Reserved: 4, Free: -4},
+	}
+
+	result := handler.ComputeQuotas(quotas)
+	require.Len(t, result, 2)
+	require.Equal(t, "clusters", result[0].Kind)
+	require.Equal(t, 3, result[0].Free)
+	require.Equal(t, "gpus", result[1].Kind)
+	require.Equal(t, 4, result[1].Reserved)
+	require.Equal(t, 0, result[1].Free)
+}
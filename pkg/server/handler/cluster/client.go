@@ -24,6 +24,7 @@ import (
 	"net/http"
 	"slices"
 	"strings"
+	"time"
 
 	"github.com/spf13/pflag"
 
@@ -31,30 +32,48 @@ import (
 	computeconstants "github.com/unikorn-cloud/compute/pkg/constants"
 	"github.com/unikorn-cloud/compute/pkg/openapi"
 	managerutil "github.com/unikorn-cloud/compute/pkg/provisioners/managers/cluster/util"
+	"github.com/unikorn-cloud/compute/pkg/server/errorsv2"
 	"github.com/unikorn-cloud/compute/pkg/server/handler/region"
+	handlerutil "github.com/unikorn-cloud/compute/pkg/server/handler/util"
+	"github.com/unikorn-cloud/compute/pkg/server/middleware/audit"
 	"github.com/unikorn-cloud/core/pkg/constants"
 	coreerrors "github.com/unikorn-cloud/core/pkg/errors"
 	coreapi "github.com/unikorn-cloud/core/pkg/openapi"
 	"github.com/unikorn-cloud/core/pkg/server/conversion"
 	"github.com/unikorn-cloud/core/pkg/server/errors"
+	"github.com/unikorn-cloud/core/pkg/server/saga"
 	"github.com/unikorn-cloud/core/pkg/server/util"
 	identityclient "github.com/unikorn-cloud/identity/pkg/client"
 	"github.com/unikorn-cloud/identity/pkg/handler/common"
 	identityapi "github.com/unikorn-cloud/identity/pkg/openapi"
 	regionapi "github.com/unikorn-cloud/region/pkg/openapi"
 
+	corev1 "k8s.io/api/core/v1"
 	kerrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/selection"
+	"k8s.io/client-go/util/retry"
 	"k8s.io/utils/ptr"
 
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
 )
 
 type Options struct {
 	NodeNetwork    net.IPNet
 	DNSNameservers []net.IP
+
+	// MaxPools limits how many pools a single cluster may define.
+	MaxPools int
+	// MaxPoolReplicas limits how many machines a single pool may request.
+	MaxPoolReplicas int
+	// PoolPowerConcurrency limits how many machines are operated on at once
+	// by parallel pool power operations.
+	PoolPowerConcurrency int
+	// SpecHistory is how many previous generations of a cluster's specification
+	// are retained so an update can be rolled back.
+	SpecHistory int
 }
 
 func (o *Options) AddFlags(f *pflag.FlagSet) {
@@ -64,6 +83,28 @@ func (o *Options) AddFlags(f *pflag.FlagSet) {
 
 	f.IPNetVar(&o.NodeNetwork, "default-node-network", *nodeNetwork, "Default node network to use when creating a cluster")
 	f.IPSliceVar(&o.DNSNameservers, "default-dns-nameservers", dnsNameservers, "Default DNS nameserver to use when creating a cluster")
+	f.IntVar(&o.MaxPools, "max-cluster-pools", 32, "Maximum number of pools a cluster may define, zero is unlimited")
+	f.IntVar(&o.MaxPoolReplicas, "max-pool-replicas", 1000, "Maximum number of machines a pool may request, zero is unlimited")
+	f.IntVar(&o.PoolPowerConcurrency, "pool-power-concurrency", 8, "Maximum number of machines operated on at once by parallel pool power operations")
+	f.IntVar(&o.SpecHistory, "cluster-spec-history", 5, "Number of previous cluster specifications retained for rollback, zero disables")
+}
+
+// validatePools checks a request doesn't ask for more pools than allowed.
+func (o *Options) validatePools(count int) error {
+	if o.MaxPools > 0 && count > o.MaxPools {
+		return errorsv2.InvalidRequest(openapi.ComputeClusterInvalidSpec, fmt.Sprintf("cluster may define at most %d pools", o.MaxPools))
+	}
+
+	return nil
+}
+
+// validatePoolReplicas checks a pool doesn't ask for more machines than allowed.
+func (o *Options) validatePoolReplicas(name string, replicas int) error {
+	if o.MaxPoolReplicas > 0 && replicas > o.MaxPoolReplicas {
+		return errorsv2.InvalidRequest(openapi.ComputeClusterInvalidSpec, fmt.Sprintf("pool %s may request at most %d machines", name, o.MaxPoolReplicas))
+	}
+
+	return nil
 }
 
 // Client wraps up cluster related management handling.
@@ -82,6 +123,12 @@ type Client struct {
 
 	// region is a client to access regions.
 	region regionapi.ClientWithResponsesInterface
+
+	// catalog, if set, is used to read region flavors and images.
+	catalog region.ClientInterface
+
+	// access controls how unauthorized reads are reported.
+	access *handlerutil.AccessOptions
 }
 
 // NewClient returns a new client with required parameters.
@@ -95,6 +142,31 @@ func NewClient(client client.Client, namespace string, options *Options, identit
 	}
 }
 
+// WithRegionCache reads flavors and images via the provided, typically cached,
+// region client, rather than directly from the region service.
+func (c *Client) WithRegionCache(regions region.ClientInterface) *Client {
+	c.catalog = regions
+
+	return c
+}
+
+// WithAccessOptions controls how unauthorized reads of individual resources
+// are reported.
+func (c *Client) WithAccessOptions(access *handlerutil.AccessOptions) *Client {
+	c.access = access
+
+	return c
+}
+
+// regions returns a client for reading the region catalog.
+func (c *Client) regions() region.ClientInterface {
+	if c.catalog != nil {
+		return c.catalog
+	}
+
+	return region.New(c.region)
+}
+
 // List returns all clusters owned by the implicit control plane.
 func (c *Client) List(ctx context.Context, organizationID string, params openapi.GetApiV1OrganizationsOrganizationIDClustersParams) (openapi.ComputeClusters, error) {
 	requirement, err := labels.NewRequirement(constants.OrganizationLabel, selection.Equals, []string{organizationID})
@@ -110,8 +182,14 @@ func (c *Client) List(ctx context.Context, organizationID string, params openapi
 	selector := labels.NewSelector()
 	selector = selector.Add(*requirement, *versionRequirement)
 
+	tagSelector, err := util.DecodeTagSelectorParam(params.Tag)
+	if err != nil {
+		return nil, err
+	}
+
 	options := &client.ListOptions{
 		LabelSelector: selector,
+		FieldSelector: handlerutil.TagSelector(tagSelector),
 	}
 
 	result := &unikornv1.ComputeClusterList{}
@@ -120,29 +198,59 @@ func (c *Client) List(ctx context.Context, organizationID string, params openapi
 		return nil, fmt.Errorf("%w: failed to list clusters", err)
 	}
 
-	tagSelector, err := util.DecodeTagSelectorParam(params.Tag)
-	if err != nil {
-		return nil, err
-	}
-
-	result.Items = slices.DeleteFunc(result.Items, func(resource unikornv1.ComputeCluster) bool {
-		return !resource.Spec.Tags.ContainsAll(tagSelector)
-	})
-
 	slices.SortStableFunc(result.Items, func(a, b unikornv1.ComputeCluster) int {
 		return strings.Compare(a.Name, b.Name)
 	})
 
-	return newGenerator(c.client, c.options, region.New(c.region), "", organizationID, "", nil).convertList(result), nil
+	return newGenerator(c.client, c.options, c.regions(), "", organizationID, "", nil).convertList(result), nil
 }
 
-func (c *Client) Get(ctx context.Context, organizationID, projectID, clusterID string) (*openapi.ComputeClusterRead, error) {
+// Get returns the cluster, along with the resource version it was read at.
+func (c *Client) Get(ctx context.Context, organizationID, projectID, clusterID string) (*openapi.ComputeClusterRead, string, error) {
 	result, err := c.get(ctx, organizationID, projectID, clusterID)
 	if err != nil {
-		return nil, err
+		return nil, "", err
+	}
+
+	out := newGenerator(c.client, c.options, c.regions(), "", organizationID, "", nil).convert(result)
+
+	c.lookupNetworkStatus(ctx, result, out.Status.Network)
+
+	return out, result.ResourceVersion, nil
+}
+
+// lookupNetworkStatus replaces the requested network configuration with that
+// reported by the region.  This is best effort, as the network may not exist yet,
+// and is only done for single reads to avoid amplifying list requests.
+func (c *Client) lookupNetworkStatus(ctx context.Context, cluster *unikornv1.ComputeCluster, status *openapi.ComputeClusterNetworkStatus) {
+	if status == nil || status.NetworkId == nil {
+		return
+	}
+
+	log := log.FromContext(ctx)
+
+	response, err := c.region.GetApiV1OrganizationsOrganizationIDProjectsProjectIDIdentitiesIdentityIDNetworksNetworkIDWithResponse(ctx, cluster.Labels[constants.OrganizationLabel], cluster.Labels[constants.ProjectLabel], cluster.Annotations[constants.IdentityAnnotation], *status.NetworkId)
+	if err != nil {
+		log.Info("failed to lookup cluster network", "networkID", *status.NetworkId, "error", err)
+
+		return
+	}
+
+	if response.StatusCode() != http.StatusOK {
+		log.Info("failed to lookup cluster network", "networkID", *status.NetworkId, "status", response.StatusCode())
+
+		return
+	}
+
+	network := response.JSON200
+
+	if network.Spec.Prefix != "" {
+		status.Prefix = network.Spec.Prefix
 	}
 
-	return newGenerator(c.client, c.options, region.New(c.region), "", organizationID, "", nil).convert(result), nil
+	if len(network.Spec.DnsNameservers) != 0 {
+		status.DnsNameservers = network.Spec.DnsNameservers
+	}
 }
 
 // get returns the cluster.
@@ -168,36 +276,86 @@ func (c *Client) get(ctx context.Context, organizationID, projectID, clusterID s
 	return resource, nil
 }
 
-func (c *Client) generateAllocations(ctx context.Context, organizationID string, resource *unikornv1.ComputeCluster) (identityapi.ResourceAllocationList, error) {
-	flavors, err := region.New(c.region).Flavors(ctx, organizationID, resource.Spec.RegionID)
+// flavorGPUs returns the number of physical GPUs a flavor has.
+func flavorGPUs(flavors []regionapi.Flavor, flavorID string) (int, error) {
+	flavorByID := func(f regionapi.Flavor) bool {
+		return f.Metadata.Id == flavorID
+	}
+
+	index := slices.IndexFunc(flavors, flavorByID)
+	if index < 0 {
+		return 0, fmt.Errorf("%w: flavorID does not exist", coreerrors.ErrConsistency)
+	}
+
+	flavor := flavors[index]
+
+	if flavor.Spec.Gpu == nil {
+		return 0, nil
+	}
+
+	return flavor.Spec.Gpu.PhysicalCount, nil
+}
+
+func (c *Client) generateAllocations(ctx context.Context, regions region.ClientInterface, organizationID string, resource *unikornv1.ComputeCluster) (identityapi.ResourceAllocationList, error) {
+	flavors, err := regions.Flavors(ctx, organizationID, resource.Spec.RegionID)
 	if err != nil {
 		return nil, err
 	}
 
 	var serversCommitted int
 
+	var serversReserved int
+
 	var gpusCommitted int
 
+	var gpusReserved int
+
 	// NOTE: the control plane is "free".
 	for _, pool := range resource.Spec.WorkloadPools.Pools {
 		serversMinimum := pool.Replicas
 
 		serversCommitted += serversMinimum
 
-		flavorByID := func(f regionapi.Flavor) bool {
-			return f.Metadata.Id == pool.FlavorID
+		gpus, err := flavorGPUs(flavors, pool.FlavorID)
+		if err != nil {
+			return nil, err
+		}
+
+		gpusCommitted += serversMinimum * gpus
+
+		// Autoscaled pools reserve enough headroom to scale up to their maximum.
+		if pool.Autoscaling != nil && pool.Autoscaling.MaxReplicas > pool.Replicas {
+			serversHeadroom := pool.Autoscaling.MaxReplicas - pool.Replicas
+
+			serversReserved += serversHeadroom
+			gpusReserved += serversHeadroom * gpus
+		}
+
+		// Rolling updates may temporarily create surge servers.
+		if pool.UpdateStrategy != nil && pool.UpdateStrategy.MaxSurge > 0 {
+			serversReserved += pool.UpdateStrategy.MaxSurge
+			gpusReserved += pool.UpdateStrategy.MaxSurge * gpus
 		}
+	}
 
-		index := slices.IndexFunc(flavors, flavorByID)
-		if index < 0 {
-			return nil, fmt.Errorf("%w: flavorID does not exist", coreerrors.ErrConsistency)
+	// Resized machines consume their own flavor's GPUs rather than the pool's.
+	for _, override := range managerutil.GetFlavorOverrides(resource) {
+		pool, ok := resource.GetWorkloadPool(override.Pool)
+		if !ok {
+			continue
 		}
 
-		flavor := flavors[index]
+		poolGPUs, err := flavorGPUs(flavors, pool.FlavorID)
+		if err != nil {
+			return nil, err
+		}
 
-		if flavor.Spec.Gpu != nil {
-			gpusCommitted += serversMinimum * flavor.Spec.Gpu.PhysicalCount
+		overrideGPUs, err := flavorGPUs(flavors, override.FlavorID)
+		if err != nil {
+			return nil, err
 		}
+
+		gpusCommitted += overrideGPUs - poolGPUs
 	}
 
 	allocations := identityapi.ResourceAllocationList{
@@ -209,12 +367,12 @@ func (c *Client) generateAllocations(ctx context.Context, organizationID string,
 		{
 			Kind:      "servers",
 			Committed: serversCommitted,
-			Reserved:  0,
+			Reserved:  serversReserved,
 		},
 		{
 			Kind:      "gpus",
 			Committed: gpusCommitted,
-			Reserved:  0,
+			Reserved:  gpusReserved,
 		},
 	}
 
@@ -327,6 +485,37 @@ func metadataMutator(required, current metav1.Object) error {
 		req[constants.AllocationAnnotation] = v
 	}
 
+	// Preserve any cordons.
+	if v, ok := cur[computeconstants.ServerCordonAnnotation]; ok {
+		req[computeconstants.ServerCordonAnnotation] = v
+	}
+
+	// Preserve any evictions, the controller may not have acted upon them yet,
+	// and the pool has already been scaled down.
+	if v, ok := cur[computeconstants.ServerDeletionHintAnnotation]; ok {
+		req[computeconstants.ServerDeletionHintAnnotation] = v
+	}
+
+	// Likewise for detachments.
+	if v, ok := cur[computeconstants.ServerDetachmentAnnotation]; ok {
+		req[computeconstants.ServerDetachmentAnnotation] = v
+	}
+
+	// Likewise for adoptions, the pool has already been scaled up.
+	if v, ok := cur[computeconstants.ServerAdoptionAnnotation]; ok {
+		req[computeconstants.ServerAdoptionAnnotation] = v
+	}
+
+	// Preserve boot completion, machines only report it once.
+	if v, ok := cur[computeconstants.ServerBootFinishedAnnotation]; ok {
+		req[computeconstants.ServerBootFinishedAnnotation] = v
+	}
+
+	// Preserve the specification history.
+	if v, ok := cur[computeconstants.SpecHistoryAnnotation]; ok {
+		req[computeconstants.SpecHistoryAnnotation] = v
+	}
+
 	required.SetAnnotations(req)
 
 	req = required.GetLabels()
@@ -346,9 +535,51 @@ func metadataMutator(required, current metav1.Object) error {
 	return nil
 }
 
+// validateSecurityGroups checks any pre-existing security groups requested for
+// workload pools exist, and are owned by the same project and region as the cluster.
+func (c *Client) validateSecurityGroups(ctx context.Context, organizationID, projectID string, request *openapi.ComputeClusterWrite) error {
+	for i := range request.Spec.WorkloadPools {
+		ids := request.Spec.WorkloadPools[i].Machine.SecurityGroupIds
+		if ids == nil {
+			continue
+		}
+
+		for j, id := range *ids {
+			if slices.Contains((*ids)[:j], id) {
+				return errorsv2.InvalidRequest(openapi.ComputeClusterInvalidSecurityGroup, "security group "+id+" is duplicated")
+			}
+
+			securityGroup, err := region.GetSecurityGroup(ctx, c.region, id)
+			if err != nil {
+				if errors.IsHTTPNotFound(err) || errors.IsForbidden(err) {
+					return errorsv2.InvalidRequest(openapi.ComputeClusterInvalidSecurityGroup, "security group "+id+" does not exist or is not accessible")
+				}
+
+				return err
+			}
+
+			if securityGroup.Metadata.OrganizationId != organizationID || securityGroup.Metadata.ProjectId != projectID {
+				return errorsv2.InvalidRequest(openapi.ComputeClusterInvalidSecurityGroup, "security group "+id+" does not belong to the cluster's project")
+			}
+
+			if securityGroup.Status.RegionId != request.Spec.RegionId {
+				return errorsv2.InvalidRequest(openapi.ComputeClusterInvalidSecurityGroup, "security group "+id+" does not belong to the cluster's region")
+			}
+		}
+	}
+
+	return nil
+}
+
 // Create creates the implicit cluster identified by the JWT claims.
 func (c *Client) Create(ctx context.Context, organizationID, projectID string, request *openapi.ComputeClusterWrite) (*openapi.ComputeClusterRead, error) {
-	cluster, err := newGenerator(c.client, c.options, region.New(c.region), c.namespace, organizationID, projectID, nil).generate(ctx, request)
+	if err := c.validateSecurityGroups(ctx, organizationID, projectID, request); err != nil {
+		return nil, err
+	}
+
+	regions := region.NewMemoized(c.regions())
+
+	cluster, err := newGenerator(c.client, c.options, regions, c.namespace, organizationID, projectID, nil).generate(ctx, request)
 	if err != nil {
 		return nil, err
 	}
@@ -359,7 +590,7 @@ func (c *Client) Create(ctx context.Context, organizationID, projectID string, r
 		return nil, err
 	}
 
-	allocations, err := c.generateAllocations(ctx, organizationID, cluster)
+	allocations, err := c.generateAllocations(ctx, regions, organizationID, cluster)
 	if err != nil {
 		return nil, fmt.Errorf("%w: failed to generate quota allocations", err)
 	}
@@ -376,7 +607,7 @@ func (c *Client) Create(ctx context.Context, organizationID, projectID string, r
 		return nil, fmt.Errorf("%w: failed to create cluster", err)
 	}
 
-	return newGenerator(c.client, c.options, region.New(c.region), "", organizationID, "", nil).convert(cluster), nil
+	return newGenerator(c.client, c.options, c.regions(), "", organizationID, "", nil).convert(cluster), nil
 }
 
 // Delete deletes the implicit cluster identified by the JWT claims.
@@ -397,18 +628,41 @@ func (c *Client) Delete(ctx context.Context, organizationID, projectID, clusterI
 	return nil
 }
 
-// Update implements read/modify/write for the cluster.
-func (c *Client) Update(ctx context.Context, organizationID, projectID, clusterID string, request *openapi.ComputeClusterWrite) error {
+// Update implements read/modify/write for the cluster.  If an entity tag is provided,
+// the update is only applied if the cluster has not been modified since that version
+// was read.
+func (c *Client) Update(ctx context.Context, organizationID, projectID, clusterID string, request *openapi.ComputeClusterWrite, ifMatch *string) error {
 	current, err := c.get(ctx, organizationID, projectID, clusterID)
 	if err != nil {
 		return err
 	}
 
+	if err := handlerutil.CheckIfMatch(current.ResourceVersion, ifMatch); err != nil {
+		return err
+	}
+
 	if current.DeletionTimestamp != nil {
-		return errors.OAuth2InvalidRequest("compute cluster is being deleted")
+		return errorsv2.InvalidRequest(openapi.ComputeClusterDeleting, "compute cluster is being deleted")
+	}
+
+	if err := c.update(ctx, organizationID, projectID, current, request); err != nil {
+		return handlerutil.PreconditionFailed(err, ifMatch)
+	}
+
+	return nil
+}
+
+// update validates and applies a requested specification to an existing cluster.
+// This is shared by anything that replaces the cluster's specification wholesale,
+// so all changes are subject to the same validation.
+func (c *Client) update(ctx context.Context, organizationID, projectID string, current *unikornv1.ComputeCluster, request *openapi.ComputeClusterWrite) error {
+	if err := c.validateSecurityGroups(ctx, organizationID, projectID, request); err != nil {
+		return err
 	}
 
-	required, err := newGenerator(c.client, c.options, region.New(c.region), c.namespace, organizationID, projectID, current).generate(ctx, request)
+	regions := region.NewMemoized(c.regions())
+
+	required, err := newGenerator(c.client, c.options, regions, c.namespace, organizationID, projectID, current).generate(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -424,30 +678,174 @@ func (c *Client) Update(ctx context.Context, organizationID, projectID, clusterI
 	updated.Annotations = required.Annotations
 	updated.Spec = required.Spec
 
+	// Per-machine overrides are preserved, unless they are no longer required.
+	managerutil.PruneMachines(updated, nil)
+
+	if err := managerutil.RecordSpecHistory(updated, current, c.options.SpecHistory); err != nil {
+		return err
+	}
+
+	audit.RecordDiff(ctx, current.Spec, updated.Spec)
+
 	if err := conversion.LogUpdate(ctx, current, updated); err != nil {
 		return fmt.Errorf("%w: failed to log update", err)
 	}
 
-	allocations, err := c.generateAllocations(ctx, organizationID, updated)
+	return saga.Run(ctx, newUpdateSaga(c, regions, organizationID, current, updated))
+}
+
+// Scale sets the replica counts of the named workload pools, keyed by pool name,
+// updating quota allocations to match.  This is used by the autoscaler, which owns
+// the replica counts of autoscaled pools, and returns the updated resource so
+// the caller can go on to record its decision in the status.
+func (c *Client) Scale(ctx context.Context, cluster *unikornv1.ComputeCluster, replicas map[string]int) (*unikornv1.ComputeCluster, error) {
+	if cluster.DeletionTimestamp != nil {
+		return nil, errorsv2.InvalidRequest(openapi.ComputeClusterDeleting, "compute cluster is being deleted")
+	}
+
+	organizationID, ok := cluster.Labels[constants.OrganizationLabel]
+	if !ok {
+		return nil, fmt.Errorf("%w: cluster missing organization label", coreerrors.ErrConsistency)
+	}
+
+	updated := cluster.DeepCopy()
+
+	for name, count := range replicas {
+		pool, ok := updated.GetWorkloadPool(name)
+		if !ok {
+			return nil, fmt.Errorf("%w: workload pool %s not found", coreerrors.ErrConsistency, name)
+		}
+
+		pool.Replicas = count
+	}
+
+	if err := saga.Run(ctx, newUpdateSaga(c, c.regions(), organizationID, cluster, updated)); err != nil {
+		return nil, err
+	}
+
+	return updated, nil
+}
+
+// updateSaga updates a cluster's quota allocations to match its new specification,
+// before the cluster itself so quota is enforced before any change is made.
+type updateSaga struct {
+	client         *Client
+	regions        region.ClientInterface
+	organizationID string
+	current        *unikornv1.ComputeCluster
+	updated        *unikornv1.ComputeCluster
+}
+
+func newUpdateSaga(client *Client, regions region.ClientInterface, organizationID string, current, updated *unikornv1.ComputeCluster) *updateSaga {
+	return &updateSaga{
+		client:         client,
+		regions:        regions,
+		organizationID: organizationID,
+		current:        current,
+		updated:        updated,
+	}
+}
+
+func (s *updateSaga) updateAllocation(ctx context.Context) error {
+	required, err := s.client.generateAllocations(ctx, s.regions, s.organizationID, s.updated)
 	if err != nil {
 		return fmt.Errorf("%w: failed to generate quota allocations", err)
 	}
 
-	if err := identityclient.NewAllocations(c.client, c.identity).Update(ctx, updated, allocations); err != nil {
-		return err
+	return identityclient.NewAllocations(s.client.client, s.client.identity).Update(ctx, s.updated, required)
+}
+
+// reconcileAllocation recalculates allocations from the cluster as it is actually
+// stored, rather than simply reverting them, as the update may have failed due to
+// a conflicting write that we'd otherwise under or over count.
+func (s *updateSaga) reconcileAllocation(ctx context.Context) error {
+	stored := &unikornv1.ComputeCluster{}
+
+	if err := s.client.client.Get(ctx, client.ObjectKeyFromObject(s.current), stored); err != nil {
+		return fmt.Errorf("%w: failed to read cluster", err)
+	}
+
+	if stored.DeletionTimestamp != nil {
+		return nil
+	}
+
+	required, err := s.client.generateAllocations(ctx, s.regions, s.organizationID, stored)
+	if err != nil {
+		return fmt.Errorf("%w: failed to generate quota allocations", err)
 	}
 
-	if err := c.client.Patch(ctx, updated, client.MergeFromWithOptions(current, &client.MergeFromWithOptimisticLock{})); err != nil {
+	return identityclient.NewAllocations(s.client.client, s.client.identity).Update(ctx, stored, required)
+}
+
+func (s *updateSaga) updateCluster(ctx context.Context) error {
+	if err := s.client.client.Patch(ctx, s.updated, client.MergeFromWithOptions(s.current, &client.MergeFromWithOptimisticLock{})); err != nil {
 		return fmt.Errorf("%w: failed to patch cluster", err)
 	}
 
 	return nil
 }
 
+func (s *updateSaga) Actions() []saga.Action {
+	return []saga.Action{
+		saga.NewAction("update quota allocation", s.updateAllocation, s.reconcileAllocation),
+		saga.NewAction("update cluster", s.updateCluster, nil),
+	}
+}
+
+// ExpectedAllocations returns the quota allocations a cluster requires given its
+// current specification, so they can be verified against those held by identity.
+func (c *Client) ExpectedAllocations(ctx context.Context, cluster *unikornv1.ComputeCluster) (identityapi.ResourceAllocationList, error) {
+	organizationID, ok := cluster.Labels[constants.OrganizationLabel]
+	if !ok {
+		return nil, fmt.Errorf("%w: cluster missing organization label", coreerrors.ErrConsistency)
+	}
+
+	return c.generateAllocations(ctx, c.regions(), organizationID, cluster)
+}
+
+// resolveEvictionIDs returns the server IDs requested for eviction.  Host names are
+// resolved to IDs using the cluster's servers, servers being deleted are ignored as
+// a rebuilt server will briefly share its name with the one it replaces.
+func resolveEvictionIDs(servers []regionapi.ServerRead, request *openapi.EvictionWrite) ([]string, error) {
+	var ids []string
+
+	if request.MachineIDs != nil {
+		ids = append(ids, *request.MachineIDs...)
+	}
+
+	if request.Hostnames != nil {
+		for _, hostname := range *request.Hostnames {
+			var matches []string
+
+			for i := range servers {
+				if servers[i].Metadata.DeletionTime == nil && servers[i].Metadata.Name == hostname {
+					matches = append(matches, servers[i].Metadata.Id)
+				}
+			}
+
+			switch len(matches) {
+			case 0:
+				return nil, errorsv2.InvalidRequest(openapi.ComputeMachineNotFound, fmt.Sprintf("requested machine host name %s not found or deleting", hostname))
+			case 1:
+				ids = append(ids, matches[0])
+			default:
+				return nil, errorsv2.InvalidRequest(openapi.ComputeMachineAmbiguousHostName, fmt.Sprintf("requested machine host name %s is ambiguous, use machine IDs instead", hostname))
+			}
+		}
+	}
+
+	if len(ids) == 0 {
+		return nil, errorsv2.InvalidRequest(openapi.ComputeMachineNotSpecified, "at least one machine ID or host name must be specified")
+	}
+
+	return slices.Compact(slices.Sorted(slices.Values(ids))), nil
+}
+
 // Evict is pretty complicated, we need to delete the requested servers from the
 // region service, and update the cluster's pools to remove those instances so they don't
 // just get recreated instantly.  What we do is scale down the cluster, but annotate it
-// with a the list of server IDs we'd like to delete.
+// with a the list of server IDs we'd like to delete.  Machines may be requested by
+// host name, which are resolved to server IDs.
 //
 //nolint:cyclop
 func (c *Client) Evict(ctx context.Context, organizationID, projectID, clusterID string, request *openapi.EvictionWrite) error {
@@ -457,11 +855,11 @@ func (c *Client) Evict(ctx context.Context, organizationID, projectID, clusterID
 	}
 
 	if cluster.DeletionTimestamp != nil {
-		return errors.OAuth2InvalidRequest("compute cluster is being deleted")
+		return errorsv2.InvalidRequest(openapi.ComputeClusterDeleting, "compute cluster is being deleted")
 	}
 
-	if _, ok := cluster.Annotations[computeconstants.ServerDeletionHintAnnotation]; ok {
-		return errors.OAuth2InvalidRequest("eviction is currently pending")
+	if managerutil.EvictionPending(cluster) {
+		return errorsv2.InvalidRequest(openapi.ComputeClusterOperationPending, "eviction is currently pending")
 	}
 
 	// Lookup the servers and ensure they all exist...
@@ -470,12 +868,17 @@ func (c *Client) Evict(ctx context.Context, organizationID, projectID, clusterID
 		return fmt.Errorf("%w: failed to list servers", err)
 	}
 
+	machineIDs, err := resolveEvictionIDs(servers, request)
+	if err != nil {
+		return err
+	}
+
 	servers = slices.DeleteFunc(servers, func(server regionapi.ServerRead) bool {
-		return server.Metadata.DeletionTime != nil || !slices.Contains(request.MachineIDs, server.Metadata.Id)
+		return server.Metadata.DeletionTime != nil || !slices.Contains(machineIDs, server.Metadata.Id)
 	})
 
-	if len(servers) != len(request.MachineIDs) {
-		return errors.OAuth2InvalidRequest("requested machine ID not found or deleting")
+	if len(servers) != len(machineIDs) {
+		return errorsv2.InvalidRequest(openapi.ComputeMachineNotFound, "requested machine ID not found or deleting")
 	}
 
 	updated := cluster.DeepCopy()
@@ -496,112 +899,796 @@ func (c *Client) Evict(ctx context.Context, organizationID, projectID, clusterID
 		pool.Replicas--
 	}
 
-	if updated.Annotations == nil {
-		updated.Annotations = map[string]string{}
-	}
+	managerutil.RequestEvictions(updated, machineIDs, time.Now())
 
-	updated.Annotations[computeconstants.ServerDeletionHintAnnotation] = strings.Join(request.MachineIDs, ",")
+	return saga.Run(ctx, newUpdateSaga(c, c.regions(), organizationID, cluster, updated))
+}
 
-	allocations, err := c.generateAllocations(ctx, organizationID, updated)
-	if err != nil {
-		return fmt.Errorf("%w: failed to generate quota allocations", err)
+// validateAdoptedServer checks an existing server is compatible with the workload
+// pool it is being adopted into.  Servers that differ in flavor or image would be
+// resized or rebuilt as soon as they were adopted, which defeats the purpose.
+func validateAdoptedServer(pool *unikornv1.ComputeClusterWorkloadPoolSpec, networkID string, server *regionapi.ServerRead) error {
+	if server.Metadata.DeletionTime != nil {
+		return errorsv2.InvalidRequest(openapi.ComputeServerNotAdoptable, fmt.Sprintf("server %s is being deleted", server.Metadata.Id))
 	}
 
-	if err := identityclient.NewAllocations(c.client, c.identity).Update(ctx, updated, allocations); err != nil {
-		return err
+	if server.Metadata.Tags != nil {
+		for _, tag := range *server.Metadata.Tags {
+			if tag.Name == constants.ComputeClusterLabel || tag.Name == computeconstants.InstanceIDTag {
+				return errorsv2.InvalidRequest(openapi.ComputeServerNotAdoptable, fmt.Sprintf("server %s is already managed", server.Metadata.Id))
+			}
+		}
 	}
 
-	if err := c.client.Patch(ctx, updated, client.MergeFromWithOptions(cluster, &client.MergeFromWithOptimisticLock{})); err != nil {
-		return fmt.Errorf("%w: failed to patch cluster", err)
+	if server.Spec.FlavorId != pool.FlavorID {
+		return errorsv2.InvalidRequest(openapi.ComputeServerNotAdoptable, fmt.Sprintf("server %s flavor does not match workload pool %s", server.Metadata.Id, pool.Name))
 	}
 
-	return nil
-}
-
-func (c *Client) HardRebootMachine(ctx context.Context, organizationID, projectID, clusterID, machineID string) error {
-	cluster, err := c.get(ctx, organizationID, projectID, clusterID)
-	if err != nil {
-		return err
+	if server.Spec.ImageId != pool.ImageID {
+		return errorsv2.InvalidRequest(openapi.ComputeServerNotAdoptable, fmt.Sprintf("server %s image does not match workload pool %s", server.Metadata.Id, pool.Name))
 	}
 
-	if cluster.DeletionTimestamp != nil {
-		return errors.OAuth2InvalidRequest("compute cluster is being deleted")
+	onNetwork := func(network regionapi.ServerNetwork) bool {
+		return network.Id == networkID
 	}
 
-	if err := region.New(c.region).HardRebootServer(ctx, organizationID, projectID, cluster.Annotations[constants.IdentityAnnotation], machineID); err != nil {
-		return err
+	if !slices.ContainsFunc(server.Spec.Networks, onNetwork) {
+		return errorsv2.InvalidRequest(openapi.ComputeServerNotAdoptable, fmt.Sprintf("server %s is not on the cluster network", server.Metadata.Id))
 	}
 
 	return nil
 }
 
-func (c *Client) SoftRebootMachine(ctx context.Context, organizationID, projectID, clusterID, machineID string) error {
+// Adopt brings existing servers under management of a cluster.  Like eviction, this
+// is a scaling operation, the pool is scaled up and the cluster annotated with the
+// servers to adopt, so the provisioner doesn't create new servers in their place.  The
+// provisioner then tags the servers as members of the pool, as it alone can do so
+// without racing against its own scale down.
+func (c *Client) Adopt(ctx context.Context, organizationID, projectID, clusterID string, request *openapi.AdoptionWrite) error {
 	cluster, err := c.get(ctx, organizationID, projectID, clusterID)
 	if err != nil {
 		return err
 	}
 
 	if cluster.DeletionTimestamp != nil {
-		return errors.OAuth2InvalidRequest("compute cluster is being deleted")
+		return errorsv2.InvalidRequest(openapi.ComputeClusterDeleting, "compute cluster is being deleted")
 	}
 
-	if err := region.New(c.region).SoftRebootServer(ctx, organizationID, projectID, cluster.Annotations[constants.IdentityAnnotation], machineID); err != nil {
+	pending, err := managerutil.GetPendingAdoptions(cluster)
+	if err != nil {
 		return err
 	}
 
-	return nil
-}
+	if len(pending) != 0 {
+		return errorsv2.InvalidRequest(openapi.ComputeClusterOperationPending, "adoption is currently pending")
+	}
 
-func (c *Client) StartMachine(ctx context.Context, organizationID, projectID, clusterID, machineID string) error {
-	cluster, err := c.get(ctx, organizationID, projectID, clusterID)
+	// Once consumed, the previous request can be replaced.
+	adoptions := managerutil.Adoptions{}
+
+	updated := cluster.DeepCopy()
+
+	pool, ok := updated.GetWorkloadPool(request.Pool)
+	if !ok {
+		return errorsv2.InvalidRequest(openapi.ComputeClusterPoolNotFound, fmt.Sprintf("workload pool %s not found", request.Pool))
+	}
+
+	// The autoscaler owns the replica count, and would undo our scale up.
+	if pool.Autoscaling != nil {
+		return errorsv2.InvalidRequest(openapi.ComputeClusterPoolAutoscaled, fmt.Sprintf("workload pool %s is autoscaled", request.Pool))
+	}
+
+	servers, err := region.New(c.region).Servers(ctx, organizationID, cluster)
 	if err != nil {
-		return err
+		return fmt.Errorf("%w: failed to list servers", err)
 	}
 
-	if cluster.DeletionTimestamp != nil {
-		return errors.OAuth2InvalidRequest("compute cluster is being deleted")
+	// Servers are identified by name within a cluster, so these must be unique.
+	names := make([]string, 0, len(servers)+len(request.ServerIDs))
+
+	for i := range servers {
+		names = append(names, servers[i].Metadata.Name)
 	}
 
-	if err := region.New(c.region).StartServer(ctx, organizationID, projectID, cluster.Annotations[constants.IdentityAnnotation], machineID); err != nil {
-		return err
+	for _, serverID := range request.ServerIDs {
+		if _, ok := adoptions[serverID]; ok {
+			return errorsv2.InvalidRequest(openapi.ComputeServerDuplicated, fmt.Sprintf("server %s requested more than once", serverID))
+		}
+
+		// Servers are looked up in the cluster's identity, so anything in another
+		// project or identity will not be found.
+		server, err := region.New(c.region).GetServer(ctx, organizationID, projectID, cluster.Annotations[constants.IdentityAnnotation], serverID)
+		if err != nil {
+			if errors.IsHTTPNotFound(err) {
+				return errorsv2.InvalidRequest(openapi.ComputeServerNotFound, fmt.Sprintf("server %s not found", serverID))
+			}
+
+			return err
+		}
+
+		if err := validateAdoptedServer(pool, cluster.Labels[constants.NetworkLabel], server); err != nil {
+			return err
+		}
+
+		if slices.Contains(names, server.Metadata.Name) {
+			return errorsv2.InvalidRequest(openapi.ComputeServerNotAdoptable, fmt.Sprintf("server %s name %s is already in use by the cluster", serverID, server.Metadata.Name))
+		}
+
+		names = append(names, server.Metadata.Name)
+
+		adoptions[serverID] = request.Pool
 	}
 
-	return nil
+	pool.Replicas += len(request.ServerIDs)
+
+	managerutil.SetAdoptions(updated, adoptions)
+
+	return saga.Run(ctx, newUpdateSaga(c, c.regions(), organizationID, cluster, updated))
 }
 
-func (c *Client) StopMachine(ctx context.Context, organizationID, projectID, clusterID, machineID string) error {
+// DetachMachine removes a machine from a cluster without deleting it.  Like eviction,
+// the machine's pool is scaled down, and the cluster annotated with the machine, so the
+// provisioner knows to remove its membership tags rather than delete something.
+func (c *Client) DetachMachine(ctx context.Context, organizationID, projectID, clusterID, machineID string) error {
 	cluster, err := c.get(ctx, organizationID, projectID, clusterID)
 	if err != nil {
 		return err
 	}
 
 	if cluster.DeletionTimestamp != nil {
-		return errors.OAuth2InvalidRequest("compute cluster is being deleted")
+		return errorsv2.InvalidRequest(openapi.ComputeClusterDeleting, "compute cluster is being deleted")
 	}
 
-	if err := region.New(c.region).StopServer(ctx, organizationID, projectID, cluster.Annotations[constants.IdentityAnnotation], machineID); err != nil {
-		return err
+	// Once consumed, the previous request can be replaced.
+	detachments := managerutil.GetPendingDetachments(cluster)
+
+	if slices.Contains(detachments, machineID) {
+		return nil
 	}
 
-	return nil
-}
+	if slices.Contains(managerutil.GetPendingEvictions(cluster), machineID) {
+		return errorsv2.InvalidRequest(openapi.ComputeClusterOperationPending, "machine eviction is currently pending")
+	}
 
-func (c *Client) CreateConsoleSession(ctx context.Context, organizationID, projectID, clusterID, machineID string) (*regionapi.ConsoleSessionResponse, error) {
-	cluster, err := c.get(ctx, organizationID, projectID, clusterID)
+	servers, err := region.New(c.region).Servers(ctx, organizationID, cluster)
 	if err != nil {
-		return nil, err
+		return fmt.Errorf("%w: failed to list servers", err)
 	}
 
-	if cluster.DeletionTimestamp != nil {
-		return nil, errors.OAuth2InvalidRequest("compute cluster is being deleted")
+	index := slices.IndexFunc(servers, func(server regionapi.ServerRead) bool {
+		return server.Metadata.DeletionTime == nil && server.Metadata.Id == machineID
+	})
+
+	if index < 0 {
+		return errors.HTTPNotFound()
 	}
 
-	resp, err := region.New(c.region).CreateConsoleSession(ctx, organizationID, projectID, cluster.Annotations[constants.IdentityAnnotation], machineID)
+	poolName, err := managerutil.GetWorkloadPoolTag(servers[index].Metadata.Tags)
 	if err != nil {
-		return nil, err
+		return fmt.Errorf("%w: failed to lookup server pool name", err)
 	}
 
-	return resp, nil
+	updated := cluster.DeepCopy()
+
+	pool, ok := updated.GetWorkloadPool(poolName)
+	if !ok {
+		return fmt.Errorf("%w: failed to lookup server pool", coreerrors.ErrConsistency)
+	}
+
+	pool.Replicas--
+
+	managerutil.SetDetachments(updated, append(detachments, machineID))
+
+	return saga.Run(ctx, newUpdateSaga(c, c.regions(), organizationID, cluster, updated))
+}
+
+// Cancel stops the cluster's most recent update from progressing any further.  It's
+// up to the provisioner to observe this and stop cleanly between server operations.
+func (c *Client) Cancel(ctx context.Context, organizationID, projectID, clusterID string) error {
+	cluster, err := c.get(ctx, organizationID, projectID, clusterID)
+	if err != nil {
+		return err
+	}
+
+	if cluster.DeletionTimestamp != nil {
+		return errorsv2.InvalidRequest(openapi.ComputeClusterDeleting, "compute cluster is being deleted")
+	}
+
+	if managerutil.IsCancelled(cluster) {
+		return nil
+	}
+
+	updated := cluster.DeepCopy()
+
+	managerutil.SetCancelled(updated)
+
+	if err := c.client.Patch(ctx, updated, client.MergeFromWithOptions(cluster, &client.MergeFromWithOptimisticLock{})); err != nil {
+		return fmt.Errorf("%w: failed to patch cluster", err)
+	}
+
+	return nil
+}
+
+// Rollback restores the cluster's specification as it was at an earlier generation.
+// The current specification is itself recorded, so a rollback can be undone.  The
+// historical specification is resubmitted as an update, so it's validated against
+// the region as it is now, and images chosen by selector are resolved as they would
+// be by an update.
+func (c *Client) Rollback(ctx context.Context, organizationID, projectID, clusterID string, generation int64) error {
+	current, err := c.get(ctx, organizationID, projectID, clusterID)
+	if err != nil {
+		return err
+	}
+
+	if current.DeletionTimestamp != nil {
+		return errorsv2.InvalidRequest(openapi.ComputeClusterDeleting, "compute cluster is being deleted")
+	}
+
+	if generation == current.Generation {
+		return nil
+	}
+
+	spec, ok, err := managerutil.GetSpecHistoryGeneration(current, generation)
+	if err != nil {
+		return err
+	}
+
+	if !ok {
+		return errorsv2.InvalidRequest(openapi.ComputeClusterUnknownGeneration, fmt.Sprintf("generation %d is not retained in the cluster's history", generation))
+	}
+
+	historical := current.DeepCopy()
+	historical.Spec = *spec.DeepCopy()
+
+	read := newGenerator(c.client, c.options, c.regions(), c.namespace, organizationID, projectID, current).convert(historical)
+
+	request := &openapi.ComputeClusterWrite{
+		Metadata: coreapi.ResourceWriteMetadata{
+			Name:        read.Metadata.Name,
+			Description: read.Metadata.Description,
+			Tags:        read.Metadata.Tags,
+		},
+		Spec: read.Spec,
+	}
+
+	return c.update(ctx, organizationID, projectID, current, request)
+}
+
+// poolCatalog describes a workload pool's current flavor and image, and the
+// flavors and images available in its region.
+type poolCatalog struct {
+	pool    *unikornv1.ComputeClusterWorkloadPoolSpec
+	flavors []regionapi.Flavor
+	images  []regionapi.Image
+	flavor  regionapi.Flavor
+	image   regionapi.Image
+}
+
+// getPoolCatalog looks up a workload pool and the region catalog it is built from.
+func (c *Client) getPoolCatalog(ctx context.Context, organizationID, projectID, clusterID, poolName string) (*poolCatalog, error) {
+	cluster, pool, err := c.getWorkloadPool(ctx, organizationID, projectID, clusterID, poolName)
+	if err != nil {
+		return nil, err
+	}
+
+	regions := c.regions()
+
+	flavors, err := regions.Flavors(ctx, organizationID, cluster.Spec.RegionID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to list flavors", err)
+	}
+
+	images, err := regions.Images(ctx, organizationID, cluster.Spec.RegionID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to list images", err)
+	}
+
+	flavorIndex := slices.IndexFunc(flavors, func(flavor regionapi.Flavor) bool {
+		return flavor.Metadata.Id == pool.FlavorID
+	})
+
+	if flavorIndex < 0 {
+		return nil, fmt.Errorf("%w: pool flavor %s no longer exists", coreerrors.ErrConsistency, pool.FlavorID)
+	}
+
+	imageIndex := slices.IndexFunc(images, func(image regionapi.Image) bool {
+		return image.Metadata.Id == pool.ImageID
+	})
+
+	if imageIndex < 0 {
+		return nil, fmt.Errorf("%w: pool image %s no longer exists", coreerrors.ErrConsistency, pool.ImageID)
+	}
+
+	// Take copies as the compatibility filters modify the lists in place.
+	catalog := &poolCatalog{
+		pool:    pool,
+		flavors: flavors,
+		images:  images,
+		flavor:  flavors[flavorIndex],
+		image:   images[imageIndex],
+	}
+
+	return catalog, nil
+}
+
+// CompatibleImages lists images that can replace the pool's current image without
+// being rejected or failing to rebuild on the pool's current flavor.
+func (c *Client) CompatibleImages(ctx context.Context, organizationID, projectID, clusterID, poolName string) (regionapi.Images, error) {
+	catalog, err := c.getPoolCatalog(ctx, organizationID, projectID, clusterID, poolName)
+	if err != nil {
+		return nil, err
+	}
+
+	return compatibleImages(catalog.pool, &catalog.flavor, catalog.images), nil
+}
+
+// CompatibleFlavors lists flavors that can replace the pool's current flavor without
+// being rejected or failing to rebuild with the pool's current image.
+func (c *Client) CompatibleFlavors(ctx context.Context, organizationID, projectID, clusterID, poolName string) (regionapi.Flavors, error) {
+	catalog, err := c.getPoolCatalog(ctx, organizationID, projectID, clusterID, poolName)
+	if err != nil {
+		return nil, err
+	}
+
+	return compatibleFlavors(catalog.pool, &catalog.image, catalog.flavors), nil
+}
+
+// Evictions reports the progress of the most recent eviction request.  The status is
+// maintained by the controller, however a request that has not yet been observed
+// will only be reflected in the deletion hint, so report those as pending.
+func (c *Client) Evictions(ctx context.Context, organizationID, projectID, clusterID string) (openapi.MachineEvictionsStatus, error) {
+	cluster, err := c.get(ctx, organizationID, projectID, clusterID)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := managerutil.GetPendingEvictions(cluster)
+	if len(ids) == 0 {
+		return convertEvictionsStatus(cluster.Status.Evictions), nil
+	}
+
+	out := make(openapi.MachineEvictionsStatus, len(ids))
+
+	for i, id := range ids {
+		out[i] = openapi.MachineEvictionStatus{
+			Id:     id,
+			Status: openapi.Pending,
+		}
+
+		index := slices.IndexFunc(cluster.Status.Evictions, func(status unikornv1.MachineEvictionStatus) bool {
+			return status.ID == id
+		})
+
+		if index >= 0 {
+			out[i] = *convertEvictionStatus(&cluster.Status.Evictions[index])
+		}
+	}
+
+	return out, nil
+}
+
+// Events returns recent provisioning events recorded by the controller against the cluster.
+func (c *Client) Events(ctx context.Context, organizationID, projectID, clusterID string) (openapi.ClusterEvents, error) {
+	cluster, err := c.get(ctx, organizationID, projectID, clusterID)
+	if err != nil {
+		return nil, err
+	}
+
+	events, err := c.events(ctx, cluster)
+	if err != nil {
+		return nil, err
+	}
+
+	return convertEvents(events), nil
+}
+
+// events lists provisioning events recorded against the cluster.
+func (c *Client) events(ctx context.Context, cluster *unikornv1.ComputeCluster) ([]corev1.Event, error) {
+	options := &client.ListOptions{
+		Namespace: cluster.Namespace,
+		LabelSelector: labels.SelectorFromSet(map[string]string{
+			computeconstants.ClusterLabel: cluster.Name,
+		}),
+	}
+
+	result := &corev1.EventList{}
+
+	if err := c.client.List(ctx, result, options); err != nil {
+		return nil, fmt.Errorf("%w: unable to list cluster events", err)
+	}
+
+	// Cluster names may be reused, so ignore any events for a previous incarnation.
+	return slices.DeleteFunc(result.Items, func(event corev1.Event) bool {
+		return event.InvolvedObject.UID != cluster.UID
+	}), nil
+}
+
+// liveServerIDs returns the IDs of servers that are not being deleted.  Per-machine
+// overrides belong to the API, so it's up to us to prune those of servers that have
+// since gone.
+func liveServerIDs(servers []regionapi.ServerRead) []string {
+	var ids []string
+
+	for i := range servers {
+		if servers[i].Metadata.DeletionTime == nil {
+			ids = append(ids, servers[i].Metadata.Id)
+		}
+	}
+
+	return ids
+}
+
+// ResizeMachine changes the flavor of a single machine.  Machines are defined by their
+// pool, so the new flavor is recorded as a per-machine override that the provisioner
+// will apply in place where the region supports it, or by rebuilding the machine.
+func (c *Client) ResizeMachine(ctx context.Context, organizationID, projectID, clusterID, machineID string, request *openapi.MachineResizeWrite) error {
+	cluster, err := c.get(ctx, organizationID, projectID, clusterID)
+	if err != nil {
+		return err
+	}
+
+	if cluster.DeletionTimestamp != nil {
+		return errorsv2.InvalidRequest(openapi.ComputeClusterDeleting, "compute cluster is being deleted")
+	}
+
+	regions := region.NewMemoized(c.regions())
+
+	servers, err := region.New(c.region).Servers(ctx, organizationID, cluster)
+	if err != nil {
+		return fmt.Errorf("%w: failed to list servers", err)
+	}
+
+	index := slices.IndexFunc(servers, func(server regionapi.ServerRead) bool {
+		return server.Metadata.DeletionTime == nil && server.Metadata.Id == machineID
+	})
+
+	if index < 0 {
+		return errors.HTTPNotFound()
+	}
+
+	poolName, err := managerutil.GetWorkloadPoolTag(servers[index].Metadata.Tags)
+	if err != nil {
+		return fmt.Errorf("%w: failed to lookup server pool name", err)
+	}
+
+	pool, ok := cluster.GetWorkloadPool(poolName)
+	if !ok {
+		return fmt.Errorf("%w: failed to lookup server pool", coreerrors.ErrConsistency)
+	}
+
+	flavors, err := regions.Flavors(ctx, organizationID, cluster.Spec.RegionID)
+	if err != nil {
+		return err
+	}
+
+	if !slices.ContainsFunc(flavors, func(f regionapi.Flavor) bool { return f.Metadata.Id == request.FlavorId }) {
+		return errorsv2.InvalidRequest(openapi.ComputeClusterInvalidFlavor, "requested flavor does not exist")
+	}
+
+	if managerutil.IsCordoned(cluster, machineID) {
+		return errorsv2.InvalidRequest(openapi.ComputeMachineCordoned, "machine is cordoned")
+	}
+
+	updated := cluster.DeepCopy()
+
+	machine := managerutil.GetMachine(updated, machineID, pool.Name)
+	machine.FlavorID = ptr.To(request.FlavorId)
+
+	managerutil.SetMachine(updated, machine)
+	managerutil.PruneMachines(updated, liveServerIDs(servers))
+
+	return saga.Run(ctx, newUpdateSaga(c, regions, organizationID, cluster, updated))
+}
+
+// setMachinePublicIP attaches or detaches a machine's public IP.  Like resizes, this is
+// recorded as a per-machine override that the provisioner applies in place.
+func (c *Client) setMachinePublicIP(ctx context.Context, organizationID, projectID, clusterID, machineID string, enabled bool) error {
+	cluster, err := c.get(ctx, organizationID, projectID, clusterID)
+	if err != nil {
+		return err
+	}
+
+	if cluster.DeletionTimestamp != nil {
+		return errorsv2.InvalidRequest(openapi.ComputeClusterDeleting, "compute cluster is being deleted")
+	}
+
+	servers, err := region.New(c.region).Servers(ctx, organizationID, cluster)
+	if err != nil {
+		return fmt.Errorf("%w: failed to list servers", err)
+	}
+
+	index := slices.IndexFunc(servers, func(server regionapi.ServerRead) bool {
+		return server.Metadata.DeletionTime == nil && server.Metadata.Id == machineID
+	})
+
+	if index < 0 {
+		return errors.HTTPNotFound()
+	}
+
+	poolName, err := managerutil.GetWorkloadPoolTag(servers[index].Metadata.Tags)
+	if err != nil {
+		return fmt.Errorf("%w: failed to lookup server pool name", err)
+	}
+
+	pool, ok := cluster.GetWorkloadPool(poolName)
+	if !ok {
+		return fmt.Errorf("%w: failed to lookup server pool", coreerrors.ErrConsistency)
+	}
+
+	if managerutil.IsCordoned(cluster, machineID) {
+		return errorsv2.InvalidRequest(openapi.ComputeMachineCordoned, "machine is cordoned")
+	}
+
+	updated := cluster.DeepCopy()
+
+	machine := managerutil.GetMachine(updated, machineID, pool.Name)
+	machine.PublicIP = ptr.To(enabled)
+
+	managerutil.SetMachine(updated, machine)
+	managerutil.PruneMachines(updated, liveServerIDs(servers))
+
+	if err := c.client.Patch(ctx, updated, client.MergeFromWithOptions(cluster, &client.MergeFromWithOptimisticLock{})); err != nil {
+		return fmt.Errorf("%w: failed to patch cluster", err)
+	}
+
+	return nil
+}
+
+// AttachMachinePublicIP allocates a public IP to a machine.
+func (c *Client) AttachMachinePublicIP(ctx context.Context, organizationID, projectID, clusterID, machineID string) error {
+	return c.setMachinePublicIP(ctx, organizationID, projectID, clusterID, machineID, true)
+}
+
+// DetachMachinePublicIP releases a machine's public IP.
+func (c *Client) DetachMachinePublicIP(ctx context.Context, organizationID, projectID, clusterID, machineID string) error {
+	return c.setMachinePublicIP(ctx, organizationID, projectID, clusterID, machineID, false)
+}
+
+// setMachineCordon cordons or uncordons a machine.
+func (c *Client) setMachineCordon(ctx context.Context, organizationID, projectID, clusterID, machineID string, cordon bool) error {
+	cluster, err := c.get(ctx, organizationID, projectID, clusterID)
+	if err != nil {
+		return err
+	}
+
+	if cluster.DeletionTimestamp != nil {
+		return errorsv2.InvalidRequest(openapi.ComputeClusterDeleting, "compute cluster is being deleted")
+	}
+
+	cordoned := managerutil.GetCordoned(cluster)
+
+	// Uncordoning doesn't require the machine to exist, so stale cordons can be removed.
+	if !cordon {
+		if !slices.Contains(cordoned, machineID) {
+			return errors.HTTPNotFound()
+		}
+
+		cordoned = slices.DeleteFunc(cordoned, func(id string) bool {
+			return id == machineID
+		})
+	} else {
+		servers, err := region.New(c.region).Servers(ctx, organizationID, cluster)
+		if err != nil {
+			return fmt.Errorf("%w: failed to list servers", err)
+		}
+
+		if !slices.ContainsFunc(servers, func(server regionapi.ServerRead) bool {
+			return server.Metadata.DeletionTime == nil && server.Metadata.Id == machineID
+		}) {
+			return errors.HTTPNotFound()
+		}
+
+		if slices.Contains(cordoned, machineID) {
+			return nil
+		}
+
+		live := liveServerIDs(servers)
+
+		cordoned = slices.DeleteFunc(cordoned, func(id string) bool {
+			return !slices.Contains(live, id)
+		})
+
+		cordoned = append(cordoned, machineID)
+	}
+
+	updated := cluster.DeepCopy()
+
+	managerutil.SetCordoned(updated, cordoned)
+
+	if err := c.client.Patch(ctx, updated, client.MergeFromWithOptions(cluster, &client.MergeFromWithOptimisticLock{})); err != nil {
+		return fmt.Errorf("%w: failed to patch cluster", err)
+	}
+
+	return nil
+}
+
+// SetMaintenanceMode enables or disables maintenance mode, while enabled no servers are
+// created, deleted, rebuilt or resized, however the cluster's status is still updated.
+func (c *Client) SetMaintenanceMode(ctx context.Context, organizationID, projectID, clusterID string, enabled bool) error {
+	cluster, err := c.get(ctx, organizationID, projectID, clusterID)
+	if err != nil {
+		return err
+	}
+
+	if cluster.DeletionTimestamp != nil {
+		return errorsv2.InvalidRequest(openapi.ComputeClusterDeleting, "compute cluster is being deleted")
+	}
+
+	if cluster.Spec.MaintenanceMode == enabled {
+		return nil
+	}
+
+	updated := cluster.DeepCopy()
+	updated.Spec.MaintenanceMode = enabled
+
+	if err := c.client.Patch(ctx, updated, client.MergeFromWithOptions(cluster, &client.MergeFromWithOptimisticLock{})); err != nil {
+		return fmt.Errorf("%w: failed to patch cluster", err)
+	}
+
+	return nil
+}
+
+// CordonMachine excludes a machine from updates, rebuilds and scale down.
+func (c *Client) CordonMachine(ctx context.Context, organizationID, projectID, clusterID, machineID string) error {
+	return c.setMachineCordon(ctx, organizationID, projectID, clusterID, machineID, true)
+}
+
+// UncordonMachine returns a machine to normal management.
+func (c *Client) UncordonMachine(ctx context.Context, organizationID, projectID, clusterID, machineID string) error {
+	return c.setMachineCordon(ctx, organizationID, projectID, clusterID, machineID, false)
+}
+
+// BootFinished records that cloud-init has finished on a machine.  This is called by
+// the machine itself, so there is no principal, instead the request is authenticated
+// by a signature only the controller and this service can generate.
+func (c *Client) BootFinished(ctx context.Context, clusterID, hostname, signature string) error {
+	update := func() error {
+		cluster := &unikornv1.ComputeCluster{}
+
+		if err := c.client.Get(ctx, client.ObjectKey{Namespace: c.namespace, Name: clusterID}, cluster); err != nil {
+			if kerrors.IsNotFound(err) {
+				return errors.HTTPNotFound().WithError(err)
+			}
+
+			return fmt.Errorf("%w: unable to get cluster", err)
+		}
+
+		token := cluster.Status.PhoneHomeToken
+		if token == nil {
+			return errors.HTTPNotFound()
+		}
+
+		if !managerutil.VerifyPhoneHomeSignature(*token, clusterID, hostname, signature) {
+			return errors.HTTPForbidden("invalid signature")
+		}
+
+		// Only retain machines that still exist, so the annotation doesn't grow
+		// as servers are replaced.
+		var hostnames []string
+
+		for i := range cluster.Status.WorkloadPools {
+			for j := range cluster.Status.WorkloadPools[i].Machines {
+				hostnames = append(hostnames, cluster.Status.WorkloadPools[i].Machines[j].Hostname)
+			}
+		}
+
+		if !slices.Contains(hostnames, hostname) {
+			return errors.HTTPNotFound()
+		}
+
+		finished := slices.DeleteFunc(managerutil.GetBootFinished(cluster), func(name string) bool {
+			return !slices.Contains(hostnames, name)
+		})
+
+		updated := cluster.DeepCopy()
+
+		managerutil.SetBootFinished(updated, append(finished, hostname))
+
+		if err := c.client.Patch(ctx, updated, client.MergeFromWithOptions(cluster, &client.MergeFromWithOptimisticLock{})); err != nil {
+			return fmt.Errorf("%w: failed to patch cluster", err)
+		}
+
+		return nil
+	}
+
+	// Machines in a pool will typically boot, and therefore call in, at the same time.
+	return retry.RetryOnConflict(retry.DefaultRetry, update)
+}
+
+func (c *Client) HardRebootMachine(ctx context.Context, organizationID, projectID, clusterID, machineID string) error {
+	cluster, err := c.get(ctx, organizationID, projectID, clusterID)
+	if err != nil {
+		return err
+	}
+
+	if cluster.DeletionTimestamp != nil {
+		return errorsv2.InvalidRequest(openapi.ComputeClusterDeleting, "compute cluster is being deleted")
+	}
+
+	if err := region.New(c.region).HardRebootServer(ctx, organizationID, projectID, cluster.Annotations[constants.IdentityAnnotation], machineID); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (c *Client) SoftRebootMachine(ctx context.Context, organizationID, projectID, clusterID, machineID string) error {
+	cluster, err := c.get(ctx, organizationID, projectID, clusterID)
+	if err != nil {
+		return err
+	}
+
+	if cluster.DeletionTimestamp != nil {
+		return errorsv2.InvalidRequest(openapi.ComputeClusterDeleting, "compute cluster is being deleted")
+	}
+
+	if err := region.New(c.region).SoftRebootServer(ctx, organizationID, projectID, cluster.Annotations[constants.IdentityAnnotation], machineID); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (c *Client) StartMachine(ctx context.Context, organizationID, projectID, clusterID, machineID string) error {
+	cluster, err := c.get(ctx, organizationID, projectID, clusterID)
+	if err != nil {
+		return err
+	}
+
+	if cluster.DeletionTimestamp != nil {
+		return errorsv2.InvalidRequest(openapi.ComputeClusterDeleting, "compute cluster is being deleted")
+	}
+
+	if err := region.New(c.region).StartServer(ctx, organizationID, projectID, cluster.Annotations[constants.IdentityAnnotation], machineID); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (c *Client) StopMachine(ctx context.Context, organizationID, projectID, clusterID, machineID string) error {
+	cluster, err := c.get(ctx, organizationID, projectID, clusterID)
+	if err != nil {
+		return err
+	}
+
+	if cluster.DeletionTimestamp != nil {
+		return errorsv2.InvalidRequest(openapi.ComputeClusterDeleting, "compute cluster is being deleted")
+	}
+
+	if err := region.New(c.region).StopServer(ctx, organizationID, projectID, cluster.Annotations[constants.IdentityAnnotation], machineID); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (c *Client) CreateConsoleSession(ctx context.Context, organizationID, projectID, clusterID, machineID string) (*regionapi.ConsoleSessionResponse, error) {
+	cluster, err := c.get(ctx, organizationID, projectID, clusterID)
+	if err != nil {
+		return nil, err
+	}
+
+	if cluster.DeletionTimestamp != nil {
+		return nil, errorsv2.InvalidRequest(openapi.ComputeClusterDeleting, "compute cluster is being deleted")
+	}
+
+	resp, err := region.New(c.region).CreateConsoleSession(ctx, organizationID, projectID, cluster.Annotations[constants.IdentityAnnotation], machineID)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// ListMachines returns the machines in every workload pool of the cluster.
+func (c *Client) ListMachines(ctx context.Context, organizationID, projectID, clusterID string, params *openapi.GetApiV1OrganizationsOrganizationIDProjectsProjectIDClustersClusterIDMachinesParams) (openapi.ComputeClusterMachineList, error) {
+	cluster, err := c.get(ctx, organizationID, projectID, clusterID)
+	if err != nil {
+		return nil, err
+	}
+
+	return convertMachines(cluster, params), nil
 }
 
 func (c *Client) GetConsoleOutput(ctx context.Context, organizationID, projectID, clusterID, machineID string, params *openapi.GetApiV1OrganizationsOrganizationIDProjectsProjectIDClustersClusterIDMachinesMachineIDConsoleoutputParams) (*regionapi.ConsoleOutputResponse, error) {
@@ -611,7 +1698,7 @@ func (c *Client) GetConsoleOutput(ctx context.Context, organizationID, projectID
 	}
 
 	if cluster.DeletionTimestamp != nil {
-		return nil, errors.OAuth2InvalidRequest("compute cluster is being deleted")
+		return nil, errorsv2.InvalidRequest(openapi.ComputeClusterDeleting, "compute cluster is being deleted")
 	}
 
 	p := &regionapi.GetApiV1OrganizationsOrganizationIDProjectsProjectIDIdentitiesIdentityIDServersServerIDConsoleoutputParams{
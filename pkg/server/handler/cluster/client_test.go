@@ -0,0 +1,550 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	unikornv1 "github.com/unikorn-cloud/compute/pkg/apis/unikorn/v1alpha1"
+	"github.com/unikorn-cloud/compute/pkg/constants"
+	"github.com/unikorn-cloud/compute/pkg/openapi"
+	managerutil "github.com/unikorn-cloud/compute/pkg/provisioners/managers/cluster/util"
+	"github.com/unikorn-cloud/compute/pkg/server/errorsv2"
+	"github.com/unikorn-cloud/compute/pkg/server/handler/cluster"
+	regionmock "github.com/unikorn-cloud/compute/pkg/server/handler/region/mock"
+	unikornv1core "github.com/unikorn-cloud/core/pkg/apis/unikorn/v1alpha1"
+	coreconstants "github.com/unikorn-cloud/core/pkg/constants"
+	coreapi "github.com/unikorn-cloud/core/pkg/openapi"
+	"github.com/unikorn-cloud/core/pkg/server/conversion"
+	coreerrors "github.com/unikorn-cloud/core/pkg/server/errors"
+	identityapi "github.com/unikorn-cloud/identity/pkg/openapi"
+	identitymock "github.com/unikorn-cloud/identity/pkg/openapi/mock"
+	regionapi "github.com/unikorn-cloud/region/pkg/openapi"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+)
+
+var errPatch = errors.New("patch failed")
+
+// updateSagaFixture returns a cluster with enough metadata to satisfy allocation
+// updates, and a single GPU pool of the requested size.
+func updateSagaFixture(replicas int) *unikornv1.ComputeCluster {
+	return &unikornv1.ComputeCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      "cluster",
+			Labels: map[string]string{
+				coreconstants.OrganizationLabel: organizationID,
+				coreconstants.ProjectLabel:      projectID,
+			},
+			Annotations: map[string]string{
+				coreconstants.AllocationAnnotation: "allocation",
+			},
+		},
+		Spec: unikornv1.ComputeClusterSpec{
+			RegionID: "region",
+			WorkloadPools: &unikornv1.ComputeClusterWorkloadPoolsSpec{
+				Pools: []unikornv1.ComputeClusterWorkloadPoolSpec{
+					{
+						Name: "pool",
+						MachineGeneric: unikornv1core.MachineGeneric{
+							FlavorID: "gpu",
+							Replicas: replicas,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// TestUpdateSagaReconcile verifies that when the cluster update fails, the quota
+// allocation is recalculated from the stored cluster, including any conflicting
+// write that caused the failure, rather than being left under-counted.
+func TestUpdateSagaReconcile(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+
+	var gpus []int
+
+	mockIdentity := identitymock.NewMockClientWithResponsesInterface(ctrl)
+	mockIdentity.EXPECT().
+		PutApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsAllocationIDWithResponse(gomock.Any(), organizationID, projectID, "allocation", gomock.Any()).
+		DoAndReturn(func(_ context.Context, _, _, _ string, body identityapi.AllocationWrite, _ ...identityapi.RequestEditorFn) (*identityapi.PutApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsAllocationIDResponse, error) {
+			for _, allocation := range body.Spec.Allocations {
+				if allocation.Kind == "gpus" {
+					gpus = append(gpus, allocation.Committed)
+				}
+			}
+
+			return &identityapi.PutApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsAllocationIDResponse{
+				HTTPResponse: &http.Response{StatusCode: http.StatusOK},
+			}, nil
+		}).
+		Times(2)
+
+	mockRegion := regionmock.NewMockClientInterface(ctrl)
+	mockRegion.EXPECT().
+		Flavors(gomock.Any(), organizationID, "region").
+		Return([]regionapi.Flavor{
+			{
+				Metadata: coreapi.StaticResourceMetadata{Id: "gpu"},
+				Spec: regionapi.FlavorSpec{
+					Gpu: &regionapi.GpuSpec{
+						PhysicalCount: 8,
+					},
+				},
+			},
+		}, nil).
+		AnyTimes()
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, unikornv1.AddToScheme(scheme))
+
+	mapper := meta.NewDefaultRESTMapper(nil)
+	mapper.Add(unikornv1.SchemeGroupVersion.WithKind("ComputeCluster"), meta.RESTScopeNamespace)
+
+	// Someone else has scaled the cluster up since we read it.
+	cli := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithRESTMapper(mapper).
+		WithObjects(updateSagaFixture(3)).
+		WithInterceptorFuncs(interceptor.Funcs{
+			Patch: func(context.Context, client.WithWatch, client.Object, client.Patch, ...client.PatchOption) error {
+				return errPatch
+			},
+		}).
+		Build()
+
+	c := cluster.NewClient(cli, "default", &cluster.Options{}, mockIdentity, nil)
+
+	current := updateSagaFixture(2)
+	updated := updateSagaFixture(1)
+
+	err := cluster.RunUpdateSaga(t.Context(), c, mockRegion, organizationID, current, updated)
+	require.ErrorIs(t, err, errPatch)
+	require.Equal(t, []int{8, 24}, gpus)
+}
+
+// TestRollbackUnknownGeneration checks rolling back to a generation that isn't
+// retained is rejected with a code clients can act on.
+func TestRollbackUnknownGeneration(t *testing.T) {
+	t.Parallel()
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, unikornv1.AddToScheme(scheme))
+
+	cli := fake.NewClientBuilder().WithScheme(scheme).WithObjects(updateSagaFixture(1)).Build()
+
+	c := cluster.NewClient(cli, "default", &cluster.Options{SpecHistory: 5}, nil, nil)
+
+	err := c.Rollback(t.Context(), organizationID, projectID, "cluster", 42)
+	require.Error(t, err)
+
+	code, ok := errorsv2.CodeOf(err)
+	require.True(t, ok)
+	require.Equal(t, openapi.ComputeClusterUnknownGeneration, code)
+}
+
+// TestRollbackValidated checks rolling back is subject to the same validation as an
+// update, so a specification that's no longer valid in the region is rejected.
+func TestRollbackValidated(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+
+	mockRegion := regionmock.NewMockClientInterface(ctrl)
+	mockRegion.EXPECT().
+		List(gomock.Any(), organizationID).
+		Return([]regionapi.RegionRead{
+			{
+				Metadata: coreapi.ResourceReadMetadata{Id: "region"},
+			},
+		}, nil).
+		AnyTimes()
+	mockRegion.EXPECT().
+		Flavors(gomock.Any(), organizationID, "region").
+		Return([]regionapi.Flavor{
+			{
+				Metadata: coreapi.StaticResourceMetadata{Id: "gpu"},
+			},
+		}, nil).
+		AnyTimes()
+
+	// The previous generation used a flavor that has since been retired.
+	previous := updateSagaFixture(1)
+	previous.Generation = 1
+	previous.Spec.WorkloadPools.Pools[0].FlavorID = "retired"
+
+	current := updateSagaFixture(1)
+	current.Generation = 2
+
+	require.NoError(t, managerutil.RecordSpecHistory(current, previous, 5))
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, unikornv1.AddToScheme(scheme))
+
+	cli := fake.NewClientBuilder().WithScheme(scheme).WithObjects(current).Build()
+
+	c := cluster.NewClient(cli, "default", &cluster.Options{SpecHistory: 5}, nil, nil).WithRegionCache(mockRegion)
+
+	err := c.Rollback(t.Context(), organizationID, projectID, "cluster", 1)
+	require.Error(t, err)
+
+	code, ok := errorsv2.CodeOf(err)
+	require.True(t, ok)
+	require.Equal(t, openapi.ComputeClusterInvalidFlavor, code)
+}
+
+// TestValidateAdoptedServer checks servers are only adopted when they match the
+// workload pool, and are not managed by anything else.
+func TestValidateAdoptedServer(t *testing.T) {
+	t.Parallel()
+
+	pool := &unikornv1.ComputeClusterWorkloadPoolSpec{
+		Name: "default",
+		MachineGeneric: unikornv1core.MachineGeneric{
+			FlavorID: "flavor",
+			ImageID:  "image",
+		},
+	}
+
+	server := func() *regionapi.ServerRead {
+		return &regionapi.ServerRead{
+			Metadata: coreapi.ProjectScopedResourceReadMetadata{
+				Id:   "server",
+				Name: "server",
+			},
+			Spec: regionapi.ServerSpec{
+				FlavorId: "flavor",
+				ImageId:  "image",
+				Networks: regionapi.ServerNetworkList{
+					{Id: "network"},
+				},
+			},
+		}
+	}
+
+	require.NoError(t, cluster.ValidateAdoptedServer(pool, "network", server()))
+	require.Error(t, cluster.ValidateAdoptedServer(pool, "other", server()))
+
+	s := server()
+	s.Spec.FlavorId = "other"
+	require.Error(t, cluster.ValidateAdoptedServer(pool, "network", s))
+
+	s = server()
+	s.Spec.ImageId = "other"
+	require.Error(t, cluster.ValidateAdoptedServer(pool, "network", s))
+
+	s = server()
+	s.Metadata.Tags = &coreapi.TagList{
+		{Name: coreconstants.ComputeClusterLabel, Value: "other"},
+	}
+	require.Error(t, cluster.ValidateAdoptedServer(pool, "network", s))
+
+	s = server()
+	s.Metadata.DeletionTime = &time.Time{}
+	require.Error(t, cluster.ValidateAdoptedServer(pool, "network", s))
+}
+
+// TestResolveEvictionIDs checks host names are resolved to machine IDs, ignoring
+// machines being deleted, and that unknown or ambiguous host names are rejected.
+func TestResolveEvictionIDs(t *testing.T) {
+	t.Parallel()
+
+	server := func(id, name string, deleting bool) regionapi.ServerRead {
+		s := regionapi.ServerRead{
+			Metadata: coreapi.ProjectScopedResourceReadMetadata{
+				Id:   id,
+				Name: name,
+			},
+		}
+
+		if deleting {
+			s.Metadata.DeletionTime = &time.Time{}
+		}
+
+		return s
+	}
+
+	servers := []regionapi.ServerRead{
+		server("id-a", "host-a", false),
+		server("id-b", "host-b", false),
+		server("id-c", "host-b", false),
+		server("id-d", "host-d", true),
+		server("id-e", "host-d", false),
+	}
+
+	request := &openapi.EvictionWrite{
+		MachineIDs: &openapi.MachineIDList{"id-a"},
+		Hostnames:  &[]string{"host-a", "host-d"},
+	}
+
+	ids, err := cluster.ResolveEvictionIDs(servers, request)
+	require.NoError(t, err)
+	require.Equal(t, []string{"id-a", "id-e"}, ids)
+
+	_, err = cluster.ResolveEvictionIDs(servers, &openapi.EvictionWrite{Hostnames: &[]string{"host-b"}})
+	require.Error(t, err)
+
+	_, err = cluster.ResolveEvictionIDs(servers, &openapi.EvictionWrite{Hostnames: &[]string{"host-z"}})
+	require.Error(t, err)
+
+	_, err = cluster.ResolveEvictionIDs(servers, &openapi.EvictionWrite{})
+	require.Error(t, err)
+}
+
+// TestMetadataMutatorPreservesControllerAnnotations checks that a cluster update,
+// which is generated without any of the annotations set by machine operations,
+// doesn't discard requests the controller has yet to act upon.
+func TestMetadataMutatorPreservesControllerAnnotations(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		annotation string
+		value      string
+	}{
+		{
+			name:       "Eviction",
+			annotation: constants.ServerDeletionHintAnnotation,
+			value:      "server-a",
+		},
+		{
+			name:       "Detachment",
+			annotation: constants.ServerDetachmentAnnotation,
+			value:      "server-a",
+		},
+		{
+			name:       "Adoption",
+			annotation: constants.ServerAdoptionAnnotation,
+			value:      "server-a=pool",
+		},
+		{
+			name:       "BootFinished",
+			annotation: constants.ServerBootFinishedAnnotation,
+			value:      "machine-a",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			current := updateSagaFixture(1)
+			current.Annotations[test.annotation] = test.value
+
+			required := updateSagaFixture(1)
+
+			require.NoError(t, conversion.UpdateObjectMetadata(required, current, cluster.MetadataMutator))
+			require.Equal(t, test.value, required.Annotations[test.annotation])
+		})
+	}
+}
+
+// TestUpdateRacingDetachment checks that a detachment requested before an update,
+// but not yet acted upon by the controller, is still pending after the update
+// is applied, otherwise the controller would delete the machine to satisfy the
+// reduced pool size.
+func TestUpdateRacingDetachment(t *testing.T) {
+	t.Parallel()
+
+	current := updateSagaFixture(1)
+	managerutil.SetDetachments(current, []string{"server-a"})
+
+	required := updateSagaFixture(0)
+
+	require.NoError(t, conversion.UpdateObjectMetadata(required, current, cluster.MetadataMutator))
+	require.Equal(t, []string{"server-a"}, managerutil.GetPendingDetachments(required))
+
+	// Once consumed, a subsequent update must not resurrect the request.
+	managerutil.ConsumeDetachments(current)
+
+	required = updateSagaFixture(0)
+	required.Status = current.Status
+
+	require.NoError(t, conversion.UpdateObjectMetadata(required, current, cluster.MetadataMutator))
+	require.Empty(t, managerutil.GetPendingDetachments(required))
+}
+
+// TestUpdateRacingAdoption checks that an adoption requested before an update,
+// but not yet acted upon by the controller, is still pending after the update
+// is applied, otherwise the controller would create a new machine in place of
+// the adopted one.
+func TestUpdateRacingAdoption(t *testing.T) {
+	t.Parallel()
+
+	current := updateSagaFixture(2)
+	managerutil.SetAdoptions(current, managerutil.Adoptions{"server-a": "pool"})
+
+	required := updateSagaFixture(2)
+
+	require.NoError(t, conversion.UpdateObjectMetadata(required, current, cluster.MetadataMutator))
+
+	pending, err := managerutil.GetPendingAdoptions(required)
+	require.NoError(t, err)
+	require.Equal(t, managerutil.Adoptions{"server-a": "pool"}, pending)
+
+	// Once consumed, a subsequent update must not resurrect the request.
+	managerutil.ConsumeAdoptions(current)
+
+	required = updateSagaFixture(2)
+	required.Status = current.Status
+
+	require.NoError(t, conversion.UpdateObjectMetadata(required, current, cluster.MetadataMutator))
+
+	pending, err = managerutil.GetPendingAdoptions(required)
+	require.NoError(t, err)
+	require.Empty(t, pending)
+}
+
+// newSecurityGroupRegion serves the security groups from a fake region service, any
+// that aren't defined are reported as not found.
+func newSecurityGroupRegion(t *testing.T, securityGroups ...regionapi.SecurityGroupV2Read) regionapi.ClientWithResponsesInterface {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		for i := range securityGroups {
+			if r.URL.Path == "/api/v2/securitygroups/"+securityGroups[i].Metadata.Id {
+				require.NoError(t, json.NewEncoder(w).Encode(&securityGroups[i]))
+
+				return
+			}
+		}
+
+		coreerrors.HTTPNotFound().Write(w, r)
+	}))
+
+	t.Cleanup(server.Close)
+
+	client, err := regionapi.NewClientWithResponses(server.URL)
+	require.NoError(t, err)
+
+	return client
+}
+
+func securityGroupFixture(id, organizationID, projectID, regionID string) regionapi.SecurityGroupV2Read {
+	securityGroup := regionapi.SecurityGroupV2Read{}
+	securityGroup.Metadata.Id = id
+	securityGroup.Metadata.OrganizationId = organizationID
+	securityGroup.Metadata.ProjectId = projectID
+	securityGroup.Status.RegionId = regionID
+
+	return securityGroup
+}
+
+// TestValidateSecurityGroups checks pre-existing security groups must exist, be
+// unique, and belong to the same project and region as the cluster.
+func TestValidateSecurityGroups(t *testing.T) {
+	t.Parallel()
+
+	regionClient := newSecurityGroupRegion(t,
+		securityGroupFixture("valid", organizationID, projectID, "region"),
+		securityGroupFixture("other-organization", "other", projectID, "region"),
+		securityGroupFixture("other-project", organizationID, "other", "region"),
+		securityGroupFixture("other-region", organizationID, projectID, "other"),
+	)
+
+	c := cluster.NewClient(nil, "default", &cluster.Options{}, nil, regionClient)
+
+	tests := []struct {
+		name  string
+		ids   *[]string
+		valid bool
+	}{
+		{
+			name:  "None",
+			valid: true,
+		},
+		{
+			name:  "Valid",
+			ids:   &[]string{"valid"},
+			valid: true,
+		},
+		{
+			name: "Duplicated",
+			ids:  &[]string{"valid", "valid"},
+		},
+		{
+			name: "Missing",
+			ids:  &[]string{"valid", "missing"},
+		},
+		{
+			name: "OtherOrganization",
+			ids:  &[]string{"other-organization"},
+		},
+		{
+			name: "OtherProject",
+			ids:  &[]string{"other-project"},
+		},
+		{
+			name: "OtherRegion",
+			ids:  &[]string{"other-region"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			request := &openapi.ComputeClusterWrite{
+				Spec: openapi.ComputeClusterSpec{
+					RegionId: "region",
+					WorkloadPools: openapi.ComputeClusterWorkloadPools{
+						{
+							Name: "default",
+							Machine: openapi.MachinePool{
+								SecurityGroupIds: test.ids,
+							},
+						},
+					},
+				},
+			}
+
+			err := cluster.ValidateSecurityGroups(t.Context(), c, organizationID, projectID, request)
+			if test.valid {
+				require.NoError(t, err)
+				return
+			}
+
+			require.Error(t, err)
+
+			code, ok := errorsv2.CodeOf(err)
+			require.True(t, ok)
+			require.Equal(t, openapi.ComputeClusterInvalidSecurityGroup, code)
+		})
+	}
+}
@@ -26,12 +26,16 @@ import (
 
 	computev1 "github.com/unikorn-cloud/compute/pkg/apis/unikorn/v1alpha1"
 	"github.com/unikorn-cloud/compute/pkg/constants"
+	computeconversion "github.com/unikorn-cloud/compute/pkg/conversion"
 	computeapi "github.com/unikorn-cloud/compute/pkg/openapi"
-	"github.com/unikorn-cloud/compute/pkg/server/handler/instance"
+	managerutil "github.com/unikorn-cloud/compute/pkg/provisioners/managers/cluster/util"
+	"github.com/unikorn-cloud/compute/pkg/server/errorsv2"
 	"github.com/unikorn-cloud/compute/pkg/server/handler/region"
 	"github.com/unikorn-cloud/compute/pkg/server/handler/util"
+	"github.com/unikorn-cloud/compute/pkg/server/middleware/audit"
 	corev1 "github.com/unikorn-cloud/core/pkg/apis/unikorn/v1alpha1"
 	coreconstants "github.com/unikorn-cloud/core/pkg/constants"
+	coreapi "github.com/unikorn-cloud/core/pkg/openapi"
 	"github.com/unikorn-cloud/core/pkg/server/conversion"
 	"github.com/unikorn-cloud/core/pkg/server/errors"
 	coreutil "github.com/unikorn-cloud/core/pkg/server/util"
@@ -43,6 +47,7 @@ import (
 
 	kerrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/utils/ptr"
 
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -67,12 +72,13 @@ func convertPools(in []computev1.InstancePoolSpec) computeapi.PoolV2List {
 
 	for i := range in {
 		out[i] = computeapi.PoolV2{
-			Name:       in[i].Name,
-			Replicas:   in[i].Replicas,
-			FlavorId:   in[i].Template.FlavorID,
-			ImageId:    in[i].Template.ImageID,
-			Networking: instance.ConvertNetworking(in[i].Template.Networking),
-			UserData:   instance.ConvertUserData(in[i].Template.UserData),
+			Name:             in[i].Name,
+			Replicas:         in[i].Replicas,
+			FlavorId:         in[i].Template.FlavorID,
+			ImageId:          in[i].Template.ImageID,
+			Networking:       computeconversion.ConvertNetworking(in[i].Template.Networking),
+			UserData:         computeconversion.ConvertUserData(in[i].Template.UserData),
+			BootstrapProfile: computeconversion.ConvertBootstrapProfile(in[i].Template.BootstrapProfile),
 		}
 	}
 
@@ -118,15 +124,23 @@ func convertList(in *computev1.ComputeClusterList) []computeapi.ClusterV2Read {
 	return out
 }
 
-func generatePools(in computeapi.PoolV2List) ([]computev1.InstancePoolSpec, error) {
+func generatePools(options *Options, in computeapi.PoolV2List) ([]computev1.InstancePoolSpec, error) {
 	if len(in) == 0 {
 		return nil, nil
 	}
 
+	if err := options.validatePools(len(in)); err != nil {
+		return nil, err
+	}
+
 	out := make([]computev1.InstancePoolSpec, len(in))
 
 	for i := range in {
-		networking, err := instance.GenerateNetworking(in[i].Networking)
+		if err := options.validatePoolReplicas(in[i].Name, in[i].Replicas); err != nil {
+			return nil, err
+		}
+
+		networking, err := computeconversion.GenerateNetworking(in[i].Networking)
 		if err != nil {
 			return nil, err
 		}
@@ -139,8 +153,9 @@ func generatePools(in computeapi.PoolV2List) ([]computev1.InstancePoolSpec, erro
 					FlavorID: in[i].FlavorId,
 					ImageID:  in[i].ImageId,
 				},
-				Networking: networking,
-				UserData:   instance.GenerateUserData(in[i].UserData),
+				Networking:       networking,
+				UserData:         computeconversion.GenerateUserData(in[i].UserData),
+				BootstrapProfile: computeconversion.GenerateBootstrapProfile(in[i].BootstrapProfile),
 			},
 		}
 	}
@@ -148,8 +163,13 @@ func generatePools(in computeapi.PoolV2List) ([]computev1.InstancePoolSpec, erro
 	return out, nil
 }
 
-func (c *Client) generate(ctx context.Context, in *computeapi.ClusterV2Update, organizationID, projectID, regionID, networkID string) (*computev1.ComputeCluster, error) {
-	pools, err := generatePools(in.Spec.Pools)
+func (c *Client) generate(ctx context.Context, in *computeapi.ClusterV2Update, currentTags corev1.TagList, organizationID, projectID, regionID, networkID string) (*computev1.ComputeCluster, error) {
+	pools, err := generatePools(c.options, in.Spec.Pools)
+	if err != nil {
+		return nil, err
+	}
+
+	tags, err := util.GenerateTagList(in.Metadata.Tags, currentTags)
 	if err != nil {
 		return nil, err
 	}
@@ -163,7 +183,7 @@ func (c *Client) generate(ctx context.Context, in *computeapi.ClusterV2Update, o
 			WithLabel(constants.ResourceAPIVersionLabel, constants.MarshalAPIVersion(2)).
 			Get(),
 		Spec: computev1.ComputeClusterSpec{
-			Tags:  conversion.GenerateTagList(in.Metadata.Tags),
+			Tags:  tags,
 			Pools: pools,
 		},
 	}
@@ -201,9 +221,15 @@ func (c *Client) ListV2(ctx context.Context, params computeapi.GetApiV2ClustersP
 		return nil, fmt.Errorf("%w: failed to add network label selector", err)
 	}
 
+	tagSelector, err := coreutil.DecodeTagSelectorParam(params.Tag)
+	if err != nil {
+		return nil, err
+	}
+
 	options := &client.ListOptions{
 		Namespace:     c.namespace,
 		LabelSelector: selector,
+		FieldSelector: util.TagSelector(tagSelector),
 	}
 
 	result := &computev1.ComputeClusterList{}
@@ -212,13 +238,9 @@ func (c *Client) ListV2(ctx context.Context, params computeapi.GetApiV2ClustersP
 		return nil, fmt.Errorf("%w: unable to list clusters", err)
 	}
 
-	tagSelector, err := coreutil.DecodeTagSelectorParam(params.Tag)
-	if err != nil {
-		return nil, err
-	}
-
 	result.Items = slices.DeleteFunc(result.Items, func(resource computev1.ComputeCluster) bool {
-		return !resource.Spec.Tags.ContainsAll(tagSelector) ||
+		return !util.MatchName(&resource, params.Name) ||
+			!util.MatchCreatedAfter(&resource, params.CreatedAfter) ||
 			rbac.AllowProjectScope(ctx, "compute:clusters", identityapi.Read, resource.Labels[coreconstants.OrganizationLabel], resource.Labels[coreconstants.ProjectLabel]) != nil
 	})
 
@@ -226,7 +248,17 @@ func (c *Client) ListV2(ctx context.Context, params computeapi.GetApiV2ClustersP
 		return cmp.Compare(a.Name, b.Name)
 	})
 
-	return convertList(result), nil
+	// Provisioning status is derived from conditions, so can only be filtered
+	// once converted.
+	out := slices.DeleteFunc(convertList(result), func(resource computeapi.ClusterV2Read) bool {
+		return !util.MatchProvisioningStatus(&resource.Metadata, params.ProvisioningStatus)
+	})
+
+	util.SortList(out, params.Sort, func(resource *computeapi.ClusterV2Read) *coreapi.ProjectScopedResourceReadMetadata {
+		return &resource.Metadata
+	})
+
+	return out, nil
 }
 
 func (c *Client) CreateV2(ctx context.Context, request *computeapi.ClusterV2Create) (*computeapi.ClusterV2Read, error) {
@@ -256,7 +288,7 @@ func (c *Client) CreateV2(ctx context.Context, request *computeapi.ClusterV2Crea
 		return nil, err
 	}
 
-	resource, err := c.generate(ctx, updateRequest, organizationID, projectID, regionID, request.Spec.NetworkId)
+	resource, err := c.generate(ctx, updateRequest, nil, organizationID, projectID, regionID, request.Spec.NetworkId)
 	if err != nil {
 		return nil, err
 	}
@@ -279,7 +311,7 @@ func (c *Client) GetRawV2(ctx context.Context, clusterID string) (*computev1.Com
 		return nil, fmt.Errorf("%w: unable to lookup cluster", err)
 	}
 
-	if err := rbac.AllowProjectScope(ctx, "compute:clusters", identityapi.Read, result.Labels[coreconstants.OrganizationLabel], result.Labels[coreconstants.ProjectLabel]); err != nil {
+	if err := util.AllowProjectScopeRead(ctx, c.access, "compute:clusters", result.Labels[coreconstants.OrganizationLabel], result.Labels[coreconstants.ProjectLabel]); err != nil {
 		return nil, err
 	}
 
@@ -301,19 +333,23 @@ func (c *Client) GetRawV2(ctx context.Context, clusterID string) (*computev1.Com
 	return result, nil
 }
 
-func (c *Client) GetV2(ctx context.Context, clusterID string) (*computeapi.ClusterV2Read, error) {
+// GetV2 returns the cluster, along with the resource version it was read at.
+func (c *Client) GetV2(ctx context.Context, clusterID string) (*computeapi.ClusterV2Read, string, error) {
 	result, err := c.GetRawV2(ctx, clusterID)
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 
-	return convert(result), nil
+	return convert(result), result.ResourceVersion, nil
 }
 
-func (c *Client) UpdateV2(ctx context.Context, clusterID string, request *computeapi.ClusterV2Update) (*computeapi.ClusterV2Read, error) {
+// UpdateV2 updates the cluster, returning the updated resource along with its new
+// resource version.  If an entity tag is provided, the update is only applied if the
+// cluster has not been modified since that version was read.
+func (c *Client) UpdateV2(ctx context.Context, clusterID string, request *computeapi.ClusterV2Update, ifMatch *string) (*computeapi.ClusterV2Read, string, error) {
 	current, err := c.GetRawV2(ctx, clusterID)
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 
 	organizationID := current.Labels[coreconstants.OrganizationLabel]
@@ -322,16 +358,20 @@ func (c *Client) UpdateV2(ctx context.Context, clusterID string, request *comput
 	networkID := current.Labels[regionconstants.NetworkLabel]
 
 	if err := rbac.AllowProjectScope(ctx, "compute:clusters", identityapi.Update, organizationID, projectID); err != nil {
-		return nil, err
+		return nil, "", err
+	}
+
+	if err := util.CheckIfMatch(current.ResourceVersion, ifMatch); err != nil {
+		return nil, "", err
 	}
 
 	if current.DeletionTimestamp != nil {
-		return nil, errors.OAuth2InvalidRequest("server is being deleted")
+		return nil, "", errorsv2.InvalidRequest(computeapi.ComputeClusterDeleting, "server is being deleted")
 	}
 
-	required, err := c.generate(ctx, request, organizationID, projectID, regionID, networkID)
+	required, err := c.generate(ctx, request, current.Spec.Tags, organizationID, projectID, regionID, networkID)
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 
 	updated := current.DeepCopy()
@@ -339,11 +379,128 @@ func (c *Client) UpdateV2(ctx context.Context, clusterID string, request *comput
 	updated.Annotations = required.Annotations
 	updated.Spec = required.Spec
 
+	if err := managerutil.RecordSpecHistory(updated, current, c.options.SpecHistory); err != nil {
+		return nil, "", err
+	}
+
+	audit.RecordDiff(ctx, current.Spec, updated.Spec)
+
+	if err := c.client.Patch(ctx, updated, client.MergeFromWithOptions(current, &client.MergeFromWithOptimisticLock{})); err != nil {
+		return nil, "", fmt.Errorf("%w: unable to update cluster", util.PreconditionFailed(err, ifMatch))
+	}
+
+	return convert(updated), updated.ResourceVersion, nil
+}
+
+func convertTags(in *computev1.ComputeCluster) *computeapi.ResourceTags {
+	return &computeapi.ResourceTags{
+		Tags:            conversion.ConvertTags(in.Spec.Tags),
+		ResourceVersion: in.ResourceVersion,
+	}
+}
+
+// GetTagsV2 returns the cluster's tags.
+func (c *Client) GetTagsV2(ctx context.Context, clusterID string) (*computeapi.ResourceTags, error) {
+	result, err := c.GetRawV2(ctx, clusterID)
+	if err != nil {
+		return nil, err
+	}
+
+	return convertTags(result), nil
+}
+
+// PatchTagsV2 modifies individual tags without touching the rest of the cluster.
+func (c *Client) PatchTagsV2(ctx context.Context, clusterID string, request *computeapi.ResourceTagsPatch) (*computeapi.ResourceTags, error) {
+	current, err := c.GetRawV2(ctx, clusterID)
+	if err != nil {
+		return nil, err
+	}
+
+	organizationID := current.Labels[coreconstants.OrganizationLabel]
+	projectID := current.Labels[coreconstants.ProjectLabel]
+
+	if err := rbac.AllowProjectScope(ctx, "compute:clusters", identityapi.Update, organizationID, projectID); err != nil {
+		return nil, err
+	}
+
+	if current.DeletionTimestamp != nil {
+		return nil, errorsv2.InvalidRequest(computeapi.ComputeClusterDeleting, "cluster is being deleted")
+	}
+
+	if err := util.CheckTagsVersion(current.ResourceVersion, request.ResourceVersion); err != nil {
+		return nil, err
+	}
+
+	tags, err := util.PatchTagList(current.Spec.Tags, request.Set, ptr.Deref(request.Remove, nil))
+	if err != nil {
+		return nil, err
+	}
+
+	updated := current.DeepCopy()
+	updated.Spec.Tags = tags
+
 	if err := c.client.Patch(ctx, updated, client.MergeFromWithOptions(current, &client.MergeFromWithOptimisticLock{})); err != nil {
-		return nil, fmt.Errorf("%w: unable to update cluster", err)
+		return nil, fmt.Errorf("%w: unable to update cluster tags", util.TagsConflict(err))
+	}
+
+	return convertTags(updated), nil
+}
+
+func convertPoolPlans(in []managerutil.PoolPlan) computeapi.ClusterV2PoolPreviewList {
+	out := make(computeapi.ClusterV2PoolPreviewList, len(in))
+
+	for i := range in {
+		out[i] = computeapi.ClusterV2PoolPreview{
+			Name:    in[i].Name,
+			Create:  in[i].Create,
+			Delete:  append([]string{}, in[i].Delete...),
+			Rebuild: append([]string{}, in[i].Rebuild...),
+		}
 	}
 
-	return convert(updated), nil
+	return out
+}
+
+// PreviewV2 reports what an update would do to the cluster's instances without
+// applying it.
+func (c *Client) PreviewV2(ctx context.Context, clusterID string, request *computeapi.ClusterV2Update) (*computeapi.ClusterV2Preview, error) {
+	current, err := c.GetRawV2(ctx, clusterID)
+	if err != nil {
+		return nil, err
+	}
+
+	organizationID := current.Labels[coreconstants.OrganizationLabel]
+	projectID := current.Labels[coreconstants.ProjectLabel]
+	regionID := current.Labels[regionconstants.RegionLabel]
+	networkID := current.Labels[regionconstants.NetworkLabel]
+
+	if err := rbac.AllowProjectScope(ctx, "compute:clusters", identityapi.Update, organizationID, projectID); err != nil {
+		return nil, err
+	}
+
+	required, err := c.generate(ctx, request, current.Spec.Tags, organizationID, projectID, regionID, networkID)
+	if err != nil {
+		return nil, err
+	}
+
+	instances := &computev1.ComputeInstanceList{}
+
+	options := &client.ListOptions{
+		Namespace: c.namespace,
+		LabelSelector: labels.SelectorFromSet(map[string]string{
+			constants.ClusterLabel: clusterID,
+		}),
+	}
+
+	if err := c.client.List(ctx, instances, options); err != nil {
+		return nil, fmt.Errorf("%w: unable to list cluster instances", err)
+	}
+
+	out := &computeapi.ClusterV2Preview{
+		Pools: convertPoolPlans(managerutil.PlanPools(required.Spec.Pools, instances.Items)),
+	}
+
+	return out, nil
 }
 
 func (c *Client) DeleteV2(ctx context.Context, clusterID string) error {
@@ -23,13 +23,25 @@ import (
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 
+	unikornv1 "github.com/unikorn-cloud/compute/pkg/apis/unikorn/v1alpha1"
+	"github.com/unikorn-cloud/compute/pkg/constants"
 	computeapi "github.com/unikorn-cloud/compute/pkg/openapi"
+	managerutil "github.com/unikorn-cloud/compute/pkg/provisioners/managers/cluster/util"
 	"github.com/unikorn-cloud/compute/pkg/server/handler/cluster"
+	coreconstants "github.com/unikorn-cloud/core/pkg/constants"
 	coreapi "github.com/unikorn-cloud/core/pkg/openapi"
 	coreerrors "github.com/unikorn-cloud/core/pkg/server/errors"
+	"github.com/unikorn-cloud/identity/pkg/middleware/authorization"
 	identityapi "github.com/unikorn-cloud/identity/pkg/openapi"
 	identitymock "github.com/unikorn-cloud/identity/pkg/openapi/mock"
+	"github.com/unikorn-cloud/identity/pkg/principal"
 	"github.com/unikorn-cloud/identity/pkg/rbac"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 )
 
 // aclWithOrgScopeCreate grants compute:clusters/Create at organization scope
@@ -109,3 +121,74 @@ func TestCreateV2RBACNoPermissions(t *testing.T) {
 	require.Error(t, err)
 	require.True(t, coreerrors.IsForbidden(err), "expected forbidden, got: %v", err)
 }
+
+// TestUpdateV2RecordsSpecHistory verifies that v2 updates record the previous
+// specification, in the same way as v1 updates, so they can be rolled back.
+func TestUpdateV2RecordsSpecHistory(t *testing.T) {
+	t.Parallel()
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, unikornv1.AddToScheme(scheme))
+
+	current := &unikornv1.ComputeCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      "cluster",
+			Labels: map[string]string{
+				coreconstants.OrganizationLabel:   organizationID,
+				coreconstants.ProjectLabel:        projectID,
+				constants.ResourceAPIVersionLabel: constants.MarshalAPIVersion(2),
+			},
+		},
+		Spec: unikornv1.ComputeClusterSpec{
+			Pools: []unikornv1.InstancePoolSpec{
+				{
+					Name:     "pool",
+					Replicas: 1,
+				},
+			},
+		},
+	}
+
+	cli := fake.NewClientBuilder().WithScheme(scheme).WithObjects(current).Build()
+
+	c := cluster.NewClient(cli, "default", &cluster.Options{SpecHistory: 5}, nil, nil)
+
+	ctx := rbac.NewContext(t.Context(), &identityapi.Acl{
+		Organizations: &identityapi.AclOrganizationList{
+			{
+				Id: organizationID,
+				Endpoints: &identityapi.AclEndpoints{
+					{
+						Name:       "compute:clusters",
+						Operations: identityapi.AclOperations{identityapi.Read, identityapi.Update},
+					},
+				},
+			},
+		},
+	})
+	ctx = authorization.NewContext(ctx, &authorization.Info{
+		Userinfo: &identityapi.Userinfo{
+			Sub: "jane@acme.com",
+		},
+	})
+	ctx = principal.NewContext(ctx, &principal.Principal{})
+
+	request := &computeapi.ClusterV2Update{
+		Metadata: coreapi.ResourceWriteMetadata{
+			Name: "cluster",
+		},
+	}
+
+	_, _, err := c.UpdateV2(ctx, "cluster", request, nil)
+	require.NoError(t, err)
+
+	updated := &unikornv1.ComputeCluster{}
+	require.NoError(t, cli.Get(t.Context(), client.ObjectKeyFromObject(current), updated))
+	require.Empty(t, updated.Spec.Pools)
+
+	spec, ok, err := managerutil.GetSpecHistoryGeneration(updated, current.Generation)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Len(t, spec.Pools, 1)
+}
@@ -0,0 +1,91 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"slices"
+
+	unikornv1 "github.com/unikorn-cloud/compute/pkg/apis/unikorn/v1alpha1"
+	"github.com/unikorn-cloud/compute/pkg/server/handler/instance"
+	regionapi "github.com/unikorn-cloud/region/pkg/openapi"
+)
+
+// flavorBaremetal returns whether the flavor is for a dedicated machine.
+func flavorBaremetal(flavor *regionapi.Flavor) bool {
+	return flavor.Spec.Baremetal != nil && *flavor.Spec.Baremetal
+}
+
+// poolDiskGiB returns the root disk size available to the image, this is the
+// persistent disk if one is defined for a virtualized flavor, otherwise the
+// flavor's ephemeral disk.  Zero means there is no known limit.
+func poolDiskGiB(pool *unikornv1.ComputeClusterWorkloadPoolSpec, flavor *regionapi.Flavor) int {
+	if pool.DiskSize != nil && !flavorBaremetal(flavor) {
+		return int(pool.DiskSize.Value() >> 30)
+	}
+
+	return flavor.Spec.Disk
+}
+
+// compatible returns whether an image can be booted on a flavor in the context
+// of a workload pool.  This mirrors the constraints applied when the server is
+// actually provisioned so a user isn't offered a choice that will fail.
+func compatible(pool *unikornv1.ComputeClusterWorkloadPoolSpec, flavor *regionapi.Flavor, image *regionapi.Image) bool {
+	if image.Status.State != regionapi.ImageStateReady {
+		return false
+	}
+
+	if image.Spec.Architecture != flavor.Spec.Architecture {
+		return false
+	}
+
+	if err := instance.ValidateVirtualization(flavor, image); err != nil {
+		return false
+	}
+
+	if disk := poolDiskGiB(pool, flavor); disk > 0 && image.Spec.SizeGiB > disk {
+		return false
+	}
+
+	// GPU flavors need the drivers baked into the image, and if the image is
+	// specific about which models it supports, the flavor's must be one of them.
+	if flavor.Spec.Gpu != nil {
+		if image.Spec.Gpu == nil || image.Spec.Gpu.Vendor != flavor.Spec.Gpu.Vendor {
+			return false
+		}
+
+		if image.Spec.Gpu.Models != nil && !slices.Contains(*image.Spec.Gpu.Models, flavor.Spec.Gpu.Model) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// compatibleImages returns images that can be used with the pool's current flavor.
+func compatibleImages(pool *unikornv1.ComputeClusterWorkloadPoolSpec, flavor *regionapi.Flavor, images []regionapi.Image) []regionapi.Image {
+	return slices.DeleteFunc(images, func(image regionapi.Image) bool {
+		return !compatible(pool, flavor, &image)
+	})
+}
+
+// compatibleFlavors returns flavors that can be used with the pool's current image.
+func compatibleFlavors(pool *unikornv1.ComputeClusterWorkloadPoolSpec, image *regionapi.Image, flavors []regionapi.Flavor) []regionapi.Flavor {
+	return slices.DeleteFunc(flavors, func(flavor regionapi.Flavor) bool {
+		return !compatible(pool, &flavor, image)
+	})
+}
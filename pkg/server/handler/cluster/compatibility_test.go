@@ -0,0 +1,157 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	computev1 "github.com/unikorn-cloud/compute/pkg/apis/unikorn/v1alpha1"
+	"github.com/unikorn-cloud/compute/pkg/server/handler/cluster"
+	coreapi "github.com/unikorn-cloud/core/pkg/openapi"
+	regionapi "github.com/unikorn-cloud/region/pkg/openapi"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/utils/ptr"
+)
+
+func compatibilityImage(id string, architecture regionapi.Architecture, virtualization regionapi.ImageVirtualization, size int, gpu *regionapi.ImageGpu) regionapi.Image {
+	return regionapi.Image{
+		Metadata: coreapi.StaticResourceMetadata{
+			Id: id,
+		},
+		Spec: regionapi.ImageSpec{
+			Architecture:   architecture,
+			Virtualization: virtualization,
+			SizeGiB:        size,
+			Gpu:            gpu,
+		},
+		Status: regionapi.ImageStatus{
+			State: regionapi.ImageStateReady,
+		},
+	}
+}
+
+func imageIDs(images []regionapi.Image) []string {
+	out := make([]string, len(images))
+
+	for i := range images {
+		out[i] = images[i].Metadata.Id
+	}
+
+	return out
+}
+
+// TestCompatibleImages ensures images are filtered by the pool flavor's constraints.
+func TestCompatibleImages(t *testing.T) {
+	t.Parallel()
+
+	flavor := &regionapi.Flavor{
+		Spec: regionapi.FlavorSpec{
+			Architecture: regionapi.ArchitectureX8664,
+			Disk:         20,
+			Gpu: &regionapi.GpuSpec{
+				Vendor: regionapi.GpuVendorNVIDIA,
+				Model:  "H100",
+			},
+		},
+	}
+
+	nvidia := &regionapi.ImageGpu{
+		Vendor: regionapi.GpuVendorNVIDIA,
+	}
+
+	notReady := compatibilityImage("not-ready", regionapi.ArchitectureX8664, regionapi.ImageVirtualizationAny, 10, nvidia)
+	notReady.Status.State = regionapi.ImageStatePending
+
+	images := []regionapi.Image{
+		compatibilityImage("ok", regionapi.ArchitectureX8664, regionapi.ImageVirtualizationAny, 10, nvidia),
+		compatibilityImage("ok-virtualized", regionapi.ArchitectureX8664, regionapi.ImageVirtualizationVirtualized, 10, nvidia),
+		compatibilityImage("ok-model", regionapi.ArchitectureX8664, regionapi.ImageVirtualizationAny, 10, &regionapi.ImageGpu{Vendor: regionapi.GpuVendorNVIDIA, Models: &regionapi.GpuModelList{"A100", "H100"}}),
+		compatibilityImage("wrong-arch", regionapi.ArchitectureAarch64, regionapi.ImageVirtualizationAny, 10, nvidia),
+		compatibilityImage("baremetal", regionapi.ArchitectureX8664, regionapi.ImageVirtualizationBaremetal, 10, nvidia),
+		compatibilityImage("too-big", regionapi.ArchitectureX8664, regionapi.ImageVirtualizationAny, 40, nvidia),
+		compatibilityImage("no-gpu", regionapi.ArchitectureX8664, regionapi.ImageVirtualizationAny, 10, nil),
+		compatibilityImage("wrong-vendor", regionapi.ArchitectureX8664, regionapi.ImageVirtualizationAny, 10, &regionapi.ImageGpu{Vendor: regionapi.GpuVendorAMD}),
+		compatibilityImage("wrong-model", regionapi.ArchitectureX8664, regionapi.ImageVirtualizationAny, 10, &regionapi.ImageGpu{Vendor: regionapi.GpuVendorNVIDIA, Models: &regionapi.GpuModelList{"A100"}}),
+		notReady,
+	}
+
+	pool := &computev1.ComputeClusterWorkloadPoolSpec{}
+
+	result := cluster.CompatibleImages(pool, flavor, images)
+	require.Equal(t, []string{"ok", "ok-virtualized", "ok-model"}, imageIDs(result))
+
+	// A persistent root disk overrides the flavor's ephemeral disk.
+	pool.DiskSize = ptr.To(resource.MustParse("50Gi"))
+
+	images = []regionapi.Image{
+		compatibilityImage("too-big", regionapi.ArchitectureX8664, regionapi.ImageVirtualizationAny, 40, nvidia),
+	}
+
+	result = cluster.CompatibleImages(pool, flavor, images)
+	require.Equal(t, []string{"too-big"}, imageIDs(result))
+}
+
+// TestCompatibleFlavors ensures flavors are filtered by the pool image's constraints.
+func TestCompatibleFlavors(t *testing.T) {
+	t.Parallel()
+
+	image := compatibilityImage("image", regionapi.ArchitectureX8664, regionapi.ImageVirtualizationVirtualized, 10, nil)
+
+	flavors := []regionapi.Flavor{
+		{
+			Metadata: coreapi.StaticResourceMetadata{Id: "ok"},
+			Spec: regionapi.FlavorSpec{
+				Architecture: regionapi.ArchitectureX8664,
+				Disk:         20,
+			},
+		},
+		{
+			Metadata: coreapi.StaticResourceMetadata{Id: "baremetal"},
+			Spec: regionapi.FlavorSpec{
+				Architecture: regionapi.ArchitectureX8664,
+				Baremetal:    ptr.To(true),
+				Disk:         20,
+			},
+		},
+		{
+			Metadata: coreapi.StaticResourceMetadata{Id: "small-disk"},
+			Spec: regionapi.FlavorSpec{
+				Architecture: regionapi.ArchitectureX8664,
+				Disk:         5,
+			},
+		},
+		{
+			Metadata: coreapi.StaticResourceMetadata{Id: "gpu"},
+			Spec: regionapi.FlavorSpec{
+				Architecture: regionapi.ArchitectureX8664,
+				Disk:         20,
+				Gpu: &regionapi.GpuSpec{
+					Vendor: regionapi.GpuVendorNVIDIA,
+					Model:  "H100",
+				},
+			},
+		},
+	}
+
+	result := cluster.CompatibleFlavors(&computev1.ComputeClusterWorkloadPoolSpec{}, &image, flavors)
+	require.Len(t, result, 1)
+	require.Equal(t, "ok", result[0].Metadata.Id)
+}
@@ -22,18 +22,27 @@ import (
 	"fmt"
 	"net"
 	"slices"
+	"time"
 
 	unikornv1 "github.com/unikorn-cloud/compute/pkg/apis/unikorn/v1alpha1"
+	computeconversion "github.com/unikorn-cloud/compute/pkg/conversion"
 	"github.com/unikorn-cloud/compute/pkg/openapi"
+	managerutil "github.com/unikorn-cloud/compute/pkg/provisioners/managers/cluster/util"
+	"github.com/unikorn-cloud/compute/pkg/server/errorsv2"
 	"github.com/unikorn-cloud/compute/pkg/server/handler/region"
+	"github.com/unikorn-cloud/compute/pkg/server/handler/util"
 	unikornv1core "github.com/unikorn-cloud/core/pkg/apis/unikorn/v1alpha1"
+	coreconstants "github.com/unikorn-cloud/core/pkg/constants"
 	coreapi "github.com/unikorn-cloud/core/pkg/openapi"
 	"github.com/unikorn-cloud/core/pkg/server/conversion"
-	"github.com/unikorn-cloud/core/pkg/server/errors"
 	"github.com/unikorn-cloud/identity/pkg/handler/common"
 	unikornv1region "github.com/unikorn-cloud/region/pkg/apis/unikorn/v1alpha1"
 	regionapi "github.com/unikorn-cloud/region/pkg/openapi"
 
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -74,11 +83,23 @@ func (g *generator) convertMachine(in *unikornv1.ComputeClusterWorkloadPoolSpec)
 		Replicas:            in.Replicas,
 		FlavorId:            in.FlavorID,
 		PublicIPAllocation:  convertPublicIPAllocation(in.PublicIPAllocation),
-		Firewall:            convertFirewallRules(in.Firewall),
+		Firewall:            computeconversion.ConvertFirewallRules(in.Firewall),
 		Image:               convertImage(in),
 		UserData:            convertUserData(in.UserData),
+		UserDataTemplate:    convertUserDataTemplate(in.UserDataTemplate),
+		BootstrapProfile:    computeconversion.ConvertBootstrapProfile(in.BootstrapProfile),
 		AllowedAddressPairs: convertAllowedAddressPairs(in.AllowedAddressPairs),
+		SecurityGroupIds:    convertSecurityGroupIDs(in.SecurityGroupIDs),
+	}
+}
+
+// convertSecurityGroupIDs converts from a custom resource into the API definition.
+func convertSecurityGroupIDs(in []string) *[]string {
+	if len(in) == 0 {
+		return nil
 	}
+
+	return &in
 }
 
 // convertAllowedAddressPairs converts from a custom resource into the API definition.
@@ -115,6 +136,15 @@ func convertImage(in *unikornv1.ComputeClusterWorkloadPoolSpec) openapi.ComputeI
 	}
 }
 
+// convertUserDataTemplate converts from a custom resource into the API definition.
+func convertUserDataTemplate(in bool) *bool {
+	if !in {
+		return nil
+	}
+
+	return &in
+}
+
 // convertUserData converts from a custom resource into the API definition.
 func convertUserData(in []byte) *[]byte {
 	if in == nil {
@@ -124,81 +154,89 @@ func convertUserData(in []byte) *[]byte {
 	return &in
 }
 
-func convertDirection(in unikornv1.FirewallRuleDirection) openapi.FirewallRuleDirection {
-	switch in {
-	case unikornv1.Ingress:
-		return openapi.Ingress
-	case unikornv1.Egress:
-		return openapi.Egress
+// convertPublicIPAllocation converts from a custom resource into the API definition.
+func convertPublicIPAllocation(in *unikornv1.PublicIPAllocationSpec) *openapi.PublicIPAllocation {
+	if in == nil {
+		return nil
 	}
 
-	return ""
+	return &openapi.PublicIPAllocation{
+		Enabled: in.Enabled,
+	}
 }
 
-// convertProtocol converts from a custom resource into the API definition.
-func convertProtocol(in unikornv1.FirewallRuleProtocol) openapi.FirewallRuleProtocol {
-	switch in {
-	case unikornv1.TCP:
-		return openapi.Tcp
-	case unikornv1.UDP:
-		return openapi.Udp
+// convertWorkloadPool converts from a custom resource into the API definition.
+func (g *generator) convertWorkloadPool(in *unikornv1.ComputeClusterWorkloadPoolSpec) *openapi.ComputeClusterWorkloadPool {
+	return &openapi.ComputeClusterWorkloadPool{
+		Name:           in.Name,
+		Machine:        *g.convertMachine(in),
+		HostnamePrefix: convertOptionalString(in.HostnamePrefix),
+		Domain:         convertOptionalString(in.Domain),
+		Autoscaling:    convertAutoscaling(in.Autoscaling),
+		AutoHealing:    convertAutoHealing(in.AutoHealing),
+		UpdateStrategy: convertUpdateStrategy(in.UpdateStrategy),
+		Labels:         convertLabels(in.Labels),
 	}
-
-	return ""
 }
 
-func convertPrefixes(in []unikornv1core.IPv4Prefix) []string {
-	out := make([]string, len(in))
-
-	for i, prefix := range in {
-		out[i] = prefix.String()
+// convertOptionalString converts an optional string from a custom resource, where
+// empty means unset, into the API definition.
+func convertOptionalString(in string) *string {
+	if in == "" {
+		return nil
 	}
 
-	return out
+	return &in
 }
 
-func convertFirewallRule(in *unikornv1.FirewallRule) *openapi.FirewallRule {
-	return &openapi.FirewallRule{
-		Direction: convertDirection(in.Direction),
-		Protocol:  convertProtocol(in.Protocol),
-		Port:      in.Port,
-		PortMax:   in.PortMax,
-		Prefixes:  convertPrefixes(in.Prefixes),
+// convertLabels converts from a custom resource into the API definition.
+func convertLabels(in unikornv1core.TagList) *coreapi.TagList {
+	if len(in) == 0 {
+		return nil
 	}
+
+	return ptr.To(conversion.ConvertTags(in))
 }
 
-// convertFirewall converts from a custom resource into the API definition.
-func convertFirewallRules(in []unikornv1.FirewallRule) *openapi.FirewallRules {
-	if len(in) == 0 {
+// convertUpdateStrategy converts from a custom resource into the API definition.
+func convertUpdateStrategy(in *unikornv1.WorkloadPoolUpdateStrategy) *openapi.ComputeClusterWorkloadPoolUpdateStrategy {
+	if in == nil {
 		return nil
 	}
 
-	out := make(openapi.FirewallRules, len(in))
+	return &openapi.ComputeClusterWorkloadPoolUpdateStrategy{
+		MaxUnavailable: ptr.To(in.MaxUnavailable),
+		MaxSurge:       ptr.To(in.MaxSurge),
+	}
+}
 
-	for i := range in {
-		out[i] = *convertFirewallRule(&in[i])
+// convertAutoHealing converts from a custom resource into the API definition.
+func convertAutoHealing(in *unikornv1.WorkloadPoolAutoHealingSpec) *openapi.ComputeClusterWorkloadPoolAutoHealing {
+	if in == nil {
+		return nil
 	}
 
-	return &out
+	return &openapi.ComputeClusterWorkloadPoolAutoHealing{
+		GracePeriodSeconds: ptr.To(int(in.GracePeriod.Seconds())),
+	}
 }
 
-// convertPublicIPAllocation converts from a custom resource into the API definition.
-func convertPublicIPAllocation(in *unikornv1.PublicIPAllocationSpec) *openapi.PublicIPAllocation {
+// convertAutoscaling converts from a custom resource into the API definition.
+func convertAutoscaling(in *unikornv1.WorkloadPoolAutoscalingSpec) *openapi.ComputeClusterWorkloadPoolAutoscaling {
 	if in == nil {
 		return nil
 	}
 
-	return &openapi.PublicIPAllocation{
-		Enabled: in.Enabled,
+	out := &openapi.ComputeClusterWorkloadPoolAutoscaling{
+		MinReplicas: in.MinReplicas,
+		MaxReplicas: in.MaxReplicas,
 	}
-}
 
-// convertWorkloadPool converts from a custom resource into the API definition.
-func (g *generator) convertWorkloadPool(in *unikornv1.ComputeClusterWorkloadPoolSpec) *openapi.ComputeClusterWorkloadPool {
-	return &openapi.ComputeClusterWorkloadPool{
-		Name:    in.Name,
-		Machine: *g.convertMachine(in),
+	if in.TargetCPUUtilization != 0 {
+		out.TargetCpuUtilization = ptr.To(in.TargetCPUUtilization)
 	}
+
+	return out
 }
 
 // convertWorkloadPools converts from a custom resource into the API definition.
@@ -255,7 +293,7 @@ func convertHealthStatus(in unikornv1core.ConditionReason) coreapi.ResourceHealt
 	}
 }
 
-func convertMachineStatus(in *unikornv1.MachineStatus) *openapi.ComputeClusterMachineStatus {
+func convertMachineStatus(in *unikornv1.MachineStatus, cluster *unikornv1.ComputeCluster) *openapi.ComputeClusterMachineStatus {
 	provisioningStatus := coreapi.ResourceProvisioningStatusUnknown
 
 	if condition, err := unikornv1core.GetCondition(in.Conditions, unikornv1core.ConditionAvailable); err == nil {
@@ -280,47 +318,295 @@ func convertMachineStatus(in *unikornv1.MachineStatus) *openapi.ComputeClusterMa
 		HealthStatus:       healthStatus,
 	}
 
+	if in.Cordoned {
+		out.Cordoned = ptr.To(true)
+	}
+
+	out.Labels = convertLabels(in.Labels)
+
+	if in.CreationTime != nil {
+		out.CreationTime = ptr.To(in.CreationTime.Time)
+	}
+
+	if condition, err := unikornv1core.GetCondition(in.Conditions, unikornv1.ConditionMaintenance); err == nil && condition.Status == corev1.ConditionTrue {
+		out.Maintenance = ptr.To(true)
+	}
+
+	// Boot reporting is only reported when enabled, otherwise it would
+	// always appear to be unfinished.
+	if cluster.Status.PhoneHomeToken != nil {
+		out.BootFinished = ptr.To(slices.Contains(managerutil.GetBootFinished(cluster), in.Hostname))
+	}
+
+	return out
+}
+
+// convertMachines flattens machine statuses from all workload pools into a
+// single list, filtered by the request parameters.
+func convertMachines(in *unikornv1.ComputeCluster, params *openapi.GetApiV1OrganizationsOrganizationIDProjectsProjectIDClustersClusterIDMachinesParams) openapi.ComputeClusterMachineList {
+	out := openapi.ComputeClusterMachineList{}
+
+	for i := range in.Status.WorkloadPools {
+		pool := &in.Status.WorkloadPools[i]
+
+		if params.Pool != nil && !slices.Contains(*params.Pool, pool.Name) {
+			continue
+		}
+
+		for j := range pool.Machines {
+			status := convertMachineStatus(&pool.Machines[j], in)
+
+			if params.Status != nil && !slices.Contains(*params.Status, status.Status) {
+				continue
+			}
+
+			if params.HealthStatus != nil && !slices.Contains(*params.HealthStatus, status.HealthStatus) {
+				continue
+			}
+
+			out = append(out, openapi.ComputeClusterMachine{
+				Id:                 status.Id,
+				Pool:               pool.Name,
+				Hostname:           status.Hostname,
+				FlavorID:           status.FlavorID,
+				ImageID:            status.ImageID,
+				PrivateIP:          status.PrivateIP,
+				PublicIP:           status.PublicIP,
+				Status:             status.Status,
+				ProvisioningStatus: status.ProvisioningStatus,
+				HealthStatus:       status.HealthStatus,
+				Cordoned:           status.Cordoned,
+				Maintenance:        status.Maintenance,
+				BootFinished:       status.BootFinished,
+				Labels:             status.Labels,
+				CreationTime:       status.CreationTime,
+			})
+		}
+	}
+
 	return out
 }
 
-func convertMachinesStatus(in []unikornv1.MachineStatus) *openapi.ComputeClusterMachinesStatus {
+func convertMachinesStatus(in []unikornv1.MachineStatus, cluster *unikornv1.ComputeCluster) *openapi.ComputeClusterMachinesStatus {
 	out := make(openapi.ComputeClusterMachinesStatus, len(in))
 
 	for i := range in {
-		out[i] = *convertMachineStatus(&in[i])
+		out[i] = *convertMachineStatus(&in[i], cluster)
 	}
 
 	return &out
 }
 
-func convertWorkloadPoolStatus(in *unikornv1.WorkloadPoolStatus) *openapi.ComputeClusterWorkloadPoolStatus {
+func convertAutoscalingStatus(in *unikornv1.WorkloadPoolAutoscalingStatus) *openapi.ComputeClusterWorkloadPoolAutoscalingStatus {
+	out := &openapi.ComputeClusterWorkloadPoolAutoscalingStatus{
+		LastScaleTime:    in.LastScaleTime.Time,
+		PreviousReplicas: in.PreviousReplicas,
+		DesiredReplicas:  in.DesiredReplicas,
+		CpuUtilization:   in.CPUUtilization,
+	}
+
+	if in.Message != "" {
+		out.Message = ptr.To(in.Message)
+	}
+
+	return out
+}
+
+func convertWorkloadPoolStatus(in *unikornv1.WorkloadPoolStatus, cluster *unikornv1.ComputeCluster) *openapi.ComputeClusterWorkloadPoolStatus {
 	out := &openapi.ComputeClusterWorkloadPoolStatus{
 		Name:     in.Name,
 		Replicas: in.Replicas,
-		Machines: convertMachinesStatus(in.Machines),
+		Machines: convertMachinesStatus(in.Machines, cluster),
+	}
+
+	if status, ok := cluster.GetAutoscalingStatus(in.Name); ok {
+		out.Autoscaling = convertAutoscalingStatus(status)
+	}
+
+	if status, ok := cluster.GetSecurityGroupStatus(in.Name); ok {
+		out.SecurityGroupId = ptr.To(status.ID)
 	}
 
 	return out
 }
 
-func convertWorkloadPoolsStatus(in []unikornv1.WorkloadPoolStatus) *openapi.ComputeClusterWorkloadPoolsStatus {
+func convertWorkloadPoolsStatus(cluster *unikornv1.ComputeCluster) *openapi.ComputeClusterWorkloadPoolsStatus {
+	in := cluster.Status.WorkloadPools
+
 	out := make(openapi.ComputeClusterWorkloadPoolsStatus, len(in))
 
 	for i := range in {
-		out[i] = *convertWorkloadPoolStatus(&in[i])
+		out[i] = *convertWorkloadPoolStatus(&in[i], cluster)
 	}
 
 	return &out
 }
 
-func convertClusterStatus(in *unikornv1.ComputeClusterStatus) *openapi.ComputeClusterStatus {
-	if in == nil {
+func convertEvictionPhase(in unikornv1.MachineEvictionPhase) openapi.MachineEvictionStatusStatus {
+	switch in {
+	case unikornv1.MachineEvictionPhasePending:
+		return openapi.Pending
+	case unikornv1.MachineEvictionPhaseDeleting:
+		return openapi.Deleting
+	case unikornv1.MachineEvictionPhaseDeleted:
+		return openapi.Deleted
+	case unikornv1.MachineEvictionPhaseFailed:
+		return openapi.Failed
+	}
+
+	return openapi.Pending
+}
+
+func convertEvictionStatus(in *unikornv1.MachineEvictionStatus) *openapi.MachineEvictionStatus {
+	out := &openapi.MachineEvictionStatus{
+		Id:      in.ID,
+		Status:  convertEvictionPhase(in.Phase),
+		Message: in.Message,
+	}
+
+	return out
+}
+
+func convertEvictionsStatus(in []unikornv1.MachineEvictionStatus) openapi.MachineEvictionsStatus {
+	out := make(openapi.MachineEvictionsStatus, len(in))
+
+	for i := range in {
+		out[i] = *convertEvictionStatus(&in[i])
+	}
+
+	return out
+}
+
+func convertEventType(in string) openapi.ClusterEventType {
+	if in == corev1.EventTypeWarning {
+		return openapi.Warning
+	}
+
+	return openapi.Normal
+}
+
+func convertEvent(in *corev1.Event) *openapi.ClusterEvent {
+	out := &openapi.ClusterEvent{
+		Type:    convertEventType(in.Type),
+		Reason:  in.Reason,
+		Message: in.Message,
+		Time:    eventTime(in),
+	}
+
+	if in.Count > 0 {
+		out.Count = ptr.To(int(in.Count))
+	}
+
+	return out
+}
+
+// eventTime returns when the event last occurred, events may be recorded using
+// either the legacy or new event APIs.
+func eventTime(in *corev1.Event) time.Time {
+	switch {
+	case !in.LastTimestamp.IsZero():
+		return in.LastTimestamp.Time
+	case !in.EventTime.IsZero():
+		return in.EventTime.Time
+	}
+
+	return in.CreationTimestamp.Time
+}
+
+func convertEvents(in []corev1.Event) openapi.ClusterEvents {
+	slices.SortStableFunc(in, func(a, b corev1.Event) int {
+		return eventTime(&b).Compare(eventTime(&a))
+	})
+
+	out := make(openapi.ClusterEvents, len(in))
+
+	for i := range in {
+		out[i] = *convertEvent(&in[i])
+	}
+
+	return out
+}
+
+func convertCancellationStatus(in *unikornv1.ComputeCluster) *openapi.ComputeClusterCancellationStatus {
+	// Only report cancellation of the current update.
+	status := in.Status.Cancellation
+	if status == nil || status.Generation != in.Generation {
+		return nil
+	}
+
+	return &openapi.ComputeClusterCancellationStatus{
+		Message: status.Message,
+	}
+}
+
+// convertNetworkStatus reports the node network as requested, the region may
+// later provide more authoritative values.
+func convertNetworkStatus(in *unikornv1.ComputeCluster) *openapi.ComputeClusterNetworkStatus {
+	if in.Spec.Network == nil {
+		return nil
+	}
+
+	out := &openapi.ComputeClusterNetworkStatus{
+		Prefix:         in.Spec.Network.NodeNetwork.String(),
+		DnsNameservers: make([]string, len(in.Spec.Network.DNSNameservers)),
+	}
+
+	for i := range in.Spec.Network.DNSNameservers {
+		out.DnsNameservers[i] = in.Spec.Network.DNSNameservers[i].String()
+	}
+
+	if networkID, ok := in.Labels[coreconstants.NetworkLabel]; ok {
+		out.NetworkId = ptr.To(networkID)
+	}
+
+	return out
+}
+
+// convertConditions reports the readiness of the cluster's dependencies in the
+// order they are provisioned, those not yet evaluated are omitted.
+func convertConditions(in *unikornv1.ComputeCluster) *openapi.ComputeClusterConditions {
+	types := []unikornv1core.ConditionType{
+		unikornv1.ConditionIdentityReady,
+		unikornv1.ConditionNetworkReady,
+		unikornv1.ConditionSecurityGroupsReady,
+		unikornv1.ConditionServersReady,
+	}
+
+	var out openapi.ComputeClusterConditions
+
+	for _, t := range types {
+		condition, err := unikornv1core.GetCondition(in.Status.Conditions, t)
+		if err != nil {
+			continue
+		}
+
+		out = append(out, openapi.ComputeClusterCondition{
+			Type:               openapi.ComputeClusterConditionType(condition.Type),
+			Status:             openapi.ComputeClusterConditionStatus(condition.Status),
+			Reason:             string(condition.Reason),
+			Message:            condition.Message,
+			LastTransitionTime: condition.LastTransitionTime.Time,
+		})
+	}
+
+	if len(out) == 0 {
 		return nil
 	}
 
+	return &out
+}
+
+func convertClusterStatus(in *unikornv1.ComputeCluster) *openapi.ComputeClusterStatus {
 	out := &openapi.ComputeClusterStatus{
-		SshPrivateKey: in.SSHPrivateKey,
-		WorkloadPools: convertWorkloadPoolsStatus(in.WorkloadPools),
+		SshPrivateKey: in.Status.SSHPrivateKey,
+		WorkloadPools: convertWorkloadPoolsStatus(in),
+		Cancellation:  convertCancellationStatus(in),
+		Network:       convertNetworkStatus(in),
+		Conditions:    convertConditions(in),
+	}
+
+	if in.Spec.MaintenanceMode {
+		out.MaintenanceMode = ptr.To(true)
 	}
 
 	return out
@@ -334,7 +620,7 @@ func (g *generator) convert(in *unikornv1.ComputeCluster) *openapi.ComputeCluste
 			RegionId:      in.Spec.RegionID,
 			WorkloadPools: g.convertWorkloadPools(in),
 		},
-		Status: convertClusterStatus(&in.Status),
+		Status: convertClusterStatus(in),
 	}
 
 	return out
@@ -364,7 +650,7 @@ func (g *generator) chooseImage(ctx context.Context, regionID string, pool *open
 	})
 
 	if len(images) == 0 {
-		return nil, errors.OAuth2InvalidRequest("no images available for the specified selector")
+		return nil, errorsv2.InvalidRequest(openapi.ComputeClusterInvalidImage, "no images available for the specified selector")
 	}
 
 	// Preserve existing image to prevent unexpected rebuilds.
@@ -438,6 +724,10 @@ func (g *generator) generateMachineGeneric(ctx context.Context, request *openapi
 		return nil, err
 	}
 
+	if err := util.ValidateBootstrapProfile(ctx, g.client, g.namespace, pool.Machine.BootstrapProfile, flavor, image); err != nil {
+		return nil, err
+	}
+
 	machine := &unikornv1core.MachineGeneric{
 		Replicas: pool.Machine.Replicas,
 		ImageID:  image.Metadata.Id,
@@ -449,11 +739,25 @@ func (g *generator) generateMachineGeneric(ctx context.Context, request *openapi
 
 // generateWorkloadPools generates the workload pools part of a cluster.
 func (g *generator) generateWorkloadPools(ctx context.Context, request *openapi.ComputeClusterWrite) (*unikornv1.ComputeClusterWorkloadPoolsSpec, error) {
+	if err := g.options.validatePools(len(request.Spec.WorkloadPools)); err != nil {
+		return nil, err
+	}
+
 	workloadPools := &unikornv1.ComputeClusterWorkloadPoolsSpec{}
 
 	for i := range request.Spec.WorkloadPools {
 		pool := &request.Spec.WorkloadPools[i]
 
+		if err := g.options.validatePoolReplicas(pool.Name, pool.Machine.Replicas); err != nil {
+			return nil, err
+		}
+
+		if pool.Autoscaling != nil {
+			if err := g.options.validatePoolReplicas(pool.Name, pool.Autoscaling.MaxReplicas); err != nil {
+				return nil, err
+			}
+		}
+
 		flavor, err := g.lookupFlavor(ctx, request, pool.Machine.FlavorId)
 		if err != nil {
 			return nil, err
@@ -464,7 +768,7 @@ func (g *generator) generateWorkloadPools(ctx context.Context, request *openapi.
 			return nil, err
 		}
 
-		firewall, err := generateFirewallRules(pool.Machine.Firewall)
+		firewall, err := computeconversion.GenerateFirewallRules(pool.Machine.Firewall)
 		if err != nil {
 			return nil, err
 		}
@@ -474,14 +778,56 @@ func (g *generator) generateWorkloadPools(ctx context.Context, request *openapi.
 			return nil, err
 		}
 
+		userDataTemplate, err := generateUserDataTemplate(&pool.Machine)
+		if err != nil {
+			return nil, err
+		}
+
+		autoscaling, err := generateAutoscaling(pool.Autoscaling)
+		if err != nil {
+			return nil, err
+		}
+
+		if autoscaling != nil {
+			machine.Replicas = g.generateAutoscaledReplicas(pool.Name, machine.Replicas, autoscaling)
+		}
+
+		updateStrategy, err := generateUpdateStrategy(pool.UpdateStrategy)
+		if err != nil {
+			return nil, err
+		}
+
+		hostnamePrefix := ptr.Deref(pool.HostnamePrefix, "")
+		domain := ptr.Deref(pool.Domain, "")
+
+		if err := managerutil.ValidateNaming(hostnamePrefix, domain); err != nil {
+			return nil, errorsv2.InvalidRequest(openapi.ComputeClusterInvalidSpec, err.Error())
+		}
+
+		// Labels are only ever user defined, so system tags are always rejected.
+		labels, err := util.GenerateTagList(pool.Labels, nil)
+		if err != nil {
+			return nil, err
+		}
+
 		workloadPool := unikornv1.ComputeClusterWorkloadPoolSpec{
 			Name:                pool.Name,
+			HostnamePrefix:      hostnamePrefix,
+			Domain:              domain,
 			MachineGeneric:      *machine,
 			PublicIPAllocation:  g.generatePublicIPAllocation(pool),
 			Firewall:            firewall,
 			UserData:            g.generateUserData(pool.Machine.UserData),
+			UserDataTemplate:    userDataTemplate,
+			BootstrapProfile:    computeconversion.GenerateBootstrapProfile(pool.Machine.BootstrapProfile),
 			ImageSelector:       g.generateImageSelector(pool.Machine.Image),
 			AllowedAddressPairs: allowedAddressPairs,
+			SecurityGroupIDs:    generateSecurityGroupIDs(pool.Machine.SecurityGroupIds),
+			Autoscaling:         autoscaling,
+			AutoHealing:         generateAutoHealing(pool.AutoHealing),
+			UpdateStrategy:      updateStrategy,
+			GoldenImage:         g.generateGoldenImage(pool.Name, machine.ImageID),
+			Labels:              labels,
 		}
 
 		workloadPools.Pools = append(workloadPools.Pools, workloadPool)
@@ -490,6 +836,98 @@ func (g *generator) generateWorkloadPools(ctx context.Context, request *openapi.
 	return workloadPools, nil
 }
 
+// generateSecurityGroupIDs generates the pre-existing security groups part of a workload pool.
+func generateSecurityGroupIDs(in *[]string) []string {
+	if in == nil || len(*in) == 0 {
+		return nil
+	}
+
+	return *in
+}
+
+// generateAutoHealing generates the auto healing part of a workload pool.
+func generateAutoHealing(in *openapi.ComputeClusterWorkloadPoolAutoHealing) *unikornv1.WorkloadPoolAutoHealingSpec {
+	if in == nil {
+		return nil
+	}
+
+	gracePeriod := ptr.Deref(in.GracePeriodSeconds, int(unikornv1.DefaultAutoHealingGracePeriod/time.Second))
+
+	return &unikornv1.WorkloadPoolAutoHealingSpec{
+		GracePeriod: metav1.Duration{
+			Duration: time.Duration(gracePeriod) * time.Second,
+		},
+	}
+}
+
+// generateUpdateStrategy generates the update strategy part of a workload pool.
+func generateUpdateStrategy(in *openapi.ComputeClusterWorkloadPoolUpdateStrategy) (*unikornv1.WorkloadPoolUpdateStrategy, error) {
+	if in == nil {
+		//nolint:nilnil
+		return nil, nil
+	}
+
+	out := &unikornv1.WorkloadPoolUpdateStrategy{
+		MaxUnavailable: ptr.Deref(in.MaxUnavailable, unikornv1.DefaultMaxUnavailable),
+		MaxSurge:       ptr.Deref(in.MaxSurge, 0),
+	}
+
+	if out.MaxUnavailable == 0 && out.MaxSurge == 0 {
+		return nil, errorsv2.InvalidRequest(openapi.ComputeClusterInvalidSpec, "update strategy maximum unavailable and maximum surge cannot both be zero")
+	}
+
+	return out, nil
+}
+
+// generateAutoscaling generates the autoscaling part of a workload pool.
+func generateAutoscaling(in *openapi.ComputeClusterWorkloadPoolAutoscaling) (*unikornv1.WorkloadPoolAutoscalingSpec, error) {
+	if in == nil {
+		//nolint:nilnil
+		return nil, nil
+	}
+
+	if in.MinReplicas > in.MaxReplicas {
+		return nil, errorsv2.InvalidRequest(openapi.ComputeClusterInvalidSpec, "autoscaling minimum replicas exceeds maximum replicas")
+	}
+
+	out := &unikornv1.WorkloadPoolAutoscalingSpec{
+		MinReplicas:          in.MinReplicas,
+		MaxReplicas:          in.MaxReplicas,
+		TargetCPUUtilization: ptr.Deref(in.TargetCpuUtilization, unikornv1.DefaultTargetCPUUtilization),
+	}
+
+	return out, nil
+}
+
+// generateAutoscaledReplicas picks the replica count for an autoscaled pool.  If the
+// pool is already being autoscaled then the current replica count is retained, as
+// that's owned by the autoscaler, otherwise the requested count is used as the
+// initial size.  Either way it's constrained by the requested bounds.
+func (g *generator) generateAutoscaledReplicas(name string, replicas int, autoscaling *unikornv1.WorkloadPoolAutoscalingSpec) int {
+	if g.current != nil {
+		if pool, ok := g.current.GetWorkloadPool(name); ok && pool.Autoscaling != nil {
+			replicas = pool.Replicas
+		}
+	}
+
+	return min(max(replicas, autoscaling.MinReplicas), autoscaling.MaxReplicas)
+}
+
+// generateGoldenImage preserves the golden image record of a pool while it continues
+// to use the image, so the controller still waits for the snapshot to be ready.
+func (g *generator) generateGoldenImage(name, imageID string) *unikornv1.WorkloadPoolGoldenImage {
+	if g.current == nil {
+		return nil
+	}
+
+	pool, ok := g.current.GetWorkloadPool(name)
+	if !ok || pool.GoldenImage == nil || pool.GoldenImage.ImageID != imageID {
+		return nil
+	}
+
+	return pool.GoldenImage
+}
+
 // generateAllowedAddressPairs generates the allowed address pairs part of a workload pool.
 func (g *generator) generateAllowedAddressPairs(in *openapi.AllowedAddressPairList) ([]unikornv1.ComputeWorkloadPoolAddressPair, error) {
 	if in == nil {
@@ -542,87 +980,28 @@ func (g *generator) generatePublicIPAllocation(request *openapi.ComputeClusterWo
 	}
 }
 
-func (g *generator) generateUserData(data *[]byte) []byte {
-	if data == nil {
-		return nil
+// generateUserDataTemplate checks that templated user data can be expanded, so
+// errors are reported now rather than failing provisioning.
+func generateUserDataTemplate(in *openapi.MachinePool) (bool, error) {
+	if in.UserDataTemplate == nil || !*in.UserDataTemplate {
+		return false, nil
 	}
 
-	return *data
-}
-
-func generateFirewallRuleDirection(in openapi.FirewallRuleDirection) unikornv1.FirewallRuleDirection {
-	switch in {
-	case openapi.Ingress:
-		return unikornv1.Ingress
-	case openapi.Egress:
-		return unikornv1.Egress
-	}
-
-	return ""
-}
-
-func generateFirewallRuleProtocol(in openapi.FirewallRuleProtocol) unikornv1.FirewallRuleProtocol {
-	switch in {
-	case openapi.Tcp:
-		return unikornv1.TCP
-	case openapi.Udp:
-		return unikornv1.UDP
-	}
-
-	return ""
-}
-
-func generatePrefixes(in []string) ([]unikornv1core.IPv4Prefix, error) {
-	out := make([]unikornv1core.IPv4Prefix, len(in))
-
-	for i := range in {
-		_, cidr, err := net.ParseCIDR(in[i])
-		if err != nil {
-			return nil, err
-		}
-
-		out[i] = unikornv1core.IPv4Prefix{
-			IPNet: *cidr,
+	if in.UserData != nil {
+		if err := managerutil.ValidateUserDataTemplate(*in.UserData); err != nil {
+			return false, errorsv2.InvalidRequest(openapi.ComputeClusterInvalidSpec, "user data template is invalid:", err)
 		}
 	}
 
-	return out, nil
+	return true, nil
 }
 
-func generateFirewallRule(in *openapi.FirewallRule) (*unikornv1.FirewallRule, error) {
-	prefixes, err := generatePrefixes(in.Prefixes)
-	if err != nil {
-		return nil, err
-	}
-
-	out := &unikornv1.FirewallRule{
-		Direction: generateFirewallRuleDirection(in.Direction),
-		Protocol:  generateFirewallRuleProtocol(in.Protocol),
-		Port:      in.Port,
-		PortMax:   in.PortMax,
-		Prefixes:  prefixes,
-	}
-
-	return out, nil
-}
-
-func generateFirewallRules(in *openapi.FirewallRules) ([]unikornv1.FirewallRule, error) {
-	if in == nil || len(*in) == 0 {
-		return nil, nil
-	}
-
-	out := make([]unikornv1.FirewallRule, len(*in))
-
-	for i := range *in {
-		rule, err := generateFirewallRule(&(*in)[i])
-		if err != nil {
-			return nil, err
-		}
-
-		out[i] = *rule
+func (g *generator) generateUserData(data *[]byte) []byte {
+	if data == nil {
+		return nil
 	}
 
-	return out, nil
+	return *data
 }
 
 func (g *generator) lookupRegion(ctx context.Context, id string) (*regionapi.RegionRead, error) {
@@ -639,11 +1018,11 @@ func (g *generator) lookupRegion(ctx context.Context, id string) (*regionapi.Reg
 		return &regions[index], nil
 	}
 
-	return nil, errors.OAuth2InvalidRequest("region ID is invalid or cannot be resolved")
+	return nil, errorsv2.InvalidRequest(openapi.ComputeClusterInvalidRegion, "region ID is invalid or cannot be resolved")
 }
 
 // lookupFlavor resolves the flavor from its name.
-// NOTE: It looks like garbage performance, but the provider should be memoized...
+// NOTE: this is called per pool, so callers should provide a memoized region client.
 func (g *generator) lookupFlavor(ctx context.Context, request *openapi.ComputeClusterWrite, id string) (*regionapi.Flavor, error) {
 	flavors, err := g.region.Flavors(ctx, g.organizationID, request.Spec.RegionId)
 	if err != nil {
@@ -658,7 +1037,7 @@ func (g *generator) lookupFlavor(ctx context.Context, request *openapi.ComputeCl
 		return &flavors[index], nil
 	}
 
-	return nil, errors.OAuth2InvalidRequest("flavor ID is invalid or cannot be resolved")
+	return nil, errorsv2.InvalidRequest(openapi.ComputeClusterInvalidFlavor, "flavor ID is invalid or cannot be resolved")
 }
 
 // generate generates the full cluster custom resource.
@@ -672,16 +1051,44 @@ func (g *generator) generate(ctx context.Context, request *openapi.ComputeCluste
 		return nil, err
 	}
 
+	var currentTags unikornv1core.TagList
+
+	if g.current != nil {
+		currentTags = g.current.Spec.Tags
+	}
+
+	tags, err := util.GenerateTagList(request.Metadata.Tags, currentTags)
+	if err != nil {
+		return nil, err
+	}
+
 	out := &unikornv1.ComputeCluster{
 		ObjectMeta: conversion.NewObjectMetadata(&request.Metadata, g.namespace).WithOrganization(g.organizationID).WithProject(g.projectID).Get(),
 		Spec: unikornv1.ComputeClusterSpec{
-			Tags:          conversion.GenerateTagList(request.Metadata.Tags),
+			Tags:          tags,
 			RegionID:      request.Spec.RegionId,
 			Network:       g.generateNetwork(),
 			WorkloadPools: computeWorkloadPools,
 		},
 	}
 
+	// These are enforced by admission control too, but catch them here so the
+	// client gets a meaningful error.
+	if err := out.Spec.Validate(); err != nil {
+		return nil, errorsv2.InvalidRequest(openapi.ComputeClusterInvalidSpec, err.Error()).WithError(err)
+	}
+
+	if g.current != nil {
+		// Maintenance mode and per-machine overrides are changed independently of
+		// the cluster's specification.
+		out.Spec.MaintenanceMode = g.current.Spec.MaintenanceMode
+		out.Spec.Machines = g.current.Spec.Machines
+
+		if err := out.Spec.ValidateUpdate(&g.current.Spec); err != nil {
+			return nil, errorsv2.InvalidRequest(openapi.ComputeClusterInvalidSpec, err.Error()).WithError(err)
+		}
+	}
+
 	if err := common.SetIdentityMetadata(ctx, &out.ObjectMeta); err != nil {
 		return nil, fmt.Errorf("%w: failed to set identity metadata", err)
 	}
@@ -18,6 +18,7 @@ limitations under the License.
 package cluster_test
 
 import (
+	"net"
 	"testing"
 	"time"
 
@@ -29,9 +30,11 @@ import (
 	"github.com/unikorn-cloud/compute/pkg/server/handler/cluster"
 	"github.com/unikorn-cloud/compute/pkg/server/handler/region/mock"
 	corev1 "github.com/unikorn-cloud/core/pkg/apis/unikorn/v1alpha1"
+	coreconstants "github.com/unikorn-cloud/core/pkg/constants"
 	coreapi "github.com/unikorn-cloud/core/pkg/openapi"
 	regionapi "github.com/unikorn-cloud/region/pkg/openapi"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
 )
 
@@ -284,3 +287,116 @@ func TestImageSelectionPreservation(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, image1ID, image.Metadata.Id)
 }
+
+// TestNetworkStatus ensures the node network is reported from the specification.
+func TestNetworkStatus(t *testing.T) {
+	t.Parallel()
+
+	_, prefix, err := net.ParseCIDR("192.168.0.0/24")
+	require.NoError(t, err)
+
+	in := &computev1.ComputeCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Labels: map[string]string{
+				coreconstants.NetworkLabel: "network",
+			},
+		},
+		Spec: computev1.ComputeClusterSpec{
+			Network: &corev1.NetworkGeneric{
+				NodeNetwork: corev1.IPv4Prefix{IPNet: *prefix},
+				DNSNameservers: []corev1.IPv4Address{
+					{IP: net.ParseIP("8.8.8.8")},
+				},
+			},
+		},
+	}
+
+	out := cluster.ConvertNetworkStatus(in)
+	require.NotNil(t, out)
+	require.Equal(t, "192.168.0.0/24", out.Prefix)
+	require.Equal(t, []string{"8.8.8.8"}, out.DnsNameservers)
+	require.Equal(t, ptr.To("network"), out.NetworkId)
+
+	require.Nil(t, cluster.ConvertNetworkStatus(&computev1.ComputeCluster{}))
+}
+
+// TestConditions ensures dependency readiness is reported in provisioning order
+// and unrelated conditions are ignored.
+func TestConditions(t *testing.T) {
+	t.Parallel()
+
+	require.Nil(t, cluster.ConvertConditions(&computev1.ComputeCluster{}))
+
+	in := &computev1.ComputeCluster{
+		Status: computev1.ComputeClusterStatus{
+			Conditions: []corev1.Condition{
+				{
+					Type:    computev1.ConditionNetworkReady,
+					Status:  "False",
+					Reason:  corev1.ConditionReasonProvisioning,
+					Message: "waiting for network",
+				},
+				{
+					Type:   corev1.ConditionAvailable,
+					Status: "False",
+					Reason: corev1.ConditionReasonProvisioning,
+				},
+				{
+					Type:    computev1.ConditionIdentityReady,
+					Status:  "True",
+					Reason:  corev1.ConditionReasonProvisioned,
+					Message: "identity ready",
+				},
+			},
+		},
+	}
+
+	out := cluster.ConvertConditions(in)
+	require.NotNil(t, out)
+	require.Len(t, *out, 2)
+	require.Equal(t, computeapi.IdentityReady, (*out)[0].Type)
+	require.Equal(t, computeapi.True, (*out)[0].Status)
+	require.Equal(t, computeapi.NetworkReady, (*out)[1].Type)
+	require.Equal(t, computeapi.False, (*out)[1].Status)
+	require.Equal(t, "waiting for network", (*out)[1].Message)
+}
+
+// TestMachines ensures machines are flattened from all pools and filtered.
+func TestMachines(t *testing.T) {
+	t.Parallel()
+
+	created := metav1.NewTime(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+
+	in := &computev1.ComputeCluster{
+		Status: computev1.ComputeClusterStatus{
+			WorkloadPools: []computev1.WorkloadPoolStatus{
+				{
+					Name: "pool-a",
+					Machines: []computev1.MachineStatus{
+						{ID: "a-1", Hostname: "a-1", Status: "Running", CreationTime: &created},
+						{ID: "a-2", Hostname: "a-2", Status: "Stopped"},
+					},
+				},
+				{
+					Name: "pool-b",
+					Machines: []computev1.MachineStatus{
+						{ID: "b-1", Hostname: "b-1", Status: "Running"},
+					},
+				},
+			},
+		},
+	}
+
+	out := cluster.ConvertMachines(in, &computeapi.GetApiV1OrganizationsOrganizationIDProjectsProjectIDClustersClusterIDMachinesParams{})
+	require.Len(t, out, 3)
+	require.Equal(t, "pool-a", out[0].Pool)
+	require.Equal(t, ptr.To(created.Time), out[0].CreationTime)
+	require.Equal(t, "pool-b", out[2].Pool)
+
+	out = cluster.ConvertMachines(in, &computeapi.GetApiV1OrganizationsOrganizationIDProjectsProjectIDClustersClusterIDMachinesParams{
+		Pool:   &[]string{"pool-a"},
+		Status: &[]regionapi.InstanceLifecyclePhase{regionapi.InstanceLifecyclePhaseRunning},
+	})
+	require.Len(t, out, 1)
+	require.Equal(t, "a-1", out[0].Id)
+}
@@ -0,0 +1,154 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	unikornv1 "github.com/unikorn-cloud/compute/pkg/apis/unikorn/v1alpha1"
+	"github.com/unikorn-cloud/compute/pkg/openapi"
+	"github.com/unikorn-cloud/compute/pkg/server/handler/region"
+	unikornv1core "github.com/unikorn-cloud/core/pkg/apis/unikorn/v1alpha1"
+	"github.com/unikorn-cloud/core/pkg/constants"
+	"github.com/unikorn-cloud/core/pkg/server/errors"
+	regionapi "github.com/unikorn-cloud/region/pkg/openapi"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
+)
+
+// machineStatus finds a machine in the cluster's status.
+func machineStatus(cluster *unikornv1.ComputeCluster, machineID string) (*unikornv1.MachineStatus, bool) {
+	for i := range cluster.Status.WorkloadPools {
+		pool := &cluster.Status.WorkloadPools[i]
+
+		for j := range pool.Machines {
+			if pool.Machines[j].ID == machineID {
+				return &pool.Machines[j], true
+			}
+		}
+	}
+
+	return nil, false
+}
+
+// convertMachineConditions converts machine conditions into the API definition.
+func convertMachineConditions(in []unikornv1core.Condition) *openapi.MachineConditions {
+	if len(in) == 0 {
+		return nil
+	}
+
+	out := make(openapi.MachineConditions, len(in))
+
+	for i := range in {
+		out[i] = openapi.MachineCondition{
+			Type:               string(in[i].Type),
+			Status:             string(in[i].Status),
+			Reason:             string(in[i].Reason),
+			Message:            in[i].Message,
+			LastTransitionTime: in[i].LastTransitionTime.Time,
+		}
+	}
+
+	return &out
+}
+
+// machineEvents selects events that refer to a machine, the controller references
+// servers by both name and ID in its messages.
+func machineEvents(in []corev1.Event, machineID, hostname string) []corev1.Event {
+	return slices.DeleteFunc(in, func(event corev1.Event) bool {
+		return !strings.Contains(event.Message, machineID) && (hostname == "" || !strings.Contains(event.Message, hostname))
+	})
+}
+
+// serverSecurityGroupIDs returns the security groups a server is a member of.
+func serverSecurityGroupIDs(server *regionapi.ServerRead) []string {
+	out := []string{}
+
+	if server.Spec.SecurityGroups != nil {
+		for _, securityGroup := range *server.Spec.SecurityGroups {
+			out = append(out, securityGroup.Id)
+		}
+	}
+
+	return out
+}
+
+// Diagnostics assembles a diagnostic bundle for a machine from the cluster's status,
+// the region service and controller events.  The machine must exist, but beyond that
+// collection is best effort so support gets as much as is available.
+func (c *Client) Diagnostics(ctx context.Context, organizationID, projectID, clusterID, machineID string) (*openapi.MachineDiagnostics, error) {
+	cluster, err := c.get(ctx, organizationID, projectID, clusterID)
+	if err != nil {
+		return nil, err
+	}
+
+	servers, err := region.New(c.region).Servers(ctx, organizationID, cluster)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to list servers", err)
+	}
+
+	index := slices.IndexFunc(servers, func(server regionapi.ServerRead) bool {
+		return server.Metadata.Id == machineID
+	})
+
+	if index < 0 {
+		return nil, errors.HTTPNotFound()
+	}
+
+	server := &servers[index]
+
+	out := &openapi.MachineDiagnostics{
+		MachineId:        machineID,
+		CollectionTime:   time.Now(),
+		Server:           server,
+		SecurityGroupIds: serverSecurityGroupIDs(server),
+		Events:           openapi.ClusterEvents{},
+	}
+
+	if status, ok := machineStatus(cluster, machineID); ok {
+		out.Machine = convertMachineStatus(status, cluster)
+		out.Conditions = convertMachineConditions(status.Conditions)
+	}
+
+	var failures []string
+
+	events, err := c.events(ctx, cluster)
+	if err != nil {
+		failures = append(failures, "events: "+err.Error())
+	} else {
+		out.Events = convertEvents(machineEvents(events, machineID, server.Metadata.Name))
+	}
+
+	consoleOutput, err := region.New(c.region).GetConsoleOutput(ctx, organizationID, projectID, cluster.Annotations[constants.IdentityAnnotation], machineID, &regionapi.GetApiV1OrganizationsOrganizationIDProjectsProjectIDIdentitiesIdentityIDServersServerIDConsoleoutputParams{})
+	if err != nil {
+		failures = append(failures, "console output: "+err.Error())
+	} else {
+		out.ConsoleOutput = ptr.To(consoleOutput.Contents)
+	}
+
+	if len(failures) > 0 {
+		out.Errors = &failures
+	}
+
+	return out, nil
+}
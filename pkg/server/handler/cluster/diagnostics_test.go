@@ -0,0 +1,50 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/unikorn-cloud/compute/pkg/server/handler/cluster"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+// TestMachineEvents ensures only events referring to the machine, by ID or
+// hostname, are included in its diagnostics.
+func TestMachineEvents(t *testing.T) {
+	t.Parallel()
+
+	events := []corev1.Event{
+		{Reason: "ServerCreated", Message: "Created server pool-1-abcde (713cf558-4d32-4598-8af2-48e587b67a50) in pool pool-1"},
+		{Reason: "ServerCreated", Message: "Created server pool-1-fghij (c7568e2d-f9ab-453d-9a3a-51375f78426b) in pool pool-1"},
+		{Reason: "ServerRebuild", Message: "Rebuilding server pool-1-abcde in pool pool-1"},
+		{Reason: "ServerCreateFailed", Message: "Failed to create server in pool pool-1: quota exceeded"},
+	}
+
+	selected := cluster.MachineEvents(slices.Clone(events), "713cf558-4d32-4598-8af2-48e587b67a50", "pool-1-abcde")
+	require.Len(t, selected, 2)
+	require.Equal(t, "ServerCreated", selected[0].Reason)
+	require.Equal(t, "ServerRebuild", selected[1].Reason)
+
+	// An unknown hostname must not match everything.
+	require.Len(t, cluster.MachineEvents(slices.Clone(events), "713cf558-4d32-4598-8af2-48e587b67a50", ""), 1)
+}
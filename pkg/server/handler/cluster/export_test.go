@@ -20,7 +20,10 @@ package cluster
 import (
 	"context"
 
+	unikornv1 "github.com/unikorn-cloud/compute/pkg/apis/unikorn/v1alpha1"
 	"github.com/unikorn-cloud/compute/pkg/openapi"
+	"github.com/unikorn-cloud/compute/pkg/server/handler/region"
+	"github.com/unikorn-cloud/core/pkg/server/saga"
 	regionapi "github.com/unikorn-cloud/region/pkg/openapi"
 )
 
@@ -30,3 +33,53 @@ var NewGenerator = newGenerator
 func ChooseImage(ctx context.Context, g *generator, regionID string, pool *openapi.ComputeClusterWorkloadPool, flavor *regionapi.Flavor) (*regionapi.Image, error) {
 	return g.chooseImage(ctx, regionID, pool, flavor)
 }
+
+//nolint:gochecknoglobals
+var CompatibleImages = compatibleImages
+
+//nolint:gochecknoglobals
+var CompatibleFlavors = compatibleFlavors
+
+//nolint:gochecknoglobals
+var FirewallRuleID = firewallRuleID
+
+//nolint:gochecknoglobals
+var PoolServers = poolServers
+
+//nolint:gochecknoglobals
+var LiveServers = liveServers
+
+//nolint:gochecknoglobals
+var ConvertNetworkStatus = convertNetworkStatus
+
+//nolint:gochecknoglobals
+var ConvertConditions = convertConditions
+
+//nolint:gochecknoglobals
+var ConvertMachines = convertMachines
+
+func RunUpdateSaga(ctx context.Context, c *Client, regions region.ClientInterface, organizationID string, current, updated *unikornv1.ComputeCluster) error {
+	return saga.Run(ctx, newUpdateSaga(c, regions, organizationID, current, updated))
+}
+
+//nolint:gochecknoglobals
+var MachineEvents = machineEvents
+
+//nolint:gochecknoglobals
+var ValidateAdoptedServer = validateAdoptedServer
+
+//nolint:gochecknoglobals
+var PreviewPools = previewPools
+
+//nolint:gochecknoglobals
+var PreviewAllocations = previewAllocations
+
+//nolint:gochecknoglobals
+var ResolveEvictionIDs = resolveEvictionIDs
+
+//nolint:gochecknoglobals
+var MetadataMutator = metadataMutator
+
+func ValidateSecurityGroups(ctx context.Context, c *Client, organizationID, projectID string, request *openapi.ComputeClusterWrite) error {
+	return c.validateSecurityGroups(ctx, organizationID, projectID, request)
+}
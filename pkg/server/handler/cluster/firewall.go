@@ -0,0 +1,182 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	unikornv1 "github.com/unikorn-cloud/compute/pkg/apis/unikorn/v1alpha1"
+	computeconversion "github.com/unikorn-cloud/compute/pkg/conversion"
+	"github.com/unikorn-cloud/compute/pkg/openapi"
+	"github.com/unikorn-cloud/compute/pkg/server/errorsv2"
+	"github.com/unikorn-cloud/core/pkg/server/errors"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// firewallRuleID derives a stable identifier for a firewall rule from its content,
+// rules have no identity of their own and prefix ordering is not significant.
+func firewallRuleID(rule *unikornv1.FirewallRule) string {
+	prefixes := computeconversion.ConvertPrefixes(rule.Prefixes)
+
+	slices.Sort(prefixes)
+
+	portMax := ""
+
+	if rule.PortMax != nil {
+		portMax = strconv.Itoa(*rule.PortMax)
+	}
+
+	fields := []string{
+		string(rule.Direction),
+		string(rule.Protocol),
+		strconv.Itoa(rule.Port),
+		portMax,
+		strings.Join(prefixes, ","),
+	}
+
+	sum := sha256.Sum256([]byte(strings.Join(fields, "/")))
+
+	return hex.EncodeToString(sum[:8])
+}
+
+func convertFirewallRuleRead(in *unikornv1.FirewallRule) *openapi.FirewallRuleRead {
+	return &openapi.FirewallRuleRead{
+		Id:   firewallRuleID(in),
+		Spec: *computeconversion.ConvertFirewallRule(in),
+	}
+}
+
+func convertFirewallRulesRead(in []unikornv1.FirewallRule) openapi.FirewallRulesRead {
+	out := make(openapi.FirewallRulesRead, len(in))
+
+	for i := range in {
+		out[i] = *convertFirewallRuleRead(&in[i])
+	}
+
+	return out
+}
+
+// getWorkloadPool returns a cluster and its named workload pool.
+func (c *Client) getWorkloadPool(ctx context.Context, organizationID, projectID, clusterID, poolName string) (*unikornv1.ComputeCluster, *unikornv1.ComputeClusterWorkloadPoolSpec, error) {
+	cluster, err := c.get(ctx, organizationID, projectID, clusterID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pool, ok := cluster.GetWorkloadPool(poolName)
+	if !ok {
+		return nil, nil, errors.HTTPNotFound()
+	}
+
+	return cluster, pool, nil
+}
+
+// updateFirewallRules replaces a workload pool's firewall rules, the provisioner
+// will then reconcile the pool's security group.
+func (c *Client) updateFirewallRules(ctx context.Context, cluster *unikornv1.ComputeCluster, poolName string, rules []unikornv1.FirewallRule) error {
+	updated := cluster.DeepCopy()
+
+	pool, ok := updated.GetWorkloadPool(poolName)
+	if !ok {
+		return errors.HTTPNotFound()
+	}
+
+	pool.Firewall = rules
+
+	if err := c.client.Patch(ctx, updated, client.MergeFromWithOptions(cluster, &client.MergeFromWithOptimisticLock{})); err != nil {
+		return fmt.Errorf("%w: failed to patch cluster", err)
+	}
+
+	return nil
+}
+
+// ListFirewallRules returns the firewall rules applied to a workload pool.
+func (c *Client) ListFirewallRules(ctx context.Context, organizationID, projectID, clusterID, poolName string) (openapi.FirewallRulesRead, error) {
+	_, pool, err := c.getWorkloadPool(ctx, organizationID, projectID, clusterID, poolName)
+	if err != nil {
+		return nil, err
+	}
+
+	return convertFirewallRulesRead(pool.Firewall), nil
+}
+
+// CreateFirewallRule adds a firewall rule to a workload pool.
+func (c *Client) CreateFirewallRule(ctx context.Context, organizationID, projectID, clusterID, poolName string, request *openapi.FirewallRule) (*openapi.FirewallRuleRead, error) {
+	cluster, pool, err := c.getWorkloadPool(ctx, organizationID, projectID, clusterID, poolName)
+	if err != nil {
+		return nil, err
+	}
+
+	if cluster.DeletionTimestamp != nil {
+		return nil, errorsv2.InvalidRequest(openapi.ComputeClusterDeleting, "compute cluster is being deleted")
+	}
+
+	rule, err := computeconversion.GenerateFirewallRule(request)
+	if err != nil {
+		return nil, errorsv2.InvalidRequest(openapi.ComputeClusterInvalidSpec, "firewall rule prefixes are invalid").WithError(err)
+	}
+
+	if err := rule.Validate(); err != nil {
+		return nil, errorsv2.InvalidRequest(openapi.ComputeClusterInvalidSpec, err.Error()).WithError(err)
+	}
+
+	id := firewallRuleID(rule)
+
+	if slices.ContainsFunc(pool.Firewall, func(existing unikornv1.FirewallRule) bool {
+		return firewallRuleID(&existing) == id
+	}) {
+		return nil, errors.HTTPConflict()
+	}
+
+	rules := append(slices.Clone(pool.Firewall), *rule)
+
+	if err := c.updateFirewallRules(ctx, cluster, poolName, rules); err != nil {
+		return nil, err
+	}
+
+	return convertFirewallRuleRead(rule), nil
+}
+
+// DeleteFirewallRule removes a firewall rule from a workload pool.
+func (c *Client) DeleteFirewallRule(ctx context.Context, organizationID, projectID, clusterID, poolName, ruleID string) error {
+	cluster, pool, err := c.getWorkloadPool(ctx, organizationID, projectID, clusterID, poolName)
+	if err != nil {
+		return err
+	}
+
+	if cluster.DeletionTimestamp != nil {
+		return errorsv2.InvalidRequest(openapi.ComputeClusterDeleting, "compute cluster is being deleted")
+	}
+
+	rules := slices.DeleteFunc(slices.Clone(pool.Firewall), func(rule unikornv1.FirewallRule) bool {
+		return firewallRuleID(&rule) == ruleID
+	})
+
+	if len(rules) == len(pool.Firewall) {
+		return errors.HTTPNotFound()
+	}
+
+	return c.updateFirewallRules(ctx, cluster, poolName, rules)
+}
@@ -0,0 +1,71 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster_test
+
+import (
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	computev1 "github.com/unikorn-cloud/compute/pkg/apis/unikorn/v1alpha1"
+	"github.com/unikorn-cloud/compute/pkg/server/handler/cluster"
+	corev1 "github.com/unikorn-cloud/core/pkg/apis/unikorn/v1alpha1"
+
+	"k8s.io/utils/ptr"
+)
+
+func mustPrefix(t *testing.T, s string) corev1.IPv4Prefix {
+	t.Helper()
+
+	_, prefix, err := net.ParseCIDR(s)
+	require.NoError(t, err)
+
+	return corev1.IPv4Prefix{IPNet: *prefix}
+}
+
+// TestFirewallRuleID ensures rule IDs are stable regardless of prefix order, and
+// unique across differing rules.
+func TestFirewallRuleID(t *testing.T) {
+	t.Parallel()
+
+	rule := &computev1.FirewallRule{
+		Direction: computev1.Ingress,
+		Protocol:  computev1.TCP,
+		Port:      443,
+		Prefixes: []corev1.IPv4Prefix{
+			mustPrefix(t, "10.0.0.0/8"),
+			mustPrefix(t, "192.168.0.0/16"),
+		},
+	}
+
+	reordered := rule.DeepCopy()
+	reordered.Prefixes[0], reordered.Prefixes[1] = reordered.Prefixes[1], reordered.Prefixes[0]
+
+	require.Equal(t, cluster.FirewallRuleID(rule), cluster.FirewallRuleID(reordered))
+
+	ranged := rule.DeepCopy()
+	ranged.PortMax = ptr.To(8443)
+
+	require.NotEqual(t, cluster.FirewallRuleID(rule), cluster.FirewallRuleID(ranged))
+
+	egress := rule.DeepCopy()
+	egress.Direction = computev1.Egress
+
+	require.NotEqual(t, cluster.FirewallRuleID(rule), cluster.FirewallRuleID(egress))
+}
@@ -0,0 +1,103 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	unikornv1 "github.com/unikorn-cloud/compute/pkg/apis/unikorn/v1alpha1"
+	"github.com/unikorn-cloud/compute/pkg/openapi"
+	"github.com/unikorn-cloud/compute/pkg/server/errorsv2"
+	"github.com/unikorn-cloud/compute/pkg/server/handler/instance"
+	"github.com/unikorn-cloud/core/pkg/constants"
+	coreapi "github.com/unikorn-cloud/core/pkg/openapi"
+	"github.com/unikorn-cloud/core/pkg/server/errors"
+	regionconstants "github.com/unikorn-cloud/region/pkg/constants"
+
+	"k8s.io/utils/ptr"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// GoldenImage snapshots an instance and sets the result as the pool's image.  The
+// snapshot will take some time to become ready, so the controller waits for it before
+// rebuilding servers.  Rebuilds are rolling by default, as a bad golden image would
+// otherwise take down the whole pool.
+func (c *Client) GoldenImage(ctx context.Context, organizationID, projectID, clusterID, poolName string, request *openapi.PoolGoldenImageWrite) error {
+	cluster, err := c.get(ctx, organizationID, projectID, clusterID)
+	if err != nil {
+		return err
+	}
+
+	if cluster.DeletionTimestamp != nil {
+		return errorsv2.InvalidRequest(openapi.ComputeClusterDeleting, "compute cluster is being deleted")
+	}
+
+	if _, ok := cluster.GetWorkloadPool(poolName); !ok {
+		return errors.HTTPNotFound()
+	}
+
+	instances := instance.NewClient(c.client, c.namespace, c.identity, c.region).WithAccessOptions(c.access)
+
+	golden, err := instances.GetRaw(ctx, request.InstanceId)
+	if err != nil {
+		return err
+	}
+
+	if golden.Labels[constants.OrganizationLabel] != organizationID || golden.Labels[constants.ProjectLabel] != projectID || golden.Labels[regionconstants.RegionLabel] != cluster.Spec.RegionID {
+		return errorsv2.InvalidRequest(openapi.ComputeClusterInvalidGoldenImage, "instance must be in the same project and region as the cluster")
+	}
+
+	snapshot := openapi.InstanceSnapshotCreate{
+		Metadata: coreapi.ResourceWriteMetadata{
+			Name:        fmt.Sprintf("%s-%s-%d", cluster.Labels[constants.NameLabel], poolName, time.Now().Unix()),
+			Description: ptr.To("Golden image for pool " + poolName),
+		},
+	}
+
+	image, err := instances.Snapshot(ctx, request.InstanceId, snapshot)
+	if err != nil {
+		return err
+	}
+
+	updated := cluster.DeepCopy()
+
+	// Looked up again as the pointer must reference the copy.
+	pool, _ := updated.GetWorkloadPool(poolName)
+
+	pool.ImageID = image.Metadata.Id
+	pool.ImageSelector = nil
+	pool.GoldenImage = &unikornv1.WorkloadPoolGoldenImage{
+		InstanceID: request.InstanceId,
+		ImageID:    image.Metadata.Id,
+	}
+
+	if pool.UpdateStrategy == nil {
+		pool.UpdateStrategy = &unikornv1.WorkloadPoolUpdateStrategy{
+			MaxUnavailable: 1,
+		}
+	}
+
+	if err := c.client.Patch(ctx, updated, client.MergeFromWithOptions(cluster, &client.MergeFromWithOptimisticLock{})); err != nil {
+		return fmt.Errorf("%w: failed to patch cluster", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,212 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+
+	"golang.org/x/sync/errgroup"
+
+	"github.com/unikorn-cloud/compute/pkg/openapi"
+	managerutil "github.com/unikorn-cloud/compute/pkg/provisioners/managers/cluster/util"
+	"github.com/unikorn-cloud/compute/pkg/server/errorsv2"
+	"github.com/unikorn-cloud/compute/pkg/server/handler/region"
+	"github.com/unikorn-cloud/core/pkg/constants"
+	"github.com/unikorn-cloud/core/pkg/server/errors"
+	regionapi "github.com/unikorn-cloud/region/pkg/openapi"
+
+	"k8s.io/utils/ptr"
+)
+
+// powerFunc performs a power operation on a single server.
+type powerFunc func(ctx context.Context, organizationID, projectID, identityID, serverID string) error
+
+// liveServers returns the servers that are members of any workload pool, ordered
+// by pool then name so that rolling operations are predictable.
+func liveServers(servers []regionapi.ServerRead) []regionapi.ServerRead {
+	servers = slices.DeleteFunc(servers, func(server regionapi.ServerRead) bool {
+		if server.Metadata.DeletionTime != nil {
+			return true
+		}
+
+		_, err := managerutil.GetWorkloadPoolTag(server.Metadata.Tags)
+
+		return err != nil
+	})
+
+	slices.SortStableFunc(servers, func(a, b regionapi.ServerRead) int {
+		poolA, _ := managerutil.GetWorkloadPoolTag(a.Metadata.Tags)
+		poolB, _ := managerutil.GetWorkloadPoolTag(b.Metadata.Tags)
+
+		return cmp.Or(cmp.Compare(poolA, poolB), cmp.Compare(a.Metadata.Name, b.Metadata.Name))
+	})
+
+	return servers
+}
+
+// poolServers returns the servers that are members of the named pool, ordered by
+// name so that rolling operations are predictable.
+func poolServers(servers []regionapi.ServerRead, poolName string) []regionapi.ServerRead {
+	return slices.DeleteFunc(liveServers(servers), func(server regionapi.ServerRead) bool {
+		name, _ := managerutil.GetWorkloadPoolTag(server.Metadata.Tags)
+
+		return name != poolName
+	})
+}
+
+// powerFuncForAction maps a power action to the region operation.
+func powerFuncForAction(regionClient *region.Client, action openapi.PoolPowerWriteAction) (powerFunc, error) {
+	switch action {
+	case openapi.PoolPowerActionStart:
+		return regionClient.StartServer, nil
+	case openapi.PoolPowerActionStop:
+		return regionClient.StopServer, nil
+	case openapi.PoolPowerActionReboot:
+		return regionClient.SoftRebootServer, nil
+	}
+
+	return nil, errorsv2.InvalidRequest(openapi.ComputeClusterInvalidPowerAction, "unsupported power action")
+}
+
+// powerServers applies a power operation to the servers.  Individual failures are
+// reported per-machine rather than failing the whole request, as some machines will
+// already have been operated on.
+func (c *Client) powerServers(ctx context.Context, organizationID, projectID, identityID string, servers []regionapi.ServerRead, power powerFunc, mode openapi.PoolPowerMode) openapi.PoolPowerResults {
+	out := make(openapi.PoolPowerResults, len(servers))
+
+	for i := range servers {
+		out[i] = openapi.PoolPowerResult{
+			Id:     servers[i].Metadata.Id,
+			Status: openapi.PoolPowerSkipped,
+		}
+
+		if pool, err := managerutil.GetWorkloadPoolTag(servers[i].Metadata.Tags); err == nil {
+			out[i].Pool = ptr.To(pool)
+		}
+	}
+
+	apply := func(i int) error {
+		if err := power(ctx, organizationID, projectID, identityID, servers[i].Metadata.Id); err != nil {
+			out[i].Status = openapi.PoolPowerFailed
+			out[i].Message = ptr.To(err.Error())
+
+			return err
+		}
+
+		out[i].Status = openapi.PoolPowerAccepted
+
+		return nil
+	}
+
+	// Rolling operations stop at the first failure so a bad operation doesn't
+	// take down the whole pool.
+	if mode == openapi.PoolPowerModeRolling {
+		for i := range servers {
+			if err := apply(i); err != nil {
+				break
+			}
+		}
+
+		return out
+	}
+
+	group := &errgroup.Group{}
+
+	if c.options != nil && c.options.PoolPowerConcurrency > 0 {
+		group.SetLimit(c.options.PoolPowerConcurrency)
+	}
+
+	for i := range servers {
+		group.Go(func() error {
+			// Errors are recorded per-machine, and must not stop others.
+			_ = apply(i)
+
+			return nil
+		})
+	}
+
+	_ = group.Wait()
+
+	return out
+}
+
+// PoolPower applies a power operation to all machines in a pool.
+func (c *Client) PoolPower(ctx context.Context, organizationID, projectID, clusterID, poolName string, request *openapi.PoolPowerWrite) (openapi.PoolPowerResults, error) {
+	cluster, err := c.get(ctx, organizationID, projectID, clusterID)
+	if err != nil {
+		return nil, err
+	}
+
+	if cluster.DeletionTimestamp != nil {
+		return nil, errorsv2.InvalidRequest(openapi.ComputeClusterDeleting, "compute cluster is being deleted")
+	}
+
+	if _, ok := cluster.GetWorkloadPool(poolName); !ok {
+		return nil, errors.HTTPNotFound()
+	}
+
+	regionClient := region.New(c.region)
+
+	power, err := powerFuncForAction(regionClient, request.Action)
+	if err != nil {
+		return nil, err
+	}
+
+	servers, err := regionClient.Servers(ctx, organizationID, cluster)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to list servers", err)
+	}
+
+	mode := ptr.Deref(request.Mode, openapi.PoolPowerModeParallel)
+
+	return c.powerServers(ctx, organizationID, projectID, cluster.Annotations[constants.IdentityAnnotation], poolServers(servers, poolName), power, mode), nil
+}
+
+// ClusterPower applies a power operation to all machines in all pools of a cluster,
+// allowing whole environments to be suspended without losing disks or addresses.
+// Stopped machines are reported as degraded, but are exempt from auto healing so
+// are not replaced while parked.
+// Rolling operations proceed one machine at a time, pool by pool, so a failure affects
+// as few pools as possible.
+func (c *Client) ClusterPower(ctx context.Context, organizationID, projectID, clusterID string, action openapi.PoolPowerWriteAction, mode *openapi.PoolPowerMode) (openapi.PoolPowerResults, error) {
+	cluster, err := c.get(ctx, organizationID, projectID, clusterID)
+	if err != nil {
+		return nil, err
+	}
+
+	if cluster.DeletionTimestamp != nil {
+		return nil, errorsv2.InvalidRequest(openapi.ComputeClusterDeleting, "compute cluster is being deleted")
+	}
+
+	regionClient := region.New(c.region)
+
+	power, err := powerFuncForAction(regionClient, action)
+	if err != nil {
+		return nil, err
+	}
+
+	servers, err := regionClient.Servers(ctx, organizationID, cluster)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to list servers", err)
+	}
+
+	return c.powerServers(ctx, organizationID, projectID, cluster.Annotations[constants.IdentityAnnotation], liveServers(servers), power, ptr.Deref(mode, openapi.PoolPowerModeParallel)), nil
+}
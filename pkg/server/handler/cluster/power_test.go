@@ -0,0 +1,95 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	managerutil "github.com/unikorn-cloud/compute/pkg/provisioners/managers/cluster/util"
+	"github.com/unikorn-cloud/compute/pkg/server/handler/cluster"
+	coreapi "github.com/unikorn-cloud/core/pkg/openapi"
+	regionapi "github.com/unikorn-cloud/region/pkg/openapi"
+
+	"k8s.io/utils/ptr"
+)
+
+func poolServer(id, name, pool string, deleting bool) regionapi.ServerRead {
+	server := regionapi.ServerRead{
+		Metadata: coreapi.ProjectScopedResourceReadMetadata{
+			Id:   id,
+			Name: name,
+			Tags: &coreapi.TagList{
+				{
+					Name:  managerutil.WorkloadPoolLabel,
+					Value: pool,
+				},
+			},
+		},
+	}
+
+	if deleting {
+		server.Metadata.DeletionTime = ptr.To(time.Now())
+	}
+
+	return server
+}
+
+// TestPoolServers ensures only live members of the pool are operated on, and in
+// a predictable order.
+func TestPoolServers(t *testing.T) {
+	t.Parallel()
+
+	servers := []regionapi.ServerRead{
+		poolServer("1", "pool-a-2", "pool-a", false),
+		poolServer("2", "pool-b-1", "pool-b", false),
+		poolServer("3", "pool-a-1", "pool-a", false),
+		poolServer("4", "pool-a-3", "pool-a", true),
+	}
+
+	result := cluster.PoolServers(servers, "pool-a")
+	require.Len(t, result, 2)
+	require.Equal(t, "3", result[0].Metadata.Id)
+	require.Equal(t, "1", result[1].Metadata.Id)
+}
+
+// TestLiveServers ensures all live pool members are operated on, grouped by pool.
+func TestLiveServers(t *testing.T) {
+	t.Parallel()
+
+	servers := []regionapi.ServerRead{
+		poolServer("1", "pool-b-1", "pool-b", false),
+		poolServer("2", "pool-a-2", "pool-a", false),
+		poolServer("3", "pool-a-1", "pool-a", false),
+		poolServer("4", "pool-a-3", "pool-a", true),
+		{
+			Metadata: coreapi.ProjectScopedResourceReadMetadata{
+				Id:   "5",
+				Name: "unmanaged",
+			},
+		},
+	}
+
+	result := cluster.LiveServers(servers)
+	require.Len(t, result, 3)
+	require.Equal(t, "3", result[0].Metadata.Id)
+	require.Equal(t, "2", result[1].Metadata.Id)
+	require.Equal(t, "1", result[2].Metadata.Id)
+}
@@ -0,0 +1,217 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+
+	unikornv1 "github.com/unikorn-cloud/compute/pkg/apis/unikorn/v1alpha1"
+	"github.com/unikorn-cloud/compute/pkg/openapi"
+	managerutil "github.com/unikorn-cloud/compute/pkg/provisioners/managers/cluster/util"
+	"github.com/unikorn-cloud/compute/pkg/server/errorsv2"
+	"github.com/unikorn-cloud/compute/pkg/server/handler/region"
+	identityapi "github.com/unikorn-cloud/identity/pkg/openapi"
+	regionapi "github.com/unikorn-cloud/region/pkg/openapi"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+)
+
+// previewPool reports what the controller will do to a pool's servers to bring them
+// in line with the required pool specification.  Cordoned servers are never updated,
+// and servers with a flavor override keep their flavor, as with the controller.
+func previewPool(current, required *unikornv1.ComputeClusterWorkloadPoolSpec, servers []regionapi.ServerRead, cordoned []string, overrides managerutil.FlavorOverrides) openapi.ComputeClusterPoolPreview {
+	out := openapi.ComputeClusterPoolPreview{
+		Change:  openapi.PoolChangeUnchanged,
+		Rebuild: []string{},
+		Resize:  []string{},
+	}
+
+	switch {
+	case current == nil:
+		out.Name = required.Name
+		out.Change = openapi.PoolChangeAdded
+	case required == nil:
+		out.Name = current.Name
+		out.Change = openapi.PoolChangeRemoved
+	default:
+		out.Name = required.Name
+
+		if !equality.Semantic.DeepEqual(current, required) {
+			out.Change = openapi.PoolChangeModified
+		}
+	}
+
+	if required == nil {
+		out.Delete = len(servers)
+
+		return out
+	}
+
+	out.Create = max(required.Replicas-len(servers), 0)
+	out.Delete = max(len(servers)-required.Replicas, 0)
+
+	for i := range servers {
+		server := &servers[i]
+
+		if slices.Contains(cordoned, server.Metadata.Id) {
+			continue
+		}
+
+		if server.Spec.ImageId != required.ImageID {
+			out.Rebuild = append(out.Rebuild, server.Metadata.Id)
+
+			continue
+		}
+
+		flavorID := required.FlavorID
+
+		if override, ok := overrides[server.Metadata.Id]; ok {
+			flavorID = override.FlavorID
+		}
+
+		if server.Spec.FlavorId != flavorID {
+			out.Resize = append(out.Resize, server.Metadata.Id)
+		}
+	}
+
+	return out
+}
+
+// lookupPool finds a pool by name, tolerating clusters without any pools.
+func lookupPool(cluster *unikornv1.ComputeCluster, name string) *unikornv1.ComputeClusterWorkloadPoolSpec {
+	if cluster.Spec.WorkloadPools == nil {
+		return nil
+	}
+
+	pool, _ := cluster.GetWorkloadPool(name)
+
+	return pool
+}
+
+// previewPools reports the effect of an update on every pool, pools that are removed
+// by the update are ordered after those in the required specification.
+func previewPools(current, required *unikornv1.ComputeCluster, servers []regionapi.ServerRead, cordoned []string, overrides managerutil.FlavorOverrides) openapi.ComputeClusterPoolPreviewList {
+	out := openapi.ComputeClusterPoolPreviewList{}
+
+	if required.Spec.WorkloadPools != nil {
+		for i := range required.Spec.WorkloadPools.Pools {
+			pool := &required.Spec.WorkloadPools.Pools[i]
+
+			out = append(out, previewPool(lookupPool(current, pool.Name), pool, poolServers(slices.Clone(servers), pool.Name), cordoned, overrides))
+		}
+	}
+
+	if current.Spec.WorkloadPools != nil {
+		for i := range current.Spec.WorkloadPools.Pools {
+			pool := &current.Spec.WorkloadPools.Pools[i]
+
+			if lookupPool(required, pool.Name) != nil {
+				continue
+			}
+
+			out = append(out, previewPool(pool, nil, poolServers(slices.Clone(servers), pool.Name), cordoned, overrides))
+		}
+	}
+
+	return out
+}
+
+// previewAllocations reports the change in quota allocations for every kind of
+// resource held by either allocation.
+func previewAllocations(current, required identityapi.ResourceAllocationList) openapi.ComputeClusterAllocationDeltaList {
+	deltas := map[string]*openapi.ComputeClusterAllocationDelta{}
+
+	get := func(kind string) *openapi.ComputeClusterAllocationDelta {
+		if _, ok := deltas[kind]; !ok {
+			deltas[kind] = &openapi.ComputeClusterAllocationDelta{
+				Kind: kind,
+			}
+		}
+
+		return deltas[kind]
+	}
+
+	for _, allocation := range current {
+		delta := get(allocation.Kind)
+		delta.Committed -= allocation.Committed
+		delta.Reserved -= allocation.Reserved
+	}
+
+	for _, allocation := range required {
+		delta := get(allocation.Kind)
+		delta.Committed += allocation.Committed
+		delta.Reserved += allocation.Reserved
+	}
+
+	out := make(openapi.ComputeClusterAllocationDeltaList, 0, len(deltas))
+
+	for _, kind := range slices.Sorted(maps.Keys(deltas)) {
+		out = append(out, *deltas[kind])
+	}
+
+	return out
+}
+
+// Preview reports what an update would do to the cluster's machines and quota
+// allocations without applying it.
+func (c *Client) Preview(ctx context.Context, organizationID, projectID, clusterID string, request *openapi.ComputeClusterWrite) (*openapi.ComputeClusterPreview, error) {
+	current, err := c.get(ctx, organizationID, projectID, clusterID)
+	if err != nil {
+		return nil, err
+	}
+
+	if current.DeletionTimestamp != nil {
+		return nil, errorsv2.InvalidRequest(openapi.ComputeClusterDeleting, "compute cluster is being deleted")
+	}
+
+	if err := c.validateSecurityGroups(ctx, organizationID, projectID, request); err != nil {
+		return nil, err
+	}
+
+	regions := region.NewMemoized(c.regions())
+
+	required, err := newGenerator(c.client, c.options, regions, c.namespace, organizationID, projectID, current).generate(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	currentAllocations, err := c.generateAllocations(ctx, regions, organizationID, current)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to generate quota allocations", err)
+	}
+
+	requiredAllocations, err := c.generateAllocations(ctx, regions, organizationID, required)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to generate quota allocations", err)
+	}
+
+	servers, err := region.New(c.region).Servers(ctx, organizationID, current)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to list servers", err)
+	}
+
+	out := &openapi.ComputeClusterPreview{
+		Pools:       previewPools(current, required, servers, managerutil.GetCordoned(current), managerutil.GetFlavorOverrides(current)),
+		Allocations: previewAllocations(currentAllocations, requiredAllocations),
+	}
+
+	return out, nil
+}
@@ -0,0 +1,127 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	unikornv1 "github.com/unikorn-cloud/compute/pkg/apis/unikorn/v1alpha1"
+	"github.com/unikorn-cloud/compute/pkg/openapi"
+	managerutil "github.com/unikorn-cloud/compute/pkg/provisioners/managers/cluster/util"
+	"github.com/unikorn-cloud/compute/pkg/server/handler/cluster"
+	unikornv1core "github.com/unikorn-cloud/core/pkg/apis/unikorn/v1alpha1"
+	identityapi "github.com/unikorn-cloud/identity/pkg/openapi"
+	regionapi "github.com/unikorn-cloud/region/pkg/openapi"
+)
+
+func previewCluster(pools ...unikornv1.ComputeClusterWorkloadPoolSpec) *unikornv1.ComputeCluster {
+	return &unikornv1.ComputeCluster{
+		Spec: unikornv1.ComputeClusterSpec{
+			WorkloadPools: &unikornv1.ComputeClusterWorkloadPoolsSpec{
+				Pools: pools,
+			},
+		},
+	}
+}
+
+func previewPool(name, flavorID, imageID string, replicas int) unikornv1.ComputeClusterWorkloadPoolSpec {
+	return unikornv1.ComputeClusterWorkloadPoolSpec{
+		Name: name,
+		MachineGeneric: unikornv1core.MachineGeneric{
+			FlavorID: flavorID,
+			ImageID:  imageID,
+			Replicas: replicas,
+		},
+	}
+}
+
+func previewServer(id, pool, flavorID, imageID string) regionapi.ServerRead {
+	server := poolServer(id, id, pool, false)
+	server.Spec.FlavorId = flavorID
+	server.Spec.ImageId = imageID
+
+	return server
+}
+
+// TestPreviewPools checks pool changes are classified correctly, and that cordoned
+// and resized machines are treated the same as they are by the controller.
+func TestPreviewPools(t *testing.T) {
+	t.Parallel()
+
+	current := previewCluster(
+		previewPool("a", "small", "old", 3),
+		previewPool("b", "small", "old", 1),
+		previewPool("c", "small", "old", 1),
+	)
+
+	required := previewCluster(
+		previewPool("a", "large", "new", 2),
+		previewPool("b", "small", "old", 1),
+		previewPool("d", "small", "old", 2),
+	)
+
+	servers := []regionapi.ServerRead{
+		previewServer("a1", "a", "small", "old"),
+		previewServer("a2", "a", "small", "new"),
+		previewServer("a3", "a", "small", "old"),
+		previewServer("a4", "a", "medium", "new"),
+		previewServer("b1", "b", "small", "old"),
+		previewServer("c1", "c", "small", "old"),
+	}
+
+	overrides := managerutil.FlavorOverrides{
+		"a4": {Pool: "a", FlavorID: "medium"},
+	}
+
+	out := cluster.PreviewPools(current, required, servers, []string{"a3"}, overrides)
+
+	expected := openapi.ComputeClusterPoolPreviewList{
+		{Name: "a", Change: openapi.PoolChangeModified, Delete: 2, Rebuild: []string{"a1"}, Resize: []string{"a2"}},
+		{Name: "b", Change: openapi.PoolChangeUnchanged, Rebuild: []string{}, Resize: []string{}},
+		{Name: "d", Change: openapi.PoolChangeAdded, Create: 2, Rebuild: []string{}, Resize: []string{}},
+		{Name: "c", Change: openapi.PoolChangeRemoved, Delete: 1, Rebuild: []string{}, Resize: []string{}},
+	}
+
+	require.Equal(t, expected, out)
+}
+
+// TestPreviewAllocations checks allocation deltas cover kinds that are added
+// and removed, and are ordered by kind.
+func TestPreviewAllocations(t *testing.T) {
+	t.Parallel()
+
+	current := identityapi.ResourceAllocationList{
+		{Kind: "servers", Committed: 3},
+		{Kind: "gpus", Committed: 8, Reserved: 8},
+	}
+
+	required := identityapi.ResourceAllocationList{
+		{Kind: "servers", Committed: 4},
+		{Kind: "publicIPs", Committed: 1},
+	}
+
+	expected := openapi.ComputeClusterAllocationDeltaList{
+		{Kind: "gpus", Committed: -8, Reserved: -8},
+		{Kind: "publicIPs", Committed: 1},
+		{Kind: "servers", Committed: 1},
+	}
+
+	require.Equal(t, expected, cluster.PreviewAllocations(current, required))
+}
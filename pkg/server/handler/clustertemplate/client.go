@@ -0,0 +1,448 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clustertemplate
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+
+	computev1 "github.com/unikorn-cloud/compute/pkg/apis/unikorn/v1alpha1"
+	computeconversion "github.com/unikorn-cloud/compute/pkg/conversion"
+	computeapi "github.com/unikorn-cloud/compute/pkg/openapi"
+	"github.com/unikorn-cloud/compute/pkg/server/errorsv2"
+	"github.com/unikorn-cloud/compute/pkg/server/handler/region"
+	"github.com/unikorn-cloud/compute/pkg/server/handler/util"
+	corev1 "github.com/unikorn-cloud/core/pkg/apis/unikorn/v1alpha1"
+	"github.com/unikorn-cloud/core/pkg/server/conversion"
+	"github.com/unikorn-cloud/core/pkg/server/errors"
+	"github.com/unikorn-cloud/identity/pkg/handler/common"
+	identityapi "github.com/unikorn-cloud/identity/pkg/openapi"
+	"github.com/unikorn-cloud/identity/pkg/principal"
+	"github.com/unikorn-cloud/identity/pkg/rbac"
+	regionapi "github.com/unikorn-cloud/region/pkg/openapi"
+
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/utils/ptr"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Client wraps up cluster template related management handling.  Templates are
+// published by platform operators, so modification requires global permissions,
+// but they are readable by anyone.
+type Client struct {
+	// client allows Compute API access.
+	client client.Client
+	// namespace the controller runs in.
+	namespace string
+	// identity is a client to access the identity service.
+	identity identityapi.ClientWithResponsesInterface
+	// region is a client to access regions.
+	region regionapi.ClientWithResponsesInterface
+	// catalog, if set, is used to read region images.
+	catalog region.ClientInterface
+}
+
+// NewClient returns a new client with required parameters.
+func NewClient(client client.Client, namespace string, identity identityapi.ClientWithResponsesInterface, region regionapi.ClientWithResponsesInterface) *Client {
+	return &Client{
+		client:    client,
+		namespace: namespace,
+		identity:  identity,
+		region:    region,
+	}
+}
+
+// WithRegionCache reads images via the provided, typically cached, region client,
+// rather than directly from the region service.
+func (c *Client) WithRegionCache(regions region.ClientInterface) *Client {
+	c.catalog = regions
+
+	return c
+}
+
+// regions returns a client for reading the region catalog.
+func (c *Client) regions() region.ClientInterface {
+	if c.catalog != nil {
+		return c.catalog
+	}
+
+	return region.New(c.region)
+}
+
+func convertImageSelector(in *computev1.ComputeWorkloadPoolImageSelector) *computeapi.ImageSelector {
+	if in == nil {
+		return nil
+	}
+
+	return &computeapi.ImageSelector{
+		Distro:  regionapi.OsDistro(in.Distro),
+		Variant: in.Variant,
+		Version: in.Version,
+	}
+}
+
+func convertPools(in []computev1.ComputeClusterTemplatePoolSpec) computeapi.ClusterTemplatePoolList {
+	out := make(computeapi.ClusterTemplatePoolList, len(in))
+
+	for i := range in {
+		out[i] = computeapi.ClusterTemplatePool{
+			Name:          in[i].Name,
+			Replicas:      in[i].Replicas,
+			FlavorId:      in[i].FlavorID,
+			ImageSelector: convertImageSelector(in[i].ImageSelector),
+			Networking:    computeconversion.ConvertNetworking(in[i].Networking),
+			UserData:      computeconversion.ConvertUserData(in[i].UserData),
+		}
+
+		if in[i].ImageID != "" {
+			out[i].ImageId = ptr.To(in[i].ImageID)
+		}
+	}
+
+	return out
+}
+
+func convert(in *computev1.ComputeClusterTemplate) *computeapi.ClusterTemplateRead {
+	return &computeapi.ClusterTemplateRead{
+		Metadata: conversion.ResourceReadMetadata(in, in.Spec.Tags),
+		Spec: computeapi.ClusterTemplateSpec{
+			Pools: convertPools(in.Spec.Pools),
+		},
+	}
+}
+
+func convertList(in *computev1.ComputeClusterTemplateList) computeapi.ClusterTemplateReadList {
+	out := make(computeapi.ClusterTemplateReadList, len(in.Items))
+
+	for i := range in.Items {
+		out[i] = *convert(&in.Items[i])
+	}
+
+	return out
+}
+
+func generateImageSelector(in *computeapi.ImageSelector) *computev1.ComputeWorkloadPoolImageSelector {
+	if in == nil {
+		return nil
+	}
+
+	return &computev1.ComputeWorkloadPoolImageSelector{
+		Distro:  computev1.OsDistro(in.Distro),
+		Variant: in.Variant,
+		Version: in.Version,
+	}
+}
+
+func generatePools(in computeapi.ClusterTemplatePoolList) ([]computev1.ComputeClusterTemplatePoolSpec, error) {
+	if len(in) == 0 {
+		return nil, errorsv2.InvalidRequest(computeapi.ComputeClusterTemplateInvalidSpec, "template must define at least one pool")
+	}
+
+	out := make([]computev1.ComputeClusterTemplatePoolSpec, len(in))
+
+	for i := range in {
+		pool := &in[i]
+
+		if slices.ContainsFunc(in[:i], func(p computeapi.ClusterTemplatePool) bool { return p.Name == pool.Name }) {
+			return nil, errorsv2.InvalidRequest(computeapi.ComputeClusterTemplateInvalidSpec, fmt.Sprintf("pool %s is defined more than once", pool.Name))
+		}
+
+		if (pool.ImageId == nil) == (pool.ImageSelector == nil) {
+			return nil, errorsv2.InvalidRequest(computeapi.ComputeClusterTemplateInvalidSpec, fmt.Sprintf("pool %s must specify exactly one of an image ID or image selector", pool.Name))
+		}
+
+		if pool.Replicas < 0 {
+			return nil, errorsv2.InvalidRequest(computeapi.ComputeClusterTemplateInvalidSpec, fmt.Sprintf("pool %s replicas must not be negative", pool.Name))
+		}
+
+		networking, err := computeconversion.GenerateNetworking(pool.Networking)
+		if err != nil {
+			return nil, err
+		}
+
+		out[i] = computev1.ComputeClusterTemplatePoolSpec{
+			Name:          pool.Name,
+			Replicas:      pool.Replicas,
+			FlavorID:      pool.FlavorId,
+			ImageID:       ptr.Deref(pool.ImageId, ""),
+			ImageSelector: generateImageSelector(pool.ImageSelector),
+			Networking:    networking,
+			UserData:      computeconversion.GenerateUserData(pool.UserData),
+		}
+	}
+
+	return out, nil
+}
+
+func (c *Client) generate(ctx context.Context, in *computeapi.ClusterTemplateWrite, currentTags corev1.TagList) (*computev1.ComputeClusterTemplate, error) {
+	pools, err := generatePools(in.Spec.Pools)
+	if err != nil {
+		return nil, err
+	}
+
+	tags, err := util.GenerateTagList(in.Metadata.Tags, currentTags)
+	if err != nil {
+		return nil, err
+	}
+
+	out := &computev1.ComputeClusterTemplate{
+		ObjectMeta: conversion.NewObjectMetadata(&in.Metadata, c.namespace).Get(),
+		Spec: computev1.ComputeClusterTemplateSpec{
+			Tags:  tags,
+			Pools: pools,
+		},
+	}
+
+	if err := common.SetIdentityMetadata(ctx, &out.ObjectMeta); err != nil {
+		return nil, fmt.Errorf("%w: failed to set identity metadata", err)
+	}
+
+	return out, nil
+}
+
+// List returns all cluster templates.
+func (c *Client) List(ctx context.Context) (computeapi.ClusterTemplateReadList, error) {
+	result := &computev1.ComputeClusterTemplateList{}
+
+	if err := c.client.List(ctx, result, &client.ListOptions{Namespace: c.namespace}); err != nil {
+		return nil, fmt.Errorf("%w: unable to list cluster templates", err)
+	}
+
+	slices.SortStableFunc(result.Items, func(a, b computev1.ComputeClusterTemplate) int {
+		return cmp.Compare(a.Name, b.Name)
+	})
+
+	return convertList(result), nil
+}
+
+func (c *Client) getRaw(ctx context.Context, clusterTemplateID string) (*computev1.ComputeClusterTemplate, error) {
+	result := &computev1.ComputeClusterTemplate{}
+
+	if err := c.client.Get(ctx, client.ObjectKey{Namespace: c.namespace, Name: clusterTemplateID}, result); err != nil {
+		if kerrors.IsNotFound(err) {
+			return nil, errors.HTTPNotFound().WithError(err)
+		}
+
+		return nil, fmt.Errorf("%w: unable to lookup cluster template", err)
+	}
+
+	return result, nil
+}
+
+// Get returns a cluster template.
+func (c *Client) Get(ctx context.Context, clusterTemplateID string) (*computeapi.ClusterTemplateRead, error) {
+	result, err := c.getRaw(ctx, clusterTemplateID)
+	if err != nil {
+		return nil, err
+	}
+
+	return convert(result), nil
+}
+
+// Create publishes a new cluster template.
+func (c *Client) Create(ctx context.Context, request *computeapi.ClusterTemplateWrite) (*computeapi.ClusterTemplateRead, error) {
+	if err := rbac.AllowGlobalScope(ctx, "compute:clustertemplates", identityapi.Create); err != nil {
+		return nil, err
+	}
+
+	resource, err := c.generate(ctx, request, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := c.client.Create(ctx, resource); err != nil {
+		return nil, fmt.Errorf("%w: unable to create cluster template", err)
+	}
+
+	return convert(resource), nil
+}
+
+// Update replaces a cluster template, clusters already created from it are
+// unaffected.
+func (c *Client) Update(ctx context.Context, clusterTemplateID string, request *computeapi.ClusterTemplateWrite) (*computeapi.ClusterTemplateRead, error) {
+	if err := rbac.AllowGlobalScope(ctx, "compute:clustertemplates", identityapi.Update); err != nil {
+		return nil, err
+	}
+
+	current, err := c.getRaw(ctx, clusterTemplateID)
+	if err != nil {
+		return nil, err
+	}
+
+	if current.DeletionTimestamp != nil {
+		return nil, errorsv2.InvalidRequest(computeapi.ComputeClusterTemplateDeleting, "cluster template is being deleted")
+	}
+
+	required, err := c.generate(ctx, request, current.Spec.Tags)
+	if err != nil {
+		return nil, err
+	}
+
+	updated := current.DeepCopy()
+	updated.Labels = required.Labels
+	updated.Annotations = required.Annotations
+	updated.Spec = required.Spec
+
+	if err := c.client.Patch(ctx, updated, client.MergeFromWithOptions(current, &client.MergeFromWithOptimisticLock{})); err != nil {
+		return nil, fmt.Errorf("%w: unable to update cluster template", err)
+	}
+
+	return convert(updated), nil
+}
+
+// Delete removes a cluster template, clusters already created from it are
+// unaffected.
+func (c *Client) Delete(ctx context.Context, clusterTemplateID string) error {
+	if err := rbac.AllowGlobalScope(ctx, "compute:clustertemplates", identityapi.Delete); err != nil {
+		return err
+	}
+
+	resource := &computev1.ComputeClusterTemplate{}
+	resource.Namespace = c.namespace
+	resource.Name = clusterTemplateID
+
+	if err := c.client.Delete(ctx, resource); err != nil {
+		if kerrors.IsNotFound(err) {
+			return errors.HTTPNotFound().WithError(err)
+		}
+
+		return fmt.Errorf("%w: unable to delete cluster template", err)
+	}
+
+	return nil
+}
+
+// selectImage returns the most recently created image that matches the selector.
+func selectImage(images []regionapi.Image, selector *computev1.ComputeWorkloadPoolImageSelector) (string, error) {
+	matches := func(image regionapi.Image) bool {
+		os := &image.Spec.Os
+
+		if string(os.Distro) != string(selector.Distro) || os.Version != selector.Version {
+			return false
+		}
+
+		return selector.Variant == nil || (os.Variant != nil && *os.Variant == *selector.Variant)
+	}
+
+	var result *regionapi.Image
+
+	for i := range images {
+		if !matches(images[i]) {
+			continue
+		}
+
+		if result == nil || images[i].Metadata.CreationTime.After(result.Metadata.CreationTime) {
+			result = &images[i]
+		}
+	}
+
+	if result == nil {
+		return "", errorsv2.InvalidRequest(computeapi.ComputeClusterTemplateInvalidImage, fmt.Sprintf("no image matches selector %s %s", selector.Distro, selector.Version))
+	}
+
+	return result.Metadata.Id, nil
+}
+
+// Instantiate generates a cluster creation request from a template, applying
+// any replica overrides and resolving image selectors against the region the
+// requested network resides in.
+func (c *Client) Instantiate(ctx context.Context, clusterTemplateID string, request *computeapi.ClusterTemplateInstantiate) (*computeapi.ClusterV2Create, error) {
+	organizationID := request.Spec.OrganizationId
+	projectID := request.Spec.ProjectId
+
+	if err := rbac.AllowProjectScopeCreate(ctx, c.identity, "compute:clusters", identityapi.Create, organizationID, projectID); err != nil {
+		return nil, err
+	}
+
+	template, err := c.getRaw(ctx, clusterTemplateID)
+	if err != nil {
+		return nil, err
+	}
+
+	replicas := map[string]int{}
+
+	if request.Spec.Pools != nil {
+		for _, override := range *request.Spec.Pools {
+			if !slices.ContainsFunc(template.Spec.Pools, func(p computev1.ComputeClusterTemplatePoolSpec) bool { return p.Name == override.Name }) {
+				return nil, errorsv2.InvalidRequest(computeapi.ComputeClusterTemplateUnknownPool, fmt.Sprintf("pool %s is not defined by the template", override.Name))
+			}
+
+			replicas[override.Name] = override.Replicas
+		}
+	}
+
+	var images []regionapi.Image
+
+	if slices.ContainsFunc(template.Spec.Pools, func(p computev1.ComputeClusterTemplatePoolSpec) bool { return p.ImageSelector != nil }) {
+		if err := util.InjectUserPrincipal(ctx, organizationID, projectID); err != nil {
+			return nil, err
+		}
+
+		network, err := region.GetNetwork(principal.NewImpersonateContext(ctx), c.region, request.Spec.NetworkId)
+		if err != nil {
+			return nil, err
+		}
+
+		images, err = c.regions().Images(principal.NewImpersonateContext(ctx), organizationID, network.Status.RegionId)
+		if err != nil {
+			return nil, fmt.Errorf("%w: failed to list images", err)
+		}
+	}
+
+	pools := make(computeapi.PoolV2List, len(template.Spec.Pools))
+
+	for i := range template.Spec.Pools {
+		pool := &template.Spec.Pools[i]
+
+		imageID := pool.ImageID
+
+		if pool.ImageSelector != nil {
+			if imageID, err = selectImage(images, pool.ImageSelector); err != nil {
+				return nil, err
+			}
+		}
+
+		pools[i] = computeapi.PoolV2{
+			Name:       pool.Name,
+			Replicas:   pool.Replicas,
+			FlavorId:   pool.FlavorID,
+			ImageId:    imageID,
+			Networking: computeconversion.ConvertNetworking(pool.Networking),
+			UserData:   computeconversion.ConvertUserData(pool.UserData),
+		}
+
+		if r, ok := replicas[pool.Name]; ok {
+			pools[i].Replicas = r
+		}
+	}
+
+	out := &computeapi.ClusterV2Create{
+		Metadata: request.Metadata,
+		Spec: computeapi.ClusterV2CreateSpec{
+			OrganizationId: organizationID,
+			ProjectId:      projectID,
+			NetworkId:      request.Spec.NetworkId,
+			Pools:          pools,
+		},
+	}
+
+	return out, nil
+}
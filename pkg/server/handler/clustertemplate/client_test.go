@@ -0,0 +1,98 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clustertemplate_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	computev1 "github.com/unikorn-cloud/compute/pkg/apis/unikorn/v1alpha1"
+	"github.com/unikorn-cloud/compute/pkg/server/handler/clustertemplate"
+	coreapi "github.com/unikorn-cloud/core/pkg/openapi"
+	regionapi "github.com/unikorn-cloud/region/pkg/openapi"
+
+	"k8s.io/utils/ptr"
+)
+
+func image(id string, created time.Time, distro regionapi.OsDistro, version string, variant *string) regionapi.Image {
+	return regionapi.Image{
+		Metadata: coreapi.StaticResourceMetadata{
+			Id:           id,
+			CreationTime: created,
+		},
+		Spec: regionapi.ImageSpec{
+			Os: regionapi.ImageOS{
+				Distro:  distro,
+				Version: version,
+				Variant: variant,
+			},
+		},
+	}
+}
+
+// TestSelectImage ensures the newest matching image is selected.
+func TestSelectImage(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+
+	images := []regionapi.Image{
+		image("old", now.Add(-time.Hour), regionapi.OsDistroUbuntu, "24.04", nil),
+		image("new", now, regionapi.OsDistroUbuntu, "24.04", nil),
+		image("newer-version", now.Add(time.Hour), regionapi.OsDistroUbuntu, "26.04", nil),
+		image("newer-distro", now.Add(time.Hour), regionapi.OsDistroRocky, "24.04", nil),
+	}
+
+	selector := &computev1.ComputeWorkloadPoolImageSelector{
+		Distro:  computev1.Ubuntu,
+		Version: "24.04",
+	}
+
+	id, err := clustertemplate.SelectImage(images, selector)
+	require.NoError(t, err)
+	require.Equal(t, "new", id)
+}
+
+// TestSelectImageVariant ensures the variant is honoured when specified.
+func TestSelectImageVariant(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+
+	images := []regionapi.Image{
+		image("server", now.Add(-time.Hour), regionapi.OsDistroUbuntu, "24.04", ptr.To("server")),
+		image("desktop", now, regionapi.OsDistroUbuntu, "24.04", ptr.To("desktop")),
+	}
+
+	selector := &computev1.ComputeWorkloadPoolImageSelector{
+		Distro:  computev1.Ubuntu,
+		Version: "24.04",
+		Variant: ptr.To("server"),
+	}
+
+	id, err := clustertemplate.SelectImage(images, selector)
+	require.NoError(t, err)
+	require.Equal(t, "server", id)
+
+	selector.Variant = ptr.To("minimal")
+
+	_, err = clustertemplate.SelectImage(images, selector)
+	require.Error(t, err)
+}
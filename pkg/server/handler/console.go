@@ -0,0 +1,133 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	regionapi "github.com/unikorn-cloud/region/pkg/openapi"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// consolePollPeriod is how often followed console output is checked for new
+// lines.  Unlike watches this calls the region service, so is less frequent.
+const consolePollPeriod = 5 * time.Second
+
+// consoleGetter reads the current console output.
+type consoleGetter func(ctx context.Context) (string, error)
+
+// consoleLines splits console output into lines, including their line endings.
+// The final line is omitted if it's incomplete, as it will likely change.
+func consoleLines(contents string) []string {
+	lines := strings.SplitAfter(contents, "\n")
+
+	return lines[:len(lines)-1]
+}
+
+// newConsoleLines returns lines from the current console output that weren't
+// in the previous one.  Console output is a window onto the end of the log, so
+// the longest run of lines at the end of the previous output that matches the
+// start of the current output is assumed to have been seen already.  When there
+// is no overlap, e.g. the log has scrolled past the window, all lines are new.
+func newConsoleLines(previous, current []string) []string {
+	for overlap := min(len(previous), len(current)); overlap > 0; overlap-- {
+		if slices.Equal(previous[len(previous)-overlap:], current[:overlap]) {
+			return current[overlap:]
+		}
+	}
+
+	return current
+}
+
+// followConsole streams console output to the client using Server-Sent Events.
+// The initial output is sent immediately, then any new lines as they appear.
+// The stream ends when the request context is done, which will usually be due
+// to the request timeout, and clients are expected to reconnect.
+func followConsole(w http.ResponseWriter, r *http.Request, initial string, get consoleGetter) {
+	ctx := r.Context()
+
+	log := log.FromContext(ctx)
+
+	write := startEventStream(w, r)
+
+	send := func(lines []string) bool {
+		data, err := json.Marshal(&regionapi.ConsoleOutput{
+			Contents: strings.Join(lines, ""),
+		})
+		if err != nil {
+			log.Error(err, "failed to marshal console output")
+			return false
+		}
+
+		return write("event: output\ndata: %s\n\n", data)
+	}
+
+	last := consoleLines(initial)
+
+	if !send(last) {
+		return
+	}
+
+	poll := time.NewTicker(consolePollPeriod)
+	defer poll.Stop()
+
+	keepalive := time.NewTicker(watchKeepalivePeriod)
+	defer keepalive.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-keepalive.C:
+			if !write(": keepalive\n\n") {
+				return
+			}
+		case <-poll.C:
+			contents, err := get(ctx)
+			if err != nil {
+				if ctx.Err() == nil {
+					log.Error(err, "failed to read console output")
+				}
+
+				return
+			}
+
+			current := consoleLines(contents)
+
+			lines := newConsoleLines(last, current)
+
+			last = current
+
+			if len(lines) == 0 {
+				continue
+			}
+
+			if !send(lines) {
+				return
+			}
+
+			keepalive.Reset(watchKeepalivePeriod)
+		}
+	}
+}
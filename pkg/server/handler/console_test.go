@@ -0,0 +1,50 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package handler_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/unikorn-cloud/compute/pkg/server/handler"
+)
+
+// TestNewConsoleLines ensures only unseen, complete lines are reported as the
+// console output window moves.
+func TestNewConsoleLines(t *testing.T) {
+	t.Parallel()
+
+	previous := handler.ConsoleLines("a\nb\nc")
+	require.Equal(t, []string{"a\n", "b\n"}, previous)
+
+	// The incomplete line is reported once it's finished.
+	current := handler.ConsoleLines("a\nb\nc\nd\n")
+	require.Equal(t, []string{"c\n", "d\n"}, handler.NewConsoleLines(previous, current))
+
+	// The window has moved on, but still overlaps.
+	previous, current = current, handler.ConsoleLines("c\nd\ne\n")
+	require.Equal(t, []string{"e\n"}, handler.NewConsoleLines(previous, current))
+
+	// Nothing has changed.
+	require.Empty(t, handler.NewConsoleLines(current, current))
+
+	// The window has moved past everything seen.
+	previous, current = current, handler.ConsoleLines("x\ny\n")
+	require.Equal(t, []string{"x\n", "y\n"}, handler.NewConsoleLines(previous, current))
+}
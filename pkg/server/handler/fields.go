@@ -0,0 +1,128 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package handler
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/unikorn-cloud/compute/pkg/openapi"
+)
+
+// fieldSelection is a tree of selected JSON members, a leaf node selects the
+// member and everything beneath it.
+type fieldSelection map[string]fieldSelection
+
+// newFieldSelection parses the requested fields, returning nil if all fields
+// are selected.  The resource ID is always selected so sparse items can still
+// be identified.
+func newFieldSelection(fields *openapi.FieldsParameter) fieldSelection {
+	if fields == nil || len(*fields) == 0 {
+		return nil
+	}
+
+	selection := fieldSelection{}
+
+	for _, field := range *fields {
+		selection.add(strings.Split(field, "."))
+	}
+
+	selection.add([]string{"metadata", "id"})
+
+	return selection
+}
+
+// add selects a path, selecting a member in its entirety takes precedence over
+// selecting any of its descendants.
+func (s fieldSelection) add(path []string) {
+	name := path[0]
+
+	if len(path) == 1 {
+		s[name] = nil
+		return
+	}
+
+	child, ok := s[name]
+	if ok && child == nil {
+		return
+	}
+
+	if !ok {
+		child = fieldSelection{}
+		s[name] = child
+	}
+
+	child.add(path[1:])
+}
+
+// apply trims a decoded JSON value down to the selection.  Selections apply to
+// each element of an array, and scalars cannot be selected through.
+func (s fieldSelection) apply(value any) (any, bool) {
+	if s == nil {
+		return value, true
+	}
+
+	switch t := value.(type) {
+	case map[string]any:
+		result := map[string]any{}
+
+		for name, child := range s {
+			member, ok := t[name]
+			if !ok {
+				continue
+			}
+
+			if member, ok = child.apply(member); ok {
+				result[name] = member
+			}
+		}
+
+		return result, true
+	case []any:
+		result := make([]any, 0, len(t))
+
+		for _, element := range t {
+			if element, ok := s.apply(element); ok {
+				result = append(result, element)
+			}
+		}
+
+		return result, true
+	}
+
+	return nil, false
+}
+
+// sparse returns a copy of the item trimmed down to the selection.
+func (s fieldSelection) sparse(item any) (any, error) {
+	data, err := json.Marshal(item)
+	if err != nil {
+		return nil, fmt.Errorf("%w: unable to marshal item", err)
+	}
+
+	var value any
+
+	if err := json.Unmarshal(data, &value); err != nil {
+		return nil, fmt.Errorf("%w: unable to unmarshal item", err)
+	}
+
+	value, _ = s.apply(value)
+
+	return value, nil
+}
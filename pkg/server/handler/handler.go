@@ -19,13 +19,17 @@ limitations under the License.
 package handler
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"slices"
 
 	"github.com/unikorn-cloud/compute/pkg/openapi"
+	"github.com/unikorn-cloud/compute/pkg/server/errorsv2"
 	"github.com/unikorn-cloud/compute/pkg/server/handler/cluster"
 	"github.com/unikorn-cloud/compute/pkg/server/handler/region"
+	handlerutil "github.com/unikorn-cloud/compute/pkg/server/handler/util"
+	"github.com/unikorn-cloud/compute/pkg/server/handler/webhook"
 	coreapi "github.com/unikorn-cloud/core/pkg/openapi"
 	"github.com/unikorn-cloud/core/pkg/server/errors"
 	"github.com/unikorn-cloud/core/pkg/server/util"
@@ -52,15 +56,24 @@ type Handler struct {
 
 	// region is a client to access regions.
 	region regionapi.ClientWithResponsesInterface
+
+	// lastKnownGood allows region reads to be served, flagged as stale, when
+	// the region service is unavailable.
+	lastKnownGood *region.LastKnownGood
+
+	// regions caches flavor and image reads from the region service.
+	regions *region.Cached
 }
 
-func New(client client.Client, namespace string, options *Options, identity identityapi.ClientWithResponsesInterface, region regionapi.ClientWithResponsesInterface) (*Handler, error) {
+func New(client client.Client, namespace string, options *Options, identity identityapi.ClientWithResponsesInterface, regionClient regionapi.ClientWithResponsesInterface) (*Handler, error) {
 	h := &Handler{
-		client:    client,
-		namespace: namespace,
-		options:   options,
-		identity:  identity,
-		region:    region,
+		client:        handlerutil.NewReadYourWritesClient(client, &options.Consistency),
+		namespace:     namespace,
+		options:       options,
+		identity:      identity,
+		region:        regionClient,
+		lastKnownGood: region.NewLastKnownGood(options.StaleMaxAge),
+		regions:       region.NewCached(region.New(regionClient), options.RegionCacheTTL),
 	}
 
 	return h, nil
@@ -74,7 +87,7 @@ func (h *Handler) setUncacheable(w http.ResponseWriter) {
 func (h *Handler) GetWellKnownOpenidProtectedResource(w http.ResponseWriter, r *http.Request) {
 	authenticationServer, err := identityapi.Host(h.identity)
 	if err != nil {
-		errors.HandleError(w, r, err)
+		errorsv2.HandleError(w, r, err)
 		return
 	}
 
@@ -101,65 +114,174 @@ func (h *Handler) regionClient() *region.Client {
 	return region.New(h.region)
 }
 
-func (h *Handler) GetApiV1OrganizationsOrganizationIDRegions(w http.ResponseWriter, r *http.Request, organizationID openapi.OrganizationIDParameter) {
+// setStale flags the response as being served from a cache after the region
+// service failed to respond, as defined by RFC7234.
+func (h *Handler) setStale(w http.ResponseWriter) {
+	w.Header().Add("Warning", `110 - "Response is Stale"`)
+}
+
+// writeRegionResponse writes a read-mostly region response that the client may
+// cache, and later revalidate with an entity tag.  Stale responses are never
+// cached so the client sees fresh data as soon as the region service recovers.
+func (h *Handler) writeRegionResponse(w http.ResponseWriter, r *http.Request, result any, stale bool, ifNoneMatch *string) {
+	if stale {
+		h.setStale(w)
+		h.setUncacheable(w)
+		util.WriteJSONResponse(w, r, http.StatusOK, result)
+
+		return
+	}
+
+	etag, err := handlerutil.ContentETag(result)
+	if err != nil {
+		errorsv2.HandleError(w, r, err)
+		return
+	}
+
+	w.Header().Set("ETag", etag)
+	h.options.setRegionCacheable(w)
+
+	if handlerutil.NotModified(etag, ifNoneMatch) {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+
+	util.WriteJSONResponse(w, r, http.StatusOK, result)
+}
+
+func (h *Handler) GetApiV1OrganizationsOrganizationIDRegions(w http.ResponseWriter, r *http.Request, organizationID openapi.OrganizationIDParameter, params openapi.GetApiV1OrganizationsOrganizationIDRegionsParams) {
 	ctx := r.Context()
 
 	if err := rbac.AllowOrganizationScope(ctx, "compute:regions", identityapi.Read, organizationID); err != nil {
-		errors.HandleError(w, r, err)
+		errorsv2.HandleError(w, r, err)
 		return
 	}
 
 	ctx = principal.NewImpersonateContext(ctx)
 
-	result, err := h.regionClient().List(ctx, organizationID)
+	result, stale, err := h.lastKnownGood.List(ctx, h.regionClient(), organizationID)
 	if err != nil {
-		errors.HandleError(w, r, fmt.Errorf("%w: unable to read regions", err))
+		errorsv2.HandleError(w, r, fmt.Errorf("%w: unable to read regions", err))
 		return
 	}
 
-	util.WriteJSONResponse(w, r, http.StatusOK, result)
+	h.writeRegionResponse(w, r, result, stale, params.IfNoneMatch)
 }
 
-func (h *Handler) GetApiV1OrganizationsOrganizationIDRegionsRegionIDFlavors(w http.ResponseWriter, r *http.Request, organizationID openapi.OrganizationIDParameter, regionID openapi.RegionIDParameter) {
+func (h *Handler) GetApiV1OrganizationsOrganizationIDRegionsRegionIDFlavors(w http.ResponseWriter, r *http.Request, organizationID openapi.OrganizationIDParameter, regionID openapi.RegionIDParameter, params openapi.GetApiV1OrganizationsOrganizationIDRegionsRegionIDFlavorsParams) {
 	ctx := r.Context()
 
 	if err := rbac.AllowOrganizationScope(ctx, "compute:flavors", identityapi.Read, organizationID); err != nil {
-		errors.HandleError(w, r, err)
+		errorsv2.HandleError(w, r, err)
 		return
 	}
 
 	ctx = principal.NewImpersonateContext(ctx)
 
-	result, err := h.regionClient().Flavors(ctx, organizationID, regionID)
+	result, stale, err := h.lastKnownGood.Flavors(ctx, h.regions, organizationID, regionID)
 	if err != nil {
-		errors.HandleError(w, r, fmt.Errorf("%w: unable to read flavors", err))
+		errorsv2.HandleError(w, r, fmt.Errorf("%w: unable to read flavors", err))
 		return
 	}
 
+	h.writeRegionResponse(w, r, result, stale, params.IfNoneMatch)
+}
+
+func (h *Handler) GetApiV1OrganizationsOrganizationIDRegionsRegionIDFlavorsAvailability(w http.ResponseWriter, r *http.Request, organizationID openapi.OrganizationIDParameter, regionID openapi.RegionIDParameter) {
+	ctx := r.Context()
+
+	if err := rbac.AllowOrganizationScope(ctx, "compute:flavors", identityapi.Read, organizationID); err != nil {
+		errorsv2.HandleError(w, r, err)
+		return
+	}
+
+	ctx = principal.NewImpersonateContext(ctx)
+
+	flavors, _, err := h.lastKnownGood.Flavors(ctx, h.regions, organizationID, regionID)
+	if err != nil {
+		errorsv2.HandleError(w, r, fmt.Errorf("%w: unable to read flavors", err))
+		return
+	}
+
+	free, err := h.quotas(ctx, organizationID)
+	if err != nil {
+		errorsv2.HandleError(w, r, fmt.Errorf("%w: unable to read quotas", err))
+		return
+	}
+
+	h.setUncacheable(w)
+	util.WriteJSONResponse(w, r, http.StatusOK, flavorAvailability(flavors, free))
+}
+
+func (h *Handler) GetApiV1OrganizationsOrganizationIDQuotasCompute(w http.ResponseWriter, r *http.Request, organizationID openapi.OrganizationIDParameter, params openapi.GetApiV1OrganizationsOrganizationIDQuotasComputeParams) {
+	ctx := r.Context()
+
+	if err := rbac.AllowOrganizationScope(ctx, "compute:flavors", identityapi.Read, organizationID); err != nil {
+		errorsv2.HandleError(w, r, err)
+		return
+	}
+
+	if params.FlavorID != nil && params.RegionID == nil {
+		errorsv2.HandleError(w, r, errorsv2.InvalidRequest(openapi.ComputeQuotaRegionNotSpecified, "region must be specified with a flavor"))
+		return
+	}
+
+	quotas, err := h.quotaList(ctx, organizationID)
+	if err != nil {
+		errorsv2.HandleError(w, r, fmt.Errorf("%w: unable to read quotas", err))
+		return
+	}
+
+	result := &openapi.ComputeQuotas{
+		Quotas: computeQuotas(quotas),
+	}
+
+	if params.FlavorID != nil {
+		ctx = principal.NewImpersonateContext(ctx)
+
+		flavors, _, err := h.lastKnownGood.Flavors(ctx, h.regions, organizationID, *params.RegionID)
+		if err != nil {
+			errorsv2.HandleError(w, r, fmt.Errorf("%w: unable to read flavors", err))
+			return
+		}
+
+		index := slices.IndexFunc(flavors, func(flavor regionapi.Flavor) bool {
+			return flavor.Metadata.Id == *params.FlavorID
+		})
+
+		if index < 0 {
+			errorsv2.HandleError(w, r, errors.HTTPNotFound())
+			return
+		}
+
+		result.Flavor = &flavorAvailability(flavors[index:index+1], freeQuotas(quotas))[0]
+	}
+
+	h.setUncacheable(w)
 	util.WriteJSONResponse(w, r, http.StatusOK, result)
 }
 
-func (h *Handler) GetApiV1OrganizationsOrganizationIDRegionsRegionIDImages(w http.ResponseWriter, r *http.Request, organizationID openapi.OrganizationIDParameter, regionID openapi.RegionIDParameter) {
+func (h *Handler) GetApiV1OrganizationsOrganizationIDRegionsRegionIDImages(w http.ResponseWriter, r *http.Request, organizationID openapi.OrganizationIDParameter, regionID openapi.RegionIDParameter, params openapi.GetApiV1OrganizationsOrganizationIDRegionsRegionIDImagesParams) {
 	ctx := r.Context()
 
 	if err := rbac.AllowOrganizationScope(ctx, "compute:images", identityapi.Read, organizationID); err != nil {
-		errors.HandleError(w, r, err)
+		errorsv2.HandleError(w, r, err)
 		return
 	}
 
 	ctx = principal.NewImpersonateContext(ctx)
 
-	result, err := h.regionClient().Images(ctx, organizationID, regionID)
+	result, stale, err := h.lastKnownGood.Images(ctx, h.regions, organizationID, regionID)
 	if err != nil {
-		errors.HandleError(w, r, fmt.Errorf("%w: unable to read images", err))
+		errorsv2.HandleError(w, r, fmt.Errorf("%w: unable to read images", err))
 		return
 	}
 
-	util.WriteJSONResponse(w, r, http.StatusOK, result)
+	h.writeRegionResponse(w, r, result, stale, params.IfNoneMatch)
 }
 
 func (h *Handler) clusterClient() *cluster.Client {
-	return cluster.NewClient(h.client, h.namespace, &h.options.Cluster, h.identity, h.region)
+	return cluster.NewClient(h.client, h.namespace, &h.options.Cluster, h.identity, h.region).WithRegionCache(h.regions).WithAccessOptions(&h.options.Access)
 }
 
 func (h *Handler) GetApiV1OrganizationsOrganizationIDClusters(w http.ResponseWriter, r *http.Request, organizationID openapi.OrganizationIDParameter, params openapi.GetApiV1OrganizationsOrganizationIDClustersParams) {
@@ -167,7 +289,7 @@ func (h *Handler) GetApiV1OrganizationsOrganizationIDClusters(w http.ResponseWri
 
 	result, err := h.clusterClient().List(ctx, organizationID, params)
 	if err != nil {
-		errors.HandleError(w, r, err)
+		errorsv2.HandleError(w, r, err)
 		return
 	}
 
@@ -176,27 +298,27 @@ func (h *Handler) GetApiV1OrganizationsOrganizationIDClusters(w http.ResponseWri
 	})
 
 	h.setUncacheable(w)
-	util.WriteJSONResponse(w, r, http.StatusOK, result)
+	writeSparseJSONList(w, r, http.StatusOK, result, params.Fields)
 }
 
 func (h *Handler) PostApiV1OrganizationsOrganizationIDProjectsProjectIDClusters(w http.ResponseWriter, r *http.Request, organizationID openapi.OrganizationIDParameter, projectID openapi.ProjectIDParameter) {
 	ctx := r.Context()
 
 	if err := rbac.AllowProjectScope(ctx, "compute:clusters", identityapi.Create, organizationID, projectID); err != nil {
-		errors.HandleError(w, r, err)
+		errorsv2.HandleError(w, r, err)
 		return
 	}
 
 	request := &openapi.ComputeClusterWrite{}
 
 	if err := util.ReadJSONBody(r, request); err != nil {
-		errors.HandleError(w, r, err)
+		errorsv2.HandleError(w, r, err)
 		return
 	}
 
 	result, err := h.clusterClient().Create(ctx, organizationID, projectID, request)
 	if err != nil {
-		errors.HandleError(w, r, err)
+		errorsv2.HandleError(w, r, err)
 		return
 	}
 
@@ -208,12 +330,12 @@ func (h *Handler) DeleteApiV1OrganizationsOrganizationIDProjectsProjectIDCluster
 	ctx := r.Context()
 
 	if err := rbac.AllowProjectScope(ctx, "compute:clusters", identityapi.Delete, organizationID, projectID); err != nil {
-		errors.HandleError(w, r, err)
+		errorsv2.HandleError(w, r, err)
 		return
 	}
 
 	if err := h.clusterClient().Delete(ctx, organizationID, projectID, clusterID); err != nil {
-		errors.HandleError(w, r, err)
+		errorsv2.HandleError(w, r, err)
 		return
 	}
 
@@ -224,38 +346,39 @@ func (h *Handler) DeleteApiV1OrganizationsOrganizationIDProjectsProjectIDCluster
 func (h *Handler) GetApiV1OrganizationsOrganizationIDProjectsProjectIDClustersClusterID(w http.ResponseWriter, r *http.Request, organizationID openapi.OrganizationIDParameter, projectID openapi.ProjectIDParameter, clusterID openapi.ClusterIDParameter) {
 	ctx := r.Context()
 
-	result, err := h.clusterClient().Get(ctx, organizationID, projectID, clusterID)
-	if err != nil {
-		errors.HandleError(w, r, err)
+	if err := handlerutil.AllowProjectScopeRead(ctx, &h.options.Access, "compute:clusters", organizationID, projectID); err != nil {
+		errorsv2.HandleError(w, r, err)
 		return
 	}
 
-	if err = rbac.AllowProjectScope(ctx, "compute:clusters", identityapi.Read, organizationID, result.Metadata.ProjectId); err != nil {
-		errors.HandleError(w, r, err)
+	result, resourceVersion, err := h.clusterClient().Get(ctx, organizationID, projectID, clusterID)
+	if err != nil {
+		errorsv2.HandleError(w, r, err)
 		return
 	}
 
 	h.setUncacheable(w)
+	handlerutil.SetETag(w, resourceVersion)
 	util.WriteJSONResponse(w, r, http.StatusOK, result)
 }
 
-func (h *Handler) PutApiV1OrganizationsOrganizationIDProjectsProjectIDClustersClusterID(w http.ResponseWriter, r *http.Request, organizationID openapi.OrganizationIDParameter, projectID openapi.ProjectIDParameter, clusterID openapi.ClusterIDParameter) {
+func (h *Handler) PutApiV1OrganizationsOrganizationIDProjectsProjectIDClustersClusterID(w http.ResponseWriter, r *http.Request, organizationID openapi.OrganizationIDParameter, projectID openapi.ProjectIDParameter, clusterID openapi.ClusterIDParameter, params openapi.PutApiV1OrganizationsOrganizationIDProjectsProjectIDClustersClusterIDParams) {
 	ctx := r.Context()
 
 	if err := rbac.AllowProjectScope(ctx, "compute:clusters", identityapi.Update, organizationID, projectID); err != nil {
-		errors.HandleError(w, r, err)
+		errorsv2.HandleError(w, r, err)
 		return
 	}
 
 	request := &openapi.ComputeClusterWrite{}
 
 	if err := util.ReadJSONBody(r, request); err != nil {
-		errors.HandleError(w, r, err)
+		errorsv2.HandleError(w, r, err)
 		return
 	}
 
-	if err := h.clusterClient().Update(ctx, organizationID, projectID, clusterID, request); err != nil {
-		errors.HandleError(w, r, err)
+	if err := h.clusterClient().Update(ctx, organizationID, projectID, clusterID, request, params.IfMatch); err != nil {
+		errorsv2.HandleError(w, r, err)
 		return
 	}
 
@@ -267,19 +390,243 @@ func (h *Handler) PostApiV1OrganizationsOrganizationIDProjectsProjectIDClustersC
 	ctx := r.Context()
 
 	if err := rbac.AllowProjectScope(ctx, "compute:clusters", identityapi.Update, organizationID, projectID); err != nil {
-		errors.HandleError(w, r, err)
+		errorsv2.HandleError(w, r, err)
 		return
 	}
 
 	request := &openapi.EvictionWrite{}
 
 	if err := util.ReadJSONBody(r, request); err != nil {
-		errors.HandleError(w, r, err)
+		errorsv2.HandleError(w, r, err)
 		return
 	}
 
 	if err := h.clusterClient().Evict(ctx, organizationID, projectID, clusterID, request); err != nil {
-		errors.HandleError(w, r, err)
+		errorsv2.HandleError(w, r, err)
+		return
+	}
+
+	h.setUncacheable(w)
+	w.WriteHeader(http.StatusAccepted)
+}
+
+func (h *Handler) PostApiV1OrganizationsOrganizationIDProjectsProjectIDClustersClusterIDAdopt(w http.ResponseWriter, r *http.Request, organizationID openapi.OrganizationIDParameter, projectID openapi.ProjectIDParameter, clusterID openapi.ClusterIDParameter) {
+	ctx := r.Context()
+
+	if err := rbac.AllowProjectScope(ctx, "compute:clusters", identityapi.Update, organizationID, projectID); err != nil {
+		errorsv2.HandleError(w, r, err)
+		return
+	}
+
+	request := &openapi.AdoptionWrite{}
+
+	if err := util.ReadJSONBody(r, request); err != nil {
+		errorsv2.HandleError(w, r, err)
+		return
+	}
+
+	if err := h.clusterClient().Adopt(ctx, organizationID, projectID, clusterID, request); err != nil {
+		errorsv2.HandleError(w, r, err)
+		return
+	}
+
+	h.setUncacheable(w)
+	w.WriteHeader(http.StatusAccepted)
+}
+
+func (h *Handler) PostApiV1OrganizationsOrganizationIDProjectsProjectIDClustersClusterIDCancel(w http.ResponseWriter, r *http.Request, organizationID openapi.OrganizationIDParameter, projectID openapi.ProjectIDParameter, clusterID openapi.ClusterIDParameter) {
+	ctx := r.Context()
+
+	if err := rbac.AllowProjectScope(ctx, "compute:clusters", identityapi.Update, organizationID, projectID); err != nil {
+		errorsv2.HandleError(w, r, err)
+		return
+	}
+
+	if err := h.clusterClient().Cancel(ctx, organizationID, projectID, clusterID); err != nil {
+		errorsv2.HandleError(w, r, err)
+		return
+	}
+
+	h.setUncacheable(w)
+	w.WriteHeader(http.StatusAccepted)
+}
+
+func (h *Handler) PostApiV1OrganizationsOrganizationIDProjectsProjectIDClustersClusterIDPreview(w http.ResponseWriter, r *http.Request, organizationID openapi.OrganizationIDParameter, projectID openapi.ProjectIDParameter, clusterID openapi.ClusterIDParameter) {
+	ctx := r.Context()
+
+	if err := rbac.AllowProjectScope(ctx, "compute:clusters", identityapi.Update, organizationID, projectID); err != nil {
+		errorsv2.HandleError(w, r, err)
+		return
+	}
+
+	request := &openapi.ComputeClusterWrite{}
+
+	if err := util.ReadJSONBody(r, request); err != nil {
+		errorsv2.HandleError(w, r, err)
+		return
+	}
+
+	result, err := h.clusterClient().Preview(ctx, organizationID, projectID, clusterID, request)
+	if err != nil {
+		errorsv2.HandleError(w, r, err)
+		return
+	}
+
+	h.setUncacheable(w)
+	util.WriteJSONResponse(w, r, http.StatusOK, result)
+}
+
+func (h *Handler) PostApiV1OrganizationsOrganizationIDProjectsProjectIDClustersClusterIDRollback(w http.ResponseWriter, r *http.Request, organizationID openapi.OrganizationIDParameter, projectID openapi.ProjectIDParameter, clusterID openapi.ClusterIDParameter, params openapi.PostApiV1OrganizationsOrganizationIDProjectsProjectIDClustersClusterIDRollbackParams) {
+	ctx := r.Context()
+
+	if err := rbac.AllowProjectScope(ctx, "compute:clusters", identityapi.Update, organizationID, projectID); err != nil {
+		errorsv2.HandleError(w, r, err)
+		return
+	}
+
+	if err := h.clusterClient().Rollback(ctx, organizationID, projectID, clusterID, params.Generation); err != nil {
+		errorsv2.HandleError(w, r, err)
+		return
+	}
+
+	h.setUncacheable(w)
+	w.WriteHeader(http.StatusAccepted)
+}
+
+func (h *Handler) GetApiV1OrganizationsOrganizationIDProjectsProjectIDClustersClusterIDEvictions(w http.ResponseWriter, r *http.Request, organizationID openapi.OrganizationIDParameter, projectID openapi.ProjectIDParameter, clusterID openapi.ClusterIDParameter) {
+	ctx := r.Context()
+
+	if err := handlerutil.AllowProjectScopeRead(ctx, &h.options.Access, "compute:clusters", organizationID, projectID); err != nil {
+		errorsv2.HandleError(w, r, err)
+		return
+	}
+
+	result, err := h.clusterClient().Evictions(ctx, organizationID, projectID, clusterID)
+	if err != nil {
+		errorsv2.HandleError(w, r, err)
+		return
+	}
+
+	h.setUncacheable(w)
+	util.WriteJSONResponse(w, r, http.StatusOK, result)
+}
+
+func (h *Handler) PostApiV1ClustersClusterIDMachinesHostnameBootfinished(w http.ResponseWriter, r *http.Request, clusterID openapi.ClusterIDParameter, hostname openapi.HostnameParameter, params openapi.PostApiV1ClustersClusterIDMachinesHostnameBootfinishedParams) {
+	if err := h.clusterClient().BootFinished(r.Context(), clusterID, hostname, params.Signature); err != nil {
+		errorsv2.HandleError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusAccepted)
+}
+
+func (h *Handler) GetApiV1OrganizationsOrganizationIDProjectsProjectIDClustersClusterIDEvents(w http.ResponseWriter, r *http.Request, organizationID openapi.OrganizationIDParameter, projectID openapi.ProjectIDParameter, clusterID openapi.ClusterIDParameter) {
+	ctx := r.Context()
+
+	if err := handlerutil.AllowProjectScopeRead(ctx, &h.options.Access, "compute:clusters", organizationID, projectID); err != nil {
+		errorsv2.HandleError(w, r, err)
+		return
+	}
+
+	result, err := h.clusterClient().Events(ctx, organizationID, projectID, clusterID)
+	if err != nil {
+		errorsv2.HandleError(w, r, err)
+		return
+	}
+
+	h.setUncacheable(w)
+	util.WriteJSONResponse(w, r, http.StatusOK, result)
+}
+
+func (h *Handler) GetApiV1OrganizationsOrganizationIDProjectsProjectIDClustersClusterIDPoolsPoolNameCompatibleImages(w http.ResponseWriter, r *http.Request, organizationID openapi.OrganizationIDParameter, projectID openapi.ProjectIDParameter, clusterID openapi.ClusterIDParameter, poolName openapi.PoolNameParameter) {
+	ctx := r.Context()
+
+	if err := handlerutil.AllowProjectScopeRead(ctx, &h.options.Access, "compute:clusters", organizationID, projectID); err != nil {
+		errorsv2.HandleError(w, r, err)
+		return
+	}
+
+	result, err := h.clusterClient().CompatibleImages(ctx, organizationID, projectID, clusterID, poolName)
+	if err != nil {
+		errorsv2.HandleError(w, r, err)
+		return
+	}
+
+	h.setUncacheable(w)
+	util.WriteJSONResponse(w, r, http.StatusOK, result)
+}
+
+func (h *Handler) GetApiV1OrganizationsOrganizationIDProjectsProjectIDClustersClusterIDPoolsPoolNameCompatibleFlavors(w http.ResponseWriter, r *http.Request, organizationID openapi.OrganizationIDParameter, projectID openapi.ProjectIDParameter, clusterID openapi.ClusterIDParameter, poolName openapi.PoolNameParameter) {
+	ctx := r.Context()
+
+	if err := handlerutil.AllowProjectScopeRead(ctx, &h.options.Access, "compute:clusters", organizationID, projectID); err != nil {
+		errorsv2.HandleError(w, r, err)
+		return
+	}
+
+	result, err := h.clusterClient().CompatibleFlavors(ctx, organizationID, projectID, clusterID, poolName)
+	if err != nil {
+		errorsv2.HandleError(w, r, err)
+		return
+	}
+
+	h.setUncacheable(w)
+	util.WriteJSONResponse(w, r, http.StatusOK, result)
+}
+
+func (h *Handler) GetApiV1OrganizationsOrganizationIDProjectsProjectIDClustersClusterIDPoolsPoolNameFirewallrules(w http.ResponseWriter, r *http.Request, organizationID openapi.OrganizationIDParameter, projectID openapi.ProjectIDParameter, clusterID openapi.ClusterIDParameter, poolName openapi.PoolNameParameter) {
+	ctx := r.Context()
+
+	if err := handlerutil.AllowProjectScopeRead(ctx, &h.options.Access, "compute:clusters", organizationID, projectID); err != nil {
+		errorsv2.HandleError(w, r, err)
+		return
+	}
+
+	result, err := h.clusterClient().ListFirewallRules(ctx, organizationID, projectID, clusterID, poolName)
+	if err != nil {
+		errorsv2.HandleError(w, r, err)
+		return
+	}
+
+	h.setUncacheable(w)
+	util.WriteJSONResponse(w, r, http.StatusOK, result)
+}
+
+func (h *Handler) PostApiV1OrganizationsOrganizationIDProjectsProjectIDClustersClusterIDPoolsPoolNameFirewallrules(w http.ResponseWriter, r *http.Request, organizationID openapi.OrganizationIDParameter, projectID openapi.ProjectIDParameter, clusterID openapi.ClusterIDParameter, poolName openapi.PoolNameParameter) {
+	ctx := r.Context()
+
+	if err := rbac.AllowProjectScope(ctx, "compute:clusters", identityapi.Update, organizationID, projectID); err != nil {
+		errorsv2.HandleError(w, r, err)
+		return
+	}
+
+	request := &openapi.FirewallRule{}
+
+	if err := util.ReadJSONBody(r, request); err != nil {
+		errorsv2.HandleError(w, r, err)
+		return
+	}
+
+	result, err := h.clusterClient().CreateFirewallRule(ctx, organizationID, projectID, clusterID, poolName, request)
+	if err != nil {
+		errorsv2.HandleError(w, r, err)
+		return
+	}
+
+	h.setUncacheable(w)
+	util.WriteJSONResponse(w, r, http.StatusCreated, result)
+}
+
+func (h *Handler) DeleteApiV1OrganizationsOrganizationIDProjectsProjectIDClustersClusterIDPoolsPoolNameFirewallrulesFirewallRuleID(w http.ResponseWriter, r *http.Request, organizationID openapi.OrganizationIDParameter, projectID openapi.ProjectIDParameter, clusterID openapi.ClusterIDParameter, poolName openapi.PoolNameParameter, firewallRuleID openapi.FirewallRuleIDParameter) {
+	ctx := r.Context()
+
+	if err := rbac.AllowProjectScope(ctx, "compute:clusters", identityapi.Update, organizationID, projectID); err != nil {
+		errorsv2.HandleError(w, r, err)
+		return
+	}
+
+	if err := h.clusterClient().DeleteFirewallRule(ctx, organizationID, projectID, clusterID, poolName, firewallRuleID); err != nil {
+		errorsv2.HandleError(w, r, err)
 		return
 	}
 
@@ -287,35 +634,151 @@ func (h *Handler) PostApiV1OrganizationsOrganizationIDProjectsProjectIDClustersC
 	w.WriteHeader(http.StatusAccepted)
 }
 
+func (h *Handler) GetApiV1OrganizationsOrganizationIDProjectsProjectIDClustersClusterIDMachines(w http.ResponseWriter, r *http.Request, organizationID openapi.OrganizationIDParameter, projectID openapi.ProjectIDParameter, clusterID openapi.ClusterIDParameter, params openapi.GetApiV1OrganizationsOrganizationIDProjectsProjectIDClustersClusterIDMachinesParams) {
+	ctx := r.Context()
+
+	if err := handlerutil.AllowProjectScopeRead(ctx, &h.options.Access, "compute:clusters", organizationID, projectID); err != nil {
+		errorsv2.HandleError(w, r, err)
+		return
+	}
+
+	result, err := h.clusterClient().ListMachines(ctx, organizationID, projectID, clusterID, &params)
+	if err != nil {
+		errorsv2.HandleError(w, r, err)
+		return
+	}
+
+	h.setUncacheable(w)
+	util.WriteJSONResponse(w, r, http.StatusOK, result)
+}
+
 func (h *Handler) GetApiV1OrganizationsOrganizationIDProjectsProjectIDClustersClusterIDMachinesMachineIDConsoleoutput(w http.ResponseWriter, r *http.Request, organizationID openapi.OrganizationIDParameter, projectID openapi.ProjectIDParameter, clusterID openapi.ClusterIDParameter, machineID openapi.MachineIDParameter, params openapi.GetApiV1OrganizationsOrganizationIDProjectsProjectIDClustersClusterIDMachinesMachineIDConsoleoutputParams) {
 	ctx := r.Context()
 
-	if err := rbac.AllowProjectScope(r.Context(), "compute:clusters", identityapi.Read, organizationID, projectID); err != nil {
-		errors.HandleError(w, r, err)
+	if err := handlerutil.AllowProjectScopeRead(r.Context(), &h.options.Access, "compute:clusters", organizationID, projectID); err != nil {
+		errorsv2.HandleError(w, r, err)
 		return
 	}
 
-	result, err := h.clusterClient().GetConsoleOutput(ctx, organizationID, projectID, clusterID, machineID, &params)
+	client := h.clusterClient()
+
+	result, err := client.GetConsoleOutput(ctx, organizationID, projectID, clusterID, machineID, &params)
 	if err != nil {
-		errors.HandleError(w, r, err)
+		errorsv2.HandleError(w, r, err)
 		return
 	}
 
 	h.setUncacheable(w)
+
+	if params.Follow != nil && *params.Follow {
+		get := func(ctx context.Context) (string, error) {
+			result, err := client.GetConsoleOutput(ctx, organizationID, projectID, clusterID, machineID, &params)
+			if err != nil {
+				return "", err
+			}
+
+			return result.Contents, nil
+		}
+
+		followConsole(w, r, result.Contents, get)
+
+		return
+	}
+
+	util.WriteJSONResponse(w, r, http.StatusOK, result)
+}
+
+func (h *Handler) PostApiV1OrganizationsOrganizationIDProjectsProjectIDClustersClusterIDMachinesMachineIDDiagnostics(w http.ResponseWriter, r *http.Request, organizationID openapi.OrganizationIDParameter, projectID openapi.ProjectIDParameter, clusterID openapi.ClusterIDParameter, machineID openapi.MachineIDParameter) {
+	ctx := r.Context()
+
+	if err := handlerutil.AllowProjectScopeRead(r.Context(), &h.options.Access, "compute:clusters", organizationID, projectID); err != nil {
+		errorsv2.HandleError(w, r, err)
+		return
+	}
+
+	result, err := h.clusterClient().Diagnostics(ctx, organizationID, projectID, clusterID, machineID)
+	if err != nil {
+		errorsv2.HandleError(w, r, err)
+		return
+	}
+
+	h.setUncacheable(w)
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="diagnostics-%s.json"`, machineID))
 	util.WriteJSONResponse(w, r, http.StatusOK, result)
 }
 
 func (h *Handler) GetApiV1OrganizationsOrganizationIDProjectsProjectIDClustersClusterIDMachinesMachineIDConsolesessions(w http.ResponseWriter, r *http.Request, organizationID openapi.OrganizationIDParameter, projectID openapi.ProjectIDParameter, clusterID openapi.ClusterIDParameter, machineID openapi.MachineIDParameter) {
 	ctx := r.Context()
 
-	if err := rbac.AllowProjectScope(r.Context(), "compute:clusters", identityapi.Read, organizationID, projectID); err != nil {
-		errors.HandleError(w, r, err)
+	if err := handlerutil.AllowProjectScopeRead(r.Context(), &h.options.Access, "compute:clusters", organizationID, projectID); err != nil {
+		errorsv2.HandleError(w, r, err)
 		return
 	}
 
 	result, err := h.clusterClient().CreateConsoleSession(ctx, organizationID, projectID, clusterID, machineID)
 	if err != nil {
-		errors.HandleError(w, r, err)
+		errorsv2.HandleError(w, r, err)
+		return
+	}
+
+	h.setUncacheable(w)
+	util.WriteJSONResponse(w, r, http.StatusOK, result)
+}
+
+func (h *Handler) PostApiV1OrganizationsOrganizationIDProjectsProjectIDClustersClusterIDPoolsPoolNamePower(w http.ResponseWriter, r *http.Request, organizationID openapi.OrganizationIDParameter, projectID openapi.ProjectIDParameter, clusterID openapi.ClusterIDParameter, poolName openapi.PoolNameParameter) {
+	ctx := r.Context()
+
+	if err := rbac.AllowProjectScope(ctx, "compute:clusters", identityapi.Update, organizationID, projectID); err != nil {
+		errorsv2.HandleError(w, r, err)
+		return
+	}
+
+	request := &openapi.PoolPowerWrite{}
+
+	if err := util.ReadJSONBody(r, request); err != nil {
+		errorsv2.HandleError(w, r, err)
+		return
+	}
+
+	result, err := h.clusterClient().PoolPower(ctx, organizationID, projectID, clusterID, poolName, request)
+	if err != nil {
+		errorsv2.HandleError(w, r, err)
+		return
+	}
+
+	h.setUncacheable(w)
+	util.WriteJSONResponse(w, r, http.StatusOK, result)
+}
+
+func (h *Handler) PostApiV1OrganizationsOrganizationIDProjectsProjectIDClustersClusterIDStop(w http.ResponseWriter, r *http.Request, organizationID openapi.OrganizationIDParameter, projectID openapi.ProjectIDParameter, clusterID openapi.ClusterIDParameter, params openapi.PostApiV1OrganizationsOrganizationIDProjectsProjectIDClustersClusterIDStopParams) {
+	ctx := r.Context()
+
+	if err := rbac.AllowProjectScope(ctx, "compute:clusters", identityapi.Update, organizationID, projectID); err != nil {
+		errorsv2.HandleError(w, r, err)
+		return
+	}
+
+	result, err := h.clusterClient().ClusterPower(ctx, organizationID, projectID, clusterID, openapi.PoolPowerActionStop, params.Mode)
+	if err != nil {
+		errorsv2.HandleError(w, r, err)
+		return
+	}
+
+	h.setUncacheable(w)
+	util.WriteJSONResponse(w, r, http.StatusOK, result)
+}
+
+func (h *Handler) PostApiV1OrganizationsOrganizationIDProjectsProjectIDClustersClusterIDStart(w http.ResponseWriter, r *http.Request, organizationID openapi.OrganizationIDParameter, projectID openapi.ProjectIDParameter, clusterID openapi.ClusterIDParameter, params openapi.PostApiV1OrganizationsOrganizationIDProjectsProjectIDClustersClusterIDStartParams) {
+	ctx := r.Context()
+
+	if err := rbac.AllowProjectScope(ctx, "compute:clusters", identityapi.Update, organizationID, projectID); err != nil {
+		errorsv2.HandleError(w, r, err)
+		return
+	}
+
+	result, err := h.clusterClient().ClusterPower(ctx, organizationID, projectID, clusterID, openapi.PoolPowerActionStart, params.Mode)
+	if err != nil {
+		errorsv2.HandleError(w, r, err)
 		return
 	}
 
@@ -323,16 +786,40 @@ func (h *Handler) GetApiV1OrganizationsOrganizationIDProjectsProjectIDClustersCl
 	util.WriteJSONResponse(w, r, http.StatusOK, result)
 }
 
+func (h *Handler) PostApiV1OrganizationsOrganizationIDProjectsProjectIDClustersClusterIDPoolsPoolNameGoldenImage(w http.ResponseWriter, r *http.Request, organizationID openapi.OrganizationIDParameter, projectID openapi.ProjectIDParameter, clusterID openapi.ClusterIDParameter, poolName openapi.PoolNameParameter) {
+	ctx := r.Context()
+
+	if err := rbac.AllowProjectScope(ctx, "compute:clusters", identityapi.Update, organizationID, projectID); err != nil {
+		errorsv2.HandleError(w, r, err)
+		return
+	}
+
+	request := &openapi.PoolGoldenImageWrite{}
+
+	if err := util.ReadJSONBody(r, request); err != nil {
+		errorsv2.HandleError(w, r, err)
+		return
+	}
+
+	if err := h.clusterClient().GoldenImage(ctx, organizationID, projectID, clusterID, poolName, request); err != nil {
+		errorsv2.HandleError(w, r, err)
+		return
+	}
+
+	h.setUncacheable(w)
+	w.WriteHeader(http.StatusAccepted)
+}
+
 func (h *Handler) PostApiV1OrganizationsOrganizationIDProjectsProjectIDClustersClusterIDMachinesMachineIDHardreboot(w http.ResponseWriter, r *http.Request, organizationID openapi.OrganizationIDParameter, projectID openapi.ProjectIDParameter, clusterID openapi.ClusterIDParameter, machineID openapi.MachineIDParameter) {
 	ctx := r.Context()
 
 	if err := rbac.AllowProjectScope(ctx, "compute:clusters", identityapi.Update, organizationID, projectID); err != nil {
-		errors.HandleError(w, r, err)
+		errorsv2.HandleError(w, r, err)
 		return
 	}
 
 	if err := h.clusterClient().HardRebootMachine(ctx, organizationID, projectID, clusterID, machineID); err != nil {
-		errors.HandleError(w, r, err)
+		errorsv2.HandleError(w, r, err)
 		return
 	}
 
@@ -344,12 +831,155 @@ func (h *Handler) PostApiV1OrganizationsOrganizationIDProjectsProjectIDClustersC
 	ctx := r.Context()
 
 	if err := rbac.AllowProjectScope(ctx, "compute:clusters", identityapi.Update, organizationID, projectID); err != nil {
-		errors.HandleError(w, r, err)
+		errorsv2.HandleError(w, r, err)
 		return
 	}
 
 	if err := h.clusterClient().SoftRebootMachine(ctx, organizationID, projectID, clusterID, machineID); err != nil {
-		errors.HandleError(w, r, err)
+		errorsv2.HandleError(w, r, err)
+		return
+	}
+
+	h.setUncacheable(w)
+	w.WriteHeader(http.StatusAccepted)
+}
+
+func (h *Handler) PostApiV1OrganizationsOrganizationIDProjectsProjectIDClustersClusterIDMachinesMachineIDResize(w http.ResponseWriter, r *http.Request, organizationID openapi.OrganizationIDParameter, projectID openapi.ProjectIDParameter, clusterID openapi.ClusterIDParameter, machineID openapi.MachineIDParameter) {
+	ctx := r.Context()
+
+	if err := rbac.AllowProjectScope(ctx, "compute:clusters", identityapi.Update, organizationID, projectID); err != nil {
+		errorsv2.HandleError(w, r, err)
+		return
+	}
+
+	request := &openapi.MachineResizeWrite{}
+
+	if err := util.ReadJSONBody(r, request); err != nil {
+		errorsv2.HandleError(w, r, err)
+		return
+	}
+
+	if err := h.clusterClient().ResizeMachine(ctx, organizationID, projectID, clusterID, machineID, request); err != nil {
+		errorsv2.HandleError(w, r, err)
+		return
+	}
+
+	h.setUncacheable(w)
+	w.WriteHeader(http.StatusAccepted)
+}
+
+func (h *Handler) PostApiV1OrganizationsOrganizationIDProjectsProjectIDClustersClusterIDMachinesMachineIDPublicip(w http.ResponseWriter, r *http.Request, organizationID openapi.OrganizationIDParameter, projectID openapi.ProjectIDParameter, clusterID openapi.ClusterIDParameter, machineID openapi.MachineIDParameter) {
+	ctx := r.Context()
+
+	if err := rbac.AllowProjectScope(ctx, "compute:clusters", identityapi.Update, organizationID, projectID); err != nil {
+		errorsv2.HandleError(w, r, err)
+		return
+	}
+
+	if err := h.clusterClient().AttachMachinePublicIP(ctx, organizationID, projectID, clusterID, machineID); err != nil {
+		errorsv2.HandleError(w, r, err)
+		return
+	}
+
+	h.setUncacheable(w)
+	w.WriteHeader(http.StatusAccepted)
+}
+
+func (h *Handler) DeleteApiV1OrganizationsOrganizationIDProjectsProjectIDClustersClusterIDMachinesMachineIDPublicip(w http.ResponseWriter, r *http.Request, organizationID openapi.OrganizationIDParameter, projectID openapi.ProjectIDParameter, clusterID openapi.ClusterIDParameter, machineID openapi.MachineIDParameter) {
+	ctx := r.Context()
+
+	if err := rbac.AllowProjectScope(ctx, "compute:clusters", identityapi.Update, organizationID, projectID); err != nil {
+		errorsv2.HandleError(w, r, err)
+		return
+	}
+
+	if err := h.clusterClient().DetachMachinePublicIP(ctx, organizationID, projectID, clusterID, machineID); err != nil {
+		errorsv2.HandleError(w, r, err)
+		return
+	}
+
+	h.setUncacheable(w)
+	w.WriteHeader(http.StatusAccepted)
+}
+
+func (h *Handler) PostApiV1OrganizationsOrganizationIDProjectsProjectIDClustersClusterIDMachinesMachineIDDetach(w http.ResponseWriter, r *http.Request, organizationID openapi.OrganizationIDParameter, projectID openapi.ProjectIDParameter, clusterID openapi.ClusterIDParameter, machineID openapi.MachineIDParameter) {
+	ctx := r.Context()
+
+	if err := rbac.AllowProjectScope(ctx, "compute:clusters", identityapi.Update, organizationID, projectID); err != nil {
+		errorsv2.HandleError(w, r, err)
+		return
+	}
+
+	if err := h.clusterClient().DetachMachine(ctx, organizationID, projectID, clusterID, machineID); err != nil {
+		errorsv2.HandleError(w, r, err)
+		return
+	}
+
+	h.setUncacheable(w)
+	w.WriteHeader(http.StatusAccepted)
+}
+
+func (h *Handler) PostApiV1OrganizationsOrganizationIDProjectsProjectIDClustersClusterIDMaintenancemode(w http.ResponseWriter, r *http.Request, organizationID openapi.OrganizationIDParameter, projectID openapi.ProjectIDParameter, clusterID openapi.ClusterIDParameter) {
+	ctx := r.Context()
+
+	if err := rbac.AllowProjectScope(ctx, "compute:clusters", identityapi.Update, organizationID, projectID); err != nil {
+		errorsv2.HandleError(w, r, err)
+		return
+	}
+
+	if err := h.clusterClient().SetMaintenanceMode(ctx, organizationID, projectID, clusterID, true); err != nil {
+		errorsv2.HandleError(w, r, err)
+		return
+	}
+
+	h.setUncacheable(w)
+	w.WriteHeader(http.StatusAccepted)
+}
+
+func (h *Handler) DeleteApiV1OrganizationsOrganizationIDProjectsProjectIDClustersClusterIDMaintenancemode(w http.ResponseWriter, r *http.Request, organizationID openapi.OrganizationIDParameter, projectID openapi.ProjectIDParameter, clusterID openapi.ClusterIDParameter) {
+	ctx := r.Context()
+
+	if err := rbac.AllowProjectScope(ctx, "compute:clusters", identityapi.Update, organizationID, projectID); err != nil {
+		errorsv2.HandleError(w, r, err)
+		return
+	}
+
+	if err := h.clusterClient().SetMaintenanceMode(ctx, organizationID, projectID, clusterID, false); err != nil {
+		errorsv2.HandleError(w, r, err)
+		return
+	}
+
+	h.setUncacheable(w)
+	w.WriteHeader(http.StatusAccepted)
+}
+
+func (h *Handler) PostApiV1OrganizationsOrganizationIDProjectsProjectIDClustersClusterIDMachinesMachineIDCordon(w http.ResponseWriter, r *http.Request, organizationID openapi.OrganizationIDParameter, projectID openapi.ProjectIDParameter, clusterID openapi.ClusterIDParameter, machineID openapi.MachineIDParameter) {
+	ctx := r.Context()
+
+	if err := rbac.AllowProjectScope(ctx, "compute:clusters", identityapi.Update, organizationID, projectID); err != nil {
+		errorsv2.HandleError(w, r, err)
+		return
+	}
+
+	if err := h.clusterClient().CordonMachine(ctx, organizationID, projectID, clusterID, machineID); err != nil {
+		errorsv2.HandleError(w, r, err)
+		return
+	}
+
+	h.setUncacheable(w)
+	w.WriteHeader(http.StatusAccepted)
+}
+
+func (h *Handler) PostApiV1OrganizationsOrganizationIDProjectsProjectIDClustersClusterIDMachinesMachineIDUncordon(w http.ResponseWriter, r *http.Request, organizationID openapi.OrganizationIDParameter, projectID openapi.ProjectIDParameter, clusterID openapi.ClusterIDParameter, machineID openapi.MachineIDParameter) {
+	ctx := r.Context()
+
+	if err := rbac.AllowProjectScope(ctx, "compute:clusters", identityapi.Update, organizationID, projectID); err != nil {
+		errorsv2.HandleError(w, r, err)
+		return
+	}
+
+	if err := h.clusterClient().UncordonMachine(ctx, organizationID, projectID, clusterID, machineID); err != nil {
+		errorsv2.HandleError(w, r, err)
 		return
 	}
 
@@ -361,12 +991,12 @@ func (h *Handler) PostApiV1OrganizationsOrganizationIDProjectsProjectIDClustersC
 	ctx := r.Context()
 
 	if err := rbac.AllowProjectScope(ctx, "compute:clusters", identityapi.Update, organizationID, projectID); err != nil {
-		errors.HandleError(w, r, err)
+		errorsv2.HandleError(w, r, err)
 		return
 	}
 
 	if err := h.clusterClient().StartMachine(ctx, organizationID, projectID, clusterID, machineID); err != nil {
-		errors.HandleError(w, r, err)
+		errorsv2.HandleError(w, r, err)
 		return
 	}
 
@@ -378,15 +1008,84 @@ func (h *Handler) PostApiV1OrganizationsOrganizationIDProjectsProjectIDClustersC
 	ctx := r.Context()
 
 	if err := rbac.AllowProjectScope(ctx, "compute:clusters", identityapi.Update, organizationID, projectID); err != nil {
-		errors.HandleError(w, r, err)
+		errorsv2.HandleError(w, r, err)
 		return
 	}
 
 	if err := h.clusterClient().StopMachine(ctx, organizationID, projectID, clusterID, machineID); err != nil {
-		errors.HandleError(w, r, err)
+		errorsv2.HandleError(w, r, err)
+		return
+	}
+
+	h.setUncacheable(w)
+	w.WriteHeader(http.StatusAccepted)
+}
+
+func (h *Handler) webhookClient() *webhook.Client {
+	return webhook.NewClient(h.client, h.namespace)
+}
+
+func (h *Handler) GetApiV1OrganizationsOrganizationIDWebhooks(w http.ResponseWriter, r *http.Request, organizationID openapi.OrganizationIDParameter) {
+	result, err := h.webhookClient().List(r.Context(), organizationID)
+	if err != nil {
+		errorsv2.HandleError(w, r, err)
+		return
+	}
+
+	h.setUncacheable(w)
+	writeJSONList(w, r, http.StatusOK, result)
+}
+
+func (h *Handler) PostApiV1OrganizationsOrganizationIDWebhooks(w http.ResponseWriter, r *http.Request, organizationID openapi.OrganizationIDParameter) {
+	request := &openapi.WebhookWrite{}
+
+	if err := util.ReadJSONBody(r, request); err != nil {
+		errorsv2.HandleError(w, r, err)
+		return
+	}
+
+	result, err := h.webhookClient().Create(r.Context(), organizationID, request)
+	if err != nil {
+		errorsv2.HandleError(w, r, err)
+		return
+	}
+
+	util.WriteJSONResponse(w, r, http.StatusCreated, result)
+}
+
+func (h *Handler) GetApiV1OrganizationsOrganizationIDWebhooksWebhookID(w http.ResponseWriter, r *http.Request, organizationID openapi.OrganizationIDParameter, webhookID openapi.WebhookIDParameter) {
+	result, err := h.webhookClient().Get(r.Context(), organizationID, webhookID)
+	if err != nil {
+		errorsv2.HandleError(w, r, err)
 		return
 	}
 
 	h.setUncacheable(w)
+	util.WriteJSONResponse(w, r, http.StatusOK, result)
+}
+
+func (h *Handler) PutApiV1OrganizationsOrganizationIDWebhooksWebhookID(w http.ResponseWriter, r *http.Request, organizationID openapi.OrganizationIDParameter, webhookID openapi.WebhookIDParameter) {
+	request := &openapi.WebhookWrite{}
+
+	if err := util.ReadJSONBody(r, request); err != nil {
+		errorsv2.HandleError(w, r, err)
+		return
+	}
+
+	result, err := h.webhookClient().Update(r.Context(), organizationID, webhookID, request)
+	if err != nil {
+		errorsv2.HandleError(w, r, err)
+		return
+	}
+
+	util.WriteJSONResponse(w, r, http.StatusOK, result)
+}
+
+func (h *Handler) DeleteApiV1OrganizationsOrganizationIDWebhooksWebhookID(w http.ResponseWriter, r *http.Request, organizationID openapi.OrganizationIDParameter, webhookID openapi.WebhookIDParameter) {
+	if err := h.webhookClient().Delete(r.Context(), organizationID, webhookID); err != nil {
+		errorsv2.HandleError(w, r, err)
+		return
+	}
+
 	w.WriteHeader(http.StatusAccepted)
 }
@@ -19,39 +19,88 @@ limitations under the License.
 package handler
 
 import (
+	"context"
 	"net/http"
 
+	"github.com/unikorn-cloud/compute/pkg/constants"
 	"github.com/unikorn-cloud/compute/pkg/openapi"
+	"github.com/unikorn-cloud/compute/pkg/server/errorsv2"
+	"github.com/unikorn-cloud/compute/pkg/server/handler/admin"
+	"github.com/unikorn-cloud/compute/pkg/server/handler/clustertemplate"
 	"github.com/unikorn-cloud/compute/pkg/server/handler/instance"
+	"github.com/unikorn-cloud/compute/pkg/server/handler/maintenance"
+	"github.com/unikorn-cloud/compute/pkg/server/handler/securitygroup"
+	handlerutil "github.com/unikorn-cloud/compute/pkg/server/handler/util"
 	"github.com/unikorn-cloud/core/pkg/server/errors"
 	"github.com/unikorn-cloud/core/pkg/server/util"
 )
 
 func (h *Handler) instanceClient() *instance.Client {
-	return instance.NewClient(h.client, h.namespace, h.identity, h.region)
+	return instance.NewClient(h.client, h.namespace, h.identity, h.region).WithRegionCache(h.regions).WithAccessOptions(&h.options.Access)
+}
+
+func (h *Handler) clusterTemplateClient() *clustertemplate.Client {
+	return clustertemplate.NewClient(h.client, h.namespace, h.identity, h.region).WithRegionCache(h.regions)
+}
+
+func (h *Handler) adminClient() *admin.Client {
+	return admin.NewClient(h.client, h.namespace)
+}
+
+func (h *Handler) maintenanceClient() *maintenance.Client {
+	return maintenance.NewClient(h.client, h.namespace).WithAccessOptions(&h.options.Access)
+}
+
+func (h *Handler) securityGroupClient() *securitygroup.Client {
+	return securitygroup.NewClient(h.region)
+}
+
+func (h *Handler) GetApiV2Version(w http.ResponseWriter, r *http.Request) {
+	result := &openapi.VersionRead{
+		Application: constants.Application,
+		Version:     constants.Version,
+		Revision:    constants.Revision,
+		Features:    h.options.features(),
+		ApiVersions: []string{"v1", "v2"},
+	}
+
+	h.setUncacheable(w)
+	util.WriteJSONResponse(w, r, http.StatusOK, result)
 }
 
 func (h *Handler) GetApiV2Instances(w http.ResponseWriter, r *http.Request, params openapi.GetApiV2InstancesParams) {
 	result, err := h.instanceClient().List(r.Context(), params)
 	if err != nil {
-		errors.HandleError(w, r, err)
+		errorsv2.HandleError(w, r, err)
 		return
 	}
 
-	util.WriteJSONResponse(w, r, http.StatusOK, result)
+	writeSparseJSONList(w, r, http.StatusOK, result, params.Fields)
 }
 
-func (h *Handler) PostApiV2Instances(w http.ResponseWriter, r *http.Request) {
+func (h *Handler) PostApiV2Instances(w http.ResponseWriter, r *http.Request, params openapi.PostApiV2InstancesParams) {
 	request := &openapi.InstanceCreate{}
 
 	if err := util.ReadJSONBody(r, request); err != nil {
-		errors.HandleError(w, r, err)
+		errorsv2.HandleError(w, r, err)
+		return
+	}
+
+	if params.Count != nil {
+		result, err := h.instanceClient().CreateBatch(r.Context(), request, *params.Count)
+		if err != nil {
+			errorsv2.HandleError(w, r, err)
+			return
+		}
+
+		util.WriteJSONResponse(w, r, http.StatusCreated, result)
+
 		return
 	}
 
 	result, err := h.instanceClient().Create(r.Context(), request)
 	if err != nil {
-		errors.HandleError(w, r, err)
+		errorsv2.HandleError(w, r, err)
 		return
 	}
 
@@ -59,35 +108,67 @@ func (h *Handler) PostApiV2Instances(w http.ResponseWriter, r *http.Request) {
 }
 
 func (h *Handler) GetApiV2InstancesInstanceID(w http.ResponseWriter, r *http.Request, instanceID openapi.InstanceIDParameter) {
-	result, err := h.instanceClient().Get(r.Context(), instanceID)
+	result, resourceVersion, err := h.instanceClient().Get(r.Context(), instanceID)
 	if err != nil {
-		errors.HandleError(w, r, err)
+		errorsv2.HandleError(w, r, err)
 		return
 	}
 
+	handlerutil.SetETag(w, resourceVersion)
 	util.WriteJSONResponse(w, r, http.StatusOK, result)
 }
 
-func (h *Handler) PutApiV2InstancesInstanceID(w http.ResponseWriter, r *http.Request, instanceID openapi.InstanceIDParameter) {
+func (h *Handler) PutApiV2InstancesInstanceID(w http.ResponseWriter, r *http.Request, instanceID openapi.InstanceIDParameter, params openapi.PutApiV2InstancesInstanceIDParams) {
 	request := &openapi.InstanceUpdate{}
 
 	if err := util.ReadJSONBody(r, request); err != nil {
-		errors.HandleError(w, r, err)
+		errorsv2.HandleError(w, r, err)
 		return
 	}
 
-	result, err := h.instanceClient().Update(r.Context(), instanceID, request)
+	result, resourceVersion, err := h.instanceClient().Update(r.Context(), instanceID, request, params.IfMatch)
 	if err != nil {
-		errors.HandleError(w, r, err)
+		errorsv2.HandleError(w, r, err)
 		return
 	}
 
+	handlerutil.SetETag(w, resourceVersion)
 	util.WriteJSONResponse(w, r, http.StatusAccepted, result)
 }
 
+func (h *Handler) GetApiV2InstancesInstanceIDTags(w http.ResponseWriter, r *http.Request, instanceID openapi.InstanceIDParameter) {
+	result, err := h.instanceClient().GetTags(r.Context(), instanceID)
+	if err != nil {
+		errorsv2.HandleError(w, r, err)
+		return
+	}
+
+	// The resource version is used to detect concurrent modification, so
+	// must never be stale.
+	h.setUncacheable(w)
+	util.WriteJSONResponse(w, r, http.StatusOK, result)
+}
+
+func (h *Handler) PatchApiV2InstancesInstanceIDTags(w http.ResponseWriter, r *http.Request, instanceID openapi.InstanceIDParameter) {
+	request := &openapi.ResourceTagsPatch{}
+
+	if err := util.ReadJSONBody(r, request); err != nil {
+		errorsv2.HandleError(w, r, err)
+		return
+	}
+
+	result, err := h.instanceClient().PatchTags(r.Context(), instanceID, request)
+	if err != nil {
+		errorsv2.HandleError(w, r, err)
+		return
+	}
+
+	util.WriteJSONResponse(w, r, http.StatusOK, result)
+}
+
 func (h *Handler) DeleteApiV2InstancesInstanceID(w http.ResponseWriter, r *http.Request, instanceID openapi.InstanceIDParameter) {
 	if err := h.instanceClient().Delete(r.Context(), instanceID); err != nil {
-		errors.HandleError(w, r, err)
+		errorsv2.HandleError(w, r, err)
 		return
 	}
 
@@ -97,7 +178,7 @@ func (h *Handler) DeleteApiV2InstancesInstanceID(w http.ResponseWriter, r *http.
 func (h *Handler) GetApiV2InstancesInstanceIDSshkey(w http.ResponseWriter, r *http.Request, instanceID openapi.InstanceIDParameter) {
 	result, err := h.instanceClient().SSHKey(r.Context(), instanceID)
 	if err != nil {
-		errors.HandleError(w, r, err)
+		errorsv2.HandleError(w, r, err)
 		return
 	}
 
@@ -106,7 +187,7 @@ func (h *Handler) GetApiV2InstancesInstanceIDSshkey(w http.ResponseWriter, r *ht
 
 func (h *Handler) PostApiV2InstancesInstanceIDStart(w http.ResponseWriter, r *http.Request, instanceID openapi.InstanceIDParameter) {
 	if err := h.instanceClient().Start(r.Context(), instanceID); err != nil {
-		errors.HandleError(w, r, err)
+		errorsv2.HandleError(w, r, err)
 		return
 	}
 
@@ -115,7 +196,7 @@ func (h *Handler) PostApiV2InstancesInstanceIDStart(w http.ResponseWriter, r *ht
 
 func (h *Handler) PostApiV2InstancesInstanceIDStop(w http.ResponseWriter, r *http.Request, instanceID openapi.InstanceIDParameter) {
 	if err := h.instanceClient().Stop(r.Context(), instanceID); err != nil {
-		errors.HandleError(w, r, err)
+		errorsv2.HandleError(w, r, err)
 		return
 	}
 
@@ -124,7 +205,7 @@ func (h *Handler) PostApiV2InstancesInstanceIDStop(w http.ResponseWriter, r *htt
 
 func (h *Handler) PostApiV2InstancesInstanceIDReboot(w http.ResponseWriter, r *http.Request, instanceID openapi.InstanceIDParameter, params openapi.PostApiV2InstancesInstanceIDRebootParams) {
 	if err := h.instanceClient().Reboot(r.Context(), instanceID, params); err != nil {
-		errors.HandleError(w, r, err)
+		errorsv2.HandleError(w, r, err)
 		return
 	}
 
@@ -134,23 +215,74 @@ func (h *Handler) PostApiV2InstancesInstanceIDReboot(w http.ResponseWriter, r *h
 func (h *Handler) PostApiV2InstancesInstanceIDSnapshot(w http.ResponseWriter, r *http.Request, instanceID openapi.InstanceIDParameter) {
 	var body openapi.InstanceSnapshotCreate
 	if err := util.ReadJSONBody(r, &body); err != nil {
-		errors.HandleError(w, r, errors.OAuth2InvalidRequest("unable to parse request body").WithError(err))
+		errorsv2.HandleError(w, r, errors.OAuth2InvalidRequest("unable to parse request body").WithError(err))
 		return
 	}
 
 	result, err := h.instanceClient().Snapshot(r.Context(), instanceID, body)
 	if err != nil {
-		errors.HandleError(w, r, err)
+		errorsv2.HandleError(w, r, err)
 		return
 	}
 
 	util.WriteJSONResponse(w, r, http.StatusCreated, result)
 }
 
+func (h *Handler) PostApiV2InstancesInstanceIDPublicip(w http.ResponseWriter, r *http.Request, instanceID openapi.InstanceIDParameter) {
+	if err := h.instanceClient().SetPublicIP(r.Context(), instanceID, true); err != nil {
+		errorsv2.HandleError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusAccepted)
+}
+
+func (h *Handler) DeleteApiV2InstancesInstanceIDPublicip(w http.ResponseWriter, r *http.Request, instanceID openapi.InstanceIDParameter) {
+	if err := h.instanceClient().SetPublicIP(r.Context(), instanceID, false); err != nil {
+		errorsv2.HandleError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusAccepted)
+}
+
+func (h *Handler) PostApiV2InstancesInstanceIDMigrateNetwork(w http.ResponseWriter, r *http.Request, instanceID openapi.InstanceIDParameter) {
+	var body openapi.InstanceNetworkMigrate
+	if err := util.ReadJSONBody(r, &body); err != nil {
+		errorsv2.HandleError(w, r, errors.OAuth2InvalidRequest("unable to parse request body").WithError(err))
+		return
+	}
+
+	if err := h.instanceClient().MigrateNetwork(r.Context(), instanceID, &body); err != nil {
+		errorsv2.HandleError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusAccepted)
+}
+
 func (h *Handler) GetApiV2InstancesInstanceIDConsoleoutput(w http.ResponseWriter, r *http.Request, instanceID openapi.InstanceIDParameter, params openapi.GetApiV2InstancesInstanceIDConsoleoutputParams) {
-	result, err := h.instanceClient().ConsoleOutput(r.Context(), instanceID, params)
+	client := h.instanceClient()
+
+	result, err := client.ConsoleOutput(r.Context(), instanceID, params)
 	if err != nil {
-		errors.HandleError(w, r, err)
+		errorsv2.HandleError(w, r, err)
+		return
+	}
+
+	if params.Follow != nil && *params.Follow {
+		get := func(ctx context.Context) (string, error) {
+			result, err := client.ConsoleOutput(ctx, instanceID, params)
+			if err != nil {
+				return "", err
+			}
+
+			return result.Contents, nil
+		}
+
+		h.setUncacheable(w)
+		followConsole(w, r, result.Contents, get)
+
 		return
 	}
 
@@ -160,7 +292,7 @@ func (h *Handler) GetApiV2InstancesInstanceIDConsoleoutput(w http.ResponseWriter
 func (h *Handler) GetApiV2InstancesInstanceIDConsolesession(w http.ResponseWriter, r *http.Request, instanceID openapi.InstanceIDParameter) {
 	result, err := h.instanceClient().ConsoleSession(r.Context(), instanceID)
 	if err != nil {
-		errors.HandleError(w, r, err)
+		errorsv2.HandleError(w, r, err)
 		return
 	}
 
@@ -170,24 +302,92 @@ func (h *Handler) GetApiV2InstancesInstanceIDConsolesession(w http.ResponseWrite
 func (h *Handler) GetApiV2Clusters(w http.ResponseWriter, r *http.Request, params openapi.GetApiV2ClustersParams) {
 	result, err := h.clusterClient().ListV2(r.Context(), params)
 	if err != nil {
-		errors.HandleError(w, r, err)
+		errorsv2.HandleError(w, r, err)
 		return
 	}
 
+	writeSparseJSONList(w, r, http.StatusOK, result, params.Fields)
+}
+
+func (h *Handler) GetApiV2Securitygroups(w http.ResponseWriter, r *http.Request, params openapi.GetApiV2SecuritygroupsParams) {
+	result, err := h.securityGroupClient().List(r.Context(), params)
+	if err != nil {
+		errorsv2.HandleError(w, r, err)
+		return
+	}
+
+	h.setUncacheable(w)
 	util.WriteJSONResponse(w, r, http.StatusOK, result)
 }
 
+func (h *Handler) PostApiV2Securitygroups(w http.ResponseWriter, r *http.Request) {
+	request := &openapi.PostApiV2SecuritygroupsJSONRequestBody{}
+
+	if err := util.ReadJSONBody(r, request); err != nil {
+		errorsv2.HandleError(w, r, err)
+		return
+	}
+
+	result, err := h.securityGroupClient().Create(r.Context(), request)
+	if err != nil {
+		errorsv2.HandleError(w, r, err)
+		return
+	}
+
+	h.setUncacheable(w)
+	util.WriteJSONResponse(w, r, http.StatusCreated, result)
+}
+
+func (h *Handler) GetApiV2SecuritygroupsSecurityGroupID(w http.ResponseWriter, r *http.Request, securityGroupID openapi.SecurityGroupIDParameter) {
+	result, err := h.securityGroupClient().Get(r.Context(), securityGroupID)
+	if err != nil {
+		errorsv2.HandleError(w, r, err)
+		return
+	}
+
+	h.setUncacheable(w)
+	util.WriteJSONResponse(w, r, http.StatusOK, result)
+}
+
+func (h *Handler) PutApiV2SecuritygroupsSecurityGroupID(w http.ResponseWriter, r *http.Request, securityGroupID openapi.SecurityGroupIDParameter) {
+	request := &openapi.PutApiV2SecuritygroupsSecurityGroupIDJSONRequestBody{}
+
+	if err := util.ReadJSONBody(r, request); err != nil {
+		errorsv2.HandleError(w, r, err)
+		return
+	}
+
+	result, err := h.securityGroupClient().Update(r.Context(), securityGroupID, request)
+	if err != nil {
+		errorsv2.HandleError(w, r, err)
+		return
+	}
+
+	h.setUncacheable(w)
+	util.WriteJSONResponse(w, r, http.StatusAccepted, result)
+}
+
+func (h *Handler) DeleteApiV2SecuritygroupsSecurityGroupID(w http.ResponseWriter, r *http.Request, securityGroupID openapi.SecurityGroupIDParameter) {
+	if err := h.securityGroupClient().Delete(r.Context(), securityGroupID); err != nil {
+		errorsv2.HandleError(w, r, err)
+		return
+	}
+
+	h.setUncacheable(w)
+	w.WriteHeader(http.StatusAccepted)
+}
+
 func (h *Handler) PostApiV2Clusters(w http.ResponseWriter, r *http.Request) {
 	request := &openapi.ClusterV2Create{}
 
 	if err := util.ReadJSONBody(r, request); err != nil {
-		errors.HandleError(w, r, err)
+		errorsv2.HandleError(w, r, err)
 		return
 	}
 
 	result, err := h.clusterClient().CreateV2(r.Context(), request)
 	if err != nil {
-		errors.HandleError(w, r, err)
+		errorsv2.HandleError(w, r, err)
 		return
 	}
 
@@ -195,37 +395,271 @@ func (h *Handler) PostApiV2Clusters(w http.ResponseWriter, r *http.Request) {
 }
 
 func (h *Handler) GetApiV2ClustersClusterID(w http.ResponseWriter, r *http.Request, clusterID openapi.ClusterIDParameter) {
-	result, err := h.clusterClient().GetV2(r.Context(), clusterID)
+	result, resourceVersion, err := h.clusterClient().GetV2(r.Context(), clusterID)
 	if err != nil {
-		errors.HandleError(w, r, err)
+		errorsv2.HandleError(w, r, err)
 		return
 	}
 
+	handlerutil.SetETag(w, resourceVersion)
 	util.WriteJSONResponse(w, r, http.StatusOK, result)
 }
 
-func (h *Handler) PutApiV2ClustersClusterID(w http.ResponseWriter, r *http.Request, clusterID openapi.ClusterIDParameter) {
+func (h *Handler) GetApiV2ClustersClusterIDWatch(w http.ResponseWriter, r *http.Request, clusterID openapi.ClusterIDParameter) {
+	client := h.clusterClient()
+
+	// Read the cluster up front so access and existence errors are reported
+	// normally, rather than as part of the event stream.
+	result, _, err := client.GetV2(r.Context(), clusterID)
+	if err != nil {
+		errorsv2.HandleError(w, r, err)
+		return
+	}
+
+	get := func(ctx context.Context) (any, error) {
+		result, _, err := client.GetV2(ctx, clusterID)
+
+		return result, err
+	}
+
+	h.setUncacheable(w)
+	watch(w, r, "cluster", result, get)
+}
+
+func (h *Handler) PutApiV2ClustersClusterID(w http.ResponseWriter, r *http.Request, clusterID openapi.ClusterIDParameter, params openapi.PutApiV2ClustersClusterIDParams) {
 	request := &openapi.ClusterV2Update{}
 
 	if err := util.ReadJSONBody(r, request); err != nil {
-		errors.HandleError(w, r, err)
+		errorsv2.HandleError(w, r, err)
 		return
 	}
 
-	result, err := h.clusterClient().UpdateV2(r.Context(), clusterID, request)
+	result, resourceVersion, err := h.clusterClient().UpdateV2(r.Context(), clusterID, request, params.IfMatch)
 	if err != nil {
-		errors.HandleError(w, r, err)
+		errorsv2.HandleError(w, r, err)
 		return
 	}
 
+	handlerutil.SetETag(w, resourceVersion)
 	util.WriteJSONResponse(w, r, http.StatusAccepted, result)
 }
 
+func (h *Handler) GetApiV2ClustersClusterIDTags(w http.ResponseWriter, r *http.Request, clusterID openapi.ClusterIDParameter) {
+	result, err := h.clusterClient().GetTagsV2(r.Context(), clusterID)
+	if err != nil {
+		errorsv2.HandleError(w, r, err)
+		return
+	}
+
+	// The resource version is used to detect concurrent modification, so
+	// must never be stale.
+	h.setUncacheable(w)
+	util.WriteJSONResponse(w, r, http.StatusOK, result)
+}
+
+func (h *Handler) PatchApiV2ClustersClusterIDTags(w http.ResponseWriter, r *http.Request, clusterID openapi.ClusterIDParameter) {
+	request := &openapi.ResourceTagsPatch{}
+
+	if err := util.ReadJSONBody(r, request); err != nil {
+		errorsv2.HandleError(w, r, err)
+		return
+	}
+
+	result, err := h.clusterClient().PatchTagsV2(r.Context(), clusterID, request)
+	if err != nil {
+		errorsv2.HandleError(w, r, err)
+		return
+	}
+
+	util.WriteJSONResponse(w, r, http.StatusOK, result)
+}
+
 func (h *Handler) DeleteApiV2ClustersClusterID(w http.ResponseWriter, r *http.Request, clusterID openapi.ClusterIDParameter) {
 	if err := h.clusterClient().DeleteV2(r.Context(), clusterID); err != nil {
-		errors.HandleError(w, r, err)
+		errorsv2.HandleError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusAccepted)
+}
+
+func (h *Handler) PostApiV2ClustersClusterIDPreview(w http.ResponseWriter, r *http.Request, clusterID openapi.ClusterIDParameter) {
+	request := &openapi.ClusterV2Update{}
+
+	if err := util.ReadJSONBody(r, request); err != nil {
+		errorsv2.HandleError(w, r, err)
+		return
+	}
+
+	result, err := h.clusterClient().PreviewV2(r.Context(), clusterID, request)
+	if err != nil {
+		errorsv2.HandleError(w, r, err)
+		return
+	}
+
+	h.setUncacheable(w)
+	util.WriteJSONResponse(w, r, http.StatusOK, result)
+}
+
+func (h *Handler) GetApiV2Clustertemplates(w http.ResponseWriter, r *http.Request) {
+	result, err := h.clusterTemplateClient().List(r.Context())
+	if err != nil {
+		errorsv2.HandleError(w, r, err)
+		return
+	}
+
+	util.WriteJSONResponse(w, r, http.StatusOK, result)
+}
+
+func (h *Handler) PostApiV2Clustertemplates(w http.ResponseWriter, r *http.Request) {
+	request := &openapi.ClusterTemplateWrite{}
+
+	if err := util.ReadJSONBody(r, request); err != nil {
+		errorsv2.HandleError(w, r, err)
+		return
+	}
+
+	result, err := h.clusterTemplateClient().Create(r.Context(), request)
+	if err != nil {
+		errorsv2.HandleError(w, r, err)
+		return
+	}
+
+	util.WriteJSONResponse(w, r, http.StatusCreated, result)
+}
+
+func (h *Handler) GetApiV2ClustertemplatesClusterTemplateID(w http.ResponseWriter, r *http.Request, clusterTemplateID openapi.ClusterTemplateIDParameter) {
+	result, err := h.clusterTemplateClient().Get(r.Context(), clusterTemplateID)
+	if err != nil {
+		errorsv2.HandleError(w, r, err)
+		return
+	}
+
+	util.WriteJSONResponse(w, r, http.StatusOK, result)
+}
+
+func (h *Handler) PutApiV2ClustertemplatesClusterTemplateID(w http.ResponseWriter, r *http.Request, clusterTemplateID openapi.ClusterTemplateIDParameter) {
+	request := &openapi.ClusterTemplateWrite{}
+
+	if err := util.ReadJSONBody(r, request); err != nil {
+		errorsv2.HandleError(w, r, err)
+		return
+	}
+
+	result, err := h.clusterTemplateClient().Update(r.Context(), clusterTemplateID, request)
+	if err != nil {
+		errorsv2.HandleError(w, r, err)
+		return
+	}
+
+	util.WriteJSONResponse(w, r, http.StatusOK, result)
+}
+
+func (h *Handler) DeleteApiV2ClustertemplatesClusterTemplateID(w http.ResponseWriter, r *http.Request, clusterTemplateID openapi.ClusterTemplateIDParameter) {
+	if err := h.clusterTemplateClient().Delete(r.Context(), clusterTemplateID); err != nil {
+		errorsv2.HandleError(w, r, err)
 		return
 	}
 
 	w.WriteHeader(http.StatusAccepted)
 }
+
+func (h *Handler) PostApiV2ClustertemplatesClusterTemplateIDClusters(w http.ResponseWriter, r *http.Request, clusterTemplateID openapi.ClusterTemplateIDParameter) {
+	ctx := r.Context()
+
+	request := &openapi.ClusterTemplateInstantiate{}
+
+	if err := util.ReadJSONBody(r, request); err != nil {
+		errorsv2.HandleError(w, r, err)
+		return
+	}
+
+	create, err := h.clusterTemplateClient().Instantiate(ctx, clusterTemplateID, request)
+	if err != nil {
+		errorsv2.HandleError(w, r, err)
+		return
+	}
+
+	result, err := h.clusterClient().CreateV2(ctx, create)
+	if err != nil {
+		errorsv2.HandleError(w, r, err)
+		return
+	}
+
+	util.WriteJSONResponse(w, r, http.StatusCreated, result)
+}
+
+func (h *Handler) GetApiV2Maintenancewindows(w http.ResponseWriter, r *http.Request) {
+	result, err := h.maintenanceClient().List(r.Context())
+	if err != nil {
+		errorsv2.HandleError(w, r, err)
+		return
+	}
+
+	h.setUncacheable(w)
+	util.WriteJSONResponse(w, r, http.StatusOK, result)
+}
+
+func (h *Handler) PostApiV2Maintenancewindows(w http.ResponseWriter, r *http.Request) {
+	request := &openapi.MaintenanceWindowWrite{}
+
+	if err := util.ReadJSONBody(r, request); err != nil {
+		errorsv2.HandleError(w, r, err)
+		return
+	}
+
+	result, err := h.maintenanceClient().Create(r.Context(), request)
+	if err != nil {
+		errorsv2.HandleError(w, r, err)
+		return
+	}
+
+	util.WriteJSONResponse(w, r, http.StatusCreated, result)
+}
+
+func (h *Handler) GetApiV2MaintenancewindowsMaintenanceWindowID(w http.ResponseWriter, r *http.Request, maintenanceWindowID openapi.MaintenanceWindowIDParameter) {
+	result, err := h.maintenanceClient().Get(r.Context(), maintenanceWindowID)
+	if err != nil {
+		errorsv2.HandleError(w, r, err)
+		return
+	}
+
+	h.setUncacheable(w)
+	util.WriteJSONResponse(w, r, http.StatusOK, result)
+}
+
+func (h *Handler) PutApiV2MaintenancewindowsMaintenanceWindowID(w http.ResponseWriter, r *http.Request, maintenanceWindowID openapi.MaintenanceWindowIDParameter) {
+	request := &openapi.MaintenanceWindowWrite{}
+
+	if err := util.ReadJSONBody(r, request); err != nil {
+		errorsv2.HandleError(w, r, err)
+		return
+	}
+
+	result, err := h.maintenanceClient().Update(r.Context(), maintenanceWindowID, request)
+	if err != nil {
+		errorsv2.HandleError(w, r, err)
+		return
+	}
+
+	util.WriteJSONResponse(w, r, http.StatusOK, result)
+}
+
+func (h *Handler) DeleteApiV2MaintenancewindowsMaintenanceWindowID(w http.ResponseWriter, r *http.Request, maintenanceWindowID openapi.MaintenanceWindowIDParameter) {
+	if err := h.maintenanceClient().Delete(r.Context(), maintenanceWindowID); err != nil {
+		errorsv2.HandleError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusAccepted)
+}
+
+func (h *Handler) GetApiV2AdminResources(w http.ResponseWriter, r *http.Request, params openapi.GetApiV2AdminResourcesParams) {
+	result, err := h.adminClient().List(r.Context(), params)
+	if err != nil {
+		errorsv2.HandleError(w, r, err)
+		return
+	}
+
+	writeJSONList(w, r, http.StatusOK, result)
+}
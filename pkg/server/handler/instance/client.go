@@ -22,17 +22,17 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"net"
 	"net/http"
-	"reflect"
 	"slices"
-	"strings"
 
 	computev1 "github.com/unikorn-cloud/compute/pkg/apis/unikorn/v1alpha1"
 	"github.com/unikorn-cloud/compute/pkg/constants"
+	computeconversion "github.com/unikorn-cloud/compute/pkg/conversion"
 	computeapi "github.com/unikorn-cloud/compute/pkg/openapi"
+	"github.com/unikorn-cloud/compute/pkg/server/errorsv2"
 	"github.com/unikorn-cloud/compute/pkg/server/handler/region"
 	"github.com/unikorn-cloud/compute/pkg/server/handler/util"
+	"github.com/unikorn-cloud/compute/pkg/server/middleware/audit"
 	corev1 "github.com/unikorn-cloud/core/pkg/apis/unikorn/v1alpha1"
 	coreconstants "github.com/unikorn-cloud/core/pkg/constants"
 	coreerrors "github.com/unikorn-cloud/core/pkg/errors"
@@ -52,6 +52,7 @@ import (
 
 	kerrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/utils/ptr"
 
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -66,6 +67,10 @@ type Client struct {
 	identity identityapi.ClientWithResponsesInterface
 	// region is a client to access regions.
 	region regionapi.ClientWithResponsesInterface
+	// catalog, if set, is used to read region flavors and images.
+	catalog region.ClientInterface
+	// access controls how unauthorized reads are reported.
+	access *util.AccessOptions
 }
 
 // New creates a new client.
@@ -78,6 +83,31 @@ func NewClient(client client.Client, namespace string, identity identityapi.Clie
 	}
 }
 
+// WithRegionCache reads flavors and images via the provided, typically cached,
+// region client, rather than directly from the region service.
+func (c *Client) WithRegionCache(regions region.ClientInterface) *Client {
+	c.catalog = regions
+
+	return c
+}
+
+// WithAccessOptions controls how unauthorized reads of individual resources
+// are reported.
+func (c *Client) WithAccessOptions(access *util.AccessOptions) *Client {
+	c.access = access
+
+	return c
+}
+
+// regions returns a client for reading the region catalog.
+func (c *Client) regions() region.ClientInterface {
+	if c.catalog != nil {
+		return c.catalog
+	}
+
+	return region.New(c.region)
+}
+
 func convertCreateToUpdateRequest(in *computeapi.InstanceCreate) (*computeapi.InstanceUpdate, error) {
 	t, err := json.Marshal(in)
 	if err != nil {
@@ -93,44 +123,26 @@ func convertCreateToUpdateRequest(in *computeapi.InstanceCreate) (*computeapi.In
 	return out, nil
 }
 
-func ConvertNetworking(in *computev1.ComputeInstanceNetworking) *computeapi.InstanceNetworking {
+func convertSnapshotPolicyStatus(in *computev1.ComputeInstanceSnapshotPolicyStatus) *computeapi.InstanceSnapshotPolicyStatus {
 	if in == nil {
 		return nil
 	}
 
-	var out computeapi.InstanceNetworking
-
-	if in.PublicIP {
-		out.PublicIP = ptr.To(true)
-	}
-
-	if len(in.SecurityGroupIDs) > 0 {
-		out.SecurityGroups = ptr.To(in.SecurityGroupIDs)
+	return &computeapi.InstanceSnapshotPolicyStatus{
+		LastSnapshotId:   in.LastSnapshotID,
+		LastSnapshotTime: in.LastSnapshotTime.Time,
 	}
-
-	if len(in.AllowedSourceAddresses) > 0 {
-		allowedSourceAddresses := make([]string, len(in.AllowedSourceAddresses))
-
-		for i := range in.AllowedSourceAddresses {
-			allowedSourceAddresses[i] = in.AllowedSourceAddresses[i].String()
-		}
-
-		out.AllowedSourceAddresses = ptr.To(allowedSourceAddresses)
-	}
-
-	if reflect.ValueOf(out).IsZero() {
-		return nil
-	}
-
-	return &out
 }
 
-func ConvertUserData(in []byte) *[]byte {
+func convertPowerScheduleStatus(in *computev1.ComputeInstancePowerScheduleStatus) *computeapi.InstancePowerScheduleStatus {
 	if in == nil {
 		return nil
 	}
 
-	return &in
+	return &computeapi.InstancePowerScheduleStatus{
+		LastAction:     computeapi.InstancePowerScheduleStatusLastAction(in.LastAction),
+		LastActionTime: in.LastActionTime.Time,
+	}
 }
 
 func convertPowerState(in *regionv1.InstanceLifecyclePhase) *regionapi.InstanceLifecyclePhase {
@@ -156,17 +168,22 @@ func convert(in *computev1.ComputeInstance) *computeapi.InstanceRead {
 	out := &computeapi.InstanceRead{
 		Metadata: conversion.ProjectScopedResourceReadMetadata(in, in.Spec.Tags),
 		Spec: computeapi.InstanceSpec{
-			FlavorId:   in.Spec.FlavorID,
-			ImageId:    in.Spec.ImageID,
-			Networking: ConvertNetworking(in.Spec.Networking),
-			UserData:   ConvertUserData(in.Spec.UserData),
+			FlavorId:         in.Spec.FlavorID,
+			ImageId:          in.Spec.ImageID,
+			Networking:       computeconversion.ConvertNetworking(in.Spec.Networking),
+			UserData:         computeconversion.ConvertUserData(in.Spec.UserData),
+			BootstrapProfile: computeconversion.ConvertBootstrapProfile(in.Spec.BootstrapProfile),
+			PowerSchedule:    computeconversion.ConvertPowerSchedule(in.Spec.PowerSchedule),
+			SnapshotPolicy:   computeconversion.ConvertSnapshotPolicy(in.Spec.SnapshotPolicy),
 		},
 		Status: computeapi.InstanceStatus{
-			RegionId:   in.Labels[regionconstants.RegionLabel],
-			NetworkId:  in.Labels[regionconstants.NetworkLabel],
-			PowerState: convertPowerState(in.Status.PowerState),
-			PrivateIP:  in.Status.PrivateIP,
-			PublicIP:   in.Status.PublicIP,
+			RegionId:       in.Labels[regionconstants.RegionLabel],
+			NetworkId:      in.Labels[regionconstants.NetworkLabel],
+			PowerState:     convertPowerState(in.Status.PowerState),
+			PrivateIP:      in.Status.PrivateIP,
+			PublicIP:       in.Status.PublicIP,
+			PowerSchedule:  convertPowerScheduleStatus(in.Status.PowerSchedule),
+			SnapshotPolicy: convertSnapshotPolicyStatus(in.Status.SnapshotPolicy),
 		},
 	}
 
@@ -183,59 +200,23 @@ func convertList(in *computev1.ComputeInstanceList) []computeapi.InstanceRead {
 	return out
 }
 
-func GenerateNetworking(in *computeapi.InstanceNetworking) (*computev1.ComputeInstanceNetworking, error) {
-	if in == nil {
-		//nolint:nilnil
-		return nil, nil
-	}
-
-	var temp computev1.ComputeInstanceNetworking
-
-	networking := *in
-
-	if networking.PublicIP != nil {
-		temp.PublicIP = *networking.PublicIP
-	}
-
-	if networking.SecurityGroups != nil {
-		temp.SecurityGroupIDs = *networking.SecurityGroups
-	}
-
-	if networking.AllowedSourceAddresses != nil {
-		allowedSourceAddresses := *networking.AllowedSourceAddresses
-
-		temp.AllowedSourceAddresses = make([]corev1.IPv4Prefix, len(allowedSourceAddresses))
-
-		for i, v := range allowedSourceAddresses {
-			_, prefix, err := net.ParseCIDR(v)
-			if err != nil {
-				return nil, errors.OAuth2InvalidRequest("failed to parse IPv4 prefix").WithError(err)
-			}
-
-			temp.AllowedSourceAddresses[i] = corev1.IPv4Prefix{
-				IPNet: *prefix,
-			}
-		}
+func (c *Client) generate(ctx context.Context, in *computeapi.InstanceUpdate, currentTags corev1.TagList, organizationID, projectID, regionID, networkID string) (*computev1.ComputeInstance, error) {
+	networking, err := computeconversion.GenerateNetworking(in.Spec.Networking)
+	if err != nil {
+		return nil, err
 	}
 
-	if reflect.ValueOf(temp).IsZero() {
-		//nolint:nilnil
-		return nil, nil
+	powerSchedule, err := computeconversion.GeneratePowerSchedule(in.Spec.PowerSchedule)
+	if err != nil {
+		return nil, err
 	}
 
-	return &temp, nil
-}
-
-func GenerateUserData(in *[]byte) []byte {
-	if in == nil || len(*in) == 0 {
-		return nil
+	snapshotPolicy, err := computeconversion.GenerateSnapshotPolicy(in.Spec.SnapshotPolicy)
+	if err != nil {
+		return nil, err
 	}
 
-	return *in
-}
-
-func (c *Client) generate(ctx context.Context, in *computeapi.InstanceUpdate, organizationID, projectID, regionID, networkID string) (*computev1.ComputeInstance, error) {
-	networking, err := GenerateNetworking(in.Spec.Networking)
+	tags, err := util.GenerateTagList(in.Metadata.Tags, currentTags)
 	if err != nil {
 		return nil, err
 	}
@@ -248,13 +229,16 @@ func (c *Client) generate(ctx context.Context, in *computeapi.InstanceUpdate, or
 			WithLabel(regionconstants.NetworkLabel, networkID).
 			Get(),
 		Spec: computev1.ComputeInstanceSpec{
-			Tags: conversion.GenerateTagList(in.Metadata.Tags),
+			Tags: tags,
 			MachineGeneric: corev1.MachineGeneric{
 				FlavorID: in.Spec.FlavorId,
 				ImageID:  in.Spec.ImageId,
 			},
-			Networking: networking,
-			UserData:   GenerateUserData(in.Spec.UserData),
+			Networking:       networking,
+			UserData:         computeconversion.GenerateUserData(in.Spec.UserData),
+			BootstrapProfile: computeconversion.GenerateBootstrapProfile(in.Spec.BootstrapProfile),
+			PowerSchedule:    powerSchedule,
+			SnapshotPolicy:   snapshotPolicy,
 		},
 	}
 
@@ -289,9 +273,15 @@ func (c *Client) List(ctx context.Context, params computeapi.GetApiV2InstancesPa
 		return nil, fmt.Errorf("%w: failed to add network label selector", err)
 	}
 
+	tagSelector, err := coreutil.DecodeTagSelectorParam(params.Tag)
+	if err != nil {
+		return nil, err
+	}
+
 	options := &client.ListOptions{
 		Namespace:     c.namespace,
 		LabelSelector: selector,
+		FieldSelector: util.TagSelector(tagSelector),
 	}
 
 	result := &computev1.ComputeInstanceList{}
@@ -300,13 +290,9 @@ func (c *Client) List(ctx context.Context, params computeapi.GetApiV2InstancesPa
 		return nil, fmt.Errorf("%w: unable to list instances", err)
 	}
 
-	tagSelector, err := coreutil.DecodeTagSelectorParam(params.Tag)
-	if err != nil {
-		return nil, err
-	}
-
 	result.Items = slices.DeleteFunc(result.Items, func(resource computev1.ComputeInstance) bool {
-		return !resource.Spec.Tags.ContainsAll(tagSelector) ||
+		return !util.MatchName(&resource, params.Name) ||
+			!util.MatchCreatedAfter(&resource, params.CreatedAfter) ||
 			rbac.AllowProjectScope(ctx, "compute:instances", identityapi.Read, resource.Labels[coreconstants.OrganizationLabel], resource.Labels[coreconstants.ProjectLabel]) != nil
 	})
 
@@ -314,7 +300,18 @@ func (c *Client) List(ctx context.Context, params computeapi.GetApiV2InstancesPa
 		return cmp.Compare(a.Name, b.Name)
 	})
 
-	return convertList(result), nil
+	// Provisioning status and power state are derived from status, so can only
+	// be filtered once converted.
+	out := slices.DeleteFunc(convertList(result), func(resource computeapi.InstanceRead) bool {
+		return !util.MatchProvisioningStatus(&resource.Metadata, params.ProvisioningStatus) ||
+			(params.PowerState != nil && (resource.Status.PowerState == nil || !slices.Contains(*params.PowerState, *resource.Status.PowerState)))
+	})
+
+	util.SortList(out, params.Sort, func(resource *computeapi.InstanceRead) *coreapi.ProjectScopedResourceReadMetadata {
+		return &resource.Metadata
+	})
+
+	return out, nil
 }
 
 func (c *Client) generateAllocation(flavor *regionapi.Flavor, publicIP bool) identityapi.ResourceAllocationList {
@@ -348,8 +345,29 @@ func (c *Client) generateAllocation(flavor *regionapi.Flavor, publicIP bool) ide
 	return required
 }
 
+// ExpectedAllocations returns the quota allocations an instance requires given its
+// current specification, so they can be verified against those held by identity.
+func (c *Client) ExpectedAllocations(ctx context.Context, instance *computev1.ComputeInstance) (identityapi.ResourceAllocationList, error) {
+	organizationID, ok := instance.Labels[coreconstants.OrganizationLabel]
+	if !ok {
+		return nil, fmt.Errorf("%w: instance missing organization label", coreerrors.ErrConsistency)
+	}
+
+	regionID, ok := instance.Labels[regionconstants.RegionLabel]
+	if !ok {
+		return nil, fmt.Errorf("%w: instance missing region label", coreerrors.ErrConsistency)
+	}
+
+	flavor, err := c.getFlavor(ctx, organizationID, regionID, instance.Spec.FlavorID)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.generateAllocation(flavor, instance.PublicIPEnabled()), nil
+}
+
 func (c *Client) getFlavor(ctx context.Context, organizationID, regionID, id string) (*regionapi.Flavor, error) {
-	resources, err := region.New(c.region).Flavors(ctx, organizationID, regionID)
+	resources, err := c.regions().Flavors(ctx, organizationID, regionID)
 	if err != nil {
 		return nil, err
 	}
@@ -360,14 +378,14 @@ func (c *Client) getFlavor(ctx context.Context, organizationID, regionID, id str
 
 	index := slices.IndexFunc(resources, match)
 	if index < 0 {
-		return nil, errors.OAuth2InvalidRequest("requested flavor does not exist or is not accessible")
+		return nil, errorsv2.InvalidRequest(computeapi.ComputeInstanceInvalidFlavor, "requested flavor does not exist or is not accessible")
 	}
 
 	return &resources[index], nil
 }
 
 func (c *Client) getImage(ctx context.Context, organizationID, regionID, id string) (*regionapi.Image, error) {
-	resources, err := region.New(c.region).Images(ctx, organizationID, regionID)
+	resources, err := c.regions().Images(ctx, organizationID, regionID)
 	if err != nil {
 		return nil, err
 	}
@@ -378,7 +396,7 @@ func (c *Client) getImage(ctx context.Context, organizationID, regionID, id stri
 
 	index := slices.IndexFunc(resources, match)
 	if index < 0 {
-		return nil, errors.OAuth2InvalidRequest("requested image does not exist or is not accessible")
+		return nil, errorsv2.InvalidRequest(computeapi.ComputeInstanceInvalidImage, "requested image does not exist or is not accessible")
 	}
 
 	return &resources[index], nil
@@ -411,15 +429,15 @@ func (c *Client) getAndValidateFlavorAndImage(ctx context.Context, organizationI
 	}
 
 	if image.Status.State != regionapi.ImageStateReady {
-		return nil, nil, errors.OAuth2InvalidRequest("Image is not in a ready state")
+		return nil, nil, errorsv2.InvalidRequest(computeapi.ComputeInstanceInvalidImage, "Image is not in a ready state")
 	}
 
 	if flavor.Spec.Architecture != image.Spec.Architecture {
-		return nil, nil, errors.OAuth2InvalidRequest("CPU architecture of flavor (", flavor.Spec.Architecture, ") does not match that of the image (", image.Spec.Architecture, "}")
+		return nil, nil, errorsv2.InvalidRequest(computeapi.ComputeInstanceInvalidFlavor, "CPU architecture of flavor (", flavor.Spec.Architecture, ") does not match that of the image (", image.Spec.Architecture, "}")
 	}
 
 	if flavor.Spec.Disk < image.Spec.SizeGiB {
-		return nil, nil, errors.OAuth2InvalidRequest("Flavor disk (", flavor.Spec.Disk, " GIB) is too small for the image (", image.Spec.SizeGiB, " GiB)")
+		return nil, nil, errorsv2.InvalidRequest(computeapi.ComputeInstanceInvalidFlavor, "Flavor disk (", flavor.Spec.Disk, " GIB) is too small for the image (", image.Spec.SizeGiB, " GiB)")
 	}
 
 	if err := ValidateVirtualization(flavor, image); err != nil {
@@ -437,11 +455,11 @@ func ValidateVirtualization(flavor *regionapi.Flavor, image *regionapi.Image) er
 		// compatible with both baremetal and VM flavors
 	case regionapi.ImageVirtualizationBaremetal:
 		if !flavorBaremetal {
-			return errors.OAuth2InvalidRequest("image requires a baremetal flavor")
+			return errorsv2.InvalidRequest(computeapi.ComputeInstanceInvalidFlavor, "image requires a baremetal flavor")
 		}
 	case regionapi.ImageVirtualizationVirtualized:
 		if flavorBaremetal {
-			return errors.OAuth2InvalidRequest("image requires a virtualized flavor")
+			return errorsv2.InvalidRequest(computeapi.ComputeInstanceInvalidFlavor, "image requires a virtualized flavor")
 		}
 	}
 
@@ -480,17 +498,39 @@ func (s *createSaga) createInstance(ctx context.Context) error {
 	return nil
 }
 
+func (s *createSaga) deleteInstance(ctx context.Context) error {
+	if err := s.client.client.Delete(ctx, s.resource); err != nil && !kerrors.IsNotFound(err) {
+		return fmt.Errorf("%w: unable to delete instance", err)
+	}
+
+	return nil
+}
+
 func (s *createSaga) Actions() []saga.Action {
 	return []saga.Action{
 		saga.NewAction("create quota allocation", s.createAllocation, s.deleteAllocation),
-		saga.NewAction("create instance", s.createInstance, nil),
+		saga.NewAction("create instance", s.createInstance, s.deleteInstance),
+	}
+}
+
+// batchCreateSaga creates a batch of instances, should any fail then all those
+// already created are deleted, and their allocations released.
+type batchCreateSaga []*createSaga
+
+func (s batchCreateSaga) Actions() []saga.Action {
+	var actions []saga.Action
+
+	for _, instance := range s {
+		actions = append(actions, instance.Actions()...)
 	}
+
+	return actions
 }
 
 // isInstanceNameInUse does a best effort attempt to ensure the instance name
 // does not already exist on the same network as that would lead to aliasing issues
 // of cloud resources and servers having the same hostname.
-func (c *Client) isInstanceNameInUse(ctx context.Context, organizationID, projectID, networkID, name string) error {
+func (c *Client) isInstanceNameInUse(ctx context.Context, organizationID, projectID, networkID string, names ...string) error {
 	selector := labels.Set{
 		coreconstants.OrganizationLabel: organizationID,
 		coreconstants.ProjectLabel:      projectID,
@@ -509,7 +549,7 @@ func (c *Client) isInstanceNameInUse(ctx context.Context, organizationID, projec
 	}
 
 	for i := range instances.Items {
-		if instances.Items[i].Labels[coreconstants.NameLabel] == name {
+		if slices.Contains(names, instances.Items[i].Labels[coreconstants.NameLabel]) {
 			// TODO: we can be more verbose here, update the interface in core.
 			return errors.HTTPConflict()
 		}
@@ -518,7 +558,52 @@ func (c *Client) isInstanceNameInUse(ctx context.Context, organizationID, projec
 	return nil
 }
 
+// maxBatchSize limits how many instances may be created in a single request.
+const maxBatchSize = 100
+
+// batchNames generates the names of instances in a batch, these are suffixed with
+// their index, and must remain valid Kubernetes names.
+func batchNames(name string, count int) ([]string, error) {
+	if count < 1 || count > maxBatchSize {
+		return nil, errorsv2.InvalidRequest(computeapi.ComputeInstanceInvalidSpec, fmt.Sprintf("instance count must be between 1 and %d", maxBatchSize))
+	}
+
+	names := make([]string, count)
+
+	for i := range names {
+		names[i] = fmt.Sprintf("%s-%d", name, i+1)
+	}
+
+	if len(names[count-1]) > validation.DNS1123LabelMaxLength {
+		return nil, errorsv2.InvalidRequest(computeapi.ComputeInstanceInvalidSpec, "instance name is too long to be suffixed with the batch index")
+	}
+
+	return names, nil
+}
+
+// Create creates a single instance.
 func (c *Client) Create(ctx context.Context, request *computeapi.InstanceCreate) (*computeapi.InstanceRead, error) {
+	result, err := c.create(ctx, request, []string{request.Metadata.Name})
+	if err != nil {
+		return nil, err
+	}
+
+	return &result[0], nil
+}
+
+// CreateBatch creates a number of identical instances, either all are created
+// or none are.
+func (c *Client) CreateBatch(ctx context.Context, request *computeapi.InstanceCreate, count int) (computeapi.InstancesRead, error) {
+	names, err := batchNames(request.Metadata.Name, count)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.create(ctx, request, names)
+}
+
+// create creates an instance with each of the given names.
+func (c *Client) create(ctx context.Context, request *computeapi.InstanceCreate, names []string) (computeapi.InstancesRead, error) {
 	organizationID := request.Spec.OrganizationId
 	projectID := request.Spec.ProjectId
 
@@ -538,17 +623,21 @@ func (c *Client) Create(ctx context.Context, request *computeapi.InstanceCreate)
 		return nil, err
 	}
 
-	if err := c.isInstanceNameInUse(ctx, organizationID, projectID, request.Spec.NetworkId, request.Metadata.Name); err != nil {
+	if err := c.isInstanceNameInUse(ctx, organizationID, projectID, request.Spec.NetworkId, names...); err != nil {
 		return nil, err
 	}
 
 	regionID := network.Status.RegionId
 
-	flavor, _, err := c.getAndValidateFlavorAndImage(principal.NewImpersonateContext(ctx), organizationID, regionID, request.Spec.FlavorId, request.Spec.ImageId)
+	flavor, image, err := c.getAndValidateFlavorAndImage(principal.NewImpersonateContext(ctx), organizationID, regionID, request.Spec.FlavorId, request.Spec.ImageId)
 	if err != nil {
 		return nil, err
 	}
 
+	if err := util.ValidateBootstrapProfile(ctx, c.client, c.namespace, request.Spec.BootstrapProfile, flavor, image); err != nil {
+		return nil, err
+	}
+
 	if err := c.validateSecurityGroups(ctx, request.Spec.Networking); err != nil {
 		return nil, err
 	}
@@ -558,18 +647,30 @@ func (c *Client) Create(ctx context.Context, request *computeapi.InstanceCreate)
 		return nil, err
 	}
 
-	resource, err := c.generate(ctx, updateRequest, organizationID, projectID, regionID, request.Spec.NetworkId)
-	if err != nil {
+	batch := make(batchCreateSaga, len(names))
+
+	for i, name := range names {
+		updateRequest.Metadata.Name = name
+
+		resource, err := c.generate(ctx, updateRequest, nil, organizationID, projectID, regionID, request.Spec.NetworkId)
+		if err != nil {
+			return nil, err
+		}
+
+		batch[i] = newCreateSaga(c, resource, flavor)
+	}
+
+	if err := saga.Run(ctx, batch); err != nil {
 		return nil, err
 	}
 
-	s := newCreateSaga(c, resource, flavor)
+	out := make(computeapi.InstancesRead, len(batch))
 
-	if err := saga.Run(ctx, s); err != nil {
-		return nil, err
+	for i := range batch {
+		out[i] = *convert(batch[i].resource)
 	}
 
-	return convert(resource), nil
+	return out, nil
 }
 
 func (c *Client) GetRaw(ctx context.Context, instanceID string) (*computev1.ComputeInstance, error) {
@@ -583,20 +684,21 @@ func (c *Client) GetRaw(ctx context.Context, instanceID string) (*computev1.Comp
 		return nil, fmt.Errorf("%w: unable to lookup instance", err)
 	}
 
-	if err := rbac.AllowProjectScope(ctx, "compute:instances", identityapi.Read, result.Labels[coreconstants.OrganizationLabel], result.Labels[coreconstants.ProjectLabel]); err != nil {
+	if err := util.AllowProjectScopeRead(ctx, c.access, "compute:instances", result.Labels[coreconstants.OrganizationLabel], result.Labels[coreconstants.ProjectLabel]); err != nil {
 		return nil, err
 	}
 
 	return result, nil
 }
 
-func (c *Client) Get(ctx context.Context, instanceID string) (*computeapi.InstanceRead, error) {
+// Get returns the instance, along with the resource version it was read at.
+func (c *Client) Get(ctx context.Context, instanceID string) (*computeapi.InstanceRead, string, error) {
 	result, err := c.GetRaw(ctx, instanceID)
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 
-	return convert(result), nil
+	return convert(result), result.ResourceVersion, nil
 }
 
 type updateSaga struct {
@@ -644,10 +746,13 @@ func (s *updateSaga) Actions() []saga.Action {
 	}
 }
 
-func (c *Client) Update(ctx context.Context, instanceID string, request *computeapi.InstanceUpdate) (*computeapi.InstanceRead, error) {
+// Update updates the instance, returning the updated resource along with its new
+// resource version.  If an entity tag is provided, the update is only applied if the
+// instance has not been modified since that version was read.
+func (c *Client) Update(ctx context.Context, instanceID string, request *computeapi.InstanceUpdate, ifMatch *string) (*computeapi.InstanceRead, string, error) {
 	current, err := c.GetRaw(ctx, instanceID)
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 
 	organizationID := current.Labels[coreconstants.OrganizationLabel]
@@ -656,34 +761,42 @@ func (c *Client) Update(ctx context.Context, instanceID string, request *compute
 	networkID := current.Labels[regionconstants.NetworkLabel]
 
 	if err := rbac.AllowProjectScope(ctx, "compute:instances", identityapi.Update, organizationID, projectID); err != nil {
-		return nil, err
+		return nil, "", err
+	}
+
+	if err := util.CheckIfMatch(current.ResourceVersion, ifMatch); err != nil {
+		return nil, "", err
 	}
 
 	if current.DeletionTimestamp != nil {
-		return nil, errors.OAuth2InvalidRequest("server is being deleted")
+		return nil, "", errorsv2.InvalidRequest(computeapi.ComputeInstanceDeleting, "server is being deleted")
 	}
 
 	if err := util.InjectUserPrincipal(ctx, organizationID, projectID); err != nil {
-		return nil, err
+		return nil, "", err
 	}
 
 	currentFlavor, _, err := c.getAndValidateFlavorAndImage(principal.NewImpersonateContext(ctx), organizationID, regionID, current.Spec.FlavorID, current.Spec.ImageID)
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 
-	flavor, _, err := c.getAndValidateFlavorAndImage(principal.NewImpersonateContext(ctx), organizationID, regionID, request.Spec.FlavorId, request.Spec.ImageId)
+	flavor, image, err := c.getAndValidateFlavorAndImage(principal.NewImpersonateContext(ctx), organizationID, regionID, request.Spec.FlavorId, request.Spec.ImageId)
 	if err != nil {
-		return nil, err
+		return nil, "", err
+	}
+
+	if err := util.ValidateBootstrapProfile(ctx, c.client, c.namespace, request.Spec.BootstrapProfile, flavor, image); err != nil {
+		return nil, "", err
 	}
 
 	if err := c.validateSecurityGroups(ctx, request.Spec.Networking); err != nil {
-		return nil, err
+		return nil, "", err
 	}
 
-	required, err := c.generate(ctx, request, organizationID, projectID, regionID, networkID)
+	required, err := c.generate(ctx, request, current.Spec.Tags, organizationID, projectID, regionID, networkID)
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 
 	// Preserve allocation information.
@@ -696,15 +809,212 @@ func (c *Client) Update(ctx context.Context, instanceID string, request *compute
 	updated.Annotations = required.Annotations
 	updated.Spec = required.Spec
 
+	audit.RecordDiff(ctx, current.Spec, updated.Spec)
+
 	s := newUpdateSaga(c, current, updated, currentFlavor, flavor)
 
 	if err := saga.Run(ctx, s); err != nil {
+		return nil, "", util.PreconditionFailed(err, ifMatch)
+	}
+
+	return convert(s.updated), s.updated.ResourceVersion, nil
+}
+
+// SetPublicIP attaches or detaches an instance's public IP.  This is a shorthand for
+// updating the instance's networking, so quota allocations are updated to match.
+func (c *Client) SetPublicIP(ctx context.Context, instanceID string, enabled bool) error {
+	current, err := c.GetRaw(ctx, instanceID)
+	if err != nil {
+		return err
+	}
+
+	organizationID := current.Labels[coreconstants.OrganizationLabel]
+	projectID := current.Labels[coreconstants.ProjectLabel]
+	regionID := current.Labels[regionconstants.RegionLabel]
+
+	if err := rbac.AllowProjectScope(ctx, "compute:instances", identityapi.Update, organizationID, projectID); err != nil {
+		return err
+	}
+
+	if current.DeletionTimestamp != nil {
+		return errorsv2.InvalidRequest(computeapi.ComputeInstanceDeleting, "server is being deleted")
+	}
+
+	if current.PublicIPEnabled() == enabled {
+		return nil
+	}
+
+	if err := util.InjectUserPrincipal(ctx, organizationID, projectID); err != nil {
+		return err
+	}
+
+	flavor, err := c.getFlavor(principal.NewImpersonateContext(ctx), organizationID, regionID, current.Spec.FlavorID)
+	if err != nil {
+		return err
+	}
+
+	updated := current.DeepCopy()
+
+	if updated.Spec.Networking == nil {
+		updated.Spec.Networking = &computev1.ComputeInstanceNetworking{}
+	}
+
+	updated.Spec.Networking.PublicIP = enabled
+
+	return saga.Run(ctx, newUpdateSaga(c, current, updated, flavor, flavor))
+}
+
+// MigrateNetwork moves an instance to another network in the same region.  Only the
+// network label, and optionally the security groups, are modified, the provisioner
+// will observe that the server is on the wrong network and rebuild it.  Allocations
+// are unaffected.
+func (c *Client) MigrateNetwork(ctx context.Context, instanceID string, request *computeapi.InstanceNetworkMigrate) error {
+	current, err := c.GetRaw(ctx, instanceID)
+	if err != nil {
+		return err
+	}
+
+	organizationID := current.Labels[coreconstants.OrganizationLabel]
+	projectID := current.Labels[coreconstants.ProjectLabel]
+
+	if err := rbac.AllowProjectScope(ctx, "compute:instances", identityapi.Update, organizationID, projectID); err != nil {
+		return err
+	}
+
+	if current.DeletionTimestamp != nil {
+		return errorsv2.InvalidRequest(computeapi.ComputeInstanceDeleting, "server is being deleted")
+	}
+
+	if request.NetworkId == current.Labels[regionconstants.NetworkLabel] {
+		return nil
+	}
+
+	if err := util.InjectUserPrincipal(ctx, organizationID, projectID); err != nil {
+		return err
+	}
+
+	network, err := region.GetNetwork(principal.NewImpersonateContext(ctx), c.region, request.NetworkId)
+	if err != nil {
+		return err
+	}
+
+	if network.Metadata.OrganizationId != organizationID || network.Metadata.ProjectId != projectID {
+		return errorsv2.InvalidRequest(computeapi.ComputeInstanceInvalidNetwork, "network must be in the same project as the instance")
+	}
+
+	if network.Status.RegionId != current.Labels[regionconstants.RegionLabel] {
+		return errorsv2.InvalidRequest(computeapi.ComputeInstanceInvalidNetwork, "network must be in the same region as the instance")
+	}
+
+	// Security groups are scoped to a network, so the existing ones will only apply
+	// on the new one if they belong to it, otherwise they must be replaced.
+	var securityGroupIDs []string
+
+	if current.Spec.Networking != nil {
+		securityGroupIDs = current.Spec.Networking.SecurityGroupIDs
+	}
+
+	if request.SecurityGroups != nil {
+		securityGroupIDs = *request.SecurityGroups
+	}
+
+	for _, id := range securityGroupIDs {
+		securityGroup, err := region.GetSecurityGroup(ctx, c.region, id)
+		if err != nil {
+			return err
+		}
+
+		if securityGroup.Status.NetworkId != request.NetworkId {
+			return errorsv2.InvalidRequest(computeapi.ComputeInstanceInvalidSecurityGroup, fmt.Sprintf("security group %s does not belong to network %s, replacement security groups must be specified", id, request.NetworkId))
+		}
+	}
+
+	if err := c.isInstanceNameInUse(ctx, organizationID, projectID, request.NetworkId, current.Labels[coreconstants.NameLabel]); err != nil {
+		return err
+	}
+
+	// The security groups are changed in the same patch, so the server is never
+	// left unprotected on either network.
+	updated := current.DeepCopy()
+	updated.Labels[regionconstants.NetworkLabel] = request.NetworkId
+
+	if request.SecurityGroups != nil {
+		if updated.Spec.Networking == nil {
+			updated.Spec.Networking = &computev1.ComputeInstanceNetworking{}
+		}
+
+		updated.Spec.Networking.SecurityGroupIDs = nil
+
+		if len(securityGroupIDs) > 0 {
+			updated.Spec.Networking.SecurityGroupIDs = securityGroupIDs
+		}
+	}
+
+	if err := c.client.Patch(ctx, updated, client.MergeFromWithOptions(current, &client.MergeFromWithOptimisticLock{})); err != nil {
+		return fmt.Errorf("%w: unable to patch instance", err)
+	}
+
+	return nil
+}
+
+func convertTags(in *computev1.ComputeInstance) *computeapi.ResourceTags {
+	return &computeapi.ResourceTags{
+		Tags:            conversion.ConvertTags(in.Spec.Tags),
+		ResourceVersion: in.ResourceVersion,
+	}
+}
+
+// GetTags returns the instance's tags.
+func (c *Client) GetTags(ctx context.Context, instanceID string) (*computeapi.ResourceTags, error) {
+	result, err := c.GetRaw(ctx, instanceID)
+	if err != nil {
 		return nil, err
 	}
 
-	return convert(s.updated), nil
+	return convertTags(result), nil
 }
 
+// PatchTags modifies individual tags without touching the rest of the instance.
+// Tags have no bearing on allocations, so the instance is patched directly.
+func (c *Client) PatchTags(ctx context.Context, instanceID string, request *computeapi.ResourceTagsPatch) (*computeapi.ResourceTags, error) {
+	current, err := c.GetRaw(ctx, instanceID)
+	if err != nil {
+		return nil, err
+	}
+
+	organizationID := current.Labels[coreconstants.OrganizationLabel]
+	projectID := current.Labels[coreconstants.ProjectLabel]
+
+	if err := rbac.AllowProjectScope(ctx, "compute:instances", identityapi.Update, organizationID, projectID); err != nil {
+		return nil, err
+	}
+
+	if current.DeletionTimestamp != nil {
+		return nil, errorsv2.InvalidRequest(computeapi.ComputeInstanceDeleting, "server is being deleted")
+	}
+
+	if err := util.CheckTagsVersion(current.ResourceVersion, request.ResourceVersion); err != nil {
+		return nil, err
+	}
+
+	tags, err := util.PatchTagList(current.Spec.Tags, request.Set, ptr.Deref(request.Remove, nil))
+	if err != nil {
+		return nil, err
+	}
+
+	updated := current.DeepCopy()
+	updated.Spec.Tags = tags
+
+	if err := c.client.Patch(ctx, updated, client.MergeFromWithOptions(current, &client.MergeFromWithOptimisticLock{})); err != nil {
+		return nil, fmt.Errorf("%w: unable to patch instance tags", util.TagsConflict(err))
+	}
+
+	return convertTags(updated), nil
+}
+
+// Delete marks the instance for deletion.  Quota allocations are deliberately
+// left alone, the controller releases them under its finalizer once the server
+// is gone, so accounting can never diverge from the instance's lifetime.
 func (c *Client) Delete(ctx context.Context, instanceID string) error {
 	resource, err := c.GetRaw(ctx, instanceID)
 	if err != nil {
@@ -731,7 +1041,8 @@ func (c *Client) Delete(ctx context.Context, instanceID string) error {
 }
 
 func (c *Client) serverID(ctx context.Context, instance *computev1.ComputeInstance) (string, error) {
-	// Constrain the search domain.
+	// Constrain the search domain.  The network is deliberately omitted as the
+	// server may still be on its previous network during a migration.
 	params := &regionapi.GetApiV2ServersParams{
 		OrganizationID: &computeapi.OrganizationIDQueryParameter{
 			instance.Labels[coreconstants.OrganizationLabel],
@@ -742,9 +1053,6 @@ func (c *Client) serverID(ctx context.Context, instance *computev1.ComputeInstan
 		RegionID: &computeapi.RegionIDQueryParameter{
 			instance.Labels[regionconstants.RegionLabel],
 		},
-		NetworkID: &computeapi.NetworkIDQueryParameter{
-			instance.Labels[regionconstants.NetworkLabel],
-		},
 		Tag: &coreapi.TagSelectorParameter{
 			constants.InstanceLabel + "=" + instance.Name,
 		},
@@ -837,32 +1145,41 @@ func (c *Client) Stop(ctx context.Context, instanceID string) error {
 	return nil
 }
 
-func (c *Client) Reboot(ctx context.Context, instanceID string, params computeapi.PostApiV2InstancesInstanceIDRebootParams) error {
-	resource, err := c.GetRaw(ctx, instanceID)
-	if err != nil {
-		return err
+// rebootType resolves the requested reboot type, honouring the deprecated hard flag.
+func rebootType(params computeapi.PostApiV2InstancesInstanceIDRebootParams) (computeapi.RebootType, error) {
+	if params.Hard == nil {
+		return ptr.Deref(params.Type, computeapi.RebootTypeSoft), nil
 	}
 
-	serverID, err := c.serverID(ctx, resource)
-	if err != nil {
-		return err
+	legacy := computeapi.RebootTypeSoft
+
+	if *params.Hard {
+		legacy = computeapi.RebootTypeHard
 	}
 
-	// TODO: we should just pass this through...
-	if params.Hard != nil && *params.Hard {
-		response, err := c.region.PostApiV2ServersServerIDHardrebootWithResponse(ctx, serverID)
-		if err != nil {
-			return fmt.Errorf("%w: unable to reboot server for instance", err)
-		}
+	if params.Type != nil && *params.Type != legacy {
+		return "", errorsv2.InvalidRequest(computeapi.ComputeInstanceInvalidRebootType, "reboot type conflicts with hard parameter")
+	}
 
-		if response.StatusCode() != http.StatusAccepted {
-			return fmt.Errorf("%w: unable to reboot server for instance - incorrect status code", coreerrors.ErrAPIStatus)
-		}
+	return legacy, nil
+}
 
-		return nil
+// reboot forwards the reboot type to the region.
+func (c *Client) reboot(ctx context.Context, serverID string, rebootType computeapi.RebootType) error {
+	var (
+		response interface{ StatusCode() int }
+		err      error
+	)
+
+	switch rebootType {
+	case computeapi.RebootTypeSoft:
+		response, err = c.region.PostApiV2ServersServerIDSoftrebootWithResponse(ctx, serverID)
+	case computeapi.RebootTypeHard:
+		response, err = c.region.PostApiV2ServersServerIDHardrebootWithResponse(ctx, serverID)
+	default:
+		return errorsv2.InvalidRequest(computeapi.ComputeInstanceInvalidRebootType, fmt.Sprintf("unsupported reboot type %s", rebootType))
 	}
 
-	response, err := c.region.PostApiV2ServersServerIDSoftrebootWithResponse(ctx, serverID)
 	if err != nil {
 		return fmt.Errorf("%w: unable to reboot server for instance", err)
 	}
@@ -874,6 +1191,25 @@ func (c *Client) Reboot(ctx context.Context, instanceID string, params computeap
 	return nil
 }
 
+func (c *Client) Reboot(ctx context.Context, instanceID string, params computeapi.PostApiV2InstancesInstanceIDRebootParams) error {
+	requested, err := rebootType(params)
+	if err != nil {
+		return err
+	}
+
+	resource, err := c.GetRaw(ctx, instanceID)
+	if err != nil {
+		return err
+	}
+
+	serverID, err := c.serverID(ctx, resource)
+	if err != nil {
+		return err
+	}
+
+	return c.reboot(ctx, serverID, requested)
+}
+
 // dropSystemTags removes any tags that are reserved for this service to use.
 func dropSystemTags(meta *coreapi.ResourceMetadata) {
 	if meta.Tags == nil {
@@ -882,7 +1218,7 @@ func dropSystemTags(meta *coreapi.ResourceMetadata) {
 
 	tags := *meta.Tags
 	tags = slices.DeleteFunc(tags, func(t coreapi.Tag) bool {
-		return strings.HasPrefix(t.Name, constants.SystemTagPrefix)
+		return constants.IsSystemTag(t.Name)
 	})
 
 	meta.Tags = &tags
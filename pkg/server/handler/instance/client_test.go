@@ -17,23 +17,40 @@ limitations under the License.
 package instance_test
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
 	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 
+	computev1 "github.com/unikorn-cloud/compute/pkg/apis/unikorn/v1alpha1"
 	computeapi "github.com/unikorn-cloud/compute/pkg/openapi"
+	"github.com/unikorn-cloud/compute/pkg/server/errorsv2"
 	"github.com/unikorn-cloud/compute/pkg/server/handler/instance"
+	coreconstants "github.com/unikorn-cloud/core/pkg/constants"
 	coreapi "github.com/unikorn-cloud/core/pkg/openapi"
 	coreerrors "github.com/unikorn-cloud/core/pkg/server/errors"
 	identityapi "github.com/unikorn-cloud/identity/pkg/openapi"
 	identitymock "github.com/unikorn-cloud/identity/pkg/openapi/mock"
+	"github.com/unikorn-cloud/identity/pkg/principal"
 	"github.com/unikorn-cloud/identity/pkg/rbac"
+	regionconstants "github.com/unikorn-cloud/region/pkg/constants"
 	regionapi "github.com/unikorn-cloud/region/pkg/openapi"
 
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/utils/ptr"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
 )
 
 const (
@@ -41,6 +58,8 @@ const (
 	projectID      = "bar"
 )
 
+var errPatch = errors.New("patch failed")
+
 // aclWithOrgScopeCreate grants compute:instances/Create at organization scope,
 // so Create must verify the project via the identity API.
 func aclWithOrgScopeCreate() *identityapi.Acl {
@@ -250,3 +269,223 @@ func TestInstanceCreateRBACNoPermissions(t *testing.T) {
 	require.Error(t, err)
 	require.True(t, coreerrors.IsForbidden(err), "expected forbidden, got: %v", err)
 }
+
+// updateSagaFixture returns an instance with enough metadata to satisfy allocation updates.
+func updateSagaFixture() *computev1.ComputeInstance {
+	return &computev1.ComputeInstance{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      "instance",
+			Labels: map[string]string{
+				coreconstants.OrganizationLabel: organizationID,
+				coreconstants.ProjectLabel:      projectID,
+			},
+			Annotations: map[string]string{
+				coreconstants.AllocationAnnotation: "allocation",
+			},
+		},
+	}
+}
+
+// TestUpdateSagaRollback verifies that when the instance update fails, the quota
+// allocation is reverted to that of the original flavor.
+func TestUpdateSagaRollback(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+
+	var gpus []int
+
+	mockIdentity := identitymock.NewMockClientWithResponsesInterface(ctrl)
+	mockIdentity.EXPECT().
+		PutApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsAllocationIDWithResponse(gomock.Any(), organizationID, projectID, "allocation", gomock.Any()).
+		DoAndReturn(func(_ context.Context, _, _, _ string, body identityapi.AllocationWrite, _ ...identityapi.RequestEditorFn) (*identityapi.PutApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsAllocationIDResponse, error) {
+			committed, _ := allocationKind(body.Spec.Allocations, "gpus")
+			gpus = append(gpus, committed)
+
+			return &identityapi.PutApiV1OrganizationsOrganizationIDProjectsProjectIDAllocationsAllocationIDResponse{
+				HTTPResponse: &http.Response{StatusCode: http.StatusOK},
+			}, nil
+		}).
+		Times(2)
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, computev1.AddToScheme(scheme))
+
+	mapper := meta.NewDefaultRESTMapper(nil)
+	mapper.Add(computev1.SchemeGroupVersion.WithKind("ComputeInstance"), meta.RESTScopeNamespace)
+
+	current := updateSagaFixture()
+
+	cli := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithRESTMapper(mapper).
+		WithObjects(current).
+		WithInterceptorFuncs(interceptor.Funcs{
+			Patch: func(context.Context, client.WithWatch, client.Object, client.Patch, ...client.PatchOption) error {
+				return errPatch
+			},
+		}).
+		Build()
+
+	c := instance.NewClient(cli, "default", mockIdentity, nil)
+
+	updated := current.DeepCopy()
+	updated.Spec.FlavorID = "gpu"
+
+	err := instance.RunUpdateSaga(t.Context(), c, current, updated, flavorWithoutGPU(), flavorWithGPU(8))
+	require.ErrorIs(t, err, errPatch)
+	require.Equal(t, []int{8, 0}, gpus)
+}
+
+// TestRebootType ensures the deprecated hard flag is honoured but cannot conflict
+// with the reboot type.
+func TestRebootType(t *testing.T) {
+	t.Parallel()
+
+	rebootType, err := instance.RebootType(computeapi.PostApiV2InstancesInstanceIDRebootParams{})
+	require.NoError(t, err)
+	require.Equal(t, computeapi.RebootTypeSoft, rebootType)
+
+	rebootType, err = instance.RebootType(computeapi.PostApiV2InstancesInstanceIDRebootParams{Hard: ptr.To(true)})
+	require.NoError(t, err)
+	require.Equal(t, computeapi.RebootTypeHard, rebootType)
+
+	rebootType, err = instance.RebootType(computeapi.PostApiV2InstancesInstanceIDRebootParams{Type: ptr.To(computeapi.RebootTypeHard)})
+	require.NoError(t, err)
+	require.Equal(t, computeapi.RebootTypeHard, rebootType)
+
+	_, err = instance.RebootType(computeapi.PostApiV2InstancesInstanceIDRebootParams{Hard: ptr.To(false), Type: ptr.To(computeapi.RebootTypeHard)})
+	require.True(t, coreerrors.IsBadRequest(err))
+}
+
+// TestBatchNames checks batch instances are suffixed with their index, and that
+// names that would become invalid are rejected.
+func TestBatchNames(t *testing.T) {
+	t.Parallel()
+
+	names, err := instance.BatchNames("runner", 3)
+	require.NoError(t, err)
+	require.Equal(t, []string{"runner-1", "runner-2", "runner-3"}, names)
+
+	_, err = instance.BatchNames("runner", 0)
+	require.Error(t, err)
+
+	_, err = instance.BatchNames(strings.Repeat("a", 62), 10)
+	require.Error(t, err)
+}
+
+// newMigrationRegion serves a network called "new" in the instance's project and
+// region, and security groups on the old and new networks.
+func newMigrationRegion(t *testing.T) regionapi.ClientWithResponsesInterface {
+	t.Helper()
+
+	network := &regionapi.NetworkV2Read{}
+	network.Metadata.Id = "new"
+	network.Metadata.OrganizationId = organizationID
+	network.Metadata.ProjectId = projectID
+	network.Status.RegionId = "region"
+
+	securityGroups := map[string]string{
+		"old-sg": "old",
+		"new-sg": "new",
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		if r.URL.Path == "/api/v2/networks/new" {
+			require.NoError(t, json.NewEncoder(w).Encode(network))
+
+			return
+		}
+
+		id := strings.TrimPrefix(r.URL.Path, "/api/v2/securitygroups/")
+
+		networkID, ok := securityGroups[id]
+		if !ok {
+			coreerrors.HTTPNotFound().Write(w, r)
+
+			return
+		}
+
+		securityGroup := &regionapi.SecurityGroupV2Read{}
+		securityGroup.Metadata.Id = id
+		securityGroup.Status.NetworkId = networkID
+
+		require.NoError(t, json.NewEncoder(w).Encode(securityGroup))
+	}))
+
+	t.Cleanup(server.Close)
+
+	regionClient, err := regionapi.NewClientWithResponses(server.URL)
+	require.NoError(t, err)
+
+	return regionClient
+}
+
+// TestMigrateNetworkSecurityGroups checks security groups from the old network must
+// be replaced when migrating, and that replacements are applied with the move.
+func TestMigrateNetworkSecurityGroups(t *testing.T) {
+	t.Parallel()
+
+	current := &computev1.ComputeInstance{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      "instance",
+			Labels: map[string]string{
+				coreconstants.OrganizationLabel: organizationID,
+				coreconstants.ProjectLabel:      projectID,
+				coreconstants.NameLabel:         "name",
+				regionconstants.RegionLabel:     "region",
+				regionconstants.NetworkLabel:    "old",
+			},
+		},
+		Spec: computev1.ComputeInstanceSpec{
+			Networking: &computev1.ComputeInstanceNetworking{
+				SecurityGroupIDs: []string{"old-sg"},
+			},
+		},
+	}
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, computev1.AddToScheme(scheme))
+
+	cli := fake.NewClientBuilder().WithScheme(scheme).WithObjects(current).Build()
+
+	c := instance.NewClient(cli, "default", nil, newMigrationRegion(t))
+
+	ctx := rbac.NewContext(t.Context(), &identityapi.Acl{
+		Organizations: &identityapi.AclOrganizationList{
+			{
+				Id: organizationID,
+				Endpoints: &identityapi.AclEndpoints{
+					{
+						Name:       "compute:instances",
+						Operations: identityapi.AclOperations{identityapi.Read, identityapi.Update},
+					},
+				},
+			},
+		},
+	})
+	ctx = principal.NewContext(ctx, &principal.Principal{})
+
+	err := c.MigrateNetwork(ctx, "instance", &computeapi.InstanceNetworkMigrate{NetworkId: "new"})
+	require.Error(t, err)
+
+	code, ok := errorsv2.CodeOf(err)
+	require.True(t, ok)
+	require.Equal(t, computeapi.ComputeInstanceInvalidSecurityGroup, code)
+
+	request := &computeapi.InstanceNetworkMigrate{
+		NetworkId:      "new",
+		SecurityGroups: &[]string{"new-sg"},
+	}
+
+	require.NoError(t, c.MigrateNetwork(ctx, "instance", request))
+
+	updated := &computev1.ComputeInstance{}
+	require.NoError(t, cli.Get(t.Context(), client.ObjectKeyFromObject(current), updated))
+	require.Equal(t, "new", updated.Labels[regionconstants.NetworkLabel])
+	require.Equal(t, []string{"new-sg"}, updated.Spec.Networking.SecurityGroupIDs)
+}
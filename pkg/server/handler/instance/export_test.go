@@ -17,6 +17,10 @@ limitations under the License.
 package instance
 
 import (
+	"context"
+
+	computev1 "github.com/unikorn-cloud/compute/pkg/apis/unikorn/v1alpha1"
+	"github.com/unikorn-cloud/core/pkg/server/saga"
 	identityapi "github.com/unikorn-cloud/identity/pkg/openapi"
 	regionapi "github.com/unikorn-cloud/region/pkg/openapi"
 )
@@ -24,3 +28,13 @@ import (
 func (c *Client) GenerateAllocation(flavor *regionapi.Flavor, publicIP bool) identityapi.ResourceAllocationList {
 	return c.generateAllocation(flavor, publicIP)
 }
+
+func RunUpdateSaga(ctx context.Context, c *Client, current, updated *computev1.ComputeInstance, currentFlavor, flavor *regionapi.Flavor) error {
+	return saga.Run(ctx, newUpdateSaga(c, current, updated, currentFlavor, flavor))
+}
+
+//nolint:gochecknoglobals
+var RebootType = rebootType
+
+//nolint:gochecknoglobals
+var BatchNames = batchNames
@@ -0,0 +1,269 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package maintenance
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+
+	computev1 "github.com/unikorn-cloud/compute/pkg/apis/unikorn/v1alpha1"
+	computeapi "github.com/unikorn-cloud/compute/pkg/openapi"
+	"github.com/unikorn-cloud/compute/pkg/server/errorsv2"
+	"github.com/unikorn-cloud/compute/pkg/server/handler/util"
+	corev1 "github.com/unikorn-cloud/core/pkg/apis/unikorn/v1alpha1"
+	"github.com/unikorn-cloud/core/pkg/server/conversion"
+	"github.com/unikorn-cloud/core/pkg/server/errors"
+	"github.com/unikorn-cloud/identity/pkg/handler/common"
+	identityapi "github.com/unikorn-cloud/identity/pkg/openapi"
+	"github.com/unikorn-cloud/identity/pkg/rbac"
+
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Client wraps up provider maintenance window handling.  Maintenance windows
+// are an operator concern, so all operations require global permissions.
+type Client struct {
+	// client allows Compute API access.
+	client client.Client
+	// namespace the controller runs in.
+	namespace string
+	// access controls how unauthorized reads are reported.
+	access *util.AccessOptions
+}
+
+// NewClient returns a new client with required parameters.
+func NewClient(client client.Client, namespace string) *Client {
+	return &Client{
+		client:    client,
+		namespace: namespace,
+	}
+}
+
+// WithAccessOptions controls how unauthorized reads of individual resources
+// are reported.
+func (c *Client) WithAccessOptions(access *util.AccessOptions) *Client {
+	c.access = access
+
+	return c
+}
+
+func convert(in *computev1.ComputeMaintenanceWindow) *computeapi.MaintenanceWindowRead {
+	out := &computeapi.MaintenanceWindowRead{
+		Metadata: conversion.ResourceReadMetadata(in, in.Spec.Tags),
+		Spec: computeapi.MaintenanceWindowSpec{
+			Start: in.Spec.Start.Time,
+			End:   in.Spec.End.Time,
+		},
+	}
+
+	if in.Spec.RegionID != "" {
+		out.Spec.RegionId = ptr.To(in.Spec.RegionID)
+	}
+
+	if len(in.Spec.MachineIDs) != 0 {
+		out.Spec.MachineIds = ptr.To(slices.Clone(in.Spec.MachineIDs))
+	}
+
+	if in.Spec.Reason != "" {
+		out.Spec.Reason = ptr.To(in.Spec.Reason)
+	}
+
+	return out
+}
+
+func convertList(in *computev1.ComputeMaintenanceWindowList) computeapi.MaintenanceWindowReadList {
+	out := make(computeapi.MaintenanceWindowReadList, len(in.Items))
+
+	for i := range in.Items {
+		out[i] = *convert(&in.Items[i])
+	}
+
+	return out
+}
+
+func generateSpec(in *computeapi.MaintenanceWindowSpec) (*computev1.ComputeMaintenanceWindowSpec, error) {
+	regionID := ptr.Deref(in.RegionId, "")
+	machineIDs := ptr.Deref(in.MachineIds, nil)
+
+	if regionID == "" && len(machineIDs) == 0 {
+		return nil, errorsv2.InvalidRequest(computeapi.ComputeMaintenanceWindowInvalidSpec, "maintenance window must specify a region or machines")
+	}
+
+	if !in.End.After(in.Start) {
+		return nil, errorsv2.InvalidRequest(computeapi.ComputeMaintenanceWindowInvalidSpec, "maintenance window must end after it starts")
+	}
+
+	out := &computev1.ComputeMaintenanceWindowSpec{
+		RegionID:   regionID,
+		MachineIDs: slices.Clone(machineIDs),
+		Start:      metav1.NewTime(in.Start),
+		End:        metav1.NewTime(in.End),
+		Reason:     ptr.Deref(in.Reason, ""),
+	}
+
+	return out, nil
+}
+
+func (c *Client) generate(ctx context.Context, in *computeapi.MaintenanceWindowWrite, currentTags corev1.TagList) (*computev1.ComputeMaintenanceWindow, error) {
+	spec, err := generateSpec(&in.Spec)
+	if err != nil {
+		return nil, err
+	}
+
+	spec.Tags, err = util.GenerateTagList(in.Metadata.Tags, currentTags)
+	if err != nil {
+		return nil, err
+	}
+
+	out := &computev1.ComputeMaintenanceWindow{
+		ObjectMeta: conversion.NewObjectMetadata(&in.Metadata, c.namespace).Get(),
+		Spec:       *spec,
+	}
+
+	if err := common.SetIdentityMetadata(ctx, &out.ObjectMeta); err != nil {
+		return nil, fmt.Errorf("%w: failed to set identity metadata", err)
+	}
+
+	return out, nil
+}
+
+// List returns all maintenance windows, ordered by start time.
+func (c *Client) List(ctx context.Context) (computeapi.MaintenanceWindowReadList, error) {
+	if err := rbac.AllowGlobalScope(ctx, "compute:maintenancewindows", identityapi.Read); err != nil {
+		return nil, err
+	}
+
+	result := &computev1.ComputeMaintenanceWindowList{}
+
+	if err := c.client.List(ctx, result, &client.ListOptions{Namespace: c.namespace}); err != nil {
+		return nil, fmt.Errorf("%w: unable to list maintenance windows", err)
+	}
+
+	slices.SortStableFunc(result.Items, func(a, b computev1.ComputeMaintenanceWindow) int {
+		return cmp.Or(a.Spec.Start.Compare(b.Spec.Start.Time), cmp.Compare(a.Name, b.Name))
+	})
+
+	return convertList(result), nil
+}
+
+func (c *Client) getRaw(ctx context.Context, maintenanceWindowID string) (*computev1.ComputeMaintenanceWindow, error) {
+	result := &computev1.ComputeMaintenanceWindow{}
+
+	if err := c.client.Get(ctx, client.ObjectKey{Namespace: c.namespace, Name: maintenanceWindowID}, result); err != nil {
+		if kerrors.IsNotFound(err) {
+			return nil, errors.HTTPNotFound().WithError(err)
+		}
+
+		return nil, fmt.Errorf("%w: unable to lookup maintenance window", err)
+	}
+
+	return result, nil
+}
+
+// Get returns a maintenance window.
+func (c *Client) Get(ctx context.Context, maintenanceWindowID string) (*computeapi.MaintenanceWindowRead, error) {
+	if err := util.AllowGlobalScopeRead(ctx, c.access, "compute:maintenancewindows"); err != nil {
+		return nil, err
+	}
+
+	result, err := c.getRaw(ctx, maintenanceWindowID)
+	if err != nil {
+		return nil, err
+	}
+
+	return convert(result), nil
+}
+
+// Create schedules a new maintenance window.
+func (c *Client) Create(ctx context.Context, request *computeapi.MaintenanceWindowWrite) (*computeapi.MaintenanceWindowRead, error) {
+	if err := rbac.AllowGlobalScope(ctx, "compute:maintenancewindows", identityapi.Create); err != nil {
+		return nil, err
+	}
+
+	resource, err := c.generate(ctx, request, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := c.client.Create(ctx, resource); err != nil {
+		return nil, fmt.Errorf("%w: unable to create maintenance window", err)
+	}
+
+	return convert(resource), nil
+}
+
+// Update replaces a maintenance window, typically to extend it when maintenance
+// overruns.
+func (c *Client) Update(ctx context.Context, maintenanceWindowID string, request *computeapi.MaintenanceWindowWrite) (*computeapi.MaintenanceWindowRead, error) {
+	if err := rbac.AllowGlobalScope(ctx, "compute:maintenancewindows", identityapi.Update); err != nil {
+		return nil, err
+	}
+
+	current, err := c.getRaw(ctx, maintenanceWindowID)
+	if err != nil {
+		return nil, err
+	}
+
+	if current.DeletionTimestamp != nil {
+		return nil, errorsv2.InvalidRequest(computeapi.ComputeMaintenanceWindowDeleting, "maintenance window is being deleted")
+	}
+
+	required, err := c.generate(ctx, request, current.Spec.Tags)
+	if err != nil {
+		return nil, err
+	}
+
+	updated := current.DeepCopy()
+	updated.Labels = required.Labels
+	updated.Annotations = required.Annotations
+	updated.Spec = required.Spec
+
+	if err := c.client.Patch(ctx, updated, client.MergeFromWithOptions(current, &client.MergeFromWithOptimisticLock{})); err != nil {
+		return nil, fmt.Errorf("%w: unable to update maintenance window", err)
+	}
+
+	return convert(updated), nil
+}
+
+// Delete removes a maintenance window, affected machines resume normal healing
+// and rebuilds on their next reconcile.
+func (c *Client) Delete(ctx context.Context, maintenanceWindowID string) error {
+	if err := rbac.AllowGlobalScope(ctx, "compute:maintenancewindows", identityapi.Delete); err != nil {
+		return err
+	}
+
+	resource := &computev1.ComputeMaintenanceWindow{}
+	resource.Namespace = c.namespace
+	resource.Name = maintenanceWindowID
+
+	if err := c.client.Delete(ctx, resource); err != nil {
+		if kerrors.IsNotFound(err) {
+			return errors.HTTPNotFound().WithError(err)
+		}
+
+		return fmt.Errorf("%w: unable to delete maintenance window", err)
+	}
+
+	return nil
+}
@@ -25,6 +25,7 @@ import (
 	"github.com/spf13/pflag"
 
 	"github.com/unikorn-cloud/compute/pkg/server/handler/cluster"
+	"github.com/unikorn-cloud/compute/pkg/server/handler/util"
 )
 
 // Options defines configurable handler options.
@@ -33,15 +34,38 @@ type Options struct {
 	// flavors don't change all that often.
 	CacheMaxAge time.Duration
 
+	// RegionMaxAge defines how long clients may cache region, flavor and image
+	// reads before revalidating them with an entity tag.
+	RegionMaxAge time.Duration
+
+	// StaleMaxAge defines how long region reads can be served from the last
+	// known good response when the region service is unavailable.
+	StaleMaxAge time.Duration
+
+	// RegionCacheTTL defines how long region flavor and image reads are
+	// cached in memory to reduce latency and load on the region service.
+	RegionCacheTTL time.Duration
+
 	// Cluster is a set of options for managed clusters.
 	Cluster cluster.Options
+
+	// Access controls how unauthorized resource reads are reported.
+	Access util.AccessOptions
+
+	// Consistency controls whether writes are visible to subsequent reads.
+	Consistency util.ConsistencyOptions
 }
 
 // AddFlags adds the options flags to the given flag set.
 func (o *Options) AddFlags(f *pflag.FlagSet) {
 	f.DurationVar(&o.CacheMaxAge, "cache-max-age", 24*time.Hour, "How long to cache long-lived queries in the browser.")
+	f.DurationVar(&o.RegionMaxAge, "region-max-age", 5*time.Minute, "How long clients may cache region, flavor and image reads before revalidating, zero always revalidates.")
+	f.DurationVar(&o.StaleMaxAge, "stale-max-age", 24*time.Hour, "How long to serve stale region reads when the region service is unavailable, zero disables.")
+	f.DurationVar(&o.RegionCacheTTL, "region-cache-ttl", time.Minute, "How long to cache region flavor and image reads in memory, zero disables.")
 
 	o.Cluster.AddFlags(f)
+	o.Access.AddFlags(f)
+	o.Consistency.AddFlags(f)
 }
 
 // setCacheable allows the client to cache the response for this request for a
@@ -50,3 +74,43 @@ func (o *Options) setCacheable(w http.ResponseWriter) {
 	w.Header().Add("Cache-Control", fmt.Sprintf("max-age=%d", o.CacheMaxAge/time.Second))
 	w.Header().Add("Cache-Control", "private")
 }
+
+// setRegionCacheable allows the client to cache region reads for a period of time,
+// after which it must revalidate its copy with the server.
+func (o *Options) setRegionCacheable(w http.ResponseWriter) {
+	if o.RegionMaxAge > 0 {
+		w.Header().Add("Cache-Control", fmt.Sprintf("max-age=%d", o.RegionMaxAge/time.Second))
+	} else {
+		w.Header().Add("Cache-Control", "no-cache")
+	}
+
+	w.Header().Add("Cache-Control", "private")
+}
+
+// features returns the optional features enabled by these options, this is
+// reported by the version endpoint so operators can see what is deployed.
+func (o *Options) features() []string {
+	features := []string{}
+
+	if o.CacheMaxAge > 0 {
+		features = append(features, "client-caching")
+	}
+
+	if o.RegionMaxAge > 0 {
+		features = append(features, "region-client-caching")
+	}
+
+	if o.StaleMaxAge > 0 {
+		features = append(features, "stale-reads")
+	}
+
+	if o.RegionCacheTTL > 0 {
+		features = append(features, "region-caching")
+	}
+
+	if o.Consistency.WriteSyncTimeout > 0 {
+		features = append(features, "read-your-writes")
+	}
+
+	return features
+}
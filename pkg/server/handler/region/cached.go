@@ -0,0 +1,133 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package region
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+
+	regionapi "github.com/unikorn-cloud/region/pkg/openapi"
+)
+
+// cacheEntry is a cached response.
+type cacheEntry[T any] struct {
+	// result is the response from the region service.
+	result []T
+
+	// expiry is when the response should no longer be used.
+	expiry time.Time
+}
+
+// Cached wraps a region client and remembers flavor and image responses for a period
+// of time, these are read on every cluster write to generate quota allocations, and
+// change infrequently.  Like LastKnownGood this is shared between requests, so callers
+// must have authorized access to the organization before use.  Regions are not cached
+// as they are cheap and already cacheable by clients.
+type Cached struct {
+	client ClientInterface
+
+	// ttl is how long responses are cached for.  A zero value disables caching.
+	ttl time.Duration
+
+	lock sync.Mutex
+
+	flavors map[string]cacheEntry[regionapi.Flavor]
+	images  map[string]cacheEntry[regionapi.Image]
+}
+
+// Ensure the ClientInterface is implemented.
+var _ ClientInterface = &Cached{}
+
+// NewCached returns a new caching client.
+func NewCached(client ClientInterface, ttl time.Duration) *Cached {
+	return &Cached{
+		client:  client,
+		ttl:     ttl,
+		flavors: map[string]cacheEntry[regionapi.Flavor]{},
+		images:  map[string]cacheEntry[regionapi.Image]{},
+	}
+}
+
+// cached returns an unexpired cached result if one exists, otherwise calls the
+// underlying client and caches the result.  The lock is not held while calling
+// the region service, so concurrent misses may result in duplicate requests, which
+// is preferable to serializing all requests behind a slow one.  Callers are free
+// to modify the returned slice.
+func cached[T any](c *Cached, cache map[string]cacheEntry[T], key string, f func() ([]T, error)) ([]T, error) {
+	if c.ttl == 0 {
+		return f()
+	}
+
+	now := time.Now()
+
+	c.lock.Lock()
+	entry, ok := cache[key]
+	c.lock.Unlock()
+
+	if ok && now.Before(entry.expiry) {
+		return slices.Clone(entry.result), nil
+	}
+
+	result, err := f()
+	if err != nil {
+		return nil, err
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	cache[key] = cacheEntry[T]{
+		result: slices.Clone(result),
+		expiry: now.Add(c.ttl),
+	}
+
+	return result, nil
+}
+
+// List lists all regions.
+func (c *Cached) List(ctx context.Context, organizationID string) ([]regionapi.RegionRead, error) {
+	return c.client.List(ctx, organizationID)
+}
+
+// Flavors returns all compute compatible flavors.
+func (c *Cached) Flavors(ctx context.Context, organizationID, regionID string) ([]regionapi.Flavor, error) {
+	return cached(c, c.flavors, organizationID+"/"+regionID, func() ([]regionapi.Flavor, error) {
+		return c.client.Flavors(ctx, organizationID, regionID)
+	})
+}
+
+// Images returns all compute compatible images.
+func (c *Cached) Images(ctx context.Context, organizationID, regionID string) ([]regionapi.Image, error) {
+	return cached(c, c.images, organizationID+"/"+regionID, func() ([]regionapi.Image, error) {
+		return c.client.Images(ctx, organizationID, regionID)
+	})
+}
+
+// Invalidate discards any cached responses for the organization and region, for
+// example when an image has been created that needs to be visible immediately.
+func (c *Cached) Invalidate(organizationID, regionID string) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	key := organizationID + "/" + regionID
+
+	delete(c.flavors, key)
+	delete(c.images, key)
+}
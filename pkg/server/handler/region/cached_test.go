@@ -0,0 +1,73 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package region_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/unikorn-cloud/compute/pkg/server/handler/region"
+	"github.com/unikorn-cloud/compute/pkg/server/handler/region/mock"
+)
+
+// TestCachedFlavors ensures the underlying client is only queried once while the
+// cache is valid, and again once invalidated.
+func TestCachedFlavors(t *testing.T) {
+	t.Parallel()
+
+	c := gomock.NewController(t)
+	defer c.Finish()
+
+	client := mock.NewMockClientInterface(c)
+	client.EXPECT().Flavors(t.Context(), organizationID, regionID).Return(flavors(), nil).Times(2)
+
+	cached := region.NewCached(client, time.Hour)
+
+	for range 2 {
+		result, err := cached.Flavors(t.Context(), organizationID, regionID)
+		require.NoError(t, err)
+		require.Equal(t, flavors(), result)
+	}
+
+	cached.Invalidate(organizationID, regionID)
+
+	result, err := cached.Flavors(t.Context(), organizationID, regionID)
+	require.NoError(t, err)
+	require.Equal(t, flavors(), result)
+}
+
+// TestCachedDisabled ensures a zero TTL passes every request through.
+func TestCachedDisabled(t *testing.T) {
+	t.Parallel()
+
+	c := gomock.NewController(t)
+	defer c.Finish()
+
+	client := mock.NewMockClientInterface(c)
+	client.EXPECT().Flavors(t.Context(), organizationID, regionID).Return(flavors(), nil).Times(2)
+
+	cached := region.NewCached(client, 0)
+
+	for range 2 {
+		_, err := cached.Flavors(t.Context(), organizationID, regionID)
+		require.NoError(t, err)
+	}
+}
@@ -0,0 +1,93 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package region
+
+import (
+	"context"
+	"slices"
+	"sync"
+
+	regionapi "github.com/unikorn-cloud/region/pkg/openapi"
+)
+
+// Memoized wraps a region client and remembers responses for its lifetime.  It is
+// intended to be scoped to a single API request, where the same region queries would
+// otherwise be made repeatedly e.g. once per workload pool.
+type Memoized struct {
+	client ClientInterface
+
+	lock sync.Mutex
+
+	regions map[string][]regionapi.RegionRead
+	flavors map[string][]regionapi.Flavor
+	images  map[string][]regionapi.Image
+}
+
+// Ensure the ClientInterface is implemented.
+var _ ClientInterface = &Memoized{}
+
+// NewMemoized returns a new memoizing client.
+func NewMemoized(client ClientInterface) *Memoized {
+	return &Memoized{
+		client:  client,
+		regions: map[string][]regionapi.RegionRead{},
+		flavors: map[string][]regionapi.Flavor{},
+		images:  map[string][]regionapi.Image{},
+	}
+}
+
+// memoize returns a cached result if one exists, otherwise calls the underlying
+// client and caches the result.  Callers are free to modify the returned slice.
+func memoize[T any](m *Memoized, cache map[string][]T, key string, f func() ([]T, error)) ([]T, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if result, ok := cache[key]; ok {
+		return slices.Clone(result), nil
+	}
+
+	result, err := f()
+	if err != nil {
+		return nil, err
+	}
+
+	cache[key] = result
+
+	return slices.Clone(result), nil
+}
+
+// List lists all regions.
+func (m *Memoized) List(ctx context.Context, organizationID string) ([]regionapi.RegionRead, error) {
+	return memoize(m, m.regions, organizationID, func() ([]regionapi.RegionRead, error) {
+		return m.client.List(ctx, organizationID)
+	})
+}
+
+// Flavors returns all compute compatible flavors.
+func (m *Memoized) Flavors(ctx context.Context, organizationID, regionID string) ([]regionapi.Flavor, error) {
+	return memoize(m, m.flavors, organizationID+"/"+regionID, func() ([]regionapi.Flavor, error) {
+		return m.client.Flavors(ctx, organizationID, regionID)
+	})
+}
+
+// Images returns all compute compatible images.
+func (m *Memoized) Images(ctx context.Context, organizationID, regionID string) ([]regionapi.Image, error) {
+	return memoize(m, m.images, organizationID+"/"+regionID, func() ([]regionapi.Image, error) {
+		return m.client.Images(ctx, organizationID, regionID)
+	})
+}
@@ -0,0 +1,69 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package region_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/unikorn-cloud/compute/pkg/server/handler/region"
+	"github.com/unikorn-cloud/compute/pkg/server/handler/region/mock"
+	coreapi "github.com/unikorn-cloud/core/pkg/openapi"
+	regionapi "github.com/unikorn-cloud/region/pkg/openapi"
+)
+
+const (
+	organizationID = "foo"
+	regionID       = "bar"
+)
+
+func flavors() []regionapi.Flavor {
+	return []regionapi.Flavor{
+		{Metadata: coreapi.StaticResourceMetadata{Id: "flavor-1"}},
+		{Metadata: coreapi.StaticResourceMetadata{Id: "flavor-2"}},
+	}
+}
+
+// TestMemoizedFlavors ensures the underlying client is only queried once and
+// that callers modifying the result don't corrupt the cache.
+func TestMemoizedFlavors(t *testing.T) {
+	t.Parallel()
+
+	c := gomock.NewController(t)
+	defer c.Finish()
+
+	client := mock.NewMockClientInterface(c)
+	client.EXPECT().Flavors(t.Context(), organizationID, regionID).Return(flavors(), nil).Times(1)
+
+	m := region.NewMemoized(client)
+
+	result, err := m.Flavors(t.Context(), organizationID, regionID)
+	require.NoError(t, err)
+	require.Len(t, result, 2)
+
+	_ = slices.DeleteFunc(result, func(f regionapi.Flavor) bool {
+		return f.Metadata.Id == "flavor-1"
+	})
+
+	result, err = m.Flavors(t.Context(), organizationID, regionID)
+	require.NoError(t, err)
+	require.Equal(t, flavors(), result)
+}
@@ -120,6 +120,19 @@ func (c *Client) Servers(ctx context.Context, organizationID string, cluster *un
 	return servers, nil
 }
 
+func (c *Client) GetServer(ctx context.Context, organizationID, projectID, identityID, serverID string) (*regionapi.ServerRead, error) {
+	resp, err := c.client.GetApiV1OrganizationsOrganizationIDProjectsProjectIDIdentitiesIdentityIDServersServerIDWithResponse(ctx, organizationID, projectID, identityID, serverID)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode() != http.StatusOK {
+		return nil, errors.PropagateError(resp.HTTPResponse, resp)
+	}
+
+	return resp.JSON200, nil
+}
+
 func (c *Client) DeleteServer(ctx context.Context, organizationID, projectID, identityID, serverID string) error {
 	resp, err := c.client.DeleteApiV1OrganizationsOrganizationIDProjectsProjectIDIdentitiesIdentityIDServersServerIDWithResponse(ctx, organizationID, projectID, identityID, serverID)
 	if err != nil {
@@ -0,0 +1,126 @@
+/*
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package region
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/unikorn-cloud/core/pkg/server/errors"
+	regionapi "github.com/unikorn-cloud/region/pkg/openapi"
+)
+
+// staleEntry is a last known good response.
+type staleEntry[T any] struct {
+	// result is the response from the region service.
+	result []T
+
+	// timestamp is when the response was received.
+	timestamp time.Time
+}
+
+// LastKnownGood remembers the last successful response from the region service
+// for the lifetime of the server.  When the region service is unavailable, reads
+// can be served from this instead, flagged as stale, so read availability doesn't
+// depend on region uptime.  Unlike Memoized this is shared between requests, so
+// callers must have authorized access to the organization before use.
+type LastKnownGood struct {
+	// maxAge is how long a response can be served for once the region service
+	// becomes unavailable.  A zero value disables serving stale responses.
+	maxAge time.Duration
+
+	lock sync.Mutex
+
+	regions map[string]staleEntry[regionapi.RegionRead]
+	flavors map[string]staleEntry[regionapi.Flavor]
+	images  map[string]staleEntry[regionapi.Image]
+}
+
+// NewLastKnownGood returns a new last known good cache.
+func NewLastKnownGood(maxAge time.Duration) *LastKnownGood {
+	return &LastKnownGood{
+		maxAge:  maxAge,
+		regions: map[string]staleEntry[regionapi.RegionRead]{},
+		flavors: map[string]staleEntry[regionapi.Flavor]{},
+		images:  map[string]staleEntry[regionapi.Image]{},
+	}
+}
+
+// unavailable checks whether an error indicates the region service could not service
+// the request, as opposed to definitively rejecting it, in which case the error must
+// be propagated to the client.
+func unavailable(err error) bool {
+	return !errors.IsBadRequest(err) &&
+		!errors.IsAccessDenied(err) &&
+		!errors.IsForbidden(err) &&
+		!errors.IsHTTPNotFound(err)
+}
+
+// lastKnownGood calls the region service, on success remembering the result, and on
+// failure returning the last known good result if one is available.  The returned
+// boolean indicates the result is stale.  Callers are free to modify the returned slice.
+func lastKnownGood[T any](l *LastKnownGood, cache map[string]staleEntry[T], key string, f func() ([]T, error)) ([]T, bool, error) {
+	result, err := f()
+	if err == nil {
+		l.lock.Lock()
+		defer l.lock.Unlock()
+
+		cache[key] = staleEntry[T]{
+			result:    slices.Clone(result),
+			timestamp: time.Now(),
+		}
+
+		return result, false, nil
+	}
+
+	if !unavailable(err) {
+		return nil, false, err
+	}
+
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	entry, ok := cache[key]
+	if !ok || time.Since(entry.timestamp) > l.maxAge {
+		return nil, false, err
+	}
+
+	return slices.Clone(entry.result), true, nil
+}
+
+// List lists all regions.
+func (l *LastKnownGood) List(ctx context.Context, client ClientInterface, organizationID string) ([]regionapi.RegionRead, bool, error) {
+	return lastKnownGood(l, l.regions, organizationID, func() ([]regionapi.RegionRead, error) {
+		return client.List(ctx, organizationID)
+	})
+}
+
+// Flavors returns all compute compatible flavors.
+func (l *LastKnownGood) Flavors(ctx context.Context, client ClientInterface, organizationID, regionID string) ([]regionapi.Flavor, bool, error) {
+	return lastKnownGood(l, l.flavors, organizationID+"/"+regionID, func() ([]regionapi.Flavor, error) {
+		return client.Flavors(ctx, organizationID, regionID)
+	})
+}
+
+// Images returns all compute compatible images.
+func (l *LastKnownGood) Images(ctx context.Context, client ClientInterface, organizationID, regionID string) ([]regionapi.Image, bool, error) {
+	return lastKnownGood(l, l.images, organizationID+"/"+regionID, func() ([]regionapi.Image, error) {
+		return client.Images(ctx, organizationID, regionID)
+	})
+}
@@ -0,0 +1,98 @@
+/*
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package region_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/unikorn-cloud/compute/pkg/server/handler/region"
+	"github.com/unikorn-cloud/compute/pkg/server/handler/region/mock"
+	coreerrors "github.com/unikorn-cloud/core/pkg/server/errors"
+)
+
+var errUnavailable = errors.New("connection refused")
+
+// TestLastKnownGoodFlavors ensures the last known good response is served, and
+// flagged as stale, when the region service is unavailable.
+func TestLastKnownGoodFlavors(t *testing.T) {
+	t.Parallel()
+
+	c := gomock.NewController(t)
+	defer c.Finish()
+
+	client := mock.NewMockClientInterface(c)
+	client.EXPECT().Flavors(t.Context(), organizationID, regionID).Return(flavors(), nil)
+	client.EXPECT().Flavors(t.Context(), organizationID, regionID).Return(nil, errUnavailable)
+
+	l := region.NewLastKnownGood(time.Hour)
+
+	result, stale, err := l.Flavors(t.Context(), client, organizationID, regionID)
+	require.NoError(t, err)
+	require.False(t, stale)
+	require.Equal(t, flavors(), result)
+
+	result, stale, err = l.Flavors(t.Context(), client, organizationID, regionID)
+	require.NoError(t, err)
+	require.True(t, stale)
+	require.Equal(t, flavors(), result)
+}
+
+// TestLastKnownGoodRejected ensures definitive errors from the region service are
+// propagated rather than masked by a stale response.
+func TestLastKnownGoodRejected(t *testing.T) {
+	t.Parallel()
+
+	c := gomock.NewController(t)
+	defer c.Finish()
+
+	client := mock.NewMockClientInterface(c)
+	client.EXPECT().Flavors(t.Context(), organizationID, regionID).Return(flavors(), nil)
+	client.EXPECT().Flavors(t.Context(), organizationID, regionID).Return(nil, coreerrors.HTTPForbidden("denied"))
+
+	l := region.NewLastKnownGood(time.Hour)
+
+	_, _, err := l.Flavors(t.Context(), client, organizationID, regionID)
+	require.NoError(t, err)
+
+	_, _, err = l.Flavors(t.Context(), client, organizationID, regionID)
+	require.True(t, coreerrors.IsForbidden(err))
+}
+
+// TestLastKnownGoodDisabled ensures nothing stale is served when disabled.
+func TestLastKnownGoodDisabled(t *testing.T) {
+	t.Parallel()
+
+	c := gomock.NewController(t)
+	defer c.Finish()
+
+	client := mock.NewMockClientInterface(c)
+	client.EXPECT().Flavors(t.Context(), organizationID, regionID).Return(flavors(), nil)
+	client.EXPECT().Flavors(t.Context(), organizationID, regionID).Return(nil, errUnavailable)
+
+	l := region.NewLastKnownGood(0)
+
+	_, _, err := l.Flavors(t.Context(), client, organizationID, regionID)
+	require.NoError(t, err)
+
+	_, _, err = l.Flavors(t.Context(), client, organizationID, regionID)
+	require.ErrorIs(t, err, errUnavailable)
+}
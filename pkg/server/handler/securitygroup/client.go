@@ -0,0 +1,189 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package securitygroup
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	computeapi "github.com/unikorn-cloud/compute/pkg/openapi"
+	"github.com/unikorn-cloud/compute/pkg/server/handler/region"
+	"github.com/unikorn-cloud/compute/pkg/server/handler/util"
+	coreapi "github.com/unikorn-cloud/core/pkg/openapi"
+	"github.com/unikorn-cloud/core/pkg/server/conversion"
+	"github.com/unikorn-cloud/core/pkg/server/errors"
+	"github.com/unikorn-cloud/identity/pkg/principal"
+	regionapi "github.com/unikorn-cloud/region/pkg/openapi"
+)
+
+// Client proxies security group management to the region service.  All requests
+// impersonate the user, so the region service enforces access control, and scopes
+// the security groups to the user's projects.
+type Client struct {
+	// region is a client to access regions.
+	region regionapi.ClientWithResponsesInterface
+}
+
+// NewClient returns a new client with required parameters.
+func NewClient(region regionapi.ClientWithResponsesInterface) *Client {
+	return &Client{
+		region: region,
+	}
+}
+
+// injectPrincipal scopes the user principal to the resource's organization and project
+// so the region service can attribute and authorize changes to it.
+func injectPrincipal(ctx context.Context, metadata *coreapi.ProjectScopedResourceReadMetadata) (context.Context, error) {
+	if err := util.InjectUserPrincipal(ctx, metadata.OrganizationId, metadata.ProjectId); err != nil {
+		return nil, err
+	}
+
+	return principal.NewImpersonateContext(ctx), nil
+}
+
+// validateTags ensures users don't set any tags reserved for the platform, while
+// preserving any that already exist on the security group.
+func validateTags(in *coreapi.TagList, current *coreapi.TagList) (*coreapi.TagList, error) {
+	tags, err := util.GenerateTagList(in, conversion.GenerateTagList(current))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(tags) == 0 {
+		return nil, nil
+	}
+
+	out := conversion.ConvertTags(tags)
+
+	return &out, nil
+}
+
+// List returns all security groups the user has access to, filtered by the parameters.
+func (c *Client) List(ctx context.Context, params computeapi.GetApiV2SecuritygroupsParams) (regionapi.SecurityGroupsV2Read, error) {
+	regionParams := &regionapi.GetApiV2SecuritygroupsParams{
+		Tag:            params.Tag,
+		OrganizationID: params.OrganizationID,
+		ProjectID:      params.ProjectID,
+		RegionID:       params.RegionID,
+		NetworkID:      params.NetworkID,
+	}
+
+	response, err := c.region.GetApiV2SecuritygroupsWithResponse(principal.NewImpersonateContext(ctx), regionParams)
+	if err != nil {
+		return nil, fmt.Errorf("%w: unable to list security groups", err)
+	}
+
+	if response.StatusCode() != http.StatusOK {
+		return nil, errors.PropagateError(response.HTTPResponse, response)
+	}
+
+	return *response.JSON200, nil
+}
+
+// Create creates a security group on a network, the security group inherits the
+// network's organization, project and region.
+func (c *Client) Create(ctx context.Context, request *regionapi.SecurityGroupV2Create) (*regionapi.SecurityGroupV2Read, error) {
+	network, err := region.GetNetwork(principal.NewImpersonateContext(ctx), c.region, request.Spec.NetworkId)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, err = injectPrincipal(ctx, &network.Metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	tags, err := validateTags(request.Metadata.Tags, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	request.Metadata.Tags = tags
+
+	response, err := c.region.PostApiV2SecuritygroupsWithResponse(ctx, *request)
+	if err != nil {
+		return nil, fmt.Errorf("%w: unable to create security group", err)
+	}
+
+	if response.StatusCode() != http.StatusCreated {
+		return nil, errors.PropagateError(response.HTTPResponse, response)
+	}
+
+	return response.JSON201, nil
+}
+
+// Get returns a security group.
+func (c *Client) Get(ctx context.Context, securityGroupID string) (*regionapi.SecurityGroupV2Read, error) {
+	return region.GetSecurityGroup(principal.NewImpersonateContext(ctx), c.region, securityGroupID)
+}
+
+// Update replaces a security group's rules and metadata.
+func (c *Client) Update(ctx context.Context, securityGroupID string, request *regionapi.SecurityGroupV2Update) (*regionapi.SecurityGroupV2Read, error) {
+	current, err := c.Get(ctx, securityGroupID)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, err = injectPrincipal(ctx, &current.Metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	tags, err := validateTags(request.Metadata.Tags, current.Metadata.Tags)
+	if err != nil {
+		return nil, err
+	}
+
+	request.Metadata.Tags = tags
+
+	response, err := c.region.PutApiV2SecuritygroupsSecurityGroupIDWithResponse(ctx, securityGroupID, *request)
+	if err != nil {
+		return nil, fmt.Errorf("%w: unable to update security group", err)
+	}
+
+	if response.StatusCode() != http.StatusAccepted {
+		return nil, errors.PropagateError(response.HTTPResponse, response)
+	}
+
+	return response.JSON202, nil
+}
+
+// Delete deletes a security group.
+func (c *Client) Delete(ctx context.Context, securityGroupID string) error {
+	current, err := c.Get(ctx, securityGroupID)
+	if err != nil {
+		return err
+	}
+
+	ctx, err = injectPrincipal(ctx, &current.Metadata)
+	if err != nil {
+		return err
+	}
+
+	response, err := c.region.DeleteApiV2SecuritygroupsSecurityGroupIDWithResponse(ctx, securityGroupID)
+	if err != nil {
+		return fmt.Errorf("%w: unable to delete security group", err)
+	}
+
+	if response.StatusCode() != http.StatusAccepted {
+		return errors.PropagateError(response.HTTPResponse, response)
+	}
+
+	return nil
+}
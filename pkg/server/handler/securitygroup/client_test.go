@@ -0,0 +1,48 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package securitygroup_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/unikorn-cloud/compute/pkg/constants"
+	"github.com/unikorn-cloud/compute/pkg/server/handler/securitygroup"
+	coreapi "github.com/unikorn-cloud/core/pkg/openapi"
+)
+
+// TestValidateTags checks users cannot set system tags on security groups, but
+// those that already exist are preserved.
+func TestValidateTags(t *testing.T) {
+	t.Parallel()
+
+	system := coreapi.Tag{Name: constants.InstanceIDTag, Value: "foo"}
+	user := coreapi.Tag{Name: "team", Value: "ci"}
+
+	_, err := securitygroup.ValidateTags(&coreapi.TagList{system}, nil)
+	require.Error(t, err)
+
+	out, err := securitygroup.ValidateTags(&coreapi.TagList{user}, &coreapi.TagList{system})
+	require.NoError(t, err)
+	require.ElementsMatch(t, coreapi.TagList{user, system}, *out)
+
+	out, err = securitygroup.ValidateTags(nil, nil)
+	require.NoError(t, err)
+	require.Nil(t, out)
+}
@@ -0,0 +1,124 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package handler
+
+import (
+	"encoding/json"
+	"mime"
+	"net/http"
+	"strings"
+
+	"github.com/unikorn-cloud/compute/pkg/openapi"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// contentTypeNDJSON is newline delimited JSON, one list item per line.
+const contentTypeNDJSON = "application/x-ndjson"
+
+// acceptsNDJSON checks whether the client has asked for NDJSON.
+func acceptsNDJSON(r *http.Request) bool {
+	for _, accept := range strings.Split(r.Header.Get("Accept"), ",") {
+		mediaType, _, err := mime.ParseMediaType(strings.TrimSpace(accept))
+		if err == nil && mediaType == contentTypeNDJSON {
+			return true
+		}
+	}
+
+	return false
+}
+
+// writeJSONList writes a list response one item at a time rather than marshaling
+// it all up front.  The HTTP server switches to chunked encoding once its buffer
+// fills, so clients can start processing items immediately and memory use is
+// bounded by the largest item, not the whole list.  Clients accepting NDJSON get
+// one item per line, everyone else gets a standard JSON array.
+func writeJSONList[T any](w http.ResponseWriter, r *http.Request, code int, items []T) {
+	writeJSONItems(w, r, code, len(items), func(i int) (any, error) {
+		return &items[i], nil
+	})
+}
+
+// writeSparseJSONList writes a list response as per writeJSONList, with each item
+// trimmed down to the selected fields as it is written.
+func writeSparseJSONList[T any](w http.ResponseWriter, r *http.Request, code int, items []T, fields *openapi.FieldsParameter) {
+	selection := newFieldSelection(fields)
+	if selection == nil {
+		writeJSONList(w, r, code, items)
+		return
+	}
+
+	writeJSONItems(w, r, code, len(items), func(i int) (any, error) {
+		return selection.sparse(&items[i])
+	})
+}
+
+// writeJSONItems streams a list of items, each generated on demand.
+func writeJSONItems(w http.ResponseWriter, r *http.Request, code int, length int, item func(int) (any, error)) {
+	log := log.FromContext(r.Context())
+
+	ndjson := acceptsNDJSON(r)
+
+	if ndjson {
+		w.Header().Add("Content-Type", contentTypeNDJSON)
+	} else {
+		w.Header().Add("Content-Type", "application/json")
+	}
+
+	w.WriteHeader(code)
+
+	// Once the header is written errors can only be logged, the client will
+	// see a truncated response.
+	write := func(s string) bool {
+		if _, err := w.Write([]byte(s)); err != nil {
+			log.Error(err, "failed to write response")
+			return false
+		}
+
+		return true
+	}
+
+	// Encode appends a newline, which is exactly what NDJSON requires, and is
+	// insignificant whitespace in a JSON array.
+	encoder := json.NewEncoder(w)
+
+	if !ndjson && !write("[") {
+		return
+	}
+
+	for i := range length {
+		if i > 0 && !ndjson && !write(",") {
+			return
+		}
+
+		value, err := item(i)
+		if err != nil {
+			log.Error(err, "failed to write response")
+			return
+		}
+
+		if err := encoder.Encode(value); err != nil {
+			log.Error(err, "failed to write response")
+			return
+		}
+	}
+
+	if !ndjson {
+		write("]")
+	}
+}
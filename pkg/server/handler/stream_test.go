@@ -0,0 +1,120 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package handler_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/unikorn-cloud/compute/pkg/openapi"
+	"github.com/unikorn-cloud/compute/pkg/server/handler"
+)
+
+//nolint:gochecknoglobals
+var items = []map[string]int{
+	{"a": 1},
+	{"b": 2},
+}
+
+// TestWriteJSONListArray ensures the default response is a valid JSON array.
+func TestWriteJSONListArray(t *testing.T) {
+	t.Parallel()
+
+	for _, in := range [][]map[string]int{nil, items} {
+		w := httptest.NewRecorder()
+
+		handler.WriteJSONList(w, httptest.NewRequest(http.MethodGet, "/", nil), http.StatusOK, in)
+
+		require.Equal(t, "application/json", w.Header().Get("Content-Type"))
+
+		var out []map[string]int
+
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &out))
+		require.Len(t, out, len(in))
+	}
+}
+
+// TestWriteJSONListNDJSON ensures one item is written per line when requested.
+func TestWriteJSONListNDJSON(t *testing.T) {
+	t.Parallel()
+
+	r := httptest.NewRequest(http.MethodGet, "/", nil)
+	r.Header.Set("Accept", "application/json;q=0.5, application/x-ndjson")
+
+	w := httptest.NewRecorder()
+
+	handler.WriteJSONList(w, r, http.StatusOK, items)
+
+	require.Equal(t, "application/x-ndjson", w.Header().Get("Content-Type"))
+
+	lines := strings.Split(strings.TrimSpace(w.Body.String()), "\n")
+	require.Len(t, lines, len(items))
+
+	for i, line := range lines {
+		var out map[string]int
+
+		require.NoError(t, json.Unmarshal([]byte(line), &out))
+		require.Equal(t, items[i], out)
+	}
+}
+
+// TestWriteSparseJSONList ensures items are trimmed down to the selected fields,
+// through arrays, and always retain their ID.
+func TestWriteSparseJSONList(t *testing.T) {
+	t.Parallel()
+
+	in := []map[string]any{
+		{
+			"metadata": map[string]any{"id": "foo", "name": "bar", "tags": []any{"baz"}},
+			"spec": map[string]any{
+				"pools": []any{
+					map[string]any{"name": "a", "replicas": 1},
+					map[string]any{"name": "b", "replicas": 2},
+				},
+			},
+			"status": map[string]any{"healthStatus": "healthy"},
+		},
+	}
+
+	fields := openapi.FieldsParameter{"metadata.name", "spec.pools.name", "status", "status.healthStatus", "metadata.name.invalid"}
+
+	w := httptest.NewRecorder()
+
+	handler.WriteSparseJSONList(w, httptest.NewRequest(http.MethodGet, "/", nil), http.StatusOK, in, &fields)
+
+	var out []map[string]any
+
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &out))
+	require.Equal(t, []map[string]any{
+		{
+			"metadata": map[string]any{"id": "foo", "name": "bar"},
+			"spec": map[string]any{
+				"pools": []any{
+					map[string]any{"name": "a"},
+					map[string]any{"name": "b"},
+				},
+			},
+			"status": map[string]any{"healthStatus": "healthy"},
+		},
+	}, out)
+}
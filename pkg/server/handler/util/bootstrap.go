@@ -0,0 +1,57 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"context"
+	"fmt"
+
+	unikornv1 "github.com/unikorn-cloud/compute/pkg/apis/unikorn/v1alpha1"
+	managerutil "github.com/unikorn-cloud/compute/pkg/provisioners/managers/cluster/util"
+	"github.com/unikorn-cloud/core/pkg/server/errors"
+	regionapi "github.com/unikorn-cloud/region/pkg/openapi"
+
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ValidateBootstrapProfile checks a bootstrap profile exists and that it has a
+// template that can be rendered for the selected flavor and image, so clients get
+// an error up front rather than a machine that never provisions.
+func ValidateBootstrapProfile(ctx context.Context, cli client.Client, namespace string, name *string, flavor *regionapi.Flavor, image *regionapi.Image) error {
+	if name == nil || *name == "" {
+		return nil
+	}
+
+	profile := &unikornv1.ComputeBootstrapProfile{}
+
+	if err := cli.Get(ctx, client.ObjectKey{Namespace: namespace, Name: *name}, profile); err != nil {
+		if kerrors.IsNotFound(err) {
+			return errors.HTTPUnprocessableContent("bootstrap profile", *name, "does not exist")
+		}
+
+		return fmt.Errorf("%w: unable to get bootstrap profile", err)
+	}
+
+	if _, err := managerutil.RenderBootstrap(profile, managerutil.NewBootstrapVariables(flavor, image)); err != nil {
+		return errors.HTTPUnprocessableContent("bootstrap profile", *name, "is not compatible with the selected flavor and image").WithError(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,146 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/spf13/pflag"
+
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// ConsistencyOptions control whether writes are visible to subsequent reads.
+type ConsistencyOptions struct {
+	// WriteSyncTimeout bounds how long a write waits to be observed by the
+	// resource cache before it is acknowledged.
+	WriteSyncTimeout time.Duration
+}
+
+// AddFlags adds the options flags to the given flag set.
+func (o *ConsistencyOptions) AddFlags(f *pflag.FlagSet) {
+	f.DurationVar(&o.WriteSyncTimeout, "write-sync-timeout", 5*time.Second, "How long writes wait to be observed by the resource cache, so they are visible to subsequent reads, zero disables.")
+}
+
+// readYourWritesClient wraps a caching client so that creates and updates are not
+// acknowledged until they are visible to reads from the cache.  Without this an
+// immediate read after a create can report the resource as not found.
+type readYourWritesClient struct {
+	client.Client
+
+	timeout time.Duration
+}
+
+// NewReadYourWritesClient returns a client with read your writes consistency, or
+// the client as is if disabled.
+func NewReadYourWritesClient(c client.Client, options *ConsistencyOptions) client.Client {
+	if options.WriteSyncTimeout == 0 {
+		return c
+	}
+
+	return &readYourWritesClient{
+		Client:  c,
+		timeout: options.WriteSyncTimeout,
+	}
+}
+
+func (c *readYourWritesClient) Create(ctx context.Context, obj client.Object, opts ...client.CreateOption) error {
+	if err := c.Client.Create(ctx, obj, opts...); err != nil {
+		return err
+	}
+
+	c.wait(ctx, obj)
+
+	return nil
+}
+
+func (c *readYourWritesClient) Update(ctx context.Context, obj client.Object, opts ...client.UpdateOption) error {
+	if err := c.Client.Update(ctx, obj, opts...); err != nil {
+		return err
+	}
+
+	c.wait(ctx, obj)
+
+	return nil
+}
+
+func (c *readYourWritesClient) Patch(ctx context.Context, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+	if err := c.Client.Patch(ctx, obj, patch, opts...); err != nil {
+		return err
+	}
+
+	c.wait(ctx, obj)
+
+	return nil
+}
+
+// wait polls the cache until the written resource version has been observed.  The
+// write has already succeeded, so this is best effort and a timeout is only logged.
+func (c *readYourWritesClient) wait(ctx context.Context, obj client.Object) {
+	key := client.ObjectKeyFromObject(obj)
+	resourceVersion := obj.GetResourceVersion()
+
+	cached, ok := obj.DeepCopyObject().(client.Object)
+	if !ok {
+		return
+	}
+
+	observed := func(ctx context.Context) (bool, error) {
+		if err := c.Client.Get(ctx, key, cached); err != nil {
+			if kerrors.IsNotFound(err) {
+				return false, nil
+			}
+
+			return false, err
+		}
+
+		return ResourceVersionObserved(resourceVersion, cached.GetResourceVersion()), nil
+	}
+
+	if err := wait.PollUntilContextTimeout(ctx, 10*time.Millisecond, c.timeout, true, observed); err != nil {
+		log.FromContext(ctx).Info("write not observed by cache", "key", key, "resourceVersion", resourceVersion, "error", err)
+	}
+}
+
+// ResourceVersionObserved tells us whether the cached resource version is at least the
+// written one.  Resource versions are opaque, but in practice are monotonic integers,
+// so a later version also means our write has been observed, e.g. a controller has
+// since updated the status.
+func ResourceVersionObserved(written, cached string) bool {
+	if written == cached {
+		return true
+	}
+
+	w, err := strconv.ParseUint(written, 10, 64)
+	if err != nil {
+		return false
+	}
+
+	c, err := strconv.ParseUint(cached, 10, 64)
+	if err != nil {
+		return false
+	}
+
+	return c >= w
+}
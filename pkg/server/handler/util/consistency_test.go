@@ -0,0 +1,103 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/unikorn-cloud/compute/pkg/server/handler/util"
+
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+)
+
+// laggingClient returns a fake client whose reads don't observe a resource until
+// it has been read the given number of times, emulating cache lag.
+func laggingClient(lag int, reads *int) client.Client {
+	return fake.NewClientBuilder().
+		WithInterceptorFuncs(interceptor.Funcs{
+			Get: func(ctx context.Context, c client.WithWatch, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+				*reads++
+
+				if *reads <= lag {
+					return kerrors.NewNotFound(corev1.Resource("configmaps"), key.Name)
+				}
+
+				return c.Get(ctx, key, obj, opts...)
+			},
+		}).
+		Build()
+}
+
+// TestReadYourWritesCreate ensures creates are only acknowledged once visible.
+func TestReadYourWritesCreate(t *testing.T) {
+	t.Parallel()
+
+	var reads int
+
+	cli := util.NewReadYourWritesClient(laggingClient(3, &reads), &util.ConsistencyOptions{WriteSyncTimeout: time.Second})
+
+	resource := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      "foo",
+		},
+	}
+
+	require.NoError(t, cli.Create(t.Context(), resource))
+	require.Equal(t, 4, reads)
+}
+
+// TestReadYourWritesTimeout ensures writes that are never observed still succeed.
+func TestReadYourWritesTimeout(t *testing.T) {
+	t.Parallel()
+
+	var reads int
+
+	cli := util.NewReadYourWritesClient(laggingClient(1000, &reads), &util.ConsistencyOptions{WriteSyncTimeout: 50 * time.Millisecond})
+
+	resource := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      "foo",
+		},
+	}
+
+	require.NoError(t, cli.Create(t.Context(), resource))
+	require.Positive(t, reads)
+}
+
+// TestResourceVersionObserved checks later resource versions count as observed.
+func TestResourceVersionObserved(t *testing.T) {
+	t.Parallel()
+
+	require.True(t, util.ResourceVersionObserved("10", "10"))
+	require.True(t, util.ResourceVersionObserved("10", "11"))
+	require.False(t, util.ResourceVersionObserved("10", "9"))
+	require.True(t, util.ResourceVersionObserved("abc", "abc"))
+	require.False(t, util.ResourceVersionObserved("abc", "def"))
+}
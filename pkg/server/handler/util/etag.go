@@ -0,0 +1,119 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/unikorn-cloud/compute/pkg/openapi"
+	"github.com/unikorn-cloud/compute/pkg/server/errorsv2"
+	coreapi "github.com/unikorn-cloud/core/pkg/openapi"
+	"github.com/unikorn-cloud/core/pkg/server/errors"
+
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+// ETag returns a strong entity tag for the resource version.
+func ETag(resourceVersion string) string {
+	return strconv.Quote(resourceVersion)
+}
+
+// SetETag adds an entity tag to the response so the client can guard subsequent
+// updates with an If-Match header.
+func SetETag(w http.ResponseWriter, resourceVersion string) {
+	w.Header().Set("ETag", ETag(resourceVersion))
+}
+
+// ContentETag returns a strong entity tag derived from the content of a response,
+// this is used for resources that have no resource version e.g. proxied region reads.
+func ContentETag(v any) (string, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return "", fmt.Errorf("%w: unable to marshal entity", err)
+	}
+
+	sum := sha256.Sum256(data)
+
+	return strconv.Quote(hex.EncodeToString(sum[:16])), nil
+}
+
+// NotModified returns true if the client's cached copy of a response, as identified
+// by the If-None-Match header, is still current.  As per RFC 9110 the header may be
+// a wildcard, or a list of entity tags, which are compared weakly.
+func NotModified(etag string, ifNoneMatch *string) bool {
+	if ifNoneMatch == nil {
+		return false
+	}
+
+	etag = strings.TrimPrefix(etag, "W/")
+
+	for tag := range strings.SplitSeq(*ifNoneMatch, ",") {
+		tag = strings.TrimSpace(tag)
+
+		if tag == "*" || strings.TrimPrefix(tag, "W/") == etag {
+			return true
+		}
+	}
+
+	return false
+}
+
+func preconditionFailed() *errorsv2.Error {
+	return errorsv2.New(openapi.ComputeResourceVersionConflict, errors.FromOpenAPIError(http.StatusPreconditionFailed, nil, &coreapi.Error{
+		Error:            coreapi.Conflict,
+		ErrorDescription: "resource has been modified since it was read",
+	}))
+}
+
+// CheckIfMatch rejects an update if the client read the resource at a different
+// resource version to the one just read by the server.  As per RFC 9110 the header
+// may be a wildcard, or a list of entity tags, weak tags never match.
+func CheckIfMatch(resourceVersion string, ifMatch *string) error {
+	if ifMatch == nil {
+		return nil
+	}
+
+	etag := ETag(resourceVersion)
+
+	for tag := range strings.SplitSeq(*ifMatch, ",") {
+		tag = strings.TrimSpace(tag)
+
+		if tag == "*" || tag == etag {
+			return nil
+		}
+	}
+
+	return preconditionFailed()
+}
+
+// PreconditionFailed translates an optimistic locking failure into a precondition
+// failure when the update was guarded by an If-Match header, as the resource must
+// have been modified since the client read it.  All other errors are returned as is.
+func PreconditionFailed(err error, ifMatch *string) error {
+	if ifMatch == nil || !kerrors.IsConflict(err) {
+		return err
+	}
+
+	return preconditionFailed().WithError(err)
+}
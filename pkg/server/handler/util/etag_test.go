@@ -0,0 +1,90 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/unikorn-cloud/compute/pkg/openapi"
+	"github.com/unikorn-cloud/compute/pkg/server/errorsv2"
+	"github.com/unikorn-cloud/compute/pkg/server/handler/util"
+
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/ptr"
+)
+
+// TestCheckIfMatch ensures updates are only allowed when the entity tag matches
+// the current resource version.
+func TestCheckIfMatch(t *testing.T) {
+	t.Parallel()
+
+	require.NoError(t, util.CheckIfMatch("10", nil))
+	require.NoError(t, util.CheckIfMatch("10", ptr.To(`"10"`)))
+	require.NoError(t, util.CheckIfMatch("10", ptr.To(`"9", "10"`)))
+	require.NoError(t, util.CheckIfMatch("10", ptr.To("*")))
+
+	for _, ifMatch := range []string{`"9"`, `W/"10"`, "10"} {
+		err := util.CheckIfMatch("10", ptr.To(ifMatch))
+		require.Error(t, err, ifMatch)
+
+		code, ok := errorsv2.CodeOf(err)
+		require.True(t, ok)
+		require.Equal(t, openapi.ComputeResourceVersionConflict, code)
+	}
+}
+
+// TestNotModified ensures cached responses are only revalidated when the entity
+// tag is current.
+func TestNotModified(t *testing.T) {
+	t.Parallel()
+
+	etag, err := util.ContentETag([]string{"foo"})
+	require.NoError(t, err)
+
+	other, err := util.ContentETag([]string{"bar"})
+	require.NoError(t, err)
+	require.NotEqual(t, etag, other)
+
+	require.False(t, util.NotModified(etag, nil))
+	require.True(t, util.NotModified(etag, ptr.To(etag)))
+	require.True(t, util.NotModified(etag, ptr.To(other+", "+etag)))
+	require.True(t, util.NotModified(etag, ptr.To("W/"+etag)))
+	require.True(t, util.NotModified(etag, ptr.To("*")))
+	require.False(t, util.NotModified(etag, ptr.To(other)))
+}
+
+// TestPreconditionFailed ensures optimistic locking failures are only translated
+// when the update was guarded by an entity tag.
+func TestPreconditionFailed(t *testing.T) {
+	t.Parallel()
+
+	conflict := kerrors.NewConflict(schema.GroupResource{}, "test", errors.New("modified"))
+
+	_, ok := errorsv2.CodeOf(util.PreconditionFailed(conflict, nil))
+	require.False(t, ok)
+
+	_, ok = errorsv2.CodeOf(util.PreconditionFailed(conflict, ptr.To(`"10"`)))
+	require.True(t, ok)
+
+	other := errors.New("other")
+	require.Equal(t, other, util.PreconditionFailed(other, ptr.To(`"10"`)))
+}
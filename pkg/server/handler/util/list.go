@@ -0,0 +1,91 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+
+	"github.com/unikorn-cloud/compute/pkg/openapi"
+	coreconstants "github.com/unikorn-cloud/core/pkg/constants"
+	coreapi "github.com/unikorn-cloud/core/pkg/openapi"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// MatchName returns whether the resource's name contains the query, ignoring case.
+// Label selectors cannot match substrings, so this is applied to the listed
+// resources before the more expensive conversion to API types.
+func MatchName(resource metav1.Object, query *openapi.NameQueryParameter) bool {
+	if query == nil {
+		return true
+	}
+
+	return strings.Contains(strings.ToLower(resource.GetLabels()[coreconstants.NameLabel]), strings.ToLower(*query))
+}
+
+// MatchCreatedAfter returns whether the resource was created after the query.
+func MatchCreatedAfter(resource metav1.Object, query *openapi.CreatedAfterQueryParameter) bool {
+	if query == nil {
+		return true
+	}
+
+	return resource.GetCreationTimestamp().After(*query)
+}
+
+// MatchProvisioningStatus returns whether the resource has one of the queried
+// provisioning statuses, these are derived from status conditions so can only be
+// checked once converted.
+func MatchProvisioningStatus(metadata *coreapi.ProjectScopedResourceReadMetadata, query *openapi.ProvisioningStatusQueryParameter) bool {
+	return query == nil || slices.Contains(*query, metadata.ProvisioningStatus)
+}
+
+// SortList orders converted resources as requested.  The sort is stable, so
+// resources that compare equal retain their existing order.
+func SortList[T any](items []T, sort *openapi.SortQueryParameter, metadata func(*T) *coreapi.ProjectScopedResourceReadMetadata) {
+	if sort == nil {
+		return
+	}
+
+	var compare func(a, b *coreapi.ProjectScopedResourceReadMetadata) int
+
+	switch *sort {
+	case openapi.ListSortName:
+		compare = func(a, b *coreapi.ProjectScopedResourceReadMetadata) int {
+			return cmp.Compare(a.Name, b.Name)
+		}
+	case openapi.ListSortCreated:
+		compare = func(a, b *coreapi.ProjectScopedResourceReadMetadata) int {
+			return a.CreationTime.Compare(b.CreationTime)
+		}
+	case openapi.ListSortStatus:
+		compare = func(a, b *coreapi.ProjectScopedResourceReadMetadata) int {
+			return cmp.Or(
+				cmp.Compare(a.ProvisioningStatus, b.ProvisioningStatus),
+				cmp.Compare(a.Name, b.Name),
+			)
+		}
+	default:
+		return
+	}
+
+	slices.SortStableFunc(items, func(a, b T) int {
+		return compare(metadata(&a), metadata(&b))
+	})
+}
@@ -0,0 +1,99 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/unikorn-cloud/compute/pkg/openapi"
+	"github.com/unikorn-cloud/compute/pkg/server/handler/util"
+	coreconstants "github.com/unikorn-cloud/core/pkg/constants"
+	coreapi "github.com/unikorn-cloud/core/pkg/openapi"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+// TestMatch ensures name and creation time filters are applied.
+func TestMatch(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+
+	resource := &metav1.ObjectMeta{
+		Labels: map[string]string{
+			coreconstants.NameLabel: "My-Cluster",
+		},
+		CreationTimestamp: metav1.NewTime(now),
+	}
+
+	require.True(t, util.MatchName(resource, nil))
+	require.True(t, util.MatchName(resource, ptr.To("my-c")))
+	require.False(t, util.MatchName(resource, ptr.To("instance")))
+
+	require.True(t, util.MatchCreatedAfter(resource, nil))
+	require.True(t, util.MatchCreatedAfter(resource, ptr.To(now.Add(-time.Hour))))
+	require.False(t, util.MatchCreatedAfter(resource, ptr.To(now.Add(time.Hour))))
+}
+
+// TestSortList ensures lists are ordered as requested, and left alone by default.
+func TestSortList(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+
+	in := []coreapi.ProjectScopedResourceReadMetadata{
+		{Id: "a", Name: "foo", CreationTime: now, ProvisioningStatus: coreapi.ResourceProvisioningStatusProvisioned},
+		{Id: "b", Name: "bar", CreationTime: now.Add(time.Hour), ProvisioningStatus: coreapi.ResourceProvisioningStatusError},
+		{Id: "c", Name: "baz", CreationTime: now.Add(-time.Hour), ProvisioningStatus: coreapi.ResourceProvisioningStatusProvisioned},
+	}
+
+	metadata := func(resource *coreapi.ProjectScopedResourceReadMetadata) *coreapi.ProjectScopedResourceReadMetadata {
+		return resource
+	}
+
+	ids := func(items []coreapi.ProjectScopedResourceReadMetadata) []string {
+		out := make([]string, len(items))
+
+		for i := range items {
+			out[i] = items[i].Id
+		}
+
+		return out
+	}
+
+	tests := []struct {
+		sort     *openapi.SortQueryParameter
+		expected []string
+	}{
+		{nil, []string{"a", "b", "c"}},
+		{ptr.To(openapi.ListSortName), []string{"b", "c", "a"}},
+		{ptr.To(openapi.ListSortCreated), []string{"c", "a", "b"}},
+		{ptr.To(openapi.ListSortStatus), []string{"b", "c", "a"}},
+	}
+
+	for _, test := range tests {
+		items := append([]coreapi.ProjectScopedResourceReadMetadata{}, in...)
+
+		util.SortList(items, test.sort, metadata)
+		require.Equal(t, test.expected, ids(items))
+	}
+}
@@ -0,0 +1,63 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"context"
+
+	"github.com/spf13/pflag"
+
+	"github.com/unikorn-cloud/core/pkg/server/errors"
+	identityapi "github.com/unikorn-cloud/identity/pkg/openapi"
+	"github.com/unikorn-cloud/identity/pkg/rbac"
+)
+
+// AccessOptions control how authorization failures are reported when reading
+// individual resources.
+type AccessOptions struct {
+	// HideForbidden reports resources the caller is not permitted to read as not
+	// found, rather than forbidden, so their existence is not leaked.
+	HideForbidden bool
+}
+
+// AddFlags adds the options flags to the given flag set.
+func (o *AccessOptions) AddFlags(f *pflag.FlagSet) {
+	f.BoolVar(&o.HideForbidden, "hide-forbidden-resources", false, "Report resources the caller is not permitted to read as not found rather than forbidden.")
+}
+
+// filter translates forbidden errors into not found errors if configured to do so.
+// A nil receiver preserves the error as is.
+func (o *AccessOptions) filter(err error) error {
+	if err == nil || o == nil || !o.HideForbidden || !errors.IsForbidden(err) {
+		return err
+	}
+
+	return errors.HTTPNotFound().WithError(err)
+}
+
+// AllowProjectScopeRead checks the caller can read a project scoped resource.
+// This must be used for all resource reads, and for any lookups by ID that
+// precede a modification, so that unauthorized access is handled consistently.
+func AllowProjectScopeRead(ctx context.Context, options *AccessOptions, endpoint, organizationID, projectID string) error {
+	return options.filter(rbac.AllowProjectScope(ctx, endpoint, identityapi.Read, organizationID, projectID))
+}
+
+// AllowGlobalScopeRead checks the caller can read a globally scoped resource.
+func AllowGlobalScopeRead(ctx context.Context, options *AccessOptions, endpoint string) error {
+	return options.filter(rbac.AllowGlobalScope(ctx, endpoint, identityapi.Read))
+}
@@ -0,0 +1,60 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/unikorn-cloud/compute/pkg/server/handler/util"
+	"github.com/unikorn-cloud/core/pkg/server/errors"
+	identityapi "github.com/unikorn-cloud/identity/pkg/openapi"
+	"github.com/unikorn-cloud/identity/pkg/rbac"
+)
+
+// TestReadForbidden ensures unauthorized reads are reported as forbidden by default.
+func TestReadForbidden(t *testing.T) {
+	t.Parallel()
+
+	ctx := rbac.NewContext(t.Context(), &identityapi.Acl{})
+
+	err := util.AllowProjectScopeRead(ctx, nil, "compute:clusters", "foo", "bar")
+	require.True(t, errors.IsForbidden(err), "expected forbidden, got: %v", err)
+
+	err = util.AllowProjectScopeRead(ctx, &util.AccessOptions{}, "compute:clusters", "foo", "bar")
+	require.True(t, errors.IsForbidden(err), "expected forbidden, got: %v", err)
+}
+
+// TestReadHidden ensures unauthorized reads are reported as not found when
+// configured to hide resource existence.
+func TestReadHidden(t *testing.T) {
+	t.Parallel()
+
+	ctx := rbac.NewContext(t.Context(), &identityapi.Acl{})
+
+	options := &util.AccessOptions{
+		HideForbidden: true,
+	}
+
+	err := util.AllowProjectScopeRead(ctx, options, "compute:clusters", "foo", "bar")
+	require.True(t, errors.IsHTTPNotFound(err), "expected not found, got: %v", err)
+
+	err = util.AllowGlobalScopeRead(ctx, options, "compute:maintenancewindows")
+	require.True(t, errors.IsHTTPNotFound(err), "expected not found, got: %v", err)
+}
@@ -0,0 +1,89 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"context"
+	"fmt"
+
+	unikornv1 "github.com/unikorn-cloud/compute/pkg/apis/unikorn/v1alpha1"
+	unikornv1core "github.com/unikorn-cloud/core/pkg/apis/unikorn/v1alpha1"
+
+	"k8s.io/apimachinery/pkg/fields"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// TagIndexField is the field used to index resources by tag, the cache will
+// then return only resources with the requested tags, rather than having to
+// list every resource in the namespace and filter them.
+const TagIndexField = "spec.tags"
+
+// tagIndexValue is the indexed form of a tag, tag names cannot contain an "="
+// as they could never be selected.
+func tagIndexValue(tag unikornv1core.Tag) string {
+	return tag.Name + "=" + tag.Value
+}
+
+// IndexTags returns the index values for a resource's tags.
+func IndexTags(object client.Object) []string {
+	var tags unikornv1core.TagList
+
+	switch t := object.(type) {
+	case *unikornv1.ComputeCluster:
+		tags = t.Spec.Tags
+	case *unikornv1.ComputeInstance:
+		tags = t.Spec.Tags
+	}
+
+	out := make([]string, len(tags))
+
+	for i, tag := range tags {
+		out[i] = tagIndexValue(tag)
+	}
+
+	return out
+}
+
+// AddTagIndexes registers the tag index for all resources that can be filtered
+// by tag.  This must be done before the cache is started.
+func AddTagIndexes(ctx context.Context, indexer client.FieldIndexer) error {
+	for _, object := range []client.Object{&unikornv1.ComputeCluster{}, &unikornv1.ComputeInstance{}} {
+		if err := indexer.IndexField(ctx, object, TagIndexField, IndexTags); err != nil {
+			return fmt.Errorf("%w: unable to index %T tags", err, object)
+		}
+	}
+
+	return nil
+}
+
+// TagSelector returns a field selector that matches resources with all the tags,
+// or nil if there are none, which will list everything.
+func TagSelector(tags unikornv1core.TagList) fields.Selector {
+	if len(tags) == 0 {
+		return nil
+	}
+
+	selectors := make([]fields.Selector, len(tags))
+
+	for i, tag := range tags {
+		selectors[i] = fields.OneTermEqualSelector(TagIndexField, tagIndexValue(tag))
+	}
+
+	return fields.AndSelectors(selectors...)
+}
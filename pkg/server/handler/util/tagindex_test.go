@@ -0,0 +1,92 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	unikornv1 "github.com/unikorn-cloud/compute/pkg/apis/unikorn/v1alpha1"
+	"github.com/unikorn-cloud/compute/pkg/server/handler/util"
+	unikornv1core "github.com/unikorn-cloud/core/pkg/apis/unikorn/v1alpha1"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func taggedInstance(name string, tags ...unikornv1core.Tag) *unikornv1.ComputeInstance {
+	return &unikornv1.ComputeInstance{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      name,
+		},
+		Spec: unikornv1.ComputeInstanceSpec{
+			Tags: tags,
+		},
+	}
+}
+
+// TestTagSelector ensures only resources with all the selected tags are listed
+// via the index.
+func TestTagSelector(t *testing.T) {
+	t.Parallel()
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, unikornv1.AddToScheme(scheme))
+
+	foo := unikornv1core.Tag{Name: "foo", Value: "a"}
+	bar := unikornv1core.Tag{Name: "bar", Value: "b=c"}
+
+	cli := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithIndex(&unikornv1.ComputeInstance{}, util.TagIndexField, util.IndexTags).
+		WithObjects(
+			taggedInstance("none"),
+			taggedInstance("foo", foo),
+			taggedInstance("both", foo, bar),
+		).
+		Build()
+
+	tests := []struct {
+		tags     unikornv1core.TagList
+		expected []string
+	}{
+		{nil, []string{"both", "foo", "none"}},
+		{unikornv1core.TagList{foo}, []string{"both", "foo"}},
+		{unikornv1core.TagList{foo, bar}, []string{"both"}},
+		{unikornv1core.TagList{{Name: "foo", Value: "b"}}, nil},
+	}
+
+	for _, test := range tests {
+		result := &unikornv1.ComputeInstanceList{}
+
+		require.NoError(t, cli.List(t.Context(), result, &client.ListOptions{FieldSelector: util.TagSelector(test.tags)}))
+
+		var names []string
+
+		for i := range result.Items {
+			names = append(names, result.Items[i].Name)
+		}
+
+		require.Equal(t, test.expected, names)
+	}
+}
@@ -0,0 +1,147 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"cmp"
+	"fmt"
+	"net/http"
+	"slices"
+
+	"github.com/unikorn-cloud/compute/pkg/constants"
+	"github.com/unikorn-cloud/compute/pkg/openapi"
+	"github.com/unikorn-cloud/compute/pkg/server/errorsv2"
+	unikornv1core "github.com/unikorn-cloud/core/pkg/apis/unikorn/v1alpha1"
+	coreapi "github.com/unikorn-cloud/core/pkg/openapi"
+	"github.com/unikorn-cloud/core/pkg/server/conversion"
+	"github.com/unikorn-cloud/core/pkg/server/errors"
+
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+// GenerateTagList converts requested tags into their stored form.  System tags
+// are reserved for use by the platform, so users may not add or modify them, but
+// may echo back any that already exist on the resource.  Existing system tags are
+// always preserved, as clients may omit them when updating a resource.  If the
+// tags are unchanged, other than their order, the current tags are returned so
+// that updates do not generate spurious changes.
+func GenerateTagList(in *coreapi.TagList, current unikornv1core.TagList) (unikornv1core.TagList, error) {
+	out := conversion.GenerateTagList(in)
+
+	for _, tag := range out {
+		if constants.IsSystemTag(tag.Name) && !slices.Contains(current, tag) {
+			return nil, errorsv2.InvalidRequest(openapi.ComputeResourceReservedTag, fmt.Sprintf("tag %s is reserved for system use", tag.Name))
+		}
+	}
+
+	for _, tag := range current {
+		if constants.IsSystemTag(tag.Name) && !slices.Contains(out, tag) {
+			out = append(out, tag)
+		}
+	}
+
+	if equalUnordered(out, current) {
+		return slices.Clone(current), nil
+	}
+
+	return out, nil
+}
+
+// equalUnordered checks whether the tag lists contain the same tags, regardless
+// of order.
+func equalUnordered(a, b unikornv1core.TagList) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	compare := func(a, b unikornv1core.Tag) int {
+		return cmp.Or(cmp.Compare(a.Name, b.Name), cmp.Compare(a.Value, b.Value))
+	}
+
+	a = slices.Clone(a)
+	b = slices.Clone(b)
+
+	slices.SortFunc(a, compare)
+	slices.SortFunc(b, compare)
+
+	return slices.Equal(a, b)
+}
+
+// PatchTagList applies a set of tag modifications to the current tags.  Tags are
+// set, replacing any existing tag with the same name, before any are removed.  As
+// with GenerateTagList, system tags may not be modified by users.
+func PatchTagList(current unikornv1core.TagList, set *coreapi.TagList, remove []string) (unikornv1core.TagList, error) {
+	out := slices.Clone(current)
+
+	for _, tag := range conversion.GenerateTagList(set) {
+		if constants.IsSystemTag(tag.Name) && !slices.Contains(current, tag) {
+			return nil, errorsv2.InvalidRequest(openapi.ComputeResourceReservedTag, fmt.Sprintf("tag %s is reserved for system use", tag.Name))
+		}
+
+		index := slices.IndexFunc(out, func(t unikornv1core.Tag) bool {
+			return t.Name == tag.Name
+		})
+
+		if index < 0 {
+			out = append(out, tag)
+			continue
+		}
+
+		out[index] = tag
+	}
+
+	for _, name := range remove {
+		if constants.IsSystemTag(name) {
+			return nil, errorsv2.InvalidRequest(openapi.ComputeResourceReservedTag, fmt.Sprintf("tag %s is reserved for system use", name))
+		}
+	}
+
+	out = slices.DeleteFunc(out, func(t unikornv1core.Tag) bool {
+		return slices.Contains(remove, t.Name)
+	})
+
+	return out, nil
+}
+
+func tagsConflict() *errorsv2.Error {
+	return errorsv2.New(openapi.ComputeResourceVersionConflict, errors.FromOpenAPIError(http.StatusConflict, nil, &coreapi.Error{
+		Error:            coreapi.Conflict,
+		ErrorDescription: "resource has been modified since the tags were read",
+	}))
+}
+
+// CheckTagsVersion rejects a tag patch if the client read the tags at a different
+// resource version to the one just read by the server.
+func CheckTagsVersion(current string, requested *string) error {
+	if requested != nil && *requested != current {
+		return tagsConflict()
+	}
+
+	return nil
+}
+
+// TagsConflict translates an optimistic locking failure when patching tags into
+// a conflict error, so the client knows to re-read the tags and retry.  All other
+// errors are returned as is.
+func TagsConflict(err error) error {
+	if !kerrors.IsConflict(err) {
+		return err
+	}
+
+	return tagsConflict().WithError(err)
+}
@@ -0,0 +1,156 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/unikorn-cloud/compute/pkg/constants"
+	"github.com/unikorn-cloud/compute/pkg/openapi"
+	"github.com/unikorn-cloud/compute/pkg/server/errorsv2"
+	"github.com/unikorn-cloud/compute/pkg/server/handler/util"
+	unikornv1core "github.com/unikorn-cloud/core/pkg/apis/unikorn/v1alpha1"
+	coreapi "github.com/unikorn-cloud/core/pkg/openapi"
+)
+
+// TestGenerateTagListReserved ensures users cannot add system tags.
+func TestGenerateTagListReserved(t *testing.T) {
+	t.Parallel()
+
+	for _, name := range []string{constants.InstanceIDTag, constants.PlatformTagPrefix + "workloadpool"} {
+		in := coreapi.TagList{
+			{Name: name, Value: "foo"},
+		}
+
+		_, err := util.GenerateTagList(&in, nil)
+		require.Error(t, err)
+
+		code, ok := errorsv2.CodeOf(err)
+		require.True(t, ok)
+		require.Equal(t, openapi.ComputeResourceReservedTag, code)
+	}
+}
+
+// TestGenerateTagListModified ensures users cannot modify existing system tags,
+// but can echo them back unchanged.
+func TestGenerateTagListModified(t *testing.T) {
+	t.Parallel()
+
+	current := unikornv1core.TagList{
+		{Name: constants.InstanceIDTag, Value: "foo"},
+	}
+
+	in := coreapi.TagList{
+		{Name: constants.InstanceIDTag, Value: "bar"},
+	}
+
+	_, err := util.GenerateTagList(&in, current)
+	require.Error(t, err)
+
+	in[0].Value = "foo"
+
+	out, err := util.GenerateTagList(&in, current)
+	require.NoError(t, err)
+	require.Equal(t, current, out)
+}
+
+// TestGenerateTagListPreserved ensures system tags are retained when omitted
+// from an update.
+func TestGenerateTagListPreserved(t *testing.T) {
+	t.Parallel()
+
+	current := unikornv1core.TagList{
+		{Name: "user", Value: "old"},
+		{Name: constants.InstanceIDTag, Value: "foo"},
+	}
+
+	in := coreapi.TagList{
+		{Name: "user", Value: "new"},
+	}
+
+	out, err := util.GenerateTagList(&in, current)
+	require.NoError(t, err)
+
+	expected := unikornv1core.TagList{
+		{Name: "user", Value: "new"},
+		{Name: constants.InstanceIDTag, Value: "foo"},
+	}
+
+	require.Equal(t, expected, out)
+}
+
+// TestGenerateTagListReordered ensures reordering tags doesn't result in a change.
+func TestGenerateTagListReordered(t *testing.T) {
+	t.Parallel()
+
+	current := unikornv1core.TagList{
+		{Name: "foo", Value: "1"},
+		{Name: "bar", Value: "2"},
+		{Name: constants.InstanceIDTag, Value: "baz"},
+	}
+
+	in := coreapi.TagList{
+		{Name: "bar", Value: "2"},
+		{Name: "foo", Value: "1"},
+	}
+
+	out, err := util.GenerateTagList(&in, current)
+	require.NoError(t, err)
+	require.Equal(t, current, out)
+}
+
+// TestPatchTagList ensures tags are replaced by name, added and removed, and that
+// system tags cannot be modified.
+func TestPatchTagList(t *testing.T) {
+	t.Parallel()
+
+	current := unikornv1core.TagList{
+		{Name: "foo", Value: "1"},
+		{Name: "bar", Value: "2"},
+		{Name: constants.InstanceIDTag, Value: "baz"},
+	}
+
+	set := coreapi.TagList{
+		{Name: "foo", Value: "3"},
+		{Name: "qux", Value: "4"},
+	}
+
+	out, err := util.PatchTagList(current, &set, []string{"bar", "missing"})
+	require.NoError(t, err)
+
+	expected := unikornv1core.TagList{
+		{Name: "foo", Value: "3"},
+		{Name: constants.InstanceIDTag, Value: "baz"},
+		{Name: "qux", Value: "4"},
+	}
+
+	require.Equal(t, expected, out)
+	require.Equal(t, "2", current[1].Value)
+
+	_, err = util.PatchTagList(current, nil, []string{constants.InstanceIDTag})
+	require.Error(t, err)
+
+	set = coreapi.TagList{
+		{Name: constants.InstanceIDTag, Value: "other"},
+	}
+
+	_, err = util.PatchTagList(current, &set, nil)
+	require.Error(t, err)
+}
@@ -0,0 +1,147 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package handler
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/unikorn-cloud/compute/pkg/server/errorsv2"
+	"github.com/unikorn-cloud/core/pkg/server/errors"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+const (
+	// watchPollPeriod is how often a watched resource is checked for changes.
+	// Reads are served from the client cache so this is cheap.
+	watchPollPeriod = 2 * time.Second
+
+	// watchKeepalivePeriod is how often a comment is sent on an idle stream
+	// to stop intermediate proxies from closing the connection.
+	watchKeepalivePeriod = 15 * time.Second
+)
+
+// eventWriter writes an event to a stream, returning false if the stream is broken.
+type eventWriter func(format string, a ...any) bool
+
+// startEventStream starts a Server-Sent Events stream, returning a function
+// that writes and flushes events.  Once started errors can only be logged, the
+// client will see the stream close.
+func startEventStream(w http.ResponseWriter, r *http.Request) eventWriter {
+	log := log.FromContext(r.Context())
+
+	controller := http.NewResponseController(w)
+
+	w.Header().Add("Content-Type", "text/event-stream")
+	w.WriteHeader(http.StatusOK)
+
+	return func(format string, a ...any) bool {
+		if _, err := fmt.Fprintf(w, format, a...); err != nil {
+			log.Error(err, "failed to write event")
+			return false
+		}
+
+		if err := controller.Flush(); err != nil {
+			log.Error(err, "failed to flush event")
+			return false
+		}
+
+		return true
+	}
+}
+
+// watchGetter reads the current state of a watched resource.
+type watchGetter func(ctx context.Context) (any, error)
+
+// watch streams a resource to the client using Server-Sent Events.  The initial
+// state is sent immediately, then again whenever its serialized form changes.
+// When the resource is deleted a final "deleted" event is sent containing its
+// last known state.  The stream ends when the request context is done, which
+// will usually be due to the request timeout, and clients are expected to
+// reconnect as EventSource does.
+func watch(w http.ResponseWriter, r *http.Request, event string, initial any, get watchGetter) {
+	ctx := r.Context()
+
+	log := log.FromContext(ctx)
+
+	last, err := json.Marshal(initial)
+	if err != nil {
+		errorsv2.HandleError(w, r, err)
+		return
+	}
+
+	write := startEventStream(w, r)
+
+	if !write("event: %s\ndata: %s\n\n", event, last) {
+		return
+	}
+
+	poll := time.NewTicker(watchPollPeriod)
+	defer poll.Stop()
+
+	keepalive := time.NewTicker(watchKeepalivePeriod)
+	defer keepalive.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-keepalive.C:
+			if !write(": keepalive\n\n") {
+				return
+			}
+		case <-poll.C:
+			current, err := get(ctx)
+			if err != nil {
+				if errors.IsHTTPNotFound(err) {
+					write("event: deleted\ndata: %s\n\n", last)
+					return
+				}
+
+				if ctx.Err() == nil {
+					log.Error(err, "failed to read watched resource")
+				}
+
+				return
+			}
+
+			data, err := json.Marshal(current)
+			if err != nil {
+				log.Error(err, "failed to marshal watched resource")
+				return
+			}
+
+			if bytes.Equal(data, last) {
+				continue
+			}
+
+			if !write("event: %s\ndata: %s\n\n", event, data) {
+				return
+			}
+
+			last = data
+
+			keepalive.Reset(watchKeepalivePeriod)
+		}
+	}
+}
@@ -0,0 +1,47 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package handler_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/unikorn-cloud/compute/pkg/server/handler"
+	"github.com/unikorn-cloud/core/pkg/server/errors"
+)
+
+// TestWatchDeleted ensures the initial state is sent and the stream closed with
+// a deleted event when the resource goes away.
+func TestWatchDeleted(t *testing.T) {
+	t.Parallel()
+
+	get := func(context.Context) (any, error) {
+		return nil, errors.HTTPNotFound()
+	}
+
+	w := httptest.NewRecorder()
+
+	handler.Watch(w, httptest.NewRequest(http.MethodGet, "/", nil), "thing", map[string]int{"a": 1}, get)
+
+	require.Equal(t, "text/event-stream", w.Header().Get("Content-Type"))
+	require.Equal(t, "event: thing\ndata: {\"a\":1}\n\nevent: deleted\ndata: {\"a\":1}\n\n", w.Body.String())
+}
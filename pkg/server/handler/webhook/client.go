@@ -0,0 +1,335 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+
+	computev1 "github.com/unikorn-cloud/compute/pkg/apis/unikorn/v1alpha1"
+	computeapi "github.com/unikorn-cloud/compute/pkg/openapi"
+	"github.com/unikorn-cloud/compute/pkg/provisioners/notifications"
+	"github.com/unikorn-cloud/compute/pkg/server/errorsv2"
+	"github.com/unikorn-cloud/compute/pkg/server/handler/util"
+	corev1 "github.com/unikorn-cloud/core/pkg/apis/unikorn/v1alpha1"
+	coreconstants "github.com/unikorn-cloud/core/pkg/constants"
+	"github.com/unikorn-cloud/core/pkg/server/conversion"
+	"github.com/unikorn-cloud/core/pkg/server/errors"
+	"github.com/unikorn-cloud/identity/pkg/handler/common"
+	identityapi "github.com/unikorn-cloud/identity/pkg/openapi"
+	"github.com/unikorn-cloud/identity/pkg/rbac"
+
+	kubernetescorev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/utils/ptr"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+// Client wraps up webhook registration.  Webhooks are owned by an organization,
+// and receive lifecycle events for all resources within it.
+type Client struct {
+	// client allows Compute API access.
+	client client.Client
+	// namespace the controller runs in.
+	namespace string
+}
+
+// NewClient returns a new client with required parameters.
+func NewClient(client client.Client, namespace string) *Client {
+	return &Client{
+		client:    client,
+		namespace: namespace,
+	}
+}
+
+func convertEvents(in []computev1.WebhookEventType) *[]computeapi.WebhookEventType {
+	if len(in) == 0 {
+		return nil
+	}
+
+	out := make([]computeapi.WebhookEventType, len(in))
+
+	for i := range in {
+		out[i] = computeapi.WebhookEventType(in[i])
+	}
+
+	return &out
+}
+
+func convertFailedDeliveries(in []computev1.WebhookDelivery) *computeapi.WebhookDeliveryList {
+	if len(in) == 0 {
+		return nil
+	}
+
+	out := make(computeapi.WebhookDeliveryList, len(in))
+
+	for i := range in {
+		out[i] = computeapi.WebhookDelivery{
+			EventId:   in[i].EventID,
+			EventType: computeapi.WebhookEventType(in[i].EventType),
+			Time:      in[i].Time.Time,
+			Attempts:  in[i].Attempts,
+			Error:     in[i].Error,
+			Payload:   in[i].Payload,
+		}
+	}
+
+	return &out
+}
+
+func convert(in *computev1.ComputeWebhook) *computeapi.WebhookRead {
+	return &computeapi.WebhookRead{
+		Metadata: conversion.OrganizationScopedResourceReadMetadata(in, in.Spec.Tags),
+		Spec: computeapi.WebhookSpec{
+			Url:    in.Spec.URL,
+			Events: convertEvents(in.Spec.Events),
+		},
+		Status: computeapi.WebhookStatus{
+			FailedDeliveries: convertFailedDeliveries(in.Status.FailedDeliveries),
+		},
+	}
+}
+
+func convertList(in *computev1.ComputeWebhookList) computeapi.WebhookReadList {
+	out := make(computeapi.WebhookReadList, len(in.Items))
+
+	for i := range in.Items {
+		out[i] = *convert(&in.Items[i])
+	}
+
+	return out
+}
+
+func generateEvents(in *[]computeapi.WebhookEventType) []computev1.WebhookEventType {
+	if in == nil {
+		return nil
+	}
+
+	out := make([]computev1.WebhookEventType, len(*in))
+
+	for i, event := range *in {
+		out[i] = computev1.WebhookEventType(event)
+	}
+
+	return out
+}
+
+func (c *Client) generate(ctx context.Context, organizationID string, in *computeapi.WebhookWrite, currentTags corev1.TagList) (*computev1.ComputeWebhook, error) {
+	// This is checked again on delivery, as host names may resolve differently
+	// by then, but catch what we can so the client gets a meaningful error.
+	if err := notifications.ValidateURL(in.Spec.Url); err != nil {
+		return nil, errorsv2.InvalidRequest(computeapi.ComputeWebhookInvalidUrl, err.Error()).WithError(err)
+	}
+
+	tags, err := util.GenerateTagList(in.Metadata.Tags, currentTags)
+	if err != nil {
+		return nil, err
+	}
+
+	out := &computev1.ComputeWebhook{
+		ObjectMeta: conversion.NewObjectMetadata(&in.Metadata, c.namespace).WithOrganization(organizationID).Get(),
+		Spec: computev1.ComputeWebhookSpec{
+			Tags:   tags,
+			URL:    in.Spec.Url,
+			Events: generateEvents(in.Spec.Events),
+		},
+	}
+
+	if err := common.SetIdentityMetadata(ctx, &out.ObjectMeta); err != nil {
+		return nil, fmt.Errorf("%w: failed to set identity metadata", err)
+	}
+
+	return out, nil
+}
+
+// List returns all webhooks registered for the organization.
+func (c *Client) List(ctx context.Context, organizationID string) (computeapi.WebhookReadList, error) {
+	if err := rbac.AllowOrganizationScope(ctx, "compute:webhooks", identityapi.Read, organizationID); err != nil {
+		return nil, err
+	}
+
+	result := &computev1.ComputeWebhookList{}
+
+	options := &client.ListOptions{
+		Namespace: c.namespace,
+		LabelSelector: labels.SelectorFromSet(map[string]string{
+			coreconstants.OrganizationLabel: organizationID,
+		}),
+	}
+
+	if err := c.client.List(ctx, result, options); err != nil {
+		return nil, fmt.Errorf("%w: unable to list webhooks", err)
+	}
+
+	slices.SortStableFunc(result.Items, func(a, b computev1.ComputeWebhook) int {
+		return cmp.Compare(a.Name, b.Name)
+	})
+
+	return convertList(result), nil
+}
+
+func (c *Client) getRaw(ctx context.Context, organizationID, webhookID string) (*computev1.ComputeWebhook, error) {
+	result := &computev1.ComputeWebhook{}
+
+	if err := c.client.Get(ctx, client.ObjectKey{Namespace: c.namespace, Name: webhookID}, result); err != nil {
+		if kerrors.IsNotFound(err) {
+			return nil, errors.HTTPNotFound().WithError(err)
+		}
+
+		return nil, fmt.Errorf("%w: unable to lookup webhook", err)
+	}
+
+	// Don't leak the existence of other organizations' webhooks.
+	if result.Labels[coreconstants.OrganizationLabel] != organizationID {
+		return nil, errors.HTTPNotFound()
+	}
+
+	return result, nil
+}
+
+// Get returns a webhook.
+func (c *Client) Get(ctx context.Context, organizationID, webhookID string) (*computeapi.WebhookRead, error) {
+	if err := rbac.AllowOrganizationScope(ctx, "compute:webhooks", identityapi.Read, organizationID); err != nil {
+		return nil, err
+	}
+
+	result, err := c.getRaw(ctx, organizationID, webhookID)
+	if err != nil {
+		return nil, err
+	}
+
+	return convert(result), nil
+}
+
+// applySecret creates or updates the secret used to sign events, this is owned
+// by the webhook so is deleted along with it.
+func (c *Client) applySecret(ctx context.Context, webhook *computev1.ComputeWebhook, secret string) error {
+	resource := &kubernetescorev1.Secret{}
+	resource.Namespace = webhook.Namespace
+	resource.Name = webhook.Name
+
+	mutate := func() error {
+		resource.Data = map[string][]byte{
+			notifications.SecretKey: []byte(secret),
+		}
+
+		return controllerutil.SetOwnerReference(webhook, resource, c.client.Scheme())
+	}
+
+	if _, err := controllerutil.CreateOrUpdate(ctx, c.client, resource, mutate); err != nil {
+		return fmt.Errorf("%w: unable to apply webhook secret", err)
+	}
+
+	return nil
+}
+
+// Create registers a new webhook.
+func (c *Client) Create(ctx context.Context, organizationID string, request *computeapi.WebhookWrite) (*computeapi.WebhookRead, error) {
+	if err := rbac.AllowOrganizationScope(ctx, "compute:webhooks", identityapi.Create, organizationID); err != nil {
+		return nil, err
+	}
+
+	secret := ptr.Deref(request.Secret, "")
+	if secret == "" {
+		return nil, errorsv2.InvalidRequest(computeapi.ComputeWebhookInvalidSecret, "webhook secret must be specified")
+	}
+
+	resource, err := c.generate(ctx, organizationID, request, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := c.client.Create(ctx, resource); err != nil {
+		if kerrors.IsAlreadyExists(err) {
+			return nil, errorsv2.New(computeapi.ComputeWebhookAlreadyExists, errors.HTTPConflict()).WithError(err)
+		}
+
+		return nil, fmt.Errorf("%w: unable to create webhook", err)
+	}
+
+	if err := c.applySecret(ctx, resource, secret); err != nil {
+		return nil, err
+	}
+
+	return convert(resource), nil
+}
+
+// Update replaces a webhook's specification, and optionally its secret.
+func (c *Client) Update(ctx context.Context, organizationID, webhookID string, request *computeapi.WebhookWrite) (*computeapi.WebhookRead, error) {
+	if err := rbac.AllowOrganizationScope(ctx, "compute:webhooks", identityapi.Update, organizationID); err != nil {
+		return nil, err
+	}
+
+	current, err := c.getRaw(ctx, organizationID, webhookID)
+	if err != nil {
+		return nil, err
+	}
+
+	if current.DeletionTimestamp != nil {
+		return nil, errorsv2.InvalidRequest(computeapi.ComputeWebhookDeleting, "webhook is being deleted")
+	}
+
+	required, err := c.generate(ctx, organizationID, request, current.Spec.Tags)
+	if err != nil {
+		return nil, err
+	}
+
+	updated := current.DeepCopy()
+	updated.Labels = required.Labels
+	updated.Annotations = required.Annotations
+	updated.Spec = required.Spec
+
+	if err := c.client.Patch(ctx, updated, client.MergeFromWithOptions(current, &client.MergeFromWithOptimisticLock{})); err != nil {
+		return nil, fmt.Errorf("%w: unable to update webhook", err)
+	}
+
+	if secret := ptr.Deref(request.Secret, ""); secret != "" {
+		if err := c.applySecret(ctx, updated, secret); err != nil {
+			return nil, err
+		}
+	}
+
+	return convert(updated), nil
+}
+
+// Delete removes a webhook, along with any events awaiting delivery.
+func (c *Client) Delete(ctx context.Context, organizationID, webhookID string) error {
+	if err := rbac.AllowOrganizationScope(ctx, "compute:webhooks", identityapi.Delete, organizationID); err != nil {
+		return err
+	}
+
+	resource, err := c.getRaw(ctx, organizationID, webhookID)
+	if err != nil {
+		return err
+	}
+
+	if err := c.client.Delete(ctx, resource); err != nil {
+		if kerrors.IsNotFound(err) {
+			return errors.HTTPNotFound().WithError(err)
+		}
+
+		return fmt.Errorf("%w: unable to delete webhook", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,441 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/spf13/pflag"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/unikorn-cloud/core/pkg/server/middleware"
+	"github.com/unikorn-cloud/core/pkg/server/middleware/routeresolver"
+	"github.com/unikorn-cloud/identity/pkg/middleware/authorization"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+var (
+	// ErrSink is raised when a sink is unknown or misconfigured.
+	ErrSink = errors.New("audit sink error")
+)
+
+const (
+	// SinkLog writes records to the structured logger.
+	SinkLog = "log"
+
+	// SinkStdout writes records to standard output as JSON lines.
+	SinkStdout = "stdout"
+
+	// SinkEvents writes records as Kubernetes events.
+	SinkEvents = "events"
+
+	// SinkWebhook posts records to a HTTP endpoint.
+	SinkWebhook = "webhook"
+
+	// maxDiffPaths bounds the size of a diff summary, anything more is
+	// elided as it's no longer a summary.
+	maxDiffPaths = 32
+)
+
+type Options struct {
+	// Sinks are where audit records are sent.
+	Sinks []string
+
+	// WebhookURL is where records are posted by the webhook sink.
+	WebhookURL string
+
+	// WebhookTimeout bounds how long a webhook post may take.
+	WebhookTimeout time.Duration
+}
+
+func (o *Options) AddFlags(f *pflag.FlagSet) {
+	f.StringSliceVar(&o.Sinks, "audit-sink", []string{SinkLog}, "Where to send audit records of mutating API calls, one or more of "+strings.Join([]string{SinkLog, SinkStdout, SinkEvents, SinkWebhook}, ", "))
+	f.StringVar(&o.WebhookURL, "audit-webhook-url", "", "URL audit records are posted to by the webhook sink")
+	f.DurationVar(&o.WebhookTimeout, "audit-webhook-timeout", 5*time.Second, "How long to wait for the audit webhook to accept a record")
+}
+
+// Component identifies the service that generated a record.
+type Component struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+}
+
+// Resource identifies the resource that was acted upon.
+type Resource struct {
+	Type string `json:"type"`
+	ID   string `json:"id,omitempty"`
+}
+
+// Record is a single audit record.
+type Record struct {
+	// Time is when the request completed.
+	Time time.Time `json:"time"`
+	// Component is the service that handled the request.
+	Component Component `json:"component"`
+	// Actor is the subject that made the request.
+	Actor string `json:"actor"`
+	// OrganizationID is the organization the resource belongs to, if known.
+	OrganizationID string `json:"organizationId,omitempty"`
+	// ProjectID is the project the resource belongs to, if known.
+	ProjectID string `json:"projectId,omitempty"`
+	// Operation is what was done e.g. create, update, delete, or the
+	// name of an action such as evict.
+	Operation string `json:"operation"`
+	// Method is the HTTP method.
+	Method string `json:"method"`
+	// Route is the API route template.
+	Route string `json:"route"`
+	// Resource is what was acted upon.
+	Resource Resource `json:"resource"`
+	// Status is the HTTP status code of the response.
+	Status int `json:"status"`
+	// Diff is a summary of the fields that were modified by an update.
+	Diff []string `json:"diff,omitempty"`
+	// TraceID allows the record to be correlated with logs and traces.
+	TraceID string `json:"traceId,omitempty"`
+}
+
+// Sink is something that persists audit records.
+type Sink interface {
+	Write(ctx context.Context, record *Record) error
+}
+
+// annotations are added to the record by handlers.
+type annotations struct {
+	lock sync.Mutex
+	diff []string
+}
+
+type annotationsKey struct{}
+
+// RecordDiff adds a summary of the changes from before to after to the audit
+// record.  Both must marshal to JSON, and the summary is the set of paths whose
+// values differ.
+func RecordDiff(ctx context.Context, before, after any) {
+	a, ok := ctx.Value(annotationsKey{}).(*annotations)
+	if !ok {
+		return
+	}
+
+	diff, err := Diff(before, after)
+	if err != nil {
+		log.FromContext(ctx).Info("failed to generate audit diff", "error", err)
+
+		return
+	}
+
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	a.diff = diff
+}
+
+// Diff returns the sorted JSON paths that differ between before and after,
+// lists are compared as a whole.
+func Diff(before, after any) ([]string, error) {
+	b, err := toJSONValue(before)
+	if err != nil {
+		return nil, err
+	}
+
+	a, err := toJSONValue(after)
+	if err != nil {
+		return nil, err
+	}
+
+	var paths []string
+
+	diff("", b, a, &paths)
+
+	slices.Sort(paths)
+
+	if len(paths) > maxDiffPaths {
+		paths = append(paths[:maxDiffPaths], fmt.Sprintf("... %d more", len(paths)-maxDiffPaths))
+	}
+
+	return paths, nil
+}
+
+func toJSONValue(in any) (any, error) {
+	data, err := json.Marshal(in)
+	if err != nil {
+		return nil, err
+	}
+
+	var out any
+
+	if err := json.Unmarshal(data, &out); err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
+
+func join(prefix, key string) string {
+	if prefix == "" {
+		return key
+	}
+
+	return prefix + "." + key
+}
+
+func diff(path string, before, after any, paths *[]string) {
+	b, bok := before.(map[string]any)
+	a, aok := after.(map[string]any)
+
+	if !bok || !aok {
+		if !reflect.DeepEqual(before, after) {
+			*paths = append(*paths, path)
+		}
+
+		return
+	}
+
+	for key, value := range b {
+		diff(join(path, key), value, a[key], paths)
+	}
+
+	for key, value := range a {
+		if _, ok := b[key]; !ok {
+			diff(join(path, key), nil, value, paths)
+		}
+	}
+}
+
+// Logger is a middleware that records all mutating API calls.
+type Logger struct {
+	component Component
+	sinks     []Sink
+}
+
+// New returns an initialized middleware.
+func New(options *Options, cli client.Client, namespace, application, version string) (*Logger, error) {
+	l := &Logger{
+		component: Component{
+			Name:    application,
+			Version: version,
+		},
+	}
+
+	for _, name := range options.Sinks {
+		switch name {
+		case SinkLog:
+			l.sinks = append(l.sinks, &LogSink{})
+		case SinkStdout:
+			l.sinks = append(l.sinks, NewWriterSink(stdout))
+		case SinkEvents:
+			l.sinks = append(l.sinks, NewEventSink(cli, namespace))
+		case SinkWebhook:
+			if options.WebhookURL == "" {
+				return nil, fmt.Errorf("%w: webhook URL must be specified", ErrSink)
+			}
+
+			l.sinks = append(l.sinks, NewWebhookSink(options.WebhookURL, options.WebhookTimeout))
+		default:
+			return nil, fmt.Errorf("%w: unknown sink %s", ErrSink, name)
+		}
+	}
+
+	return l, nil
+}
+
+// metadata is common to all API resources, and is used to fill in any gaps
+// not derivable from the route.
+type metadata struct {
+	Metadata struct {
+		ID             string `json:"id"`
+		OrganizationID string `json:"organizationId"`
+		ProjectID      string `json:"projectId"`
+	} `json:"metadata"`
+}
+
+// lastParameter returns the last path parameter's name, and the resource type
+// that precedes it.
+func lastParameter(segments []string) (string, string, bool) {
+	for i := len(segments) - 1; i > 0; i-- {
+		if strings.HasPrefix(segments[i], "{") {
+			return segments[i-1], strings.Trim(segments[i], "{}"), true
+		}
+	}
+
+	return "", "", false
+}
+
+// resources returns the resources in the response body.  Not everything returns a
+// resource, that's fine, the single empty resource results in one record for the
+// request.  Batch creations return a list of resources, each of which is recorded
+// individually so they can be found by resource ID.
+func resources(capture *middleware.Capture) []metadata {
+	if capture.Body() == nil {
+		return []metadata{{}}
+	}
+
+	var m metadata
+
+	if err := json.Unmarshal(capture.Body().Bytes(), &m); err == nil {
+		return []metadata{m}
+	}
+
+	var batch []metadata
+
+	if err := json.Unmarshal(capture.Body().Bytes(), &batch); err != nil || len(batch) == 0 {
+		return []metadata{{}}
+	}
+
+	return batch
+}
+
+// generate creates records, derived from the template, from the request and response.
+func (l *Logger) generate(r *http.Request, capture *middleware.Capture, route *routeresolver.RouteInfo, template *Record) []*Record {
+	batch := resources(capture)
+
+	records := make([]*Record, len(batch))
+
+	for i := range batch {
+		record := *template
+
+		l.generateRecord(r, route, &record, &batch[i])
+
+		records[i] = &record
+	}
+
+	return records
+}
+
+// generateRecord fills in the record for a single resource.
+func (l *Logger) generateRecord(r *http.Request, route *routeresolver.RouteInfo, record *Record, m *metadata) {
+	record.Method = r.Method
+	record.Route = route.Route.Path
+	record.OrganizationID = route.Parameters["organizationID"]
+	record.ProjectID = route.Parameters["projectID"]
+
+	if record.OrganizationID == "" {
+		record.OrganizationID = m.Metadata.OrganizationID
+	}
+
+	if record.ProjectID == "" {
+		record.ProjectID = m.Metadata.ProjectID
+	}
+
+	segments := strings.Split(strings.TrimSuffix(route.Route.Path, "/"), "/")
+	last := segments[len(segments)-1]
+
+	resourceType, parameter, ok := lastParameter(segments)
+	if ok {
+		record.Resource = Resource{
+			Type: resourceType,
+			ID:   route.Parameters[parameter],
+		}
+	}
+
+	switch r.Method {
+	case http.MethodPut, http.MethodPatch:
+		record.Operation = "update"
+	case http.MethodDelete:
+		record.Operation = "delete"
+	default:
+		// Creations return a new resource, anything else is an action on the
+		// resource identified by the path.
+		if m.Metadata.ID != "" && m.Metadata.ID != record.Resource.ID {
+			record.Operation = "create"
+			record.Resource = Resource{
+				Type: last,
+				ID:   m.Metadata.ID,
+			}
+
+			return
+		}
+
+		record.Operation = last
+	}
+}
+
+func (l *Logger) handle(w http.ResponseWriter, r *http.Request, next http.Handler) {
+	// Reads are not of interest, so avoid capturing them, which also means
+	// streamed responses are unaffected.
+	if r.Method == http.MethodGet || r.Method == http.MethodHead || r.Method == http.MethodOptions {
+		next.ServeHTTP(w, r)
+
+		return
+	}
+
+	a := &annotations{}
+
+	r = r.WithContext(context.WithValue(r.Context(), annotationsKey{}, a))
+
+	capture := middleware.CaptureResponse(w, r, next)
+
+	ctx := r.Context()
+
+	// If there is no accountability e.g. a global call, it's not worth recording.
+	info, err := authorization.FromContext(ctx)
+	if err != nil {
+		return
+	}
+
+	route, err := routeresolver.FromContext(ctx)
+	if err != nil {
+		return
+	}
+
+	record := &Record{
+		Time:      time.Now().UTC(),
+		Component: l.component,
+		Status:    capture.StatusCode(),
+	}
+
+	if info.Userinfo != nil {
+		record.Actor = info.Userinfo.Sub
+	}
+
+	a.lock.Lock()
+	record.Diff = a.diff
+	a.lock.Unlock()
+
+	if spanContext := trace.SpanContextFromContext(ctx); spanContext.HasTraceID() {
+		record.TraceID = spanContext.TraceID().String()
+	}
+
+	// The response has already been sent, so sink failures cannot be reported
+	// to the client, only logged.
+	for _, generated := range l.generate(r, capture, route, record) {
+		for _, sink := range l.sinks {
+			if err := sink.Write(ctx, generated); err != nil {
+				log.FromContext(ctx).Error(err, "failed to write audit record")
+			}
+		}
+	}
+}
+
+// Middleware returns the HTTP handler.
+func (l *Logger) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		l.handle(w, r, next)
+	})
+}
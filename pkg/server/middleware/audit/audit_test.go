@@ -0,0 +1,175 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/getkin/kin-openapi/routers"
+	"github.com/stretchr/testify/require"
+
+	"github.com/unikorn-cloud/compute/pkg/server/middleware/audit"
+	"github.com/unikorn-cloud/core/pkg/server/middleware/routeresolver"
+	"github.com/unikorn-cloud/identity/pkg/middleware/authorization"
+	identityapi "github.com/unikorn-cloud/identity/pkg/openapi"
+)
+
+type sink struct {
+	records []*audit.Record
+}
+
+func (s *sink) Write(_ context.Context, record *audit.Record) error {
+	s.records = append(s.records, record)
+
+	return nil
+}
+
+func doAll(t *testing.T, handler http.HandlerFunc, method, path string, parameters map[string]string) []*audit.Record {
+	t.Helper()
+
+	s := &sink{}
+
+	info := &routeresolver.RouteInfo{
+		Route: &routers.Route{
+			Path: path,
+		},
+		Parameters: parameters,
+	}
+
+	ctx := context.WithValue(t.Context(), routeresolver.RouteInfoKey, info)
+	ctx = authorization.NewContext(ctx, &authorization.Info{
+		Userinfo: &identityapi.Userinfo{
+			Sub: "jane@acme.com",
+		},
+	})
+
+	r := httptest.NewRequestWithContext(ctx, method, "/", nil)
+	w := httptest.NewRecorder()
+
+	audit.NewWithSinks(s).Middleware(handler).ServeHTTP(w, r)
+
+	return s.records
+}
+
+func do(t *testing.T, handler http.HandlerFunc, method, path string, parameters map[string]string) *audit.Record {
+	t.Helper()
+
+	records := doAll(t, handler, method, path, parameters)
+	if len(records) == 0 {
+		return nil
+	}
+
+	require.Len(t, records, 1)
+
+	return records[0]
+}
+
+// TestCreate ensures creations are identified from the response.
+func TestCreate(t *testing.T) {
+	t.Parallel()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"metadata":{"id":"baz","organizationId":"foo","projectId":"bar"}}`))
+	}
+
+	record := do(t, handler, http.MethodPost, "/api/v2/instances", nil)
+	require.NotNil(t, record)
+	require.Equal(t, "jane@acme.com", record.Actor)
+	require.Equal(t, "create", record.Operation)
+	require.Equal(t, audit.Resource{Type: "instances", ID: "baz"}, record.Resource)
+	require.Equal(t, "foo", record.OrganizationID)
+	require.Equal(t, "bar", record.ProjectID)
+	require.Equal(t, http.StatusCreated, record.Status)
+}
+
+// TestCreateBatch ensures batch creations are recorded as one creation per resource.
+func TestCreateBatch(t *testing.T) {
+	t.Parallel()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`[{"metadata":{"id":"baz","organizationId":"foo","projectId":"bar"}},{"metadata":{"id":"qux","organizationId":"foo","projectId":"bar"}}]`))
+	}
+
+	records := doAll(t, handler, http.MethodPost, "/api/v2/instances", nil)
+	require.Len(t, records, 2)
+
+	for i, id := range []string{"baz", "qux"} {
+		require.Equal(t, "create", records[i].Operation)
+		require.Equal(t, audit.Resource{Type: "instances", ID: id}, records[i].Resource)
+		require.Equal(t, "foo", records[i].OrganizationID)
+		require.Equal(t, "bar", records[i].ProjectID)
+	}
+}
+
+// TestAction ensures actions are attributed to the resource in the path.
+func TestAction(t *testing.T) {
+	t.Parallel()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusAccepted)
+	}
+
+	parameters := map[string]string{
+		"organizationID": "foo",
+		"projectID":      "bar",
+		"clusterID":      "baz",
+	}
+
+	record := do(t, handler, http.MethodPost, "/api/v1/organizations/{organizationID}/projects/{projectID}/clusters/{clusterID}/evict", parameters)
+	require.NotNil(t, record)
+	require.Equal(t, "evict", record.Operation)
+	require.Equal(t, audit.Resource{Type: "clusters", ID: "baz"}, record.Resource)
+	require.Equal(t, "foo", record.OrganizationID)
+	require.Equal(t, "bar", record.ProjectID)
+}
+
+// TestUpdateDiff ensures handlers can annotate updates with a diff.
+func TestUpdateDiff(t *testing.T) {
+	t.Parallel()
+
+	type spec struct {
+		Flavor string            `json:"flavor"`
+		Tags   map[string]string `json:"tags,omitempty"`
+	}
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		audit.RecordDiff(r.Context(), &spec{Flavor: "small"}, &spec{Flavor: "large", Tags: map[string]string{"foo": "bar"}})
+		w.WriteHeader(http.StatusOK)
+	}
+
+	record := do(t, handler, http.MethodPut, "/api/v2/instances/{instanceID}", map[string]string{"instanceID": "baz"})
+	require.NotNil(t, record)
+	require.Equal(t, "update", record.Operation)
+	require.Equal(t, []string{"flavor", "tags"}, record.Diff)
+}
+
+// TestRead ensures reads are not audited.
+func TestRead(t *testing.T) {
+	t.Parallel()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}
+
+	require.Nil(t, do(t, handler, http.MethodGet, "/api/v2/instances", nil))
+}
@@ -0,0 +1,176 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+//nolint:gochecknoglobals
+var stdout io.Writer = os.Stdout
+
+// LogSink writes records to the structured logger.
+type LogSink struct{}
+
+func (s *LogSink) Write(ctx context.Context, record *Record) error {
+	log.FromContext(ctx).Info("audit", "record", record)
+
+	return nil
+}
+
+// WriterSink writes records as JSON lines.
+type WriterSink struct {
+	lock sync.Mutex
+	w    io.Writer
+}
+
+func NewWriterSink(w io.Writer) *WriterSink {
+	return &WriterSink{
+		w: w,
+	}
+}
+
+func (s *WriterSink) Write(_ context.Context, record *Record) error {
+	data, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+
+	// Serialize so concurrent records are not interleaved.
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if _, err := s.w.Write(append(data, '\n')); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// EventSink writes records as Kubernetes events.
+type EventSink struct {
+	client    client.Client
+	namespace string
+}
+
+func NewEventSink(cli client.Client, namespace string) *EventSink {
+	return &EventSink{
+		client:    cli,
+		namespace: namespace,
+	}
+}
+
+func (s *EventSink) Write(ctx context.Context, record *Record) error {
+	data, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+
+	eventType := corev1.EventTypeNormal
+
+	if record.Status >= http.StatusBadRequest {
+		eventType = corev1.EventTypeWarning
+	}
+
+	now := metav1.NewTime(record.Time)
+
+	event := &corev1.Event{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:    s.namespace,
+			GenerateName: "audit-",
+		},
+		InvolvedObject: corev1.ObjectReference{
+			Kind:      record.Resource.Type,
+			Name:      record.Resource.ID,
+			Namespace: s.namespace,
+		},
+		Reason:  "Audit",
+		Message: string(data),
+		Type:    eventType,
+		Source: corev1.EventSource{
+			Component: record.Component.Name,
+		},
+		FirstTimestamp: now,
+		LastTimestamp:  now,
+		Count:          1,
+	}
+
+	if err := s.client.Create(ctx, event); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// WebhookSink posts records to a HTTP endpoint.
+type WebhookSink struct {
+	url    string
+	client *http.Client
+}
+
+func NewWebhookSink(url string, timeout time.Duration) *WebhookSink {
+	return &WebhookSink{
+		url: url,
+		client: &http.Client{
+			Timeout: timeout,
+		},
+	}
+}
+
+func (s *WebhookSink) Write(ctx context.Context, record *Record) error {
+	data, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+
+	// The request context is cancelled once the response is sent, but the
+	// record still needs delivering.
+	request, err := http.NewRequestWithContext(context.WithoutCancel(ctx), http.MethodPost, s.url, bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+
+	request.Header.Set("Content-Type", "application/json")
+
+	response, err := s.client.Do(request)
+	if err != nil {
+		return err
+	}
+
+	defer response.Body.Close()
+
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return fmt.Errorf("%w: webhook responded with status %d", ErrSink, response.StatusCode)
+	}
+
+	return nil
+}
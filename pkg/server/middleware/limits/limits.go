@@ -0,0 +1,86 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package limits
+
+import (
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/spf13/pflag"
+
+	servererrors "github.com/unikorn-cloud/core/pkg/server/errors"
+)
+
+type Options struct {
+	// MaxBodySize is the largest request body in bytes that will be read.
+	MaxBodySize int64
+}
+
+func (o *Options) AddFlags(f *pflag.FlagSet) {
+	f.Int64Var(&o.MaxBodySize, "server-max-body-size", 1<<20, "Maximum size of a request body in bytes")
+}
+
+// Limits protects the server from clients sending overly large payloads.
+type Limits struct {
+	options *Options
+}
+
+func New(options *Options) *Limits {
+	return &Limits{
+		options: options,
+	}
+}
+
+// body wraps a size limited request body and translates an overflow into
+// an API error so handlers report it to the client rather than as an internal
+// server error.
+type body struct {
+	io.ReadCloser
+}
+
+func (b *body) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+
+	var maxBytesError *http.MaxBytesError
+
+	if errors.As(err, &maxBytesError) {
+		return n, servererrors.HTTPRequestEntityTooLarge("request body exceeds the maximum size").WithError(err)
+	}
+
+	return n, err
+}
+
+func (l *Limits) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Reject anything we know is too large up front, otherwise limit
+		// what is read for chunked transfers and lying clients.
+		if r.ContentLength > l.options.MaxBodySize {
+			servererrors.HTTPRequestEntityTooLarge("request body exceeds the maximum size").Write(w, r)
+			return
+		}
+
+		if r.Body != nil && r.Body != http.NoBody {
+			r.Body = &body{
+				ReadCloser: http.MaxBytesReader(w, r.Body, l.options.MaxBodySize),
+			}
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
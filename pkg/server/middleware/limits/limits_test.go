@@ -0,0 +1,82 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package limits_test
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/unikorn-cloud/compute/pkg/server/middleware/limits"
+	"github.com/unikorn-cloud/core/pkg/server/errors"
+)
+
+func newHandler() http.Handler {
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if _, err := io.ReadAll(r.Body); err != nil {
+			errors.HandleError(w, r, err)
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+	})
+
+	return limits.New(&limits.Options{MaxBodySize: 8}).Middleware(next)
+}
+
+// TestBodyWithinLimit ensures normal requests are unaffected.
+func TestBodyWithinLimit(t *testing.T) {
+	t.Parallel()
+
+	w := httptest.NewRecorder()
+
+	newHandler().ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/", strings.NewReader("{}")))
+
+	require.Equal(t, http.StatusOK, w.Code)
+}
+
+// TestContentLengthExceedsLimit ensures declared oversized bodies are rejected
+// before being read.
+func TestContentLengthExceedsLimit(t *testing.T) {
+	t.Parallel()
+
+	w := httptest.NewRecorder()
+
+	newHandler().ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/", strings.NewReader("0123456789")))
+
+	require.Equal(t, http.StatusRequestEntityTooLarge, w.Code)
+}
+
+// TestStreamedBodyExceedsLimit ensures bodies of unknown length are truncated
+// and reported correctly.
+func TestStreamedBodyExceedsLimit(t *testing.T) {
+	t.Parallel()
+
+	r := httptest.NewRequest(http.MethodPost, "/", strings.NewReader("0123456789"))
+	r.ContentLength = -1
+
+	w := httptest.NewRecorder()
+
+	newHandler().ServeHTTP(w, r)
+
+	require.Equal(t, http.StatusRequestEntityTooLarge, w.Code)
+}
@@ -0,0 +1,84 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/unikorn-cloud/compute/pkg/metrics"
+	"github.com/unikorn-cloud/core/pkg/server/middleware/routeresolver"
+)
+
+// recorder captures the response status code.
+type recorder struct {
+	http.ResponseWriter
+
+	code int
+}
+
+func (r *recorder) WriteHeader(code int) {
+	if r.code == 0 {
+		r.code = code
+	}
+
+	r.ResponseWriter.WriteHeader(code)
+}
+
+func (r *recorder) Write(b []byte) (int, error) {
+	if r.code == 0 {
+		r.code = http.StatusOK
+	}
+
+	return r.ResponseWriter.Write(b)
+}
+
+// Unwrap allows http.ResponseController to access the underlying writer
+// e.g. for flushing streamed responses.
+func (r *recorder) Unwrap() http.ResponseWriter {
+	return r.ResponseWriter
+}
+
+// Middleware records request durations by route, this must be installed after
+// route resolution so the route template is used, rather than the raw path that
+// contains resource IDs.
+func Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		route := "unknown"
+
+		if info, err := routeresolver.FromContext(r.Context()); err == nil {
+			route = info.Route.Path
+		}
+
+		start := time.Now()
+
+		rec := &recorder{
+			ResponseWriter: w,
+		}
+
+		next.ServeHTTP(rec, r)
+
+		code := rec.code
+		if code == 0 {
+			code = http.StatusOK
+		}
+
+		metrics.HTTPRequestDuration.WithLabelValues(r.Method, route, strconv.Itoa(code)).Observe(time.Since(start).Seconds())
+	})
+}
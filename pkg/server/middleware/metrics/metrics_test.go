@@ -0,0 +1,58 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/require"
+
+	"github.com/unikorn-cloud/compute/pkg/metrics"
+	metricsmiddleware "github.com/unikorn-cloud/compute/pkg/server/middleware/metrics"
+)
+
+// TestStatusCodeRecorded ensures requests are observed with the status code
+// written by the handler, and unresolved routes don't use the raw path.
+func TestStatusCodeRecorded(t *testing.T) {
+	t.Parallel()
+
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusTeapot)
+	})
+
+	w := httptest.NewRecorder()
+
+	metricsmiddleware.Middleware(next).ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/v1/foo", nil))
+
+	require.Equal(t, http.StatusTeapot, w.Code)
+
+	observer, err := metrics.HTTPRequestDuration.GetMetricWithLabelValues(http.MethodGet, "unknown", "418")
+	require.NoError(t, err)
+
+	histogram, ok := observer.(prometheus.Histogram)
+	require.True(t, ok)
+
+	var metric dto.Metric
+
+	require.NoError(t, histogram.Write(&metric))
+	require.Equal(t, uint64(1), metric.GetHistogram().GetSampleCount())
+}
@@ -0,0 +1,324 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package quota
+
+import (
+	"context"
+	"encoding/json"
+	"math"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/spf13/pflag"
+	"golang.org/x/time/rate"
+
+	coreopenapi "github.com/unikorn-cloud/core/pkg/openapi"
+	"github.com/unikorn-cloud/core/pkg/server/middleware/routeresolver"
+	identityapi "github.com/unikorn-cloud/identity/pkg/openapi"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+const (
+	// ReadQuotaKind is the identity quota kind that, when defined for an organization,
+	// overrides the read requests per minute.
+	ReadQuotaKind = "compute-api-read-rpm"
+
+	// WriteQuotaKind is the identity quota kind that, when defined for an organization,
+	// overrides the write requests per minute.
+	WriteQuotaKind = "compute-api-write-rpm"
+
+	// tooManyRequests is not defined by the core error codes.
+	tooManyRequests coreopenapi.ErrorError = "too_many_requests"
+)
+
+// Scope groups API calls that share a quota.
+type Scope string
+
+const (
+	// ScopeRead covers safe methods e.g. GET.
+	ScopeRead Scope = "read"
+
+	// ScopeWrite covers everything that may mutate state.
+	ScopeWrite Scope = "write"
+)
+
+type Options struct {
+	// ReadRequestsPerMinute is the default read quota for an organization.
+	ReadRequestsPerMinute int
+
+	// WriteRequestsPerMinute is the default write quota for an organization.
+	WriteRequestsPerMinute int
+
+	// OrganizationReadRequestsPerMinute overrides the read quota for specific organizations.
+	OrganizationReadRequestsPerMinute map[string]int
+
+	// OrganizationWriteRequestsPerMinute overrides the write quota for specific organizations.
+	OrganizationWriteRequestsPerMinute map[string]int
+
+	// IdentityPolicy allows organization quotas defined in identity to take precedence.
+	IdentityPolicy bool
+
+	// IdentityPolicyTTL is how long policy read from identity is trusted for.
+	IdentityPolicyTTL time.Duration
+}
+
+func (o *Options) AddFlags(f *pflag.FlagSet) {
+	f.IntVar(&o.ReadRequestsPerMinute, "api-quota-read-rpm", 0, "Read requests per minute allowed per organization, 0 is unlimited")
+	f.IntVar(&o.WriteRequestsPerMinute, "api-quota-write-rpm", 0, "Write requests per minute allowed per organization, 0 is unlimited")
+	f.StringToIntVar(&o.OrganizationReadRequestsPerMinute, "api-quota-organization-read-rpm", nil, "Per-organization read requests per minute overrides e.g. org-id=600")
+	f.StringToIntVar(&o.OrganizationWriteRequestsPerMinute, "api-quota-organization-write-rpm", nil, "Per-organization write requests per minute overrides e.g. org-id=60")
+	f.BoolVar(&o.IdentityPolicy, "api-quota-identity-policy", false, "Allow the "+ReadQuotaKind+" and "+WriteQuotaKind+" organization quotas in identity to override flags")
+	f.DurationVar(&o.IdentityPolicyTTL, "api-quota-identity-policy-ttl", time.Minute, "How long to cache organization quota policy read from identity")
+}
+
+// Policy is the requests per minute allowed for an organization, where 0 is unlimited.
+type Policy struct {
+	Read  int
+	Write int
+}
+
+// get returns the limit for a scope.
+func (p *Policy) get(scope Scope) int {
+	if scope == ScopeRead {
+		return p.Read
+	}
+
+	return p.Write
+}
+
+// policyEntry is a cached policy from identity.
+type policyEntry struct {
+	policy  Policy
+	expires time.Time
+}
+
+// limiterKey uniquely identifies a bucket.
+type limiterKey struct {
+	organizationID string
+	scope          Scope
+}
+
+// Quota limits API call volume per organization so a single tenant cannot
+// monopolize a shared deployment.
+type Quota struct {
+	options  *Options
+	identity identityapi.ClientWithResponsesInterface
+
+	lock     sync.Mutex
+	limiters map[limiterKey]*rate.Limiter
+	policies map[string]*policyEntry
+
+	// now allows time to be mocked in tests.
+	now func() time.Time
+}
+
+// New returns a new quota middleware, identity may be nil when identity
+// policy is not in use.
+func New(options *Options, identity identityapi.ClientWithResponsesInterface) *Quota {
+	return &Quota{
+		options:  options,
+		identity: identity,
+		limiters: map[limiterKey]*rate.Limiter{},
+		policies: map[string]*policyEntry{},
+		now:      time.Now,
+	}
+}
+
+// scopeForMethod maps a HTTP method to a quota scope.
+func scopeForMethod(method string) Scope {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return ScopeRead
+	}
+
+	return ScopeWrite
+}
+
+// staticPolicy returns the policy defined by flags.
+func (q *Quota) staticPolicy(organizationID string) Policy {
+	policy := Policy{
+		Read:  q.options.ReadRequestsPerMinute,
+		Write: q.options.WriteRequestsPerMinute,
+	}
+
+	if value, ok := q.options.OrganizationReadRequestsPerMinute[organizationID]; ok {
+		policy.Read = value
+	}
+
+	if value, ok := q.options.OrganizationWriteRequestsPerMinute[organizationID]; ok {
+		policy.Write = value
+	}
+
+	return policy
+}
+
+// identityPolicy overlays any quotas defined in identity on the static policy.
+func (q *Quota) identityPolicy(ctx context.Context, organizationID string, policy Policy) (Policy, error) {
+	response, err := q.identity.GetApiV1OrganizationsOrganizationIDQuotasWithResponse(ctx, organizationID)
+	if err != nil {
+		return policy, err
+	}
+
+	if response.StatusCode() != http.StatusOK {
+		return policy, nil
+	}
+
+	for _, quota := range response.JSON200.Quotas {
+		switch quota.Kind {
+		case ReadQuotaKind:
+			policy.Read = quota.Quantity
+		case WriteQuotaKind:
+			policy.Write = quota.Quantity
+		}
+	}
+
+	return policy, nil
+}
+
+// policy returns the current policy for the organization.  Identity errors are
+// not fatal, the static policy is used instead, as rejecting every request when
+// identity is unavailable would be worse than not enforcing its overrides.
+func (q *Quota) policy(ctx context.Context, organizationID string) Policy {
+	policy := q.staticPolicy(organizationID)
+
+	if !q.options.IdentityPolicy || q.identity == nil {
+		return policy
+	}
+
+	now := q.now()
+
+	q.lock.Lock()
+	entry, ok := q.policies[organizationID]
+	q.lock.Unlock()
+
+	if ok && now.Before(entry.expires) {
+		return entry.policy
+	}
+
+	policy, err := q.identityPolicy(ctx, organizationID, policy)
+	if err != nil {
+		log.FromContext(ctx).Info("failed to read api quota policy", "organizationID", organizationID, "error", err)
+	}
+
+	q.lock.Lock()
+	q.policies[organizationID] = &policyEntry{
+		policy:  policy,
+		expires: now.Add(q.options.IdentityPolicyTTL),
+	}
+	q.lock.Unlock()
+
+	return policy
+}
+
+// reserve takes a token from the organization's bucket for the scope, returning
+// how long to wait before retrying if none are available.
+func (q *Quota) reserve(organizationID string, scope Scope, requestsPerMinute int) (time.Duration, bool) {
+	limit := rate.Limit(float64(requestsPerMinute) / time.Minute.Seconds())
+
+	key := limiterKey{
+		organizationID: organizationID,
+		scope:          scope,
+	}
+
+	now := q.now()
+
+	q.lock.Lock()
+	defer q.lock.Unlock()
+
+	limiter, ok := q.limiters[key]
+	if !ok {
+		limiter = rate.NewLimiter(limit, requestsPerMinute)
+		q.limiters[key] = limiter
+	}
+
+	// Policy may change at runtime.
+	if limiter.Limit() != limit || limiter.Burst() != requestsPerMinute {
+		limiter.SetLimitAt(now, limit)
+		limiter.SetBurstAt(now, requestsPerMinute)
+	}
+
+	reservation := limiter.ReserveN(now, 1)
+
+	delay := reservation.DelayFrom(now)
+	if delay == 0 {
+		return 0, true
+	}
+
+	reservation.CancelAt(now)
+
+	return delay, false
+}
+
+// writeTooManyRequests emits a 429 with a Retry-After header in whole seconds.
+func writeTooManyRequests(w http.ResponseWriter, r *http.Request, retryAfter time.Duration) {
+	w.Header().Add("Cache-Control", "no-cache")
+	w.Header().Add("Content-Type", "application/json")
+	w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(retryAfter.Seconds()))))
+	w.WriteHeader(http.StatusTooManyRequests)
+
+	body := &coreopenapi.Error{
+		Error:            tooManyRequests,
+		ErrorDescription: "organization API quota exceeded",
+	}
+
+	if err := json.NewEncoder(w).Encode(body); err != nil {
+		log.FromContext(r.Context()).Error(err, "failed to write error response")
+	}
+}
+
+func (q *Quota) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		// Only organization scoped APIs are subject to quotas.
+		info, err := routeresolver.FromContext(ctx)
+		if err != nil {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		organizationID, ok := info.Parameters["organizationID"]
+		if !ok {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		scope := scopeForMethod(r.Method)
+
+		policy := q.policy(ctx, organizationID)
+
+		requestsPerMinute := policy.get(scope)
+		if requestsPerMinute <= 0 {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		if retryAfter, ok := q.reserve(organizationID, scope, requestsPerMinute); !ok {
+			log.FromContext(ctx).Info("api quota exceeded", "organizationID", organizationID, "scope", scope)
+
+			writeTooManyRequests(w, r, retryAfter)
+
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
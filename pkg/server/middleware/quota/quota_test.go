@@ -0,0 +1,111 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package quota_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/unikorn-cloud/compute/pkg/server/middleware/quota"
+	"github.com/unikorn-cloud/core/pkg/server/middleware/routeresolver"
+)
+
+func newHandler(options *quota.Options) http.Handler {
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+
+	return quota.New(options, nil).Middleware(next)
+}
+
+func do(t *testing.T, handler http.Handler, method, organizationID string) *httptest.ResponseRecorder {
+	t.Helper()
+
+	info := &routeresolver.RouteInfo{
+		Parameters: map[string]string{
+			"organizationID": organizationID,
+		},
+	}
+
+	r := httptest.NewRequestWithContext(context.WithValue(t.Context(), routeresolver.RouteInfoKey, info), method, "/", nil)
+	w := httptest.NewRecorder()
+
+	handler.ServeHTTP(w, r)
+
+	return w
+}
+
+// TestQuotaExceeded ensures requests over quota are rejected with a retry hint.
+func TestQuotaExceeded(t *testing.T) {
+	t.Parallel()
+
+	handler := newHandler(&quota.Options{ReadRequestsPerMinute: 2})
+
+	require.Equal(t, http.StatusOK, do(t, handler, http.MethodGet, "foo").Code)
+	require.Equal(t, http.StatusOK, do(t, handler, http.MethodGet, "foo").Code)
+
+	w := do(t, handler, http.MethodGet, "foo")
+	require.Equal(t, http.StatusTooManyRequests, w.Code)
+	require.Equal(t, "30", w.Header().Get("Retry-After"))
+
+	// Other organizations are unaffected.
+	require.Equal(t, http.StatusOK, do(t, handler, http.MethodGet, "bar").Code)
+
+	// Writes are unlimited.
+	require.Equal(t, http.StatusOK, do(t, handler, http.MethodPost, "foo").Code)
+}
+
+// TestQuotaOrganizationOverride ensures per-organization overrides take precedence.
+func TestQuotaOrganizationOverride(t *testing.T) {
+	t.Parallel()
+
+	options := &quota.Options{
+		WriteRequestsPerMinute: 1,
+		OrganizationWriteRequestsPerMinute: map[string]int{
+			"foo": 0,
+		},
+	}
+
+	handler := newHandler(options)
+
+	for range 5 {
+		require.Equal(t, http.StatusOK, do(t, handler, http.MethodDelete, "foo").Code)
+	}
+
+	require.Equal(t, http.StatusOK, do(t, handler, http.MethodDelete, "bar").Code)
+	require.Equal(t, http.StatusTooManyRequests, do(t, handler, http.MethodDelete, "bar").Code)
+}
+
+// TestQuotaUnscoped ensures APIs without an organization are not limited.
+func TestQuotaUnscoped(t *testing.T) {
+	t.Parallel()
+
+	handler := newHandler(&quota.Options{ReadRequestsPerMinute: 1})
+
+	for range 3 {
+		w := httptest.NewRecorder()
+
+		handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/", nil))
+
+		require.Equal(t, http.StatusOK, w.Code)
+	}
+}
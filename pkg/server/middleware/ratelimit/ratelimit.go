@@ -0,0 +1,197 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ratelimit
+
+import (
+	"encoding/json"
+	"math"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/spf13/pflag"
+	"golang.org/x/time/rate"
+
+	coreopenapi "github.com/unikorn-cloud/core/pkg/openapi"
+	"github.com/unikorn-cloud/core/pkg/server/middleware/routeresolver"
+	"github.com/unikorn-cloud/identity/pkg/middleware/authorization"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+const (
+	// tooManyRequests is not defined by the core error codes.
+	tooManyRequests coreopenapi.ErrorError = "too_many_requests"
+)
+
+type Options struct {
+	// RequestsPerSecond is the sustained request rate allowed per client, 0 is unlimited.
+	RequestsPerSecond float64
+
+	// Burst is the number of requests a client may make in excess of the sustained rate.
+	Burst int
+
+	// IdleTimeout is how long a client's bucket is retained after its last request.
+	IdleTimeout time.Duration
+}
+
+func (o *Options) AddFlags(f *pflag.FlagSet) {
+	f.Float64Var(&o.RequestsPerSecond, "api-rate-limit-rps", 0, "Requests per second allowed per token subject, or organization when there is no subject, 0 is unlimited")
+	f.IntVar(&o.Burst, "api-rate-limit-burst", 20, "Requests allowed in excess of the rate limit in a burst")
+	f.DurationVar(&o.IdleTimeout, "api-rate-limit-idle-timeout", 10*time.Minute, "How long rate limit state is retained for an idle client, 0 retains it indefinitely")
+}
+
+// bucket is a client's rate limiter.
+type bucket struct {
+	limiter  *rate.Limiter
+	lastSeen time.Time
+}
+
+// RateLimiter limits the request rate of individual clients, so automation that
+// polls aggressively cannot translate into excessive load on Kubernetes and the
+// region service.  Unlike quotas, which bound an organization's total volume over
+// a minute, this smooths request rates per caller.
+type RateLimiter struct {
+	options *Options
+
+	lock      sync.Mutex
+	buckets   map[string]*bucket
+	lastSweep time.Time
+
+	// now allows time to be mocked in tests.
+	now func() time.Time
+}
+
+// New returns a new rate limiting middleware.
+func New(options *Options) *RateLimiter {
+	return &RateLimiter{
+		options: options,
+		buckets: map[string]*bucket{},
+		now:     time.Now,
+	}
+}
+
+// key identifies the client, preferring the token subject so individual users and
+// service accounts are limited independently, and falling back to the organization.
+func key(r *http.Request) (string, bool) {
+	ctx := r.Context()
+
+	if info, err := authorization.FromContext(ctx); err == nil && info.Userinfo != nil && info.Userinfo.Sub != "" {
+		return "subject:" + info.Userinfo.Sub, true
+	}
+
+	if route, err := routeresolver.FromContext(ctx); err == nil {
+		if organizationID, ok := route.Parameters["organizationID"]; ok {
+			return "organization:" + organizationID, true
+		}
+	}
+
+	return "", false
+}
+
+// sweep removes buckets that have been idle for longer than the timeout, this
+// bounds memory use as clients come and go.  Must be called with the lock held.
+func (l *RateLimiter) sweep(now time.Time) {
+	if l.options.IdleTimeout <= 0 || now.Sub(l.lastSweep) < l.options.IdleTimeout {
+		return
+	}
+
+	for key, bucket := range l.buckets {
+		if now.Sub(bucket.lastSeen) >= l.options.IdleTimeout {
+			delete(l.buckets, key)
+		}
+	}
+
+	l.lastSweep = now
+}
+
+// reserve takes a token from the client's bucket, returning how long to wait
+// before retrying if none are available.
+func (l *RateLimiter) reserve(key string) (time.Duration, bool) {
+	now := l.now()
+
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	l.sweep(now)
+
+	b, ok := l.buckets[key]
+	if !ok {
+		b = &bucket{
+			limiter: rate.NewLimiter(rate.Limit(l.options.RequestsPerSecond), max(l.options.Burst, 1)),
+		}
+
+		l.buckets[key] = b
+	}
+
+	b.lastSeen = now
+
+	reservation := b.limiter.ReserveN(now, 1)
+
+	delay := reservation.DelayFrom(now)
+	if delay == 0 {
+		return 0, true
+	}
+
+	reservation.CancelAt(now)
+
+	return delay, false
+}
+
+// writeTooManyRequests emits a 429 with a Retry-After header in whole seconds.
+func writeTooManyRequests(w http.ResponseWriter, r *http.Request, retryAfter time.Duration) {
+	w.Header().Add("Cache-Control", "no-cache")
+	w.Header().Add("Content-Type", "application/json")
+	w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(retryAfter.Seconds()))))
+	w.WriteHeader(http.StatusTooManyRequests)
+
+	body := &coreopenapi.Error{
+		Error:            tooManyRequests,
+		ErrorDescription: "API rate limit exceeded",
+	}
+
+	if err := json.NewEncoder(w).Encode(body); err != nil {
+		log.FromContext(r.Context()).Error(err, "failed to write error response")
+	}
+}
+
+func (l *RateLimiter) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if l.options.RequestsPerSecond <= 0 {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		key, ok := key(r)
+		if !ok {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		if retryAfter, ok := l.reserve(key); !ok {
+			log.FromContext(r.Context()).Info("api rate limit exceeded", "key", key)
+
+			writeTooManyRequests(w, r, retryAfter)
+
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,95 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ratelimit_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/unikorn-cloud/compute/pkg/server/middleware/ratelimit"
+	"github.com/unikorn-cloud/core/pkg/server/middleware/routeresolver"
+	"github.com/unikorn-cloud/identity/pkg/middleware/authorization"
+	identityapi "github.com/unikorn-cloud/identity/pkg/openapi"
+)
+
+func newHandler(options *ratelimit.Options) http.Handler {
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+
+	return ratelimit.New(options).Middleware(next)
+}
+
+func do(t *testing.T, handler http.Handler, subject, organizationID string) *httptest.ResponseRecorder {
+	t.Helper()
+
+	info := &routeresolver.RouteInfo{
+		Parameters: map[string]string{
+			"organizationID": organizationID,
+		},
+	}
+
+	ctx := context.WithValue(t.Context(), routeresolver.RouteInfoKey, info)
+
+	if subject != "" {
+		ctx = authorization.NewContext(ctx, &authorization.Info{
+			Userinfo: &identityapi.Userinfo{
+				Sub: subject,
+			},
+		})
+	}
+
+	w := httptest.NewRecorder()
+
+	handler.ServeHTTP(w, httptest.NewRequestWithContext(ctx, http.MethodGet, "/", nil))
+
+	return w
+}
+
+// TestRateLimitSubject ensures clients are limited by token subject, independently
+// of other subjects in the same organization.
+func TestRateLimitSubject(t *testing.T) {
+	t.Parallel()
+
+	handler := newHandler(&ratelimit.Options{RequestsPerSecond: 0.5, Burst: 2})
+
+	require.Equal(t, http.StatusOK, do(t, handler, "alice", "foo").Code)
+	require.Equal(t, http.StatusOK, do(t, handler, "alice", "foo").Code)
+
+	w := do(t, handler, "alice", "foo")
+	require.Equal(t, http.StatusTooManyRequests, w.Code)
+	require.Equal(t, "2", w.Header().Get("Retry-After"))
+
+	require.Equal(t, http.StatusOK, do(t, handler, "bob", "foo").Code)
+}
+
+// TestRateLimitOrganization ensures requests without a subject are limited by
+// organization.
+func TestRateLimitOrganization(t *testing.T) {
+	t.Parallel()
+
+	handler := newHandler(&ratelimit.Options{RequestsPerSecond: 1, Burst: 1})
+
+	require.Equal(t, http.StatusOK, do(t, handler, "", "foo").Code)
+	require.Equal(t, http.StatusTooManyRequests, do(t, handler, "", "foo").Code)
+	require.Equal(t, http.StatusOK, do(t, handler, "", "bar").Code)
+}
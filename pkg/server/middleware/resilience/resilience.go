@@ -0,0 +1,340 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resilience
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/spf13/pflag"
+
+	coreopenapi "github.com/unikorn-cloud/core/pkg/openapi"
+	servererrors "github.com/unikorn-cloud/core/pkg/server/errors"
+	identityclient "github.com/unikorn-cloud/identity/pkg/client"
+)
+
+const (
+	// temporarilyUnavailable is not defined by the core error codes.
+	temporarilyUnavailable coreopenapi.ErrorError = "temporarily_unavailable"
+)
+
+var (
+	// ErrUnavailable is raised when a request cannot be serviced by the dependent
+	// service, either because it failed or the circuit breaker is open.
+	ErrUnavailable = errors.New("service unavailable")
+)
+
+type Options struct {
+	// Retries is how many times an idempotent request is retried on a
+	// transient failure.
+	Retries int
+
+	// RetryBackoff is the initial delay between retries, this doubles with
+	// each subsequent retry.
+	RetryBackoff time.Duration
+
+	// RetryMaxBackoff bounds the delay between retries.
+	RetryMaxBackoff time.Duration
+
+	// BreakerThreshold is the number of consecutive failures that trip the
+	// circuit breaker.
+	BreakerThreshold int
+
+	// BreakerCooldown is how long the circuit breaker stays open before a
+	// request is allowed through to probe the service.
+	BreakerCooldown time.Duration
+}
+
+func (o *Options) AddFlags(f *pflag.FlagSet) {
+	f.IntVar(&o.Retries, "region-retries", 2, "How many times idempotent region requests are retried on transient failures")
+	f.DurationVar(&o.RetryBackoff, "region-retry-backoff", 100*time.Millisecond, "Initial delay between region request retries, this doubles with each retry")
+	f.DurationVar(&o.RetryMaxBackoff, "region-retry-max-backoff", 2*time.Second, "Maximum delay between region request retries")
+	f.IntVar(&o.BreakerThreshold, "region-breaker-threshold", 5, "Consecutive region request failures that open the circuit breaker, 0 disables")
+	f.DurationVar(&o.BreakerCooldown, "region-breaker-cooldown", 30*time.Second, "How long the circuit breaker stays open before probing the region service")
+}
+
+// Resilience protects users from transient failures of a dependent service by
+// retrying idempotent requests, and protects the service from load while it's
+// unhealthy with a circuit breaker.  When the breaker is open, requests fail
+// fast with a 503, and the middleware advises clients when to retry.
+type Resilience struct {
+	options *Options
+
+	lock sync.Mutex
+
+	// failures is the number of consecutive failures.
+	failures int
+
+	// openUntil is when the breaker may be half-opened.
+	openUntil time.Time
+
+	// probing is set when the breaker is half-open and a request is in flight
+	// to determine whether the service has recovered.
+	probing bool
+}
+
+// New returns a new resilience middleware.
+func New(options *Options) *Resilience {
+	return &Resilience{
+		options: options,
+	}
+}
+
+// unavailable is returned when a request cannot be serviced by the dependent
+// service, so it's reported as a 503, rather than an internal error.
+func unavailable(err error) error {
+	cause := ErrUnavailable
+
+	if err != nil {
+		cause = fmt.Errorf("%w: %w", ErrUnavailable, err)
+	}
+
+	return servererrors.FromOpenAPIError(http.StatusServiceUnavailable, nil, &coreopenapi.Error{
+		Error:            temporarilyUnavailable,
+		ErrorDescription: "region service is temporarily unavailable",
+	}).WithError(cause)
+}
+
+// allow checks whether the breaker will let a request through.
+func (r *Resilience) allow() bool {
+	if r.options.BreakerThreshold == 0 {
+		return true
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if r.openUntil.IsZero() {
+		return true
+	}
+
+	if time.Now().Before(r.openUntil) || r.probing {
+		return false
+	}
+
+	r.probing = true
+
+	return true
+}
+
+// record updates the breaker with the outcome of a request.
+func (r *Resilience) record(failed bool) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	r.probing = false
+
+	if !failed {
+		r.failures = 0
+		r.openUntil = time.Time{}
+
+		return
+	}
+
+	r.failures++
+
+	if r.options.BreakerThreshold > 0 && r.failures >= r.options.BreakerThreshold {
+		r.openUntil = time.Now().Add(r.options.BreakerCooldown)
+	}
+}
+
+// release abandons a request without an outcome e.g. it was cancelled by the client.
+func (r *Resilience) release() {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	r.probing = false
+}
+
+// retryAfter returns how long a client should wait before retrying.
+func (r *Resilience) retryAfter() time.Duration {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	return max(time.Until(r.openUntil), time.Second)
+}
+
+// idempotent returns whether a request can be safely retried.
+func idempotent(r *http.Request) bool {
+	switch r.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return true
+	}
+
+	return false
+}
+
+// transient returns whether the response indicates the service is unhealthy.
+func transient(response *http.Response, err error) bool {
+	if err != nil {
+		return true
+	}
+
+	switch response.StatusCode {
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+
+	return false
+}
+
+// discard drains and closes a response body so the connection can be reused.
+func discard(response *http.Response) {
+	if response == nil {
+		return
+	}
+
+	_, _ = io.Copy(io.Discard, response.Body)
+	_ = response.Body.Close()
+}
+
+// sleep waits for the specified duration, returning early if the context is done.
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// transport retries and circuit breaks outbound HTTP requests.
+type transport struct {
+	next       http.RoundTripper
+	resilience *Resilience
+}
+
+func (t *transport) RoundTrip(r *http.Request) (*http.Response, error) {
+	options := t.resilience.options
+
+	if !t.resilience.allow() {
+		return nil, unavailable(nil)
+	}
+
+	retries := 0
+
+	if idempotent(r) {
+		retries = options.Retries
+	}
+
+	backoff := options.RetryBackoff
+
+	for attempt := 0; ; attempt++ {
+		response, err := t.next.RoundTrip(r)
+
+		if r.Context().Err() != nil {
+			t.resilience.release()
+
+			return response, err
+		}
+
+		if !transient(response, err) {
+			t.resilience.record(false)
+
+			return response, nil
+		}
+
+		if attempt == retries {
+			t.resilience.record(true)
+
+			discard(response)
+
+			return nil, unavailable(err)
+		}
+
+		discard(response)
+
+		if err := sleep(r.Context(), backoff); err != nil {
+			t.resilience.release()
+
+			return nil, err
+		}
+
+		backoff = min(backoff*2, options.RetryMaxBackoff)
+	}
+}
+
+// Builder wraps an OpenAPI client builder so that all requests made by the
+// client are retried and circuit broken.
+type Builder[T any] struct {
+	identityclient.Builder[T]
+
+	resilience *Resilience
+}
+
+// NewBuilder returns a resilient OpenAPI client builder.
+func NewBuilder[T any](resilience *Resilience, builder identityclient.Builder[T]) *Builder[T] {
+	return &Builder[T]{
+		Builder:    builder,
+		resilience: resilience,
+	}
+}
+
+// WithHTTPClient wraps the client's transport.
+func (b *Builder[T]) WithHTTPClient(client *http.Client) {
+	next := client.Transport
+	if next == nil {
+		next = http.DefaultTransport
+	}
+
+	client.Transport = &transport{
+		next:       next,
+		resilience: b.resilience,
+	}
+
+	b.Builder.WithHTTPClient(client)
+}
+
+// writer advises the client when to retry service unavailable responses.
+type writer struct {
+	http.ResponseWriter
+
+	resilience *Resilience
+}
+
+func (w *writer) WriteHeader(code int) {
+	if code == http.StatusServiceUnavailable && w.Header().Get("Retry-After") == "" {
+		w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(w.resilience.retryAfter().Seconds()))))
+	}
+
+	w.ResponseWriter.WriteHeader(code)
+}
+
+// Unwrap allows http.ResponseController to access the underlying writer
+// e.g. for flushing streamed responses.
+func (w *writer) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
+// Middleware adds a Retry-After header to service unavailable responses, as the
+// core error handling doesn't allow errors to propagate headers.
+func (r *Resilience) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		next.ServeHTTP(&writer{ResponseWriter: w, resilience: r}, req)
+	})
+}
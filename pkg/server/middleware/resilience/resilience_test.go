@@ -0,0 +1,137 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resilience_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/unikorn-cloud/compute/pkg/server/middleware/resilience"
+	"github.com/unikorn-cloud/core/pkg/server/errors"
+)
+
+// builder captures the HTTP client passed to it.
+type builder struct {
+	client *http.Client
+}
+
+func (b *builder) WithHTTPClient(client *http.Client) {
+	b.client = client
+}
+
+func (b *builder) WithRequestEditorFn(fn func(context.Context, *http.Request) error) {
+}
+
+func (b *builder) Client(hostname string) (*struct{}, error) {
+	return &struct{}{}, nil
+}
+
+// newClient returns a HTTP client that calls a server that fails the specified number
+// of times before succeeding, and a count of the calls it received.
+func newClient(t *testing.T, r *resilience.Resilience, failures int32) (*http.Client, string, *atomic.Int32) {
+	t.Helper()
+
+	var calls atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) <= failures {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	t.Cleanup(server.Close)
+
+	b := &builder{}
+
+	resilience.NewBuilder[struct{}](r, b).WithHTTPClient(&http.Client{})
+
+	return b.client, server.URL, &calls
+}
+
+func do(t *testing.T, client *http.Client, method, url string) error {
+	t.Helper()
+
+	r, err := http.NewRequestWithContext(t.Context(), method, url, nil)
+	require.NoError(t, err)
+
+	response, err := client.Do(r)
+	if err != nil {
+		return err
+	}
+
+	return response.Body.Close()
+}
+
+// TestRetry ensures idempotent requests are retried on transient failures, and
+// others are not.
+func TestRetry(t *testing.T) {
+	t.Parallel()
+
+	r := resilience.New(&resilience.Options{
+		Retries:         2,
+		RetryBackoff:    time.Millisecond,
+		RetryMaxBackoff: time.Millisecond,
+	})
+
+	client, url, calls := newClient(t, r, 2)
+	require.NoError(t, do(t, client, http.MethodGet, url))
+	require.Equal(t, int32(3), calls.Load())
+
+	client, url, calls = newClient(t, r, 1)
+	require.ErrorIs(t, do(t, client, http.MethodPost, url), resilience.ErrUnavailable)
+	require.Equal(t, int32(1), calls.Load())
+}
+
+// TestBreaker ensures consecutive failures open the breaker, failing fast with
+// a service unavailable error that advises when to retry.
+func TestBreaker(t *testing.T) {
+	t.Parallel()
+
+	r := resilience.New(&resilience.Options{
+		BreakerThreshold: 2,
+		BreakerCooldown:  time.Hour,
+	})
+
+	client, url, calls := newClient(t, r, 2)
+
+	require.Error(t, do(t, client, http.MethodGet, url))
+	require.Error(t, do(t, client, http.MethodGet, url))
+
+	err := do(t, client, http.MethodGet, url)
+	require.ErrorIs(t, err, resilience.ErrUnavailable)
+	require.Equal(t, int32(2), calls.Load())
+
+	handler := r.Middleware(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		errors.HandleError(w, req, err)
+	}))
+
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequestWithContext(t.Context(), http.MethodGet, "/", nil))
+
+	require.Equal(t, http.StatusServiceUnavailable, w.Code)
+	require.Equal(t, "3600", w.Header().Get("Retry-After"))
+}
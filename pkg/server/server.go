@@ -24,11 +24,23 @@ import (
 	"net/http/pprof"
 
 	chi "github.com/go-chi/chi/v5"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/spf13/pflag"
 
+	unikornv1 "github.com/unikorn-cloud/compute/pkg/apis/unikorn/v1alpha1"
 	"github.com/unikorn-cloud/compute/pkg/constants"
+	"github.com/unikorn-cloud/compute/pkg/health"
+	"github.com/unikorn-cloud/compute/pkg/metrics"
 	"github.com/unikorn-cloud/compute/pkg/openapi"
 	"github.com/unikorn-cloud/compute/pkg/server/handler"
+	"github.com/unikorn-cloud/compute/pkg/server/middleware/audit"
+	"github.com/unikorn-cloud/compute/pkg/server/middleware/limits"
+	metricsmiddleware "github.com/unikorn-cloud/compute/pkg/server/middleware/metrics"
+	"github.com/unikorn-cloud/compute/pkg/server/middleware/quota"
+	"github.com/unikorn-cloud/compute/pkg/server/middleware/ratelimit"
+	"github.com/unikorn-cloud/compute/pkg/server/middleware/resilience"
+	"github.com/unikorn-cloud/compute/pkg/tracing"
 	coreclient "github.com/unikorn-cloud/core/pkg/client"
 	"github.com/unikorn-cloud/core/pkg/openapi/helpers"
 	"github.com/unikorn-cloud/core/pkg/options"
@@ -36,11 +48,13 @@ import (
 	"github.com/unikorn-cloud/core/pkg/server/middleware/logging"
 	"github.com/unikorn-cloud/core/pkg/server/middleware/opentelemetry"
 	"github.com/unikorn-cloud/core/pkg/server/middleware/routeresolver"
+	"github.com/unikorn-cloud/core/pkg/server/middleware/timeout"
 	identityclient "github.com/unikorn-cloud/identity/pkg/client"
-	"github.com/unikorn-cloud/identity/pkg/middleware/audit"
 	openapimiddleware "github.com/unikorn-cloud/identity/pkg/middleware/openapi"
 	openapimiddlewareremote "github.com/unikorn-cloud/identity/pkg/middleware/openapi/remote"
+	identityapi "github.com/unikorn-cloud/identity/pkg/openapi"
 	regionclient "github.com/unikorn-cloud/region/pkg/client"
+	regionapi "github.com/unikorn-cloud/region/pkg/openapi"
 
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -58,6 +72,21 @@ type Server struct {
 	// CORSOptions are for remote resource sharing.
 	CORSOptions cors.Options
 
+	// LimitsOptions bound the size of request payloads.
+	LimitsOptions limits.Options
+
+	// QuotaOptions bound the API call volume per organization.
+	QuotaOptions quota.Options
+
+	// RateLimitOptions bound the API request rate per client.
+	RateLimitOptions ratelimit.Options
+
+	// AuditOptions control where records of mutating API calls are sent.
+	AuditOptions audit.Options
+
+	// ResilienceOptions control retries and circuit breaking of region calls.
+	ResilienceOptions resilience.Options
+
 	// ClientOptions are for generic TLS client options e.g. certificates.
 	ClientOptions coreclient.HTTPClientOptions
 
@@ -69,6 +98,9 @@ type Server struct {
 
 	// OpenAPIOptions are for OpenAPI processing.
 	OpenAPIOptions openapimiddleware.Options
+
+	// HealthOptions control the liveness and readiness endpoints.
+	HealthOptions health.Options
 }
 
 func (s *Server) AddFlags(flags *pflag.FlagSet) {
@@ -84,10 +116,16 @@ func (s *Server) AddFlags(flags *pflag.FlagSet) {
 	s.ServerOptions.AddFlags(flags)
 	s.HandlerOptions.AddFlags(flags)
 	s.CORSOptions.AddFlags(flags)
+	s.LimitsOptions.AddFlags(flags)
+	s.QuotaOptions.AddFlags(flags)
+	s.RateLimitOptions.AddFlags(flags)
+	s.AuditOptions.AddFlags(flags)
+	s.ResilienceOptions.AddFlags(flags)
 	s.ClientOptions.AddFlags(flags)
 	s.IdentityOptions.AddFlags(flags)
 	s.RegionOptions.AddFlags(flags)
 	s.OpenAPIOptions.AddFlags(flags)
+	s.HealthOptions.AddFlags(flags)
 }
 
 func (s *Server) SetupLogging() {
@@ -108,6 +146,9 @@ func (s *Server) GetServer(client client.Client) (*http.Server, error) {
 	pprofHandler.HandleFunc("/debug/pprof/profile", pprof.Profile)
 	pprofHandler.HandleFunc("/debug/pprof/symbol", pprof.Symbol)
 	pprofHandler.HandleFunc("/debug/pprof/trace", pprof.Trace)
+	pprofHandler.Handle("/metrics", promhttp.Handler())
+
+	metrics.MustRegister(prometheus.DefaultRegisterer)
 
 	go func() {
 		pprofServer := http.Server{
@@ -138,15 +179,25 @@ func (s *Server) GetServer(client client.Client) (*http.Server, error) {
 	//   can trigger alerts based on them.
 	// * Route resolver provides routing and OpenAPI information to child middlewares.
 	// * CORS emulates OPTIONS endpoints based on OpenAPI (requires route resolver).
+	// * Timeout bounds how long any one request may hold resources.
+	// * Limits bounds request body sizes before anything attempts to read them.
+	// * Metrics records request durations per route (requires route resolver).
+	// * Resilience advises clients when to retry if the region is unavailable.
 	opentelemetry := opentelemetry.New(constants.Application, constants.Version)
 	logging := logging.New()
 	routeresolver := routeresolver.New(schema)
 	cors := cors.New(&s.CORSOptions)
+	limits := limits.New(&s.LimitsOptions)
+	regionResilience := resilience.New(&s.ResilienceOptions)
 
 	router.Use(opentelemetry.Middleware)
 	router.Use(logging.Middleware)
 	router.Use(routeresolver.Middleware)
 	router.Use(cors.Middleware)
+	router.Use(timeout.Middleware(s.ServerOptions.RequestTimeout))
+	router.Use(limits.Middleware)
+	router.Use(metricsmiddleware.Middleware)
+	router.Use(regionResilience.Middleware)
 	router.NotFound(http.HandlerFunc(handler.NotFound))
 	router.MethodNotAllowed(http.HandlerFunc(handler.MethodNotAllowed))
 
@@ -155,30 +206,66 @@ func (s *Server) GetServer(client client.Client) (*http.Server, error) {
 		return nil, err
 	}
 
+	identityBuilder := tracing.NewBuilder[identityapi.ClientWithResponses](identityapi.NewBuilder(), "identity")
+
+	identity, err := identityclient.APIClient(context.TODO(), identityclient.NewBaseClient(client, s.IdentityOptions, &s.ClientOptions), identityBuilder)
+	if err != nil {
+		return nil, err
+	}
+
+	// The validator authenticates requests, and checks parameters and bodies
+	// against the OpenAPI schema, so malformed requests are rejected with a 400
+	// before they reach a handler.
 	validator := openapimiddleware.NewValidator(&s.OpenAPIOptions, authorizer)
-	audit := audit.New(constants.Application, constants.Version)
+
+	// Audit records all mutating calls, for compliance purposes, to the configured sinks.
+	audit, err := audit.New(&s.AuditOptions, client, s.CoreOptions.Namespace, constants.Application, constants.Version)
+	if err != nil {
+		return nil, err
+	}
+
+	quota := quota.New(&s.QuotaOptions, identity)
+	ratelimit := ratelimit.New(&s.RateLimitOptions)
 
 	// Middleware specified here is applied to all requests post-routing.
 	// NOTE: these are applied in reverse order!!
+	// Quotas and rate limits are applied after authentication so unauthenticated
+	// requests cannot exhaust an organization's allowance, and so rate limits can
+	// be applied per token subject.  Tracing is innermost so handler execution
+	// gets its own span.
 	chiServerOptions := openapi.ChiServerOptions{
 		BaseRouter:       router,
 		ErrorHandlerFunc: handler.HandleError,
 		Middlewares: []openapi.MiddlewareFunc{
+			tracing.Middleware,
+			quota.Middleware,
 			audit.Middleware,
+			ratelimit.Middleware,
 			validator.Middleware,
 		},
 	}
 
-	identity, err := identityclient.New(client, s.IdentityOptions, &s.ClientOptions).APIClient(context.TODO())
-	if err != nil {
-		return nil, err
-	}
+	regionBase := identityclient.NewBaseClient(client, s.RegionOptions, &s.ClientOptions)
+
+	// Tracing and metrics wrap the transport innermost so each retry attempt is recorded.
+	regionBuilder := tracing.NewBuilder[regionapi.ClientWithResponses](metrics.NewBuilder[regionapi.ClientWithResponses](resilience.NewBuilder[regionapi.ClientWithResponses](regionResilience, regionapi.NewBuilder()), "region"), "region")
 
-	region, err := regionclient.New(client, s.RegionOptions, &s.ClientOptions).APIClient(context.TODO())
+	region, err := identityclient.APIClient(context.TODO(), regionBase, regionBuilder)
 	if err != nil {
 		return nil, err
 	}
 
+	checker := health.New(&s.HealthOptions)
+	checker.AddLivenessCheck("kubernetes", health.KubernetesCheck(client, s.CoreOptions.Namespace, &unikornv1.ComputeClusterList{}))
+	checker.AddReadinessCheck("identity", health.IdentityCheck(client, s.IdentityOptions, &s.ClientOptions))
+	checker.AddReadinessCheck("region", health.RegionCheck(client, s.RegionOptions, &s.ClientOptions))
+
+	go func() {
+		if err := checker.Start(context.TODO()); err != nil {
+			fmt.Println(err)
+		}
+	}()
+
 	handlerInterface, err := handler.New(client, s.CoreOptions.Namespace, &s.HandlerOptions, identity, region)
 	if err != nil {
 		return nil, err
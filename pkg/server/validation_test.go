@@ -0,0 +1,78 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server_test
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/getkin/kin-openapi/routers"
+	"github.com/getkin/kin-openapi/routers/legacy"
+	"github.com/stretchr/testify/require"
+
+	"github.com/unikorn-cloud/compute/pkg/openapi"
+)
+
+// validateRequest mirrors what the OpenAPI middleware does before a request
+// reaches a handler, albeit with a different router.
+func validateRequest(t *testing.T, router routers.Router, method, path, body string) error {
+	t.Helper()
+
+	r, err := http.NewRequestWithContext(t.Context(), method, "http://localhost"+path, strings.NewReader(body))
+	require.NoError(t, err)
+
+	r.Header.Set("Content-Type", "application/json")
+
+	route, params, err := router.FindRoute(r)
+	require.NoError(t, err)
+
+	input := &openapi3filter.RequestValidationInput{
+		Request:    r,
+		PathParams: params,
+		Route:      route,
+		Options: &openapi3filter.Options{
+			AuthenticationFunc: openapi3filter.NoopAuthenticationFunc,
+		},
+	}
+
+	return openapi3filter.ValidateRequest(t.Context(), input)
+}
+
+// TestRequestValidation checks malformed identifiers are rejected by the schema
+// rather than being passed on to Kubernetes or the region service.
+func TestRequestValidation(t *testing.T) {
+	t.Parallel()
+
+	spec, err := openapi.GetSwagger()
+	require.NoError(t, err)
+
+	router, err := legacy.NewRouter(spec)
+	require.NoError(t, err)
+
+	require.NoError(t, validateRequest(t, router, http.MethodGet, "/api/v2/instances/a1b2c3", ""))
+	require.Error(t, validateRequest(t, router, http.MethodGet, "/api/v2/instances/Not_A_Name", ""))
+	require.Error(t, validateRequest(t, router, http.MethodGet, "/api/v2/instances?organizationID=..", ""))
+
+	const path = "/api/v1/organizations/org/projects/project/clusters/cluster/evict"
+
+	require.NoError(t, validateRequest(t, router, http.MethodPost, path, `{"machineIDs":["a1b2c3"]}`))
+	require.Error(t, validateRequest(t, router, http.MethodPost, path, `{"machineIDs":[]}`))
+	require.Error(t, validateRequest(t, router, http.MethodPost, path, `{"machineIDs":["../etc"]}`))
+}
@@ -0,0 +1,165 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package tracing extends OpenTelemetry traces, established by the API server
+// middleware or reconcilers, across calls to other services.
+package tracing
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	chi "github.com/go-chi/chi/v5"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.22.0"
+	"go.opentelemetry.io/otel/trace"
+
+	coreconstants "github.com/unikorn-cloud/core/pkg/constants"
+	"github.com/unikorn-cloud/core/pkg/provisioners"
+	identityclient "github.com/unikorn-cloud/identity/pkg/client"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// instrumentationName identifies spans created by this service.
+	instrumentationName = "github.com/unikorn-cloud/compute"
+)
+
+func tracer() trace.Tracer {
+	return otel.Tracer(instrumentationName)
+}
+
+// End records the outcome of an operation and ends the span.  Yields are part
+// of normal reconciliation, so are not considered errors.
+func End(span trace.Span, err error) {
+	if err != nil && !errors.Is(err, provisioners.ErrYield) {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	span.End()
+}
+
+// StartReconcile starts a span for a reconcile of the resource, any calls to other
+// services made with the returned context will be children of this span.
+func StartReconcile(ctx context.Context, controller, operation string, resource client.Object) (context.Context, trace.Span) {
+	labels := resource.GetLabels()
+
+	attributes := []attribute.KeyValue{
+		attribute.String("k8s.namespace.name", resource.GetNamespace()),
+		attribute.String("unikorn.resource.id", resource.GetName()),
+		attribute.String("unikorn.organization.id", labels[coreconstants.OrganizationLabel]),
+		attribute.String("unikorn.project.id", labels[coreconstants.ProjectLabel]),
+	}
+
+	return tracer().Start(ctx, controller+" "+operation, trace.WithSpanKind(trace.SpanKindInternal), trace.WithAttributes(attributes...))
+}
+
+// transport creates a client span for each outbound request.
+type transport struct {
+	next    http.RoundTripper
+	service string
+}
+
+func (t *transport) RoundTrip(r *http.Request) (*http.Response, error) {
+	attributes := []attribute.KeyValue{
+		semconv.PeerService(t.service),
+		semconv.HTTPRequestMethodKey.String(r.Method),
+		semconv.ServerAddress(r.URL.Hostname()),
+		semconv.URLPath(r.URL.Path),
+	}
+
+	ctx, span := tracer().Start(r.Context(), t.service+" "+r.Method, trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(attributes...))
+	defer span.End()
+
+	// Round trippers must not modify the request, and the downstream service
+	// needs to be a child of this span, not the caller's.
+	r = r.Clone(ctx)
+
+	otel.GetTextMapPropagator().Inject(ctx, propagation.HeaderCarrier(r.Header))
+
+	response, err := t.next.RoundTrip(r)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, err
+	}
+
+	span.SetAttributes(semconv.HTTPResponseStatusCode(response.StatusCode))
+
+	if response.StatusCode >= http.StatusInternalServerError {
+		span.SetStatus(codes.Error, http.StatusText(response.StatusCode))
+	}
+
+	return response, nil
+}
+
+// Builder wraps an OpenAPI client builder so that all requests made by the
+// client are traced.
+type Builder[T any] struct {
+	identityclient.Builder[T]
+
+	service string
+}
+
+// NewBuilder returns a traced OpenAPI client builder.
+func NewBuilder[T any](builder identityclient.Builder[T], service string) *Builder[T] {
+	return &Builder[T]{
+		Builder: builder,
+		service: service,
+	}
+}
+
+// WithHTTPClient traces the client's transport.  The client may be shared, so
+// it's copied rather than modified.
+func (b *Builder[T]) WithHTTPClient(client *http.Client) {
+	next := client.Transport
+	if next == nil {
+		next = http.DefaultTransport
+	}
+
+	traced := *client
+	traced.Transport = &transport{
+		next:    next,
+		service: b.service,
+	}
+
+	b.Builder.WithHTTPClient(&traced)
+}
+
+// Middleware creates a span for handler execution, so time spent in the handler
+// can be separated from authentication, authorization and the like.
+func Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		route := r.URL.Path
+
+		if routeContext := chi.RouteContext(r.Context()); routeContext != nil && routeContext.RoutePattern() != "" {
+			route = routeContext.RoutePattern()
+		}
+
+		ctx, span := tracer().Start(r.Context(), "handler "+r.Method+" "+route, trace.WithSpanKind(trace.SpanKindInternal))
+		defer span.End()
+
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
@@ -0,0 +1,94 @@
+/*
+Copyright 2024-2025 the Unikorn Authors.
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tracing_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/unikorn-cloud/compute/pkg/tracing"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+// builder captures the HTTP client it's given.
+type builder struct {
+	client *http.Client
+}
+
+func (b *builder) WithHTTPClient(client *http.Client) {
+	b.client = client
+}
+
+func (b *builder) WithRequestEditorFn(fn func(context.Context, *http.Request) error) {
+}
+
+func (b *builder) Client(hostname string) (*struct{}, error) {
+	return &struct{}{}, nil
+}
+
+// TestTransport ensures outbound requests get a client span that's a child of
+// the caller's, and the downstream service is a child of the client span.
+//
+//nolint:paralleltest
+func TestTransport(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+
+	var downstream trace.SpanContext
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		downstream = trace.SpanContextFromContext(otel.GetTextMapPropagator().Extract(r.Context(), propagation.HeaderCarrier(r.Header)))
+	}))
+	defer server.Close()
+
+	inner := &builder{}
+
+	tracing.NewBuilder[struct{}](inner, "test").WithHTTPClient(server.Client())
+
+	ctx, span := tracing.StartReconcile(t.Context(), "test", "provision", &corev1.ConfigMap{})
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+	require.NoError(t, err)
+
+	response, err := inner.client.Do(request)
+	require.NoError(t, err)
+	require.NoError(t, response.Body.Close())
+
+	tracing.End(span, nil)
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 2)
+
+	client, parent := spans[0], spans[1]
+
+	require.Equal(t, "test GET", client.Name())
+	require.Equal(t, parent.SpanContext().SpanID(), client.Parent().SpanID())
+	require.Equal(t, client.SpanContext().SpanID(), downstream.SpanID())
+}
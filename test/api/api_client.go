@@ -23,7 +23,6 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"io"
 	"net/http"
 	"net/url"
 	"strings"
@@ -346,7 +345,7 @@ func (c *APIClient) EvictMachines(ctx context.Context, orgID, projectID, cluster
 	path := c.endpoints.EvictMachines(orgID, projectID, clusterID)
 
 	body := openapi.EvictionWrite{
-		MachineIDs: machineIDs,
+		MachineIDs: &machineIDs,
 	}
 
 	bodyBytes, err := json.Marshal(body)
@@ -363,61 +362,18 @@ func (c *APIClient) EvictMachines(ctx context.Context, orgID, projectID, cluster
 	return nil
 }
 
-// QuotaInfo represents quota information for a resource.
-type QuotaInfo struct {
-	Kind        string `json:"kind"`
-	DisplayName string `json:"displayName"`
-	Description string `json:"description"`
-	Quantity    int    `json:"quantity"`
-	Used        int    `json:"used"`
-	Committed   int    `json:"committed"`
-	Reserved    int    `json:"reserved"`
-	Default     int    `json:"default"`
-	Free        int    `json:"free"`
-}
-
-// QuotaResponse represents the quota API response structure.
-type QuotaResponse struct {
-	Quotas []QuotaInfo `json:"quotas"`
-}
-
 // CheckClusterQuota checks if there is sufficient cluster quota available.
 func (c *APIClient) CheckClusterQuota(ctx context.Context, orgID string) error {
-	// Build the quota URL using the identity base URL
-	quotaURL := fmt.Sprintf("%s/api/v1/organizations/%s/quotas",
-		strings.TrimSuffix(c.config.IdentityBaseURL, "/"), orgID)
-
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, quotaURL, nil)
-	if err != nil {
-		return fmt.Errorf("creating quota request: %w", err)
-	}
-
-	// Add auth token
-	if c.config.AuthToken != "" {
-		req.Header.Set("Authorization", "Bearer "+c.config.AuthToken)
-	}
+	path := c.endpoints.GetComputeQuotas(orgID)
 
-	client := &http.Client{Timeout: c.config.RequestTimeout}
-
-	resp, err := client.Do(req)
+	//nolint:bodyclose // response body is closed in DoRequest
+	_, respBody, err := c.DoRequest(ctx, http.MethodGet, path, nil, http.StatusOK)
 	if err != nil {
 		return fmt.Errorf("checking quota: %w", err)
 	}
 
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		bodyBytes, _ := io.ReadAll(resp.Body)
-		return fmt.Errorf("quota check failed with status %d: %s", resp.StatusCode, string(bodyBytes))
-	}
-
-	bodyBytes, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return fmt.Errorf("reading quota response: %w", err)
-	}
-
-	var response QuotaResponse
-	if err := json.Unmarshal(bodyBytes, &response); err != nil {
+	var response openapi.ComputeQuotas
+	if err := json.Unmarshal(respBody, &response); err != nil {
 		return fmt.Errorf("parsing quota response: %w", err)
 	}
 
@@ -425,10 +381,10 @@ func (c *APIClient) CheckClusterQuota(ctx context.Context, orgID string) error {
 	for _, quota := range response.Quotas {
 		if quota.Kind == "clusters" {
 			if quota.Free <= 0 {
-				return fmt.Errorf("insufficient cluster quota: free=%d, used=%d, quantity=%d (need at least 1 free cluster)", quota.Free, quota.Used, quota.Quantity)
+				return fmt.Errorf("insufficient cluster quota: free=%d, committed=%d, quantity=%d (need at least 1 free cluster)", quota.Free, quota.Committed, quota.Quantity)
 			}
 			// Quota is sufficient
-			ginkgo.GinkgoWriter.Printf("Cluster quota check passed: free=%d, used=%d, quantity=%d\n", quota.Free, quota.Used, quota.Quantity)
+			ginkgo.GinkgoWriter.Printf("Cluster quota check passed: free=%d, committed=%d, quantity=%d\n", quota.Free, quota.Committed, quota.Quantity)
 
 			return nil
 		}
@@ -46,6 +46,11 @@ func (e *Endpoints) ListImages(orgID, regionID string) string {
 		url.PathEscape(orgID), url.PathEscape(regionID))
 }
 
+func (e *Endpoints) GetComputeQuotas(orgID string) string {
+	return fmt.Sprintf("/api/v1/organizations/%s/quotas/compute",
+		url.PathEscape(orgID))
+}
+
 // Cluster management endpoints.
 func (e *Endpoints) ListClusters(orgID, projectID string) string {
 	return fmt.Sprintf("/api/v1/organizations/%s/projects/%s/clusters",
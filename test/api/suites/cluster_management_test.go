@@ -56,7 +56,7 @@ var _ = Describe("Core Cluster Management", func() {
 
 				Expect(err).To(HaveOccurred())
 				Expect(err.Error()).To(ContainSubstring("400"))
-				Expect(err.Error()).To(ContainSubstring("region ID is invalid or cannot be resolved"))
+				Expect(err.Error()).To(ContainSubstring("regionId"))
 			})
 			It("should reject cluster creation with invalid flavor", func() {
 				_, err := client.CreateCluster(ctx, config.OrgID, config.ProjectID,
@@ -67,6 +67,18 @@ var _ = Describe("Core Cluster Management", func() {
 
 				Expect(err).To(HaveOccurred())
 				Expect(err.Error()).To(ContainSubstring("400"))
+				Expect(err.Error()).To(ContainSubstring("compute.cluster.invalid_flavor"))
+			})
+
+			It("should reject cluster creation with an unknown region", func() {
+				_, err := client.CreateCluster(ctx, config.OrgID, config.ProjectID,
+					api.NewClusterPayload().
+						WithRegionID("unknown-region").
+						BuildTyped())
+
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("400"))
+				Expect(err.Error()).To(ContainSubstring("compute.cluster.invalid_region"))
 			})
 
 			It("should reject cluster creation with invalid image", func() {
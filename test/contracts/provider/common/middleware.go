@@ -0,0 +1,56 @@
+//go:build integration
+
+/*
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"net/http"
+
+	identityapi "github.com/unikorn-cloud/identity/pkg/openapi"
+	"github.com/unikorn-cloud/identity/pkg/principal"
+	"github.com/unikorn-cloud/identity/pkg/rbac"
+)
+
+// MockACLMiddleware injects a global read-only ACL into the request context so the
+// handler's RBAC checks pass without requiring real authentication.  Organization
+// and project IDs come from the consumer contract, so access is granted globally
+// rather than to a fixed set of scopes.
+func MockACLMiddleware() func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			endpoints := identityapi.AclEndpoints{
+				{Name: "compute:clusters", Operations: identityapi.AclOperations{identityapi.Read}},
+				{Name: "compute:instances", Operations: identityapi.AclOperations{identityapi.Read}},
+			}
+
+			acl := &identityapi.Acl{
+				Global: &endpoints,
+			}
+
+			ctx := rbac.NewContext(r.Context(), acl)
+
+			p := &principal.Principal{
+				Actor: "test-user@example.com",
+			}
+
+			ctx = principal.NewContext(ctx, p)
+
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
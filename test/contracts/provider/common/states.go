@@ -0,0 +1,261 @@
+//go:build integration
+
+/*
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	unikornv1 "github.com/unikorn-cloud/compute/pkg/apis/unikorn/v1alpha1"
+	unikornv1core "github.com/unikorn-cloud/core/pkg/apis/unikorn/v1alpha1"
+	coreclient "github.com/unikorn-cloud/core/pkg/client"
+	coreconstants "github.com/unikorn-cloud/core/pkg/constants"
+	regionconstants "github.com/unikorn-cloud/region/pkg/constants"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+const (
+	// TestNamespace is the namespace the fake data layer and handler use.
+	TestNamespace = "default"
+
+	// Default test resource IDs, used when the consumer doesn't provide one.
+	TestOrganizationID = "test-org-id"
+	TestProjectID      = "test-project-id"
+	TestRegionID       = "test-region-id"
+	TestNetworkID      = "test-network-id"
+	TestClusterID      = "test-cluster-id"
+	TestInstanceID     = "test-instance-id"
+	TestFlavorID       = "test-flavor-id"
+	TestImageID        = "test-image-id"
+)
+
+const (
+	// Pact state names - these must match the consumer contract states.
+	StateOrganizationHasClusters   = "organization has clusters"
+	StateOrganizationHasNoClusters = "organization has no clusters"
+	StateClusterExists             = "cluster exists"
+	StateClusterDoesNotExist       = "cluster does not exist"
+	StateProjectHasInstances       = "project has instances"
+	StateProjectHasNoInstances     = "project has no instances"
+	StateInstanceExists            = "instance exists"
+	StateInstanceDoesNotExist      = "instance does not exist"
+
+	// State parameter keys.
+	ParamOrganizationID = "organizationID"
+	ParamProjectID      = "projectID"
+	ParamClusterID      = "clusterID"
+	ParamInstanceID     = "instanceID"
+)
+
+var (
+	// ErrParameter is returned when a state parameter is malformed.
+	ErrParameter = errors.New("parameter error")
+)
+
+// NewClient returns a fake Kubernetes client that acts as the data layer
+// for provider verification, so no cluster is required.
+func NewClient() (client.Client, error) {
+	scheme, err := coreclient.NewScheme(unikornv1.AddToScheme)
+	if err != nil {
+		return nil, err
+	}
+
+	return fake.NewClientBuilder().WithScheme(scheme).Build(), nil
+}
+
+// StateManager seeds the data layer with resources for each provider state.
+type StateManager struct {
+	client    client.Client
+	namespace string
+}
+
+// NewStateManager creates a new state manager.
+func NewStateManager(client client.Client) *StateManager {
+	return &StateManager{
+		client:    client,
+		namespace: TestNamespace,
+	}
+}
+
+// getStringParam returns a string parameter, or the default if not set.
+func getStringParam(params map[string]any, key, defaultValue string) (string, error) {
+	value, ok := params[key]
+	if !ok {
+		return defaultValue, nil
+	}
+
+	s, ok := value.(string)
+	if !ok {
+		return "", fmt.Errorf("%w: parameter %s is not a string", ErrParameter, key)
+	}
+
+	return s, nil
+}
+
+// identifiers are the set of parameters that states may define.
+type identifiers struct {
+	organizationID string
+	projectID      string
+	clusterID      string
+	instanceID     string
+}
+
+func getIdentifiers(params map[string]any) (*identifiers, error) {
+	var (
+		ids identifiers
+		err error
+	)
+
+	if ids.organizationID, err = getStringParam(params, ParamOrganizationID, TestOrganizationID); err != nil {
+		return nil, err
+	}
+
+	if ids.projectID, err = getStringParam(params, ParamProjectID, TestProjectID); err != nil {
+		return nil, err
+	}
+
+	if ids.clusterID, err = getStringParam(params, ParamClusterID, TestClusterID); err != nil {
+		return nil, err
+	}
+
+	if ids.instanceID, err = getStringParam(params, ParamInstanceID, TestInstanceID); err != nil {
+		return nil, err
+	}
+
+	return &ids, nil
+}
+
+// objectMeta returns common project scoped metadata.
+func (sm *StateManager) objectMeta(ids *identifiers, id, name string) metav1.ObjectMeta {
+	return metav1.ObjectMeta{
+		Namespace:         sm.namespace,
+		Name:              id,
+		CreationTimestamp: metav1.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC),
+		Labels: map[string]string{
+			coreconstants.NameLabel:         name,
+			coreconstants.OrganizationLabel: ids.organizationID,
+			coreconstants.ProjectLabel:      ids.projectID,
+		},
+		Annotations: map[string]string{
+			coreconstants.CreatorAnnotation: "test-user@example.com",
+		},
+	}
+}
+
+func (sm *StateManager) createCluster(ctx context.Context, ids *identifiers) error {
+	cluster := &unikornv1.ComputeCluster{
+		ObjectMeta: sm.objectMeta(ids, ids.clusterID, "test-cluster"),
+		Spec: unikornv1.ComputeClusterSpec{
+			RegionID: TestRegionID,
+			WorkloadPools: &unikornv1.ComputeClusterWorkloadPoolsSpec{
+				Pools: []unikornv1.ComputeClusterWorkloadPoolSpec{
+					{
+						Name: "default",
+						MachineGeneric: unikornv1core.MachineGeneric{
+							Replicas: 1,
+							FlavorID: TestFlavorID,
+							ImageID:  TestImageID,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	cluster.Labels[regionconstants.RegionLabel] = TestRegionID
+
+	if err := sm.client.Create(ctx, cluster); err != nil {
+		return fmt.Errorf("creating cluster: %w", err)
+	}
+
+	return nil
+}
+
+func (sm *StateManager) createInstance(ctx context.Context, ids *identifiers) error {
+	instance := &unikornv1.ComputeInstance{
+		ObjectMeta: sm.objectMeta(ids, ids.instanceID, "test-instance"),
+		Spec: unikornv1.ComputeInstanceSpec{
+			MachineGeneric: unikornv1core.MachineGeneric{
+				FlavorID: TestFlavorID,
+				ImageID:  TestImageID,
+			},
+		},
+	}
+
+	instance.Labels[regionconstants.RegionLabel] = TestRegionID
+	instance.Labels[regionconstants.NetworkLabel] = TestNetworkID
+
+	if err := sm.client.Create(ctx, instance); err != nil {
+		return fmt.Errorf("creating instance: %w", err)
+	}
+
+	return nil
+}
+
+// cleanup removes all resources from the data layer.
+func (sm *StateManager) cleanup(ctx context.Context) error {
+	if err := sm.client.DeleteAllOf(ctx, &unikornv1.ComputeCluster{}, client.InNamespace(sm.namespace)); err != nil {
+		return fmt.Errorf("deleting clusters: %w", err)
+	}
+
+	if err := sm.client.DeleteAllOf(ctx, &unikornv1.ComputeInstance{}, client.InNamespace(sm.namespace)); err != nil {
+		return fmt.Errorf("deleting instances: %w", err)
+	}
+
+	return nil
+}
+
+// handle resets the data layer, and on setup seeds it using the provided callback.
+func (sm *StateManager) handle(ctx context.Context, setup bool, params map[string]any, seed func(context.Context, *identifiers) error) error {
+	if err := sm.cleanup(ctx); err != nil {
+		return err
+	}
+
+	if !setup || seed == nil {
+		return nil
+	}
+
+	ids, err := getIdentifiers(params)
+	if err != nil {
+		return err
+	}
+
+	return seed(ctx, ids)
+}
+
+// HandleClusterState handles states that require a cluster to exist.
+func (sm *StateManager) HandleClusterState(ctx context.Context, setup bool, params map[string]any) error {
+	return sm.handle(ctx, setup, params, sm.createCluster)
+}
+
+// HandleInstanceState handles states that require an instance to exist.
+func (sm *StateManager) HandleInstanceState(ctx context.Context, setup bool, params map[string]any) error {
+	return sm.handle(ctx, setup, params, sm.createInstance)
+}
+
+// HandleEmptyState handles states that require no resources to exist.
+func (sm *StateManager) HandleEmptyState(ctx context.Context, setup bool, params map[string]any) error {
+	return sm.handle(ctx, setup, params, nil)
+}
@@ -0,0 +1,246 @@
+//go:build integration
+
+/*
+Copyright 2026 Nscale.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	chi "github.com/go-chi/chi/v5"
+	. "github.com/onsi/ginkgo/v2" //nolint:revive
+	. "github.com/onsi/gomega"    //nolint:revive
+	"github.com/pact-foundation/pact-go/v2/models"
+	"github.com/pact-foundation/pact-go/v2/provider"
+
+	"github.com/unikorn-cloud/compute/pkg/constants"
+	"github.com/unikorn-cloud/compute/pkg/openapi"
+	"github.com/unikorn-cloud/compute/pkg/server/handler"
+	"github.com/unikorn-cloud/compute/test/contracts/provider/common"
+	"github.com/unikorn-cloud/core/pkg/openapi/helpers"
+	"github.com/unikorn-cloud/core/pkg/server/middleware/logging"
+	"github.com/unikorn-cloud/core/pkg/server/middleware/routeresolver"
+	identityapi "github.com/unikorn-cloud/identity/pkg/openapi"
+	regionapi "github.com/unikorn-cloud/region/pkg/openapi"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// providerName is how compute is known to consumers.
+	providerName = "uni-compute"
+
+	// unavailableURL is used for upstream services, the provider states only
+	// seed the Kubernetes data layer so any upstream call is a contract bug.
+	unavailableURL = "http://127.0.0.1:1"
+)
+
+var testingT *testing.T //nolint:gochecknoglobals
+
+func TestContracts(t *testing.T) { //nolint:paralleltest
+	testingT = t
+
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Compute Provider Contract Verification Suite")
+}
+
+// getenv returns an environment variable, or the default if not set.
+func getenv(key, defaultValue string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+
+	return defaultValue
+}
+
+// startTestServer starts the compute API on an ephemeral port, backed by the
+// provided data layer, and returns its base URL.
+func startTestServer(k8sClient client.Client) (*http.Server, string, error) {
+	schema, err := helpers.NewSchema(openapi.GetSwagger)
+	if err != nil {
+		return nil, "", err
+	}
+
+	identity, err := identityapi.NewClientWithResponses(unavailableURL)
+	if err != nil {
+		return nil, "", err
+	}
+
+	region, err := regionapi.NewClientWithResponses(unavailableURL)
+	if err != nil {
+		return nil, "", err
+	}
+
+	handlerInterface, err := handler.New(k8sClient, common.TestNamespace, &handler.Options{}, identity, region)
+	if err != nil {
+		return nil, "", err
+	}
+
+	// Authentication and authorization are replaced by a mock ACL, everything
+	// else mirrors the production middleware chain.
+	router := chi.NewRouter()
+	router.Use(logging.New().Middleware)
+	router.Use(routeresolver.New(schema).Middleware)
+	router.Use(common.MockACLMiddleware())
+	router.NotFound(http.HandlerFunc(handler.NotFound))
+	router.MethodNotAllowed(http.HandlerFunc(handler.MethodNotAllowed))
+
+	chiServerOptions := openapi.ChiServerOptions{
+		BaseRouter:       router,
+		ErrorHandlerFunc: handler.HandleError,
+	}
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, "", err
+	}
+
+	server := &http.Server{
+		ReadHeaderTimeout: 10 * time.Second,
+		Handler:           openapi.HandlerWithOptions(handlerInterface, chiServerOptions),
+	}
+
+	go func() {
+		if err := server.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			fmt.Printf("server error: %v\n", err)
+		}
+	}()
+
+	return server, "http://" + listener.Addr().String(), nil
+}
+
+// createStateHandlers maps consumer provider states on to data layer seeding.
+func createStateHandlers(ctx context.Context, stateManager *common.StateManager) models.StateHandlers {
+	handle := func(f func(context.Context, bool, map[string]any) error) models.StateHandler {
+		return func(setup bool, state models.ProviderState) (models.ProviderStateResponse, error) {
+			return nil, f(ctx, setup, state.Parameters)
+		}
+	}
+
+	return models.StateHandlers{
+		common.StateOrganizationHasClusters:   handle(stateManager.HandleClusterState),
+		common.StateOrganizationHasNoClusters: handle(stateManager.HandleEmptyState),
+		common.StateClusterExists:             handle(stateManager.HandleClusterState),
+		common.StateClusterDoesNotExist:       handle(stateManager.HandleEmptyState),
+		common.StateProjectHasInstances:       handle(stateManager.HandleInstanceState),
+		common.StateProjectHasNoInstances:     handle(stateManager.HandleEmptyState),
+		common.StateInstanceExists:            handle(stateManager.HandleInstanceState),
+		common.StateInstanceDoesNotExist:      handle(stateManager.HandleEmptyState),
+	}
+}
+
+var _ = Describe("Compute Provider Verification", func() {
+	var (
+		ctx          context.Context
+		cancel       context.CancelFunc
+		server       *http.Server
+		serverURL    string
+		stateManager *common.StateManager
+	)
+
+	BeforeEach(func() {
+		//nolint:fatcontext
+		ctx, cancel = context.WithCancel(context.Background())
+
+		k8sClient, err := common.NewClient()
+		Expect(err).NotTo(HaveOccurred())
+
+		stateManager = common.NewStateManager(k8sClient)
+
+		server, serverURL, err = startTestServer(k8sClient)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		shutdownCtx, shutdownCancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer shutdownCancel()
+
+		Expect(server.Shutdown(shutdownCtx)).To(Succeed())
+
+		cancel()
+	})
+
+	Describe("Verifying pacts from Pact Broker", func() {
+		It("should verify all consumer contracts", func() {
+			brokerURL := os.Getenv("PACT_BROKER_URL")
+			if brokerURL == "" {
+				Skip("PACT_BROKER_URL environment variable not set, skipping broker verification")
+			}
+
+			selectors := []provider.Selector{
+				&provider.ConsumerVersionSelector{
+					MainBranch: true,
+				},
+				&provider.ConsumerVersionSelector{
+					MatchingBranch: true,
+				},
+				&provider.ConsumerVersionSelector{
+					DeployedOrReleased: true,
+				},
+			}
+
+			// Webhook triggered verification for a specific consumer branch.
+			if branch := strings.TrimSpace(os.Getenv("CONSUMER_BRANCH")); branch != "" {
+				selectors = append(selectors, &provider.ConsumerVersionSelector{
+					Branch: branch,
+				})
+			}
+
+			err := provider.NewVerifier().VerifyProvider(testingT, provider.VerifyRequest{
+				ProviderBaseURL:            serverURL,
+				Provider:                   providerName,
+				BrokerURL:                  brokerURL,
+				BrokerUsername:             getenv("PACT_BROKER_USERNAME", "pact"),
+				BrokerPassword:             getenv("PACT_BROKER_PASSWORD", "pact"),
+				PublishVerificationResults: os.Getenv("CI") == "true" || os.Getenv("PUBLISH_VERIFICATION") == "true",
+				ProviderVersion:            getenv("PROVIDER_VERSION", constants.Version),
+				ProviderBranch:             os.Getenv("GIT_BRANCH"),
+				ConsumerVersionSelectors:   selectors,
+				EnablePending:              true,
+				StateHandlers:              createStateHandlers(ctx, stateManager),
+			})
+
+			Expect(err).NotTo(HaveOccurred(), "provider verification should succeed")
+		})
+	})
+
+	Describe("Verifying pacts from local files", func() {
+		It("should verify local pact files", func() {
+			pactFile := os.Getenv("PACT_FILE")
+			if pactFile == "" {
+				Skip("PACT_FILE environment variable not set, skipping local file verification")
+			}
+
+			err := provider.NewVerifier().VerifyProvider(testingT, provider.VerifyRequest{
+				ProviderBaseURL: serverURL,
+				Provider:        providerName,
+				PactFiles:       []string{pactFile},
+				StateHandlers:   createStateHandlers(ctx, stateManager),
+			})
+
+			Expect(err).NotTo(HaveOccurred(), "provider verification should succeed")
+		})
+	})
+})